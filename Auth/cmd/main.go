@@ -4,11 +4,14 @@ import (
 	"Classroom/Auth/internal/app"
 	"Classroom/Auth/internal/config"
 	"Classroom/Auth/internal/controller"
+	"Classroom/Auth/internal/producer"
 	"Classroom/Auth/internal/repo"
 	"Classroom/Auth/internal/service"
+	"Classroom/Auth/pkg/jwks"
 	"Classroom/Auth/pkg/postgres"
 	"Classroom/Auth/pkg/redis"
 	"context"
+	"log"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -27,9 +30,15 @@ func main() {
 	redis := redis.MustNew(conf.RedisURL)
 	defer redis.Close()
 
+	producer := producer.MustNewProducer([]string{conf.KafkaBroker})
+	defer producer.Close()
+
 	userRepo := repo.NewUserRepo(postgres)
 	tokenRepo := repo.NewTokenRepo(redis)
-	authService := service.NewAuthService(logger, userRepo, tokenRepo, conf.Auth)
+	lockoutRepo := repo.NewLockoutRepo(redis)
+	accessTokenRepo := repo.NewAccessTokenRepo(postgres)
+	keys := mustLoadKeys(logger, conf.Auth)
+	authService := service.NewAuthService(logger, userRepo, tokenRepo, lockoutRepo, accessTokenRepo, producer, keys, conf.Auth)
 	authController := controller.NewAuthController(logger, authService)
 
 	app := app.New(logger, conf, authController)
@@ -43,6 +52,20 @@ func main() {
 	app.Stop()
 }
 
+// Без настроенных ключей генерируем временный, подходит только для локальной разработки с одним инстансом
+func mustLoadKeys(logger *slog.Logger, conf config.Auth) *jwks.KeySet {
+	if conf.SigningKeysDir != "" {
+		return jwks.MustLoad(conf.SigningKeysDir, conf.ActiveKeyID)
+	}
+
+	logger.Warn("signing keys dir is not set, generating temporary signing key")
+	keys, err := jwks.Generate()
+	if err != nil {
+		log.Fatalf("failed to generate signing key: %v", err)
+	}
+	return keys
+}
+
 // Загружаем переменные окружения перед стартом, не проверяем ошибку потому что не всегда будет .env файл
 func init() {
 	godotenv.Load()
@@ -13,13 +13,47 @@ type Config struct {
 	Port        int    `mapstructure:"port"`
 	PostgresURL string `mapstructure:"postgres_url"`
 	RedisURL    string `mapstructure:"redis_url"`
+	KafkaBroker string `mapstructure:"kafka_broker"`
 	Auth        Auth   `mapstructure:"auth"`
 }
 
 type Auth struct {
-	JwtSecret  string        `mapstructure:"jwt_secret"`
-	AccessTTL  time.Duration `mapstructure:"access_ttl"`
-	RefreshTTL time.Duration `mapstructure:"refresh_ttl"`
+	// Директория с приватными ключами подписи в PEM, имя файла - kid.
+	// Если не задана, при старте генерируется временный ключ
+	SigningKeysDir string `mapstructure:"signing_keys_dir"`
+	// kid ключа, которым подписываются новые токены
+	ActiveKeyID string        `mapstructure:"active_key_id"`
+	AccessTTL   time.Duration `mapstructure:"access_ttl"`
+	RefreshTTL  time.Duration `mapstructure:"refresh_ttl"`
+	// Время жизни токена для сброса пароля
+	PasswordResetTTL time.Duration `mapstructure:"password_reset_ttl"`
+	// Время жизни токена для подтверждения почты
+	EmailVerificationTTL time.Duration `mapstructure:"email_verification_ttl"`
+	// Запрещать вход пользователям с неподтвержденной почтой
+	RequireEmailVerification bool `mapstructure:"require_email_verification"`
+	// Время, за которое нужно ввести код MFA после ввода пароля
+	MFAChallengeTTL time.Duration `mapstructure:"mfa_challenge_ttl"`
+	// Название сервиса в приложении-аутентификаторе
+	MFAIssuer string `mapstructure:"mfa_issuer"`
+	// Максимальный срок действия персонального токена доступа
+	AccessTokenMaxTTL time.Duration `mapstructure:"access_token_max_ttl"`
+	// Защита от перебора паролей
+	Lockout Lockout `mapstructure:"lockout"`
+}
+
+type Lockout struct {
+	// Неудачные попытки сбрасываются, если их не было в течение этого времени
+	FailureWindow time.Duration `mapstructure:"failure_window"`
+	// После стольких неудачных попыток между попытками появляется задержка,
+	// которая удваивается с каждой следующей неудачей
+	BackoffAfter int           `mapstructure:"backoff_after"`
+	BackoffBase  time.Duration `mapstructure:"backoff_base"`
+	BackoffMax   time.Duration `mapstructure:"backoff_max"`
+	// После стольких неудачных попыток вход блокируется на Duration.
+	// Для IP порог выше, так как за одним адресом может быть много пользователей
+	MaxEmailFailures int           `mapstructure:"max_email_failures"`
+	MaxIPFailures    int           `mapstructure:"max_ip_failures"`
+	Duration         time.Duration `mapstructure:"duration"`
 }
 
 func MustNew() *Config {
@@ -33,9 +67,24 @@ func MustNew() *Config {
 	v.BindEnv("port")
 	v.BindEnv("postgres_url")
 	v.BindEnv("redis_url")
-	v.BindEnv("auth.jwt_secret")
+	v.BindEnv("kafka_broker")
+	v.BindEnv("auth.signing_keys_dir")
+	v.BindEnv("auth.active_key_id")
 	v.BindEnv("auth.access_ttl")
 	v.BindEnv("auth.refresh_ttl")
+	v.BindEnv("auth.password_reset_ttl")
+	v.BindEnv("auth.email_verification_ttl")
+	v.BindEnv("auth.require_email_verification")
+	v.BindEnv("auth.mfa_challenge_ttl")
+	v.BindEnv("auth.mfa_issuer")
+	v.BindEnv("auth.access_token_max_ttl")
+	v.BindEnv("auth.lockout.failure_window")
+	v.BindEnv("auth.lockout.backoff_after")
+	v.BindEnv("auth.lockout.backoff_base")
+	v.BindEnv("auth.lockout.backoff_max")
+	v.BindEnv("auth.lockout.max_email_failures")
+	v.BindEnv("auth.lockout.max_ip_failures")
+	v.BindEnv("auth.lockout.duration")
 
 	v.SetConfigFile(*configPath)
 
@@ -4,24 +4,56 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
+	"time"
 
 	"Classroom/Auth/internal/dto"
 	"Classroom/Auth/internal/entities"
 	"Classroom/Auth/internal/service"
 	pb "Classroom/Auth/pkg/api/auth"
+	"Classroom/Auth/pkg/jwks"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AuthService interface {
 	Register(ctx context.Context, dto dto.RegisterDTO) (string, error)
 	Login(ctx context.Context, dto dto.LoginDTO) (dto.TokensDTO, error)
-	Refresh(ctx context.Context, refreshToken string) (string, error)
+	Refresh(ctx context.Context, refreshToken string) (dto.TokensDTO, error)
 	Logout(ctx context.Context, refreshToken string) error
 	GetUserInfo(ctx context.Context, userID string) (entities.User, error)
+	RequestPasswordReset(ctx context.Context, dto dto.RequestPasswordResetDTO) error
+	ResetPassword(ctx context.Context, dto dto.ResetPasswordDTO) error
+	VerifyEmail(ctx context.Context, dto dto.VerifyEmailDTO) (string, error)
+	ResendVerificationEmail(ctx context.Context, dto dto.ResendVerificationEmailDTO) error
+	GetJWKS(ctx context.Context) []jwks.JWK
+	ListSessions(ctx context.Context, userID string) ([]entities.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	RevokeAllSessions(ctx context.Context, userID string) error
+	SetupMFA(ctx context.Context, userID string) (dto.MFASetupDTO, error)
+	ConfirmMFA(ctx context.Context, dto dto.ConfirmMFADTO) ([]string, error)
+	DisableMFA(ctx context.Context, dto dto.DisableMFADTO) error
+	VerifyMFA(ctx context.Context, dto dto.VerifyMFADTO) (dto.TokensDTO, error)
+	ListLockouts(ctx context.Context) ([]entities.LoginAttempts, error)
+	ClearLockout(ctx context.Context, dto dto.ClearLockoutDTO) error
+	CreateAccessToken(ctx context.Context, dto dto.CreateAccessTokenDTO) (entities.AccessToken, string, error)
+	ListAccessTokens(ctx context.Context, userID string) ([]entities.AccessToken, error)
+	RevokeAccessToken(ctx context.Context, userID, tokenID string) error
+	IntrospectAccessToken(ctx context.Context, token string) (dto.AccessTokenInfoDTO, error)
+	UpdateProfile(ctx context.Context, dto dto.UpdateProfileDTO) (entities.User, error)
+	ChangePassword(ctx context.Context, dto dto.ChangePasswordDTO) error
+	ChangeEmail(ctx context.Context, dto dto.ChangeEmailDTO) error
+	ListUsers(ctx context.Context, dto dto.ListUsersDTO) ([]entities.User, int, error)
+	GetUser(ctx context.Context, userID string) (entities.User, error)
+	SetSuperuser(ctx context.Context, userID string, isSuperUser bool) error
+	DisableUser(ctx context.Context, userID string, disabled bool) error
+	DeleteUser(ctx context.Context, userID string) error
 }
 
 type authController struct {
@@ -82,8 +114,10 @@ func (c *authController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 
 	// преобразование в dto для передачи между слоями
 	dto := dto.LoginDTO{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: req.UserAgent,
+		IP:        req.Ip,
 	}
 
 	// валидация данных
@@ -94,15 +128,31 @@ func (c *authController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 
 	tokens, err := c.svc.Login(ctx, dto)
 
+	var tooManyAttempts *service.TooManyAttemptsError
+	if errors.As(err, &tooManyAttempts) {
+		logger.Debug("too many login attempts", "retry_after", tooManyAttempts.RetryAfter)
+		return nil, retryAfterError("too many login attempts", tooManyAttempts.RetryAfter)
+	}
 	if errors.Is(err, service.ErrInvalidCredentials) {
 		logger.Debug("invalid credentials")
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
+	if errors.Is(err, service.ErrUserDisabled) {
+		logger.Debug("user disabled")
+		return nil, status.Error(codes.PermissionDenied, "user disabled")
+	}
+	if errors.Is(err, service.ErrEmailNotVerified) {
+		logger.Debug("email not verified")
+		return nil, status.Error(codes.PermissionDenied, "email not verified")
+	}
 	if err != nil {
 		logger.Error("failed to login user", "err", err)
 		return nil, status.Error(codes.Internal, "failed to login user")
 	}
 
+	if tokens.MFAToken != "" {
+		return &pb.LoginResponse{MfaRequired: true, MfaToken: tokens.MFAToken}, nil
+	}
 	return &pb.LoginResponse{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
 }
 
@@ -110,18 +160,22 @@ func (c *authController) Refresh(ctx context.Context, req *pb.RefreshRequest) (*
 	const op = "controller.Refresh"
 	logger := c.logger.With(slog.String("op", op))
 
-	accessToken, err := c.svc.Refresh(ctx, req.RefreshToken)
+	tokens, err := c.svc.Refresh(ctx, req.RefreshToken)
 
 	if errors.Is(err, service.ErrInvalidToken) {
 		logger.Debug("invalid token")
 		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
 	}
+	if errors.Is(err, service.ErrUserDisabled) {
+		logger.Debug("user disabled")
+		return nil, status.Error(codes.PermissionDenied, "user disabled")
+	}
 	if err != nil {
 		logger.Error("failed to refresh token", "err", err)
 		return nil, status.Error(codes.Internal, "failed to refresh token")
 	}
 
-	return &pb.RefreshResponse{AccessToken: accessToken}, nil
+	return &pb.RefreshResponse{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
 }
 
 func (c *authController) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
@@ -150,10 +204,739 @@ func (c *authController) GetUserInfo(ctx context.Context, req *pb.GetUserInfoReq
 	}
 
 	return &pb.GetUserInfoResponse{
-		UserId:      user.ID,
-		Email:       user.Email,
-		FirstName:   user.FirstName,
-		LastName:    user.LastName,
-		IsSuperuser: user.IsSuperUser,
+		UserId:        user.ID,
+		Email:         user.Email,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		IsSuperuser:   user.IsSuperUser,
+		EmailVerified: user.EmailVerifiedAt != nil,
+	}, nil
+}
+
+func (c *authController) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	const op = "controller.RequestPasswordReset"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.RequestPasswordResetDTO{
+		Email: req.Email,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	if err := c.svc.RequestPasswordReset(ctx, dto); err != nil {
+		logger.Error("failed to request password reset", "err", err)
+		return nil, status.Error(codes.Internal, "failed to request password reset")
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (c *authController) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	const op = "controller.ResetPassword"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ResetPasswordDTO{
+		Token:    req.Token,
+		Password: req.NewPassword,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	err := c.svc.ResetPassword(ctx, dto)
+
+	if errors.Is(err, service.ErrInvalidToken) {
+		logger.Debug("invalid token")
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired reset token")
+	}
+	if err != nil {
+		logger.Error("failed to reset password", "err", err)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+func (c *authController) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	const op = "controller.VerifyEmail"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.VerifyEmailDTO{
+		Token: req.Token,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := c.svc.VerifyEmail(ctx, dto)
+
+	if errors.Is(err, service.ErrInvalidToken) {
+		logger.Debug("invalid token")
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification token")
+	}
+	if err != nil {
+		logger.Error("failed to verify email", "err", err)
+		return nil, status.Error(codes.Internal, "failed to verify email")
+	}
+
+	return &pb.VerifyEmailResponse{UserId: userID}, nil
+}
+
+func (c *authController) ResendVerificationEmail(ctx context.Context, req *pb.ResendVerificationEmailRequest) (*pb.ResendVerificationEmailResponse, error) {
+	const op = "controller.ResendVerificationEmail"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ResendVerificationEmailDTO{
+		Email: req.Email,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	if err := c.svc.ResendVerificationEmail(ctx, dto); err != nil {
+		logger.Error("failed to resend verification email", "err", err)
+		return nil, status.Error(codes.Internal, "failed to resend verification email")
+	}
+
+	return &pb.ResendVerificationEmailResponse{}, nil
+}
+
+func (c *authController) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	keys := c.svc.GetJWKS(ctx)
+
+	resp := &pb.GetJWKSResponse{Keys: make([]*pb.JWK, 0, len(keys))}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, &pb.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return resp, nil
+}
+
+func (c *authController) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	sessions, err := c.svc.ListSessions(ctx, req.UserId)
+	if err != nil {
+		c.logger.Error("failed to list sessions", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	resp := &pb.ListSessionsResponse{Sessions: make([]*pb.Session, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &pb.Session{
+			SessionId:  session.ID,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (c *authController) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if err := c.validate.Var(req.SessionId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session id")
+	}
+
+	err := c.svc.RevokeSession(ctx, req.UserId, req.SessionId)
+	if errors.Is(err, service.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to revoke session", "err", err, "id", req.SessionId)
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func (c *authController) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	if err := c.svc.RevokeAllSessions(ctx, req.UserId); err != nil {
+		c.logger.Error("failed to revoke all sessions", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to revoke all sessions")
+	}
+
+	return &pb.RevokeAllSessionsResponse{}, nil
+}
+
+func (c *authController) SetupMFA(ctx context.Context, req *pb.SetupMFARequest) (*pb.SetupMFAResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	setup, err := c.svc.SetupMFA(ctx, req.UserId)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, service.ErrMFAAlreadyEnabled) {
+		return nil, status.Error(codes.AlreadyExists, "mfa already enabled")
+	}
+	if err != nil {
+		c.logger.Error("failed to setup mfa", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to setup mfa")
+	}
+
+	return &pb.SetupMFAResponse{Secret: setup.Secret, OtpauthUri: setup.URI}, nil
+}
+
+func (c *authController) ConfirmMFA(ctx context.Context, req *pb.ConfirmMFARequest) (*pb.ConfirmMFAResponse, error) {
+	const op = "controller.ConfirmMFA"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ConfirmMFADTO{
+		UserID: req.UserId,
+		Code:   req.Code,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	recoveryCodes, err := c.svc.ConfirmMFA(ctx, dto)
+
+	var tooManyAttempts *service.TooManyAttemptsError
+	if errors.As(err, &tooManyAttempts) {
+		logger.Debug("too many mfa attempts", "retry_after", tooManyAttempts.RetryAfter)
+		return nil, retryAfterError("too many mfa attempts", tooManyAttempts.RetryAfter)
+	}
+
+	if errors.Is(err, service.ErrMFANotFound) {
+		logger.Debug("mfa setup not found")
+		return nil, status.Error(codes.FailedPrecondition, "mfa setup not started")
+	}
+	if errors.Is(err, service.ErrMFAAlreadyEnabled) {
+		logger.Debug("mfa already enabled")
+		return nil, status.Error(codes.AlreadyExists, "mfa already enabled")
+	}
+	if errors.Is(err, service.ErrInvalidMFACode) {
+		logger.Debug("invalid mfa code")
+		return nil, status.Error(codes.InvalidArgument, "invalid mfa code")
+	}
+	if err != nil {
+		logger.Error("failed to confirm mfa", "err", err)
+		return nil, status.Error(codes.Internal, "failed to confirm mfa")
+	}
+
+	return &pb.ConfirmMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (c *authController) DisableMFA(ctx context.Context, req *pb.DisableMFARequest) (*pb.DisableMFAResponse, error) {
+	const op = "controller.DisableMFA"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.DisableMFADTO{
+		UserID:   req.UserId,
+		Password: req.Password,
+		Code:     req.Code,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	err := c.svc.DisableMFA(ctx, dto)
+
+	var tooManyAttempts *service.TooManyAttemptsError
+	if errors.As(err, &tooManyAttempts) {
+		logger.Debug("too many mfa attempts", "retry_after", tooManyAttempts.RetryAfter)
+		return nil, retryAfterError("too many mfa attempts", tooManyAttempts.RetryAfter)
+	}
+	if errors.Is(err, service.ErrInvalidCredentials) {
+		logger.Debug("invalid password")
+		return nil, status.Error(codes.PermissionDenied, "invalid password")
+	}
+	if errors.Is(err, service.ErrUserNotFound) {
+		logger.Debug("user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if errors.Is(err, service.ErrMFANotFound) {
+		logger.Debug("mfa not enabled")
+		return nil, status.Error(codes.FailedPrecondition, "mfa not enabled")
+	}
+	if errors.Is(err, service.ErrInvalidMFACode) {
+		logger.Debug("invalid mfa code")
+		return nil, status.Error(codes.InvalidArgument, "invalid mfa code")
+	}
+	if err != nil {
+		logger.Error("failed to disable mfa", "err", err)
+		return nil, status.Error(codes.Internal, "failed to disable mfa")
+	}
+
+	return &pb.DisableMFAResponse{}, nil
+}
+
+func (c *authController) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.VerifyMFAResponse, error) {
+	const op = "controller.VerifyMFA"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.VerifyMFADTO{
+		Token: req.MfaToken,
+		Code:  req.Code,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	tokens, err := c.svc.VerifyMFA(ctx, dto)
+
+	var tooManyAttempts *service.TooManyAttemptsError
+	if errors.As(err, &tooManyAttempts) {
+		logger.Debug("too many mfa attempts", "retry_after", tooManyAttempts.RetryAfter)
+		return nil, retryAfterError("too many mfa attempts", tooManyAttempts.RetryAfter)
+	}
+	if errors.Is(err, service.ErrInvalidToken) {
+		logger.Debug("invalid mfa token")
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired mfa token")
+	}
+	if errors.Is(err, service.ErrInvalidMFACode) {
+		logger.Debug("invalid mfa code")
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+	}
+	if errors.Is(err, service.ErrUserDisabled) {
+		logger.Debug("user disabled")
+		return nil, status.Error(codes.PermissionDenied, "user disabled")
+	}
+	if err != nil {
+		logger.Error("failed to verify mfa", "err", err)
+		return nil, status.Error(codes.Internal, "failed to verify mfa")
+	}
+
+	return &pb.VerifyMFAResponse{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
+}
+
+func (c *authController) ListLockouts(ctx context.Context, req *pb.ListLockoutsRequest) (*pb.ListLockoutsResponse, error) {
+	lockouts, err := c.svc.ListLockouts(ctx)
+	if err != nil {
+		c.logger.Error("failed to list lockouts", "err", err)
+		return nil, status.Error(codes.Internal, "failed to list lockouts")
+	}
+
+	resp := &pb.ListLockoutsResponse{Lockouts: make([]*pb.Lockout, 0, len(lockouts))}
+	for _, lockout := range lockouts {
+		resp.Lockouts = append(resp.Lockouts, &pb.Lockout{
+			Kind:          lockout.Kind,
+			Subject:       lockout.Subject,
+			Failures:      int32(lockout.Failures),
+			LastFailureAt: timestamppb.New(lockout.LastFailureAt),
+			LockedUntil:   timestamppb.New(lockout.LockedUntil),
+		})
+	}
+	return resp, nil
+}
+
+func (c *authController) ClearLockout(ctx context.Context, req *pb.ClearLockoutRequest) (*pb.ClearLockoutResponse, error) {
+	const op = "controller.ClearLockout"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ClearLockoutDTO{
+		Kind:    req.Kind,
+		Subject: req.Subject,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	err := c.svc.ClearLockout(ctx, dto)
+
+	if errors.Is(err, service.ErrLockoutNotFound) {
+		logger.Debug("lockout not found")
+		return nil, status.Error(codes.NotFound, "lockout not found")
+	}
+	if err != nil {
+		logger.Error("failed to clear lockout", "err", err)
+		return nil, status.Error(codes.Internal, "failed to clear lockout")
+	}
+
+	return &pb.ClearLockoutResponse{}, nil
+}
+
+func (c *authController) CreateAccessToken(ctx context.Context, req *pb.CreateAccessTokenRequest) (*pb.CreateAccessTokenResponse, error) {
+	const op = "controller.CreateAccessToken"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.CreateAccessTokenDTO{
+		UserID: req.UserId,
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	}
+	if req.ExpiresAt != nil {
+		dto.ExpiresAt = req.ExpiresAt.AsTime()
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	accessToken, token, err := c.svc.CreateAccessToken(ctx, dto)
+
+	if errors.Is(err, service.ErrInvalidAccessTokenExpiry) {
+		logger.Debug("invalid access token expiry")
+		return nil, status.Error(codes.InvalidArgument, "invalid access token expiry")
+	}
+	if err != nil {
+		logger.Error("failed to create access token", "err", err)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+
+	return &pb.CreateAccessTokenResponse{Token: token, AccessToken: accessTokenToPb(accessToken)}, nil
+}
+
+func (c *authController) ListAccessTokens(ctx context.Context, req *pb.ListAccessTokensRequest) (*pb.ListAccessTokensResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	tokens, err := c.svc.ListAccessTokens(ctx, req.UserId)
+	if err != nil {
+		c.logger.Error("failed to list access tokens", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to list access tokens")
+	}
+
+	resp := &pb.ListAccessTokensResponse{AccessTokens: make([]*pb.AccessToken, 0, len(tokens))}
+	for _, token := range tokens {
+		resp.AccessTokens = append(resp.AccessTokens, accessTokenToPb(token))
+	}
+	return resp, nil
+}
+
+func (c *authController) RevokeAccessToken(ctx context.Context, req *pb.RevokeAccessTokenRequest) (*pb.RevokeAccessTokenResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if err := c.validate.Var(req.TokenId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token id")
+	}
+
+	err := c.svc.RevokeAccessToken(ctx, req.UserId, req.TokenId)
+	if errors.Is(err, service.ErrAccessTokenNotFound) {
+		return nil, status.Error(codes.NotFound, "access token not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to revoke access token", "err", err, "id", req.TokenId)
+		return nil, status.Error(codes.Internal, "failed to revoke access token")
+	}
+
+	return &pb.RevokeAccessTokenResponse{}, nil
+}
+
+func (c *authController) IntrospectAccessToken(ctx context.Context, req *pb.IntrospectAccessTokenRequest) (*pb.IntrospectAccessTokenResponse, error) {
+	info, err := c.svc.IntrospectAccessToken(ctx, req.Token)
+	if errors.Is(err, service.ErrInvalidToken) {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	if err != nil {
+		c.logger.Error("failed to introspect access token", "err", err)
+		return nil, status.Error(codes.Internal, "failed to introspect access token")
+	}
+
+	return &pb.IntrospectAccessTokenResponse{
+		UserId:      info.UserID,
+		IsSuperuser: info.IsSuperUser,
+		Scopes:      info.Scopes,
+		ExpiresAt:   timestamppb.New(info.ExpiresAt),
+	}, nil
+}
+
+func (c *authController) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	const op = "controller.UpdateProfile"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.UpdateProfileDTO{
+		UserID:    req.UserId,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	user, err := c.svc.UpdateProfile(ctx, dto)
+
+	if errors.Is(err, service.ErrUserNotFound) {
+		logger.Debug("user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		logger.Error("failed to update profile", "err", err)
+		return nil, status.Error(codes.Internal, "failed to update profile")
+	}
+
+	return &pb.UpdateProfileResponse{
+		UserId:    user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
 	}, nil
 }
+
+func (c *authController) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	const op = "controller.ChangePassword"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ChangePasswordDTO{
+		UserID:          req.UserId,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+		RefreshToken:    req.RefreshToken,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	err := c.svc.ChangePassword(ctx, dto)
+
+	if errors.Is(err, service.ErrInvalidCredentials) {
+		logger.Debug("invalid current password")
+		return nil, status.Error(codes.PermissionDenied, "invalid current password")
+	}
+	if errors.Is(err, service.ErrUserNotFound) {
+		logger.Debug("user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		logger.Error("failed to change password", "err", err)
+		return nil, status.Error(codes.Internal, "failed to change password")
+	}
+
+	return &pb.ChangePasswordResponse{}, nil
+}
+
+func (c *authController) ChangeEmail(ctx context.Context, req *pb.ChangeEmailRequest) (*pb.ChangeEmailResponse, error) {
+	const op = "controller.ChangeEmail"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ChangeEmailDTO{
+		UserID:          req.UserId,
+		CurrentPassword: req.CurrentPassword,
+		NewEmail:        req.NewEmail,
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	err := c.svc.ChangeEmail(ctx, dto)
+
+	if errors.Is(err, service.ErrInvalidCredentials) {
+		logger.Debug("invalid current password")
+		return nil, status.Error(codes.PermissionDenied, "invalid current password")
+	}
+	if errors.Is(err, service.ErrUserAlreadyExists) {
+		logger.Debug("email already taken")
+		return nil, status.Error(codes.AlreadyExists, "email already taken")
+	}
+	if errors.Is(err, service.ErrUserNotFound) {
+		logger.Debug("user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		logger.Error("failed to change email", "err", err)
+		return nil, status.Error(codes.Internal, "failed to change email")
+	}
+
+	return &pb.ChangeEmailResponse{}, nil
+}
+
+func (c *authController) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	const op = "controller.ListUsers"
+	logger := c.logger.With(slog.String("op", op))
+
+	// преобразование в dto для передачи между слоями
+	dto := dto.ListUsersDTO{
+		Query: strings.TrimSpace(req.Query),
+		Index: int(req.Index),
+		Limit: int(req.Limit),
+	}
+
+	// валидация данных
+	if err := c.validate.Struct(dto); err != nil {
+		logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	users, total, err := c.svc.ListUsers(ctx, dto)
+	if err != nil {
+		logger.Error("failed to list users", "err", err)
+		return nil, status.Error(codes.Internal, "failed to list users")
+	}
+
+	resp := &pb.ListUsersResponse{Users: make([]*pb.User, 0, len(users)), Total: int32(total)}
+	for _, user := range users {
+		resp.Users = append(resp.Users, userToPb(user))
+	}
+	return resp, nil
+}
+
+func (c *authController) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	user, err := c.svc.GetUser(ctx, req.UserId)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to get user", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	return &pb.GetUserResponse{User: userToPb(user)}, nil
+}
+
+func (c *authController) SetSuperuser(ctx context.Context, req *pb.SetSuperuserRequest) (*pb.SetSuperuserResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	err := c.svc.SetSuperuser(ctx, req.UserId, req.IsSuperuser)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to set superuser", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to set superuser")
+	}
+
+	return &pb.SetSuperuserResponse{}, nil
+}
+
+func (c *authController) DisableUser(ctx context.Context, req *pb.DisableUserRequest) (*pb.DisableUserResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	err := c.svc.DisableUser(ctx, req.UserId, req.Disabled)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to disable user", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to disable user")
+	}
+
+	return &pb.DisableUserResponse{}, nil
+}
+
+func (c *authController) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	if err := c.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	err := c.svc.DeleteUser(ctx, req.UserId)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, service.ErrUserInUse) {
+		return nil, status.Error(codes.FailedPrecondition, "user has courses or task statuses, disable the user instead")
+	}
+	if err != nil {
+		c.logger.Error("failed to delete user", "err", err, "id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to delete user")
+	}
+
+	return &pb.DeleteUserResponse{}, nil
+}
+
+func userToPb(user entities.User) *pb.User {
+	resp := &pb.User{
+		UserId:        user.ID,
+		Email:         user.Email,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		IsSuperuser:   user.IsSuperUser,
+		EmailVerified: user.EmailVerifiedAt != nil,
+	}
+	if user.DisabledAt != nil {
+		resp.DisabledAt = timestamppb.New(*user.DisabledAt)
+	}
+	return resp
+}
+
+func accessTokenToPb(token entities.AccessToken) *pb.AccessToken {
+	resp := &pb.AccessToken{
+		TokenId:   token.ID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+		ExpiresAt: timestamppb.New(token.ExpiresAt),
+	}
+	if token.LastUsedAt != nil {
+		resp.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+	return resp
+}
+
+// Возвращает ResourceExhausted с RetryInfo, чтобы клиент знал, когда повторить запрос
+func retryAfterError(msg string, retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
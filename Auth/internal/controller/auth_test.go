@@ -9,12 +9,15 @@ import (
 	"context"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestAuthController_Register(t *testing.T) {
@@ -119,6 +122,23 @@ func TestAuthController_Login(t *testing.T) {
 				RefreshToken: "token2",
 			},
 		},
+		{
+			name: "mfa required",
+			mockBehavior: func(svc *mocks.MockAuthService, req *pb.LoginRequest) {
+				svc.EXPECT().Login(mock.Anything, dto.LoginDTO{
+					Email:    req.Email,
+					Password: req.Password,
+				}).Return(dto.TokensDTO{MFAToken: "mfa-token"}, nil)
+			},
+			req: &pb.LoginRequest{
+				Email:    "email@email.com",
+				Password: "password",
+			},
+			want: &pb.LoginResponse{
+				MfaRequired: true,
+				MfaToken:    "mfa-token",
+			},
+		},
 		{
 			name:         "invalid email",
 			mockBehavior: func(svc *mocks.MockAuthService, req *pb.LoginRequest) {},
@@ -142,6 +162,25 @@ func TestAuthController_Login(t *testing.T) {
 			},
 			wantErr: status.Error(codes.Unauthenticated, "invalid credentials"),
 		},
+		{
+			name: "too many attempts",
+			mockBehavior: func(svc *mocks.MockAuthService, req *pb.LoginRequest) {
+				svc.EXPECT().Login(mock.Anything, dto.LoginDTO{
+					Email:    req.Email,
+					Password: req.Password,
+				}).Return(dto.TokensDTO{}, &service.TooManyAttemptsError{RetryAfter: time.Minute})
+			},
+			req: &pb.LoginRequest{
+				Email:    "email@email.com",
+				Password: "password",
+			},
+			wantErr: func() error {
+				st, err := status.New(codes.ResourceExhausted, "too many login attempts").
+					WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Minute)})
+				require.NoError(t, err)
+				return st.Err()
+			}(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -173,19 +212,20 @@ func TestAuthController_Refresh(t *testing.T) {
 		{
 			name: "success",
 			mockBehavior: func(svc *mocks.MockAuthService, req *pb.RefreshRequest) {
-				svc.EXPECT().Refresh(mock.Anything, req.RefreshToken).Return("token", nil)
+				svc.EXPECT().Refresh(mock.Anything, req.RefreshToken).Return(dto.TokensDTO{AccessToken: "token", RefreshToken: "token2"}, nil)
 			},
 			req: &pb.RefreshRequest{
 				RefreshToken: "token",
 			},
 			want: &pb.RefreshResponse{
-				AccessToken: "token",
+				AccessToken:  "token",
+				RefreshToken: "token2",
 			},
 		},
 		{
 			name: "invalid token",
 			mockBehavior: func(svc *mocks.MockAuthService, req *pb.RefreshRequest) {
-				svc.EXPECT().Refresh(mock.Anything, req.RefreshToken).Return("", service.ErrInvalidToken)
+				svc.EXPECT().Refresh(mock.Anything, req.RefreshToken).Return(dto.TokensDTO{}, service.ErrInvalidToken)
 			},
 			req: &pb.RefreshRequest{
 				RefreshToken: "token",
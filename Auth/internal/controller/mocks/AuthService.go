@@ -7,6 +7,7 @@ package controller
 import (
 	"Classroom/Auth/internal/dto"
 	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/pkg/jwks"
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -39,236 +40,1511 @@ func (_m *MockAuthService) EXPECT() *MockAuthService_Expecter {
 	return &MockAuthService_Expecter{mock: &_m.Mock}
 }
 
-// GetUserInfo provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) GetUserInfo(ctx context.Context, userID string) (entities.User, error) {
+// ChangeEmail provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ChangeEmail(ctx context.Context, dto1 dto.ChangeEmailDTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ChangeEmailDTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_ChangeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmail'
+type MockAuthService_ChangeEmail_Call struct {
+	*mock.Call
+}
+
+// ChangeEmail is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ChangeEmail(ctx interface{}, dto1 interface{}) *MockAuthService_ChangeEmail_Call {
+	return &MockAuthService_ChangeEmail_Call{Call: _e.mock.On("ChangeEmail", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ChangeEmail_Call) Run(run func(ctx context.Context, dto1 dto.ChangeEmailDTO)) *MockAuthService_ChangeEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ChangeEmailDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ChangeEmail_Call) Return(err error) *MockAuthService_ChangeEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_ChangeEmail_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ChangeEmailDTO) error) *MockAuthService_ChangeEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangePassword provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ChangePassword(ctx context.Context, dto1 dto.ChangePasswordDTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ChangePasswordDTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockAuthService_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ChangePassword(ctx interface{}, dto1 interface{}) *MockAuthService_ChangePassword_Call {
+	return &MockAuthService_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ChangePassword_Call) Run(run func(ctx context.Context, dto1 dto.ChangePasswordDTO)) *MockAuthService_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ChangePasswordDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ChangePassword_Call) Return(err error) *MockAuthService_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ChangePasswordDTO) error) *MockAuthService_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearLockout provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ClearLockout(ctx context.Context, dto1 dto.ClearLockoutDTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearLockout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ClearLockoutDTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_ClearLockout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearLockout'
+type MockAuthService_ClearLockout_Call struct {
+	*mock.Call
+}
+
+// ClearLockout is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ClearLockout(ctx interface{}, dto1 interface{}) *MockAuthService_ClearLockout_Call {
+	return &MockAuthService_ClearLockout_Call{Call: _e.mock.On("ClearLockout", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ClearLockout_Call) Run(run func(ctx context.Context, dto1 dto.ClearLockoutDTO)) *MockAuthService_ClearLockout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ClearLockoutDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ClearLockout_Call) Return(err error) *MockAuthService_ClearLockout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_ClearLockout_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ClearLockoutDTO) error) *MockAuthService_ClearLockout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmMFA provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ConfirmMFA(ctx context.Context, dto1 dto.ConfirmMFADTO) ([]string, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmMFA")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ConfirmMFADTO) ([]string, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ConfirmMFADTO) []string); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.ConfirmMFADTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_ConfirmMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmMFA'
+type MockAuthService_ConfirmMFA_Call struct {
+	*mock.Call
+}
+
+// ConfirmMFA is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ConfirmMFA(ctx interface{}, dto1 interface{}) *MockAuthService_ConfirmMFA_Call {
+	return &MockAuthService_ConfirmMFA_Call{Call: _e.mock.On("ConfirmMFA", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ConfirmMFA_Call) Run(run func(ctx context.Context, dto1 dto.ConfirmMFADTO)) *MockAuthService_ConfirmMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ConfirmMFADTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ConfirmMFA_Call) Return(strings []string, err error) *MockAuthService_ConfirmMFA_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockAuthService_ConfirmMFA_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ConfirmMFADTO) ([]string, error)) *MockAuthService_ConfirmMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAccessToken provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) CreateAccessToken(ctx context.Context, dto1 dto.CreateAccessTokenDTO) (entities.AccessToken, string, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAccessToken")
+	}
+
+	var r0 entities.AccessToken
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateAccessTokenDTO) (entities.AccessToken, string, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateAccessTokenDTO) entities.AccessToken); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(entities.AccessToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.CreateAccessTokenDTO) string); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dto.CreateAccessTokenDTO) error); ok {
+		r2 = returnFunc(ctx, dto1)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthService_CreateAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccessToken'
+type MockAuthService_CreateAccessToken_Call struct {
+	*mock.Call
+}
+
+// CreateAccessToken is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) CreateAccessToken(ctx interface{}, dto1 interface{}) *MockAuthService_CreateAccessToken_Call {
+	return &MockAuthService_CreateAccessToken_Call{Call: _e.mock.On("CreateAccessToken", ctx, dto1)}
+}
+
+func (_c *MockAuthService_CreateAccessToken_Call) Run(run func(ctx context.Context, dto1 dto.CreateAccessTokenDTO)) *MockAuthService_CreateAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateAccessTokenDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_CreateAccessToken_Call) Return(accessToken entities.AccessToken, s string, err error) *MockAuthService_CreateAccessToken_Call {
+	_c.Call.Return(accessToken, s, err)
+	return _c
+}
+
+func (_c *MockAuthService_CreateAccessToken_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.CreateAccessTokenDTO) (entities.AccessToken, string, error)) *MockAuthService_CreateAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) DeleteUser(ctx context.Context, userID string) error {
 	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserInfo")
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockAuthService_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockAuthService_Expecter) DeleteUser(ctx interface{}, userID interface{}) *MockAuthService_DeleteUser_Call {
+	return &MockAuthService_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, userID)}
+}
+
+func (_c *MockAuthService_DeleteUser_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_DeleteUser_Call) Return(err error) *MockAuthService_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAuthService_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableMFA provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) DisableMFA(ctx context.Context, dto1 dto.DisableMFADTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.DisableMFADTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_DisableMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableMFA'
+type MockAuthService_DisableMFA_Call struct {
+	*mock.Call
+}
+
+// DisableMFA is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) DisableMFA(ctx interface{}, dto1 interface{}) *MockAuthService_DisableMFA_Call {
+	return &MockAuthService_DisableMFA_Call{Call: _e.mock.On("DisableMFA", ctx, dto1)}
+}
+
+func (_c *MockAuthService_DisableMFA_Call) Run(run func(ctx context.Context, dto1 dto.DisableMFADTO)) *MockAuthService_DisableMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.DisableMFADTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_DisableMFA_Call) Return(err error) *MockAuthService_DisableMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_DisableMFA_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.DisableMFADTO) error) *MockAuthService_DisableMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableUser provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) DisableUser(ctx context.Context, userID string, disabled bool) error {
+	ret := _mock.Called(ctx, userID, disabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, userID, disabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_DisableUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableUser'
+type MockAuthService_DisableUser_Call struct {
+	*mock.Call
+}
+
+// DisableUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - disabled
+func (_e *MockAuthService_Expecter) DisableUser(ctx interface{}, userID interface{}, disabled interface{}) *MockAuthService_DisableUser_Call {
+	return &MockAuthService_DisableUser_Call{Call: _e.mock.On("DisableUser", ctx, userID, disabled)}
+}
+
+func (_c *MockAuthService_DisableUser_Call) Run(run func(ctx context.Context, userID string, disabled bool)) *MockAuthService_DisableUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_DisableUser_Call) Return(err error) *MockAuthService_DisableUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_DisableUser_Call) RunAndReturn(run func(ctx context.Context, userID string, disabled bool) error) *MockAuthService_DisableUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJWKS provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) GetJWKS(ctx context.Context) []jwks.JWK {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWKS")
+	}
+
+	var r0 []jwks.JWK
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []jwks.JWK); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]jwks.JWK)
+		}
+	}
+	return r0
+}
+
+// MockAuthService_GetJWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJWKS'
+type MockAuthService_GetJWKS_Call struct {
+	*mock.Call
+}
+
+// GetJWKS is a helper method to define mock.On call
+//   - ctx
+func (_e *MockAuthService_Expecter) GetJWKS(ctx interface{}) *MockAuthService_GetJWKS_Call {
+	return &MockAuthService_GetJWKS_Call{Call: _e.mock.On("GetJWKS", ctx)}
+}
+
+func (_c *MockAuthService_GetJWKS_Call) Run(run func(ctx context.Context)) *MockAuthService_GetJWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_GetJWKS_Call) Return(jwks []jwks.JWK) *MockAuthService_GetJWKS_Call {
+	_c.Call.Return(jwks)
+	return _c
+}
+
+func (_c *MockAuthService_GetJWKS_Call) RunAndReturn(run func(ctx context.Context) []jwks.JWK) *MockAuthService_GetJWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) GetUser(ctx context.Context, userID string) (entities.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entities.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockAuthService_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockAuthService_Expecter) GetUser(ctx interface{}, userID interface{}) *MockAuthService_GetUser_Call {
+	return &MockAuthService_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
+}
+
+func (_c *MockAuthService_GetUser_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_GetUser_Call) Return(user entities.User, err error) *MockAuthService_GetUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthService_GetUser_Call) RunAndReturn(run func(ctx context.Context, userID string) (entities.User, error)) *MockAuthService_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserInfo provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) GetUserInfo(ctx context.Context, userID string) (entities.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserInfo")
+	}
+
+	var r0 entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entities.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_GetUserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInfo'
+type MockAuthService_GetUserInfo_Call struct {
+	*mock.Call
+}
+
+// GetUserInfo is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockAuthService_Expecter) GetUserInfo(ctx interface{}, userID interface{}) *MockAuthService_GetUserInfo_Call {
+	return &MockAuthService_GetUserInfo_Call{Call: _e.mock.On("GetUserInfo", ctx, userID)}
+}
+
+func (_c *MockAuthService_GetUserInfo_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_GetUserInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_GetUserInfo_Call) Return(user entities.User, err error) *MockAuthService_GetUserInfo_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthService_GetUserInfo_Call) RunAndReturn(run func(ctx context.Context, userID string) (entities.User, error)) *MockAuthService_GetUserInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IntrospectAccessToken provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) IntrospectAccessToken(ctx context.Context, token string) (dto.AccessTokenInfoDTO, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IntrospectAccessToken")
+	}
+
+	var r0 dto.AccessTokenInfoDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (dto.AccessTokenInfoDTO, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) dto.AccessTokenInfoDTO); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(dto.AccessTokenInfoDTO)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_IntrospectAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IntrospectAccessToken'
+type MockAuthService_IntrospectAccessToken_Call struct {
+	*mock.Call
+}
+
+// IntrospectAccessToken is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockAuthService_Expecter) IntrospectAccessToken(ctx interface{}, token interface{}) *MockAuthService_IntrospectAccessToken_Call {
+	return &MockAuthService_IntrospectAccessToken_Call{Call: _e.mock.On("IntrospectAccessToken", ctx, token)}
+}
+
+func (_c *MockAuthService_IntrospectAccessToken_Call) Run(run func(ctx context.Context, token string)) *MockAuthService_IntrospectAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_IntrospectAccessToken_Call) Return(accessTokenInfoDTO dto.AccessTokenInfoDTO, err error) *MockAuthService_IntrospectAccessToken_Call {
+	_c.Call.Return(accessTokenInfoDTO, err)
+	return _c
+}
+
+func (_c *MockAuthService_IntrospectAccessToken_Call) RunAndReturn(run func(ctx context.Context, token string) (dto.AccessTokenInfoDTO, error)) *MockAuthService_IntrospectAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccessTokens provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ListAccessTokens(ctx context.Context, userID string) ([]entities.AccessToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAccessTokens")
+	}
+
+	var r0 []entities.AccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.AccessToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.AccessToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.AccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_ListAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccessTokens'
+type MockAuthService_ListAccessTokens_Call struct {
+	*mock.Call
+}
+
+// ListAccessTokens is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockAuthService_Expecter) ListAccessTokens(ctx interface{}, userID interface{}) *MockAuthService_ListAccessTokens_Call {
+	return &MockAuthService_ListAccessTokens_Call{Call: _e.mock.On("ListAccessTokens", ctx, userID)}
+}
+
+func (_c *MockAuthService_ListAccessTokens_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_ListAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ListAccessTokens_Call) Return(accessTokens []entities.AccessToken, err error) *MockAuthService_ListAccessTokens_Call {
+	_c.Call.Return(accessTokens, err)
+	return _c
+}
+
+func (_c *MockAuthService_ListAccessTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]entities.AccessToken, error)) *MockAuthService_ListAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLockouts provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ListLockouts(ctx context.Context) ([]entities.LoginAttempts, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLockouts")
+	}
+
+	var r0 []entities.LoginAttempts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]entities.LoginAttempts, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []entities.LoginAttempts); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.LoginAttempts)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_ListLockouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLockouts'
+type MockAuthService_ListLockouts_Call struct {
+	*mock.Call
+}
+
+// ListLockouts is a helper method to define mock.On call
+//   - ctx
+func (_e *MockAuthService_Expecter) ListLockouts(ctx interface{}) *MockAuthService_ListLockouts_Call {
+	return &MockAuthService_ListLockouts_Call{Call: _e.mock.On("ListLockouts", ctx)}
+}
+
+func (_c *MockAuthService_ListLockouts_Call) Run(run func(ctx context.Context)) *MockAuthService_ListLockouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ListLockouts_Call) Return(loginAttemptss []entities.LoginAttempts, err error) *MockAuthService_ListLockouts_Call {
+	_c.Call.Return(loginAttemptss, err)
+	return _c
+}
+
+func (_c *MockAuthService_ListLockouts_Call) RunAndReturn(run func(ctx context.Context) ([]entities.LoginAttempts, error)) *MockAuthService_ListLockouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ListSessions(ctx context.Context, userID string) ([]entities.Session, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []entities.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Session, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Session); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockAuthService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockAuthService_Expecter) ListSessions(ctx interface{}, userID interface{}) *MockAuthService_ListSessions_Call {
+	return &MockAuthService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID)}
+}
+
+func (_c *MockAuthService_ListSessions_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ListSessions_Call) Return(sessions []entities.Session, err error) *MockAuthService_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockAuthService_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]entities.Session, error)) *MockAuthService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ListUsers(ctx context.Context, dto1 dto.ListUsersDTO) ([]entities.User, int, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []entities.User
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ListUsersDTO) ([]entities.User, int, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ListUsersDTO) []entities.User); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.ListUsersDTO) int); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dto.ListUsersDTO) error); ok {
+		r2 = returnFunc(ctx, dto1)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthService_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockAuthService_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ListUsers(ctx interface{}, dto1 interface{}) *MockAuthService_ListUsers_Call {
+	return &MockAuthService_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ListUsers_Call) Run(run func(ctx context.Context, dto1 dto.ListUsersDTO)) *MockAuthService_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ListUsersDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ListUsers_Call) Return(users []entities.User, n int, err error) *MockAuthService_ListUsers_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockAuthService_ListUsers_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ListUsersDTO) ([]entities.User, int, error)) *MockAuthService_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) Login(ctx context.Context, dto1 dto.LoginDTO) (dto.TokensDTO, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 dto.TokensDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.LoginDTO) (dto.TokensDTO, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.LoginDTO) dto.TokensDTO); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(dto.TokensDTO)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.LoginDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthService_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) Login(ctx interface{}, dto1 interface{}) *MockAuthService_Login_Call {
+	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, dto1)}
+}
+
+func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, dto1 dto.LoginDTO)) *MockAuthService_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.LoginDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) Return(tokensDTO dto.TokensDTO, err error) *MockAuthService_Login_Call {
+	_c.Call.Return(tokensDTO, err)
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.LoginDTO) (dto.TokensDTO, error)) *MockAuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) Logout(ctx context.Context, refreshToken string) error {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx
+//   - refreshToken
+func (_e *MockAuthService_Expecter) Logout(ctx interface{}, refreshToken interface{}) *MockAuthService_Logout_Call {
+	return &MockAuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, refreshToken)}
+}
+
+func (_c *MockAuthService_Logout_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) Return(err error) *MockAuthService_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) error) *MockAuthService_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) Refresh(ctx context.Context, refreshToken string) (dto.TokensDTO, error) {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 dto.TokensDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (dto.TokensDTO, error)); ok {
+		return returnFunc(ctx, refreshToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) dto.TokensDTO); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		r0 = ret.Get(0).(dto.TokensDTO)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockAuthService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx
+//   - refreshToken
+func (_e *MockAuthService_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockAuthService_Refresh_Call {
+	return &MockAuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *MockAuthService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Refresh_Call) Return(tokensDTO dto.TokensDTO, err error) *MockAuthService_Refresh_Call {
+	_c.Call.Return(tokensDTO, err)
+	return _c
+}
+
+func (_c *MockAuthService_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (dto.TokensDTO, error)) *MockAuthService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) Register(ctx context.Context, dto1 dto.RegisterDTO) (string, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RegisterDTO) (string, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RegisterDTO) string); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.RegisterDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockAuthService_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) Register(ctx interface{}, dto1 interface{}) *MockAuthService_Register_Call {
+	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, dto1)}
+}
+
+func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, dto1 dto.RegisterDTO)) *MockAuthService_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.RegisterDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Register_Call) Return(s string, err error) *MockAuthService_Register_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockAuthService_Register_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.RegisterDTO) (string, error)) *MockAuthService_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) RequestPasswordReset(ctx context.Context, dto1 dto.RequestPasswordResetDTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RequestPasswordResetDTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockAuthService_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) RequestPasswordReset(ctx interface{}, dto1 interface{}) *MockAuthService_RequestPasswordReset_Call {
+	return &MockAuthService_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", ctx, dto1)}
+}
+
+func (_c *MockAuthService_RequestPasswordReset_Call) Run(run func(ctx context.Context, dto1 dto.RequestPasswordResetDTO)) *MockAuthService_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.RequestPasswordResetDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_RequestPasswordReset_Call) Return(err error) *MockAuthService_RequestPasswordReset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_RequestPasswordReset_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.RequestPasswordResetDTO) error) *MockAuthService_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendVerificationEmail provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ResendVerificationEmail(ctx context.Context, dto1 dto.ResendVerificationEmailDTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendVerificationEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ResendVerificationEmailDTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_ResendVerificationEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendVerificationEmail'
+type MockAuthService_ResendVerificationEmail_Call struct {
+	*mock.Call
+}
+
+// ResendVerificationEmail is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ResendVerificationEmail(ctx interface{}, dto1 interface{}) *MockAuthService_ResendVerificationEmail_Call {
+	return &MockAuthService_ResendVerificationEmail_Call{Call: _e.mock.On("ResendVerificationEmail", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ResendVerificationEmail_Call) Run(run func(ctx context.Context, dto1 dto.ResendVerificationEmailDTO)) *MockAuthService_ResendVerificationEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ResendVerificationEmailDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ResendVerificationEmail_Call) Return(err error) *MockAuthService_ResendVerificationEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_ResendVerificationEmail_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ResendVerificationEmailDTO) error) *MockAuthService_ResendVerificationEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ResetPassword(ctx context.Context, dto1 dto.ResetPasswordDTO) error {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
 	}
 
-	var r0 entities.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.User, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.User); ok {
-		r0 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ResetPasswordDTO) error); ok {
+		r0 = returnFunc(ctx, dto1)
 	} else {
-		r0 = ret.Get(0).(entities.User)
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	return r0
+}
+
+// MockAuthService_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockAuthService_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) ResetPassword(ctx interface{}, dto1 interface{}) *MockAuthService_ResetPassword_Call {
+	return &MockAuthService_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, dto1)}
+}
+
+func (_c *MockAuthService_ResetPassword_Call) Run(run func(ctx context.Context, dto1 dto.ResetPasswordDTO)) *MockAuthService_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ResetPasswordDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ResetPassword_Call) Return(err error) *MockAuthService_ResetPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ResetPasswordDTO) error) *MockAuthService_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAccessToken provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) RevokeAccessToken(ctx context.Context, userID string, tokenID string) error {
+	ret := _mock.Called(ctx, userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, tokenID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockAuthService_GetUserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInfo'
-type MockAuthService_GetUserInfo_Call struct {
+// MockAuthService_RevokeAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccessToken'
+type MockAuthService_RevokeAccessToken_Call struct {
 	*mock.Call
 }
 
-// GetUserInfo is a helper method to define mock.On call
+// RevokeAccessToken is a helper method to define mock.On call
 //   - ctx
 //   - userID
-func (_e *MockAuthService_Expecter) GetUserInfo(ctx interface{}, userID interface{}) *MockAuthService_GetUserInfo_Call {
-	return &MockAuthService_GetUserInfo_Call{Call: _e.mock.On("GetUserInfo", ctx, userID)}
+//   - tokenID
+func (_e *MockAuthService_Expecter) RevokeAccessToken(ctx interface{}, userID interface{}, tokenID interface{}) *MockAuthService_RevokeAccessToken_Call {
+	return &MockAuthService_RevokeAccessToken_Call{Call: _e.mock.On("RevokeAccessToken", ctx, userID, tokenID)}
 }
 
-func (_c *MockAuthService_GetUserInfo_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_GetUserInfo_Call {
+func (_c *MockAuthService_RevokeAccessToken_Call) Run(run func(ctx context.Context, userID string, tokenID string)) *MockAuthService_RevokeAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_GetUserInfo_Call) Return(user entities.User, err error) *MockAuthService_GetUserInfo_Call {
-	_c.Call.Return(user, err)
+func (_c *MockAuthService_RevokeAccessToken_Call) Return(err error) *MockAuthService_RevokeAccessToken_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthService_GetUserInfo_Call) RunAndReturn(run func(ctx context.Context, userID string) (entities.User, error)) *MockAuthService_GetUserInfo_Call {
+func (_c *MockAuthService_RevokeAccessToken_Call) RunAndReturn(run func(ctx context.Context, userID string, tokenID string) error) *MockAuthService_RevokeAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Login(ctx context.Context, dto1 dto.LoginDTO) (dto.TokensDTO, error) {
-	ret := _mock.Called(ctx, dto1)
+// RevokeAllSessions provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) RevokeAllSessions(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for RevokeAllSessions")
 	}
 
-	var r0 dto.TokensDTO
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.LoginDTO) (dto.TokensDTO, error)); ok {
-		return returnFunc(ctx, dto1)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.LoginDTO) dto.TokensDTO); ok {
-		r0 = returnFunc(ctx, dto1)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(dto.TokensDTO)
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.LoginDTO) error); ok {
-		r1 = returnFunc(ctx, dto1)
+	return r0
+}
+
+// MockAuthService_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type MockAuthService_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockAuthService_Expecter) RevokeAllSessions(ctx interface{}, userID interface{}) *MockAuthService_RevokeAllSessions_Call {
+	return &MockAuthService_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", ctx, userID)}
+}
+
+func (_c *MockAuthService_RevokeAllSessions_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_RevokeAllSessions_Call) Return(err error) *MockAuthService_RevokeAllSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_RevokeAllSessions_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAuthService_RevokeAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	ret := _mock.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, sessionID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockAuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type MockAuthService_Login_Call struct {
+// MockAuthService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockAuthService_RevokeSession_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// RevokeSession is a helper method to define mock.On call
 //   - ctx
-//   - dto1
-func (_e *MockAuthService_Expecter) Login(ctx interface{}, dto1 interface{}) *MockAuthService_Login_Call {
-	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, dto1)}
+//   - userID
+//   - sessionID
+func (_e *MockAuthService_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *MockAuthService_RevokeSession_Call {
+	return &MockAuthService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
 }
 
-func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, dto1 dto.LoginDTO)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_RevokeSession_Call) Run(run func(ctx context.Context, userID string, sessionID string)) *MockAuthService_RevokeSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(dto.LoginDTO))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) Return(tokensDTO dto.TokensDTO, err error) *MockAuthService_Login_Call {
-	_c.Call.Return(tokensDTO, err)
+func (_c *MockAuthService_RevokeSession_Call) Return(err error) *MockAuthService_RevokeSession_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.LoginDTO) (dto.TokensDTO, error)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, userID string, sessionID string) error) *MockAuthService_RevokeSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Logout provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Logout(ctx context.Context, refreshToken string) error {
-	ret := _mock.Called(ctx, refreshToken)
+// SetSuperuser provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) SetSuperuser(ctx context.Context, userID string, isSuperUser bool) error {
+	ret := _mock.Called(ctx, userID, isSuperUser)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Logout")
+		panic("no return value specified for SetSuperuser")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, userID, isSuperUser)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockAuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
-type MockAuthService_Logout_Call struct {
+// MockAuthService_SetSuperuser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSuperuser'
+type MockAuthService_SetSuperuser_Call struct {
 	*mock.Call
 }
 
-// Logout is a helper method to define mock.On call
+// SetSuperuser is a helper method to define mock.On call
 //   - ctx
-//   - refreshToken
-func (_e *MockAuthService_Expecter) Logout(ctx interface{}, refreshToken interface{}) *MockAuthService_Logout_Call {
-	return &MockAuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, refreshToken)}
+//   - userID
+//   - isSuperUser
+func (_e *MockAuthService_Expecter) SetSuperuser(ctx interface{}, userID interface{}, isSuperUser interface{}) *MockAuthService_SetSuperuser_Call {
+	return &MockAuthService_SetSuperuser_Call{Call: _e.mock.On("SetSuperuser", ctx, userID, isSuperUser)}
 }
 
-func (_c *MockAuthService_Logout_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthService_Logout_Call {
+func (_c *MockAuthService_SetSuperuser_Call) Run(run func(ctx context.Context, userID string, isSuperUser bool)) *MockAuthService_SetSuperuser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Logout_Call) Return(err error) *MockAuthService_Logout_Call {
+func (_c *MockAuthService_SetSuperuser_Call) Return(err error) *MockAuthService_SetSuperuser_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthService_Logout_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) error) *MockAuthService_Logout_Call {
+func (_c *MockAuthService_SetSuperuser_Call) RunAndReturn(run func(ctx context.Context, userID string, isSuperUser bool) error) *MockAuthService_SetSuperuser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Refresh provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Refresh(ctx context.Context, refreshToken string) (string, error) {
-	ret := _mock.Called(ctx, refreshToken)
+// SetupMFA provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) SetupMFA(ctx context.Context, userID string) (dto.MFASetupDTO, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Refresh")
+		panic("no return value specified for SetupMFA")
 	}
 
-	var r0 string
+	var r0 dto.MFASetupDTO
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (dto.MFASetupDTO, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) dto.MFASetupDTO); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(dto.MFASetupDTO)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, refreshToken)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
-type MockAuthService_Refresh_Call struct {
+// MockAuthService_SetupMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetupMFA'
+type MockAuthService_SetupMFA_Call struct {
 	*mock.Call
 }
 
-// Refresh is a helper method to define mock.On call
+// SetupMFA is a helper method to define mock.On call
 //   - ctx
-//   - refreshToken
-func (_e *MockAuthService_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockAuthService_Refresh_Call {
-	return &MockAuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+//   - userID
+func (_e *MockAuthService_Expecter) SetupMFA(ctx interface{}, userID interface{}) *MockAuthService_SetupMFA_Call {
+	return &MockAuthService_SetupMFA_Call{Call: _e.mock.On("SetupMFA", ctx, userID)}
 }
 
-func (_c *MockAuthService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthService_Refresh_Call {
+func (_c *MockAuthService_SetupMFA_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_SetupMFA_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Refresh_Call) Return(s string, err error) *MockAuthService_Refresh_Call {
-	_c.Call.Return(s, err)
+func (_c *MockAuthService_SetupMFA_Call) Return(mfaSetupDTO dto.MFASetupDTO, err error) *MockAuthService_SetupMFA_Call {
+	_c.Call.Return(mfaSetupDTO, err)
 	return _c
 }
 
-func (_c *MockAuthService_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (string, error)) *MockAuthService_Refresh_Call {
+func (_c *MockAuthService_SetupMFA_Call) RunAndReturn(run func(ctx context.Context, userID string) (dto.MFASetupDTO, error)) *MockAuthService_SetupMFA_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Register(ctx context.Context, dto1 dto.RegisterDTO) (string, error) {
+// UpdateProfile provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) UpdateProfile(ctx context.Context, dto1 dto.UpdateProfileDTO) (entities.User, error) {
 	ret := _mock.Called(ctx, dto1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Register")
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateProfileDTO) (entities.User, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateProfileDTO) entities.User); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(entities.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.UpdateProfileDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockAuthService_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) UpdateProfile(ctx interface{}, dto1 interface{}) *MockAuthService_UpdateProfile_Call {
+	return &MockAuthService_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, dto1)}
+}
+
+func (_c *MockAuthService_UpdateProfile_Call) Run(run func(ctx context.Context, dto1 dto.UpdateProfileDTO)) *MockAuthService_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.UpdateProfileDTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_UpdateProfile_Call) Return(user entities.User, err error) *MockAuthService_UpdateProfile_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthService_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.UpdateProfileDTO) (entities.User, error)) *MockAuthService_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) VerifyEmail(ctx context.Context, dto1 dto.VerifyEmailDTO) (string, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
 	}
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RegisterDTO) (string, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.VerifyEmailDTO) (string, error)); ok {
 		return returnFunc(ctx, dto1)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RegisterDTO) string); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.VerifyEmailDTO) string); ok {
 		r0 = returnFunc(ctx, dto1)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.RegisterDTO) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.VerifyEmailDTO) error); ok {
 		r1 = returnFunc(ctx, dto1)
 	} else {
 		r1 = ret.Error(1)
@@ -276,31 +1552,86 @@ func (_mock *MockAuthService) Register(ctx context.Context, dto1 dto.RegisterDTO
 	return r0, r1
 }
 
-// MockAuthService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type MockAuthService_Register_Call struct {
+// MockAuthService_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockAuthService_VerifyEmail_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
+// VerifyEmail is a helper method to define mock.On call
 //   - ctx
 //   - dto1
-func (_e *MockAuthService_Expecter) Register(ctx interface{}, dto1 interface{}) *MockAuthService_Register_Call {
-	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, dto1)}
+func (_e *MockAuthService_Expecter) VerifyEmail(ctx interface{}, dto1 interface{}) *MockAuthService_VerifyEmail_Call {
+	return &MockAuthService_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, dto1)}
 }
 
-func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, dto1 dto.RegisterDTO)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_VerifyEmail_Call) Run(run func(ctx context.Context, dto1 dto.VerifyEmailDTO)) *MockAuthService_VerifyEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(dto.RegisterDTO))
+		run(args[0].(context.Context), args[1].(dto.VerifyEmailDTO))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Register_Call) Return(s string, err error) *MockAuthService_Register_Call {
+func (_c *MockAuthService_VerifyEmail_Call) Return(s string, err error) *MockAuthService_VerifyEmail_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockAuthService_Register_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.RegisterDTO) (string, error)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.VerifyEmailDTO) (string, error)) *MockAuthService_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyMFA provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) VerifyMFA(ctx context.Context, dto1 dto.VerifyMFADTO) (dto.TokensDTO, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMFA")
+	}
+
+	var r0 dto.TokensDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.VerifyMFADTO) (dto.TokensDTO, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.VerifyMFADTO) dto.TokensDTO); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(dto.TokensDTO)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.VerifyMFADTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_VerifyMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMFA'
+type MockAuthService_VerifyMFA_Call struct {
+	*mock.Call
+}
+
+// VerifyMFA is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockAuthService_Expecter) VerifyMFA(ctx interface{}, dto1 interface{}) *MockAuthService_VerifyMFA_Call {
+	return &MockAuthService_VerifyMFA_Call{Call: _e.mock.On("VerifyMFA", ctx, dto1)}
+}
+
+func (_c *MockAuthService_VerifyMFA_Call) Run(run func(ctx context.Context, dto1 dto.VerifyMFADTO)) *MockAuthService_VerifyMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.VerifyMFADTO))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_VerifyMFA_Call) Return(tokensDTO dto.TokensDTO, err error) *MockAuthService_VerifyMFA_Call {
+	_c.Call.Return(tokensDTO, err)
+	return _c
+}
+
+func (_c *MockAuthService_VerifyMFA_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.VerifyMFADTO) (dto.TokensDTO, error)) *MockAuthService_VerifyMFA_Call {
 	_c.Call.Return(run)
 	return _c
 }
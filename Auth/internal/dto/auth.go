@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type RegisterDTO struct {
 	Email     string `validate:"required,email"`
 	Password  string `validate:"required"`
@@ -8,11 +10,99 @@ type RegisterDTO struct {
 }
 
 type LoginDTO struct {
-	Email    string `validate:"required,email"`
-	Password string `validate:"required"`
+	Email     string `validate:"required,email"`
+	Password  string `validate:"required"`
+	UserAgent string
+	IP        string
+}
+
+type CreateSessionDTO struct {
+	UserID    string
+	UserAgent string
+	IP        string
 }
 
 type TokensDTO struct {
 	AccessToken  string
 	RefreshToken string
+	// Если у пользователя подключена MFA, Login возвращает только этот токен,
+	// а access и refresh токены выдаются после VerifyMFA
+	MFAToken string
+}
+
+type RequestPasswordResetDTO struct {
+	Email string `validate:"required,email"`
+}
+
+type ResetPasswordDTO struct {
+	Token    string `validate:"required,uuid"`
+	Password string `validate:"required"`
+}
+
+type VerifyEmailDTO struct {
+	Token string `validate:"required,uuid"`
+}
+
+type ResendVerificationEmailDTO struct {
+	Email string `validate:"required,email"`
+}
+
+type MFASetupDTO struct {
+	Secret string
+	URI    string // otpauth:// URI для QR-кода
+}
+
+type ConfirmMFADTO struct {
+	UserID string `validate:"required,uuid"`
+	Code   string `validate:"required,numeric,len=6"`
+}
+
+type DisableMFADTO struct {
+	UserID   string `validate:"required,uuid"`
+	Password string `validate:"required"`
+	Code     string `validate:"required"` // TOTP или код восстановления
+}
+
+type VerifyMFADTO struct {
+	Token string `validate:"required,uuid"`
+	Code  string `validate:"required"` // TOTP или код восстановления
+}
+
+type ClearLockoutDTO struct {
+	Kind    string `validate:"required,oneof=email ip mfa"`
+	Subject string `validate:"required"`
+}
+
+type CreateAccessTokenDTO struct {
+	UserID    string    `validate:"required,uuid"`
+	Name      string    `validate:"required,max=100"`
+	Scopes    []string  `validate:"required,min=1,unique,dive,oneof=profile:read courses:read courses:write lessons:read lessons:write tasks:read tasks:write"`
+	ExpiresAt time.Time `validate:"required"`
+}
+
+// Информация о действующем персональном токене, по которой Gateway аутентифицирует запрос
+type AccessTokenInfoDTO struct {
+	UserID      string
+	IsSuperUser bool
+	Scopes      []string
+	ExpiresAt   time.Time
+}
+
+type UpdateProfileDTO struct {
+	UserID    string  `validate:"required,uuid"`
+	FirstName *string `validate:"omitempty,min=1,max=100"`
+	LastName  *string `validate:"omitempty,min=1,max=100"`
+}
+
+type ChangePasswordDTO struct {
+	UserID          string `validate:"required,uuid"`
+	CurrentPassword string `validate:"required"`
+	NewPassword     string `validate:"required"`
+	RefreshToken    string // Сессия этого токена не завершается после смены пароля
+}
+
+type ChangeEmailDTO struct {
+	UserID          string `validate:"required,uuid"`
+	CurrentPassword string `validate:"required"`
+	NewEmail        string `validate:"required,email"`
 }
@@ -7,3 +7,9 @@ type CreateUserDTO struct {
 	LastName     string
 	IsSuperUser  bool
 }
+
+type ListUsersDTO struct {
+	Query string `validate:"max=100"` // Подстрока почты, имени или фамилии
+	Index int    `validate:"min=0"`
+	Limit int    `validate:"min=1,max=100"`
+}
@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// Персональный токен доступа для скриптов и интеграций
+type AccessToken struct {
+	ID         string
+	UserID     string
+	Name       string
+	Scopes     []string // Например courses:read, tasks:write
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time // nil, если токен еще не использовался
+}
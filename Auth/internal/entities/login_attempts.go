@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+const (
+	LockoutKindEmail = "email"
+	LockoutKindIP    = "ip"
+	// Неверные коды и пароли при подключении и отключении MFA, по ID пользователя
+	LockoutKindMFA = "mfa"
+)
+
+// Неудачные попытки входа по почте или IP адресу и проверки кода MFA по пользователю
+type LoginAttempts struct {
+	Kind          string // email, ip или mfa
+	Subject       string // Почта, IP адрес или ID пользователя
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time // Нулевое значение, если вход не заблокирован
+}
@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+// Настройки двухфакторной аутентификации по TOTP
+type MFA struct {
+	UserID        string
+	Secret        string
+	RecoveryCodes []string   // sha256-хеши неиспользованных кодов восстановления
+	ConfirmedAt   *time.Time // nil, пока подключение не подтверждено кодом
+}
+
+// Первый шаг входа для пользователей с MFA, хранит данные будущей сессии
+type MFAChallenge struct {
+	UserID    string
+	UserAgent string
+	IP        string
+	Attempts  int // Количество неверно введенных кодов
+	ExpiresAt time.Time
+}
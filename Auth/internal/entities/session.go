@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// Сессия соответствует цепочке refresh токенов, выданных в рамках одного входа
+type Session struct {
+	ID         string
+	UserID     string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
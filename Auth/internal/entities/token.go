@@ -4,5 +4,6 @@ import "time"
 
 type RefreshToken struct {
 	UserID    string
+	FamilyID  string // Идентификатор цепочки токенов, выданных в рамках одного входа
 	ExpiresAt time.Time
 }
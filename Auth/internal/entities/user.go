@@ -1,10 +1,14 @@
 package entities
 
+import "time"
+
 type User struct {
-	ID           string
-	Email        string
-	FirstName    string
-	LastName     string
-	IsSuperUser  bool
-	PasswordHash []byte
+	ID              string
+	Email           string
+	FirstName       string
+	LastName        string
+	IsSuperUser     bool
+	PasswordHash    []byte
+	EmailVerifiedAt *time.Time // nil, если почта еще не подтверждена
+	DisabledAt      *time.Time // nil, если пользователь не отключен
 }
@@ -0,0 +1,62 @@
+package producer
+
+import (
+	"Classroom/Auth/pkg/events"
+	"encoding/json"
+	"log"
+
+	"github.com/IBM/sarama"
+)
+
+type kafkaProducer struct {
+	producer sarama.SyncProducer
+}
+
+func MustNewProducer(brokers []string) *kafkaProducer {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 5
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		log.Fatalf("failed to create producer: %v", err)
+	}
+
+	return &kafkaProducer{producer: producer}
+}
+
+func (p *kafkaProducer) Close() error {
+	return p.producer.Close()
+}
+
+func (p *kafkaProducer) PublishPasswordResetRequested(event events.PasswordResetRequested) error {
+	return p.publish(events.PasswordResetRequestedTopic, event)
+}
+
+func (p *kafkaProducer) PublishUserRegistered(event events.UserRegistered) error {
+	return p.publish(events.UserRegisteredTopic, event)
+}
+
+func (p *kafkaProducer) PublishEmailVerificationRequested(event events.EmailVerificationRequested) error {
+	return p.publish(events.EmailVerificationRequestedTopic, event)
+}
+
+func (p *kafkaProducer) PublishUserUpdated(event events.UserUpdated) error {
+	return p.publish(events.UserUpdatedTopic, event)
+}
+
+func (p *kafkaProducer) publish(topic string, msg any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	kafkaMsg := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(data),
+	}
+
+	_, _, err = p.producer.SendMessage(kafkaMsg)
+	return err
+}
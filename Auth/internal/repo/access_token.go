@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/internal/service"
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type accessTokenRepo struct {
+	storage *sqlx.DB
+	qb      sq.StatementBuilderType
+}
+
+func NewAccessTokenRepo(storage *sqlx.DB) *accessTokenRepo {
+	qb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return &accessTokenRepo{
+		storage: storage,
+		qb:      qb,
+	}
+}
+
+func (r *accessTokenRepo) Create(ctx context.Context, dto dto.CreateAccessTokenDTO, tokenHash []byte) (entities.AccessToken, error) {
+	query, args := r.qb.
+		Insert("access_tokens").
+		Columns("user_id", "name", "token_hash", "scopes", "expires_at").
+		Values(dto.UserID, dto.Name, tokenHash, pq.StringArray(dto.Scopes), dto.ExpiresAt).
+		Suffix("RETURNING token_id, user_id, name, scopes, created_at, expires_at, last_used_at").
+		MustSql()
+
+	var token AccessToken
+	if err := r.storage.GetContext(ctx, &token, query, args...); err != nil {
+		return entities.AccessToken{}, err
+	}
+	return token.ToEntity(), nil
+}
+
+func (r *accessTokenRepo) GetByHash(ctx context.Context, tokenHash []byte) (entities.AccessToken, error) {
+	query, args := r.qb.
+		Select("token_id", "user_id", "name", "scopes", "created_at", "expires_at", "last_used_at").
+		From("access_tokens").
+		Where(sq.Eq{"token_hash": tokenHash}).
+		MustSql()
+
+	var token AccessToken
+	err := r.storage.GetContext(ctx, &token, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.AccessToken{}, service.ErrAccessTokenNotFound
+	}
+	if err != nil {
+		return entities.AccessToken{}, err
+	}
+	return token.ToEntity(), nil
+}
+
+func (r *accessTokenRepo) ListByUserID(ctx context.Context, userID string) ([]entities.AccessToken, error) {
+	query, args := r.qb.
+		Select("token_id", "user_id", "name", "scopes", "created_at", "expires_at", "last_used_at").
+		From("access_tokens").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at DESC").
+		MustSql()
+
+	var tokens []AccessToken
+	if err := r.storage.SelectContext(ctx, &tokens, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]entities.AccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, token.ToEntity())
+	}
+	return result, nil
+}
+
+func (r *accessTokenRepo) UpdateLastUsed(ctx context.Context, id string) error {
+	query, args := r.qb.
+		Update("access_tokens").
+		Set("last_used_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"token_id": id}).
+		MustSql()
+
+	_, err := r.storage.ExecContext(ctx, query, args...)
+	return err
+}
+
+// Удаляет токен, если он принадлежит пользователю
+func (r *accessTokenRepo) Delete(ctx context.Context, userID, id string) error {
+	query, args := r.qb.
+		Delete("access_tokens").
+		Where(sq.Eq{"token_id": id, "user_id": userID}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrAccessTokenNotFound
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/internal/service"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Индекс активных блокировок, score - время окончания блокировки
+const lockoutsKey = "loginLockouts"
+
+type lockoutRepo struct {
+	storage *redis.Client
+}
+
+func NewLockoutRepo(storage *redis.Client) *lockoutRepo {
+	return &lockoutRepo{
+		storage: storage,
+	}
+}
+
+func (r *lockoutRepo) GetAttempts(ctx context.Context, kind, subject string) (entities.LoginAttempts, error) {
+	fields, err := r.storage.HGetAll(ctx, loginAttemptsKey(kind, subject)).Result()
+	if err != nil {
+		return entities.LoginAttempts{}, err
+	}
+	return parseLoginAttempts(kind, subject, fields), nil
+}
+
+// Увеличивает счетчик неудачных попыток, счетчик сбрасывается, если попыток не было в течение window
+func (r *lockoutRepo) RegisterFailure(ctx context.Context, kind, subject string, window time.Duration) (entities.LoginAttempts, error) {
+	key := loginAttemptsKey(kind, subject)
+
+	pipe := r.storage.TxPipeline()
+	pipe.HIncrBy(ctx, key, "failures", 1)
+	pipe.HSet(ctx, key, "last_failure_at", time.Now().Unix())
+	pipe.Expire(ctx, key, window)
+	all := pipe.HGetAll(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return entities.LoginAttempts{}, err
+	}
+	return parseLoginAttempts(kind, subject, all.Val()), nil
+}
+
+func (r *lockoutRepo) Lock(ctx context.Context, kind, subject string, until time.Time) error {
+	key := loginAttemptsKey(kind, subject)
+
+	pipe := r.storage.TxPipeline()
+	pipe.HSet(ctx, key, "locked_until", until.Unix())
+	pipe.ExpireAt(ctx, key, until)
+	pipe.ZAdd(ctx, lockoutsKey, redis.Z{Score: float64(until.Unix()), Member: lockoutMember(kind, subject)})
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *lockoutRepo) ResetAttempts(ctx context.Context, kind, subject string) error {
+	return r.storage.Del(ctx, loginAttemptsKey(kind, subject)).Err()
+}
+
+// Возвращает действующие блокировки, истекшие удаляются из индекса
+func (r *lockoutRepo) ListLockouts(ctx context.Context) ([]entities.LoginAttempts, error) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := r.storage.ZRemRangeByScore(ctx, lockoutsKey, "-inf", "("+now).Err(); err != nil {
+		return nil, err
+	}
+
+	members, err := r.storage.ZRange(ctx, lockoutsKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	lockouts := make([]entities.LoginAttempts, 0, len(members))
+	for _, member := range members {
+		kind, subject, _ := strings.Cut(member, ":")
+		attempts, err := r.GetAttempts(ctx, kind, subject)
+		if err != nil {
+			return nil, err
+		}
+		// Блокировка могла быть снята вместе с ключом попыток
+		if attempts.LockedUntil.IsZero() {
+			continue
+		}
+		lockouts = append(lockouts, attempts)
+	}
+	return lockouts, nil
+}
+
+// Снимает блокировку и сбрасывает счетчик неудачных попыток
+func (r *lockoutRepo) ClearLockout(ctx context.Context, kind, subject string) error {
+	pipe := r.storage.TxPipeline()
+	removed := pipe.ZRem(ctx, lockoutsKey, lockoutMember(kind, subject))
+	deleted := pipe.Del(ctx, loginAttemptsKey(kind, subject))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	if removed.Val() == 0 && deleted.Val() == 0 {
+		return service.ErrLockoutNotFound
+	}
+	return nil
+}
+
+func parseLoginAttempts(kind, subject string, fields map[string]string) entities.LoginAttempts {
+	attempts := entities.LoginAttempts{Kind: kind, Subject: subject}
+	if failures, err := strconv.Atoi(fields["failures"]); err == nil {
+		attempts.Failures = failures
+	}
+	if ts, err := strconv.ParseInt(fields["last_failure_at"], 10, 64); err == nil {
+		attempts.LastFailureAt = time.Unix(ts, 0)
+	}
+	if ts, err := strconv.ParseInt(fields["locked_until"], 10, 64); err == nil {
+		attempts.LockedUntil = time.Unix(ts, 0)
+	}
+	return attempts
+}
+
+func lockoutMember(kind, subject string) string {
+	return fmt.Sprintf("%s:%s", kind, subject)
+}
+
+func loginAttemptsKey(kind, subject string) string {
+	return fmt.Sprintf("loginAttempts:%s:%s", kind, subject)
+}
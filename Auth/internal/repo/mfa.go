@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/internal/service"
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// Сохраняет новый секрет TOTP. Неподтвержденная настройка перезаписывается,
+// а если MFA уже подключена, возвращает ErrMFAAlreadyEnabled
+func (r *userRepo) SaveMFASecret(ctx context.Context, userID, secret string) error {
+	query, args := r.qb.
+		Insert("user_mfa").
+		Columns("user_id", "secret").
+		Values(userID, secret).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE
+			SET secret = EXCLUDED.secret, recovery_codes = '{}', created_at = NOW()
+			WHERE user_mfa.confirmed_at IS NULL`).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *userRepo) GetMFA(ctx context.Context, userID string) (entities.MFA, error) {
+	query, args := r.qb.
+		Select("user_id", "secret", "recovery_codes", "confirmed_at").
+		From("user_mfa").
+		Where(sq.Eq{"user_id": userID}).
+		MustSql()
+
+	var mfa MFA
+	err := r.storage.GetContext(ctx, &mfa, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.MFA{}, service.ErrMFANotFound
+	}
+	if err != nil {
+		return entities.MFA{}, err
+	}
+	return mfa.ToEntity(), nil
+}
+
+// Подтверждает подключение MFA и сохраняет хеши кодов восстановления
+func (r *userRepo) ConfirmMFA(ctx context.Context, userID string, recoveryCodes []string) error {
+	query, args := r.qb.
+		Update("user_mfa").
+		Set("confirmed_at", sq.Expr("NOW()")).
+		Set("recovery_codes", pq.StringArray(recoveryCodes)).
+		Where(sq.Eq{"user_id": userID, "confirmed_at": nil}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrMFANotFound
+	}
+	return nil
+}
+
+// Удаляет использованный код восстановления. Если код уже был использован
+// параллельным запросом, возвращает ErrInvalidMFACode
+func (r *userRepo) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	query, args := r.qb.
+		Update("user_mfa").
+		Set("recovery_codes", sq.Expr("array_remove(recovery_codes, ?)", codeHash)).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Expr("? = ANY(recovery_codes)", codeHash)).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (r *userRepo) DeleteMFA(ctx context.Context, userID string) error {
+	query, args := r.qb.
+		Delete("user_mfa").
+		Where(sq.Eq{"user_id": userID}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrMFANotFound
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/internal/service"
+	"Classroom/Auth/pkg/e"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Создает токен первого шага входа, по которому затем выдаются токены после проверки кода
+func (r *tokenRepo) CreateMFAChallenge(ctx context.Context, dto dto.CreateSessionDTO, ttl time.Duration) (string, error) {
+	challenge := MFAChallenge{
+		UserID:    dto.UserID,
+		UserAgent: dto.UserAgent,
+		IP:        dto.IP,
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	}
+	token := uuid.NewString()
+	if err := r.saveMFAChallenge(ctx, token, challenge); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Возвращает данные входа и сразу удаляет токен, чтобы его нельзя было проверять параллельно
+func (r *tokenRepo) ConsumeMFAChallenge(ctx context.Context, token string) (entities.MFAChallenge, error) {
+	data, err := r.storage.GetDel(ctx, mfaChallengeKey(token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return entities.MFAChallenge{}, service.ErrInvalidToken
+	}
+	if err != nil {
+		return entities.MFAChallenge{}, err
+	}
+
+	var challenge MFAChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return entities.MFAChallenge{}, e.Wrap(err, "failed to unmarshal mfa challenge")
+	}
+	return challenge.ToEntity(), nil
+}
+
+// Возвращает токен после неверного кода, чтобы пользователь мог повторить попытку.
+// Время жизни токена не продлевается
+func (r *tokenRepo) RetryMFAChallenge(ctx context.Context, token string, challenge entities.MFAChallenge) error {
+	return r.saveMFAChallenge(ctx, token, MFAChallenge{
+		UserID:    challenge.UserID,
+		UserAgent: challenge.UserAgent,
+		IP:        challenge.IP,
+		Attempts:  challenge.Attempts,
+		ExpiresAt: challenge.ExpiresAt.Unix(),
+	})
+}
+
+func (r *tokenRepo) saveMFAChallenge(ctx context.Context, token string, challenge MFAChallenge) error {
+	ttl := time.Until(time.Unix(challenge.ExpiresAt, 0))
+	if ttl <= 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return e.Wrap(err, "failed to marshal mfa challenge")
+	}
+	return r.storage.Set(ctx, mfaChallengeKey(token), data, ttl).Err()
+}
+
+func mfaChallengeKey(token string) string {
+	return fmt.Sprintf("mfaChallenge:%s", token)
+}
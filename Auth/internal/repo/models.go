@@ -3,36 +3,119 @@ package repo
 import (
 	"Classroom/Auth/internal/entities"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type User struct {
-	ID           string `db:"user_id"`
-	Email        string `db:"email"`
-	PasswordHash []byte `db:"password_hash"`
-	IsSuperUser  bool   `db:"is_superuser"`
-	FirstName    string `db:"first_name"`
-	LastName     string `db:"last_name"`
+	ID              string     `db:"user_id"`
+	Email           string     `db:"email"`
+	PasswordHash    []byte     `db:"password_hash"`
+	IsSuperUser     bool       `db:"is_superuser"`
+	FirstName       string     `db:"first_name"`
+	LastName        string     `db:"last_name"`
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
+	DisabledAt      *time.Time `db:"disabled_at"`
 }
 
 func (u User) ToEntity() entities.User {
 	return entities.User{
-		ID:           u.ID,
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		IsSuperUser:  u.IsSuperUser,
-		FirstName:    u.FirstName,
-		LastName:     u.LastName,
+		ID:              u.ID,
+		Email:           u.Email,
+		PasswordHash:    u.PasswordHash,
+		IsSuperUser:     u.IsSuperUser,
+		FirstName:       u.FirstName,
+		LastName:        u.LastName,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		DisabledAt:      u.DisabledAt,
 	}
 }
 
 type RefreshToken struct {
 	UserID    string `json:"user_id"`
+	FamilyID  string `json:"family_id"`
 	ExpiresAt int64  `json:"expires_at"`
 }
 
 func (r RefreshToken) ToEntity() entities.RefreshToken {
 	return entities.RefreshToken{
 		UserID:    r.UserID,
+		FamilyID:  r.FamilyID,
 		ExpiresAt: time.Unix(r.ExpiresAt, 0),
 	}
 }
+
+type Session struct {
+	UserID     string `json:"user_id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  int64  `json:"created_at"`
+	LastUsedAt int64  `json:"last_used_at"`
+}
+
+func (s Session) ToEntity(id string) entities.Session {
+	return entities.Session{
+		ID:         id,
+		UserID:     s.UserID,
+		UserAgent:  s.UserAgent,
+		IP:         s.IP,
+		CreatedAt:  time.Unix(s.CreatedAt, 0),
+		LastUsedAt: time.Unix(s.LastUsedAt, 0),
+	}
+}
+
+type MFA struct {
+	UserID        string         `db:"user_id"`
+	Secret        string         `db:"secret"`
+	RecoveryCodes pq.StringArray `db:"recovery_codes"`
+	ConfirmedAt   *time.Time     `db:"confirmed_at"`
+}
+
+func (m MFA) ToEntity() entities.MFA {
+	return entities.MFA{
+		UserID:        m.UserID,
+		Secret:        m.Secret,
+		RecoveryCodes: m.RecoveryCodes,
+		ConfirmedAt:   m.ConfirmedAt,
+	}
+}
+
+type MFAChallenge struct {
+	UserID    string `json:"user_id"`
+	UserAgent string `json:"user_agent"`
+	IP        string `json:"ip"`
+	Attempts  int    `json:"attempts"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+func (c MFAChallenge) ToEntity() entities.MFAChallenge {
+	return entities.MFAChallenge{
+		UserID:    c.UserID,
+		UserAgent: c.UserAgent,
+		IP:        c.IP,
+		Attempts:  c.Attempts,
+		ExpiresAt: time.Unix(c.ExpiresAt, 0),
+	}
+}
+
+type AccessToken struct {
+	ID         string         `db:"token_id"`
+	UserID     string         `db:"user_id"`
+	Name       string         `db:"name"`
+	Scopes     pq.StringArray `db:"scopes"`
+	CreatedAt  time.Time      `db:"created_at"`
+	ExpiresAt  time.Time      `db:"expires_at"`
+	LastUsedAt *time.Time     `db:"last_used_at"`
+}
+
+func (t AccessToken) ToEntity() entities.AccessToken {
+	return entities.AccessToken{
+		ID:         t.ID,
+		UserID:     t.UserID,
+		Name:       t.Name,
+		Scopes:     t.Scopes,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"Classroom/Auth/internal/service"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Создает одноразовый токен для сброса пароля
+func (r *tokenRepo) CreatePasswordReset(ctx context.Context, userID string, ttl time.Duration) (string, error) {
+	return r.createOneTime(ctx, passwordResetKey, userID, ttl)
+}
+
+// Возвращает ID пользователя и сразу удаляет токен, чтобы его нельзя было использовать повторно
+func (r *tokenRepo) ConsumePasswordReset(ctx context.Context, token string) (string, error) {
+	return r.consumeOneTime(ctx, passwordResetKey(token))
+}
+
+// Создает одноразовый токен для подтверждения почты. Токен привязан к адресу, на который
+// отправлено письмо, поэтому после смены почты старые ссылки не подтвердят новый адрес
+func (r *tokenRepo) CreateEmailVerification(ctx context.Context, userID, email string, ttl time.Duration) (string, error) {
+	return r.createOneTime(ctx, emailVerificationKey, userID+":"+email, ttl)
+}
+
+// Возвращает ID пользователя и подтверждаемую почту и сразу удаляет токен
+func (r *tokenRepo) ConsumeEmailVerification(ctx context.Context, token string) (string, string, error) {
+	value, err := r.consumeOneTime(ctx, emailVerificationKey(token))
+	if err != nil {
+		return "", "", err
+	}
+	// В UUID нет двоеточий, поэтому почта - всё после первого
+	userID, email, ok := strings.Cut(value, ":")
+	if !ok {
+		return "", "", service.ErrInvalidToken
+	}
+	return userID, email, nil
+}
+
+func (r *tokenRepo) createOneTime(ctx context.Context, key func(string) string, userID string, ttl time.Duration) (string, error) {
+	token := uuid.NewString()
+	if err := r.storage.Set(ctx, key(token), userID, ttl).Err(); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (r *tokenRepo) consumeOneTime(ctx context.Context, key string) (string, error) {
+	userID, err := r.storage.GetDel(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", service.ErrInvalidToken
+	}
+	if err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func passwordResetKey(token string) string {
+	return fmt.Sprintf("passwordReset:%s", token)
+}
+
+func emailVerificationKey(token string) string {
+	return fmt.Sprintf("emailVerification:%s", token)
+}
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"Classroom/Auth/internal/dto"
 	"Classroom/Auth/internal/entities"
 	"Classroom/Auth/internal/service"
 	"Classroom/Auth/pkg/e"
@@ -8,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,26 +26,61 @@ func NewTokenRepo(storage *redis.Client) *tokenRepo {
 	}
 }
 
-func (r *tokenRepo) Create(ctx context.Context, userID string, ttl time.Duration) (string, error) {
-	payload := RefreshToken{
-		UserID:    userID,
-		ExpiresAt: time.Now().Add(ttl).Unix(),
+// Создает refresh токен с новой цепочкой (family) и сессией для нее, используется при входе
+func (r *tokenRepo) Create(ctx context.Context, dto dto.CreateSessionDTO, ttl time.Duration) (string, error) {
+	familyID := uuid.NewString()
+	now := time.Now().Unix()
+	session := Session{
+		UserID:     dto.UserID,
+		UserAgent:  dto.UserAgent,
+		IP:         dto.IP,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	if err := r.saveSession(ctx, familyID, session, ttl); err != nil {
+		return "", err
+	}
+	return r.issue(ctx, dto.UserID, familyID, ttl)
+}
+
+// Выдает новый токен в той же цепочке, старый токен помечается использованным.
+// Если токен уже был ротирован параллельным запросом, возвращает ErrTokenReused
+func (r *tokenRepo) Rotate(ctx context.Context, token string, ttl time.Duration) (string, error) {
+	data, err := r.storage.GetDel(ctx, tokenKey(token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return "", service.ErrTokenReused
 	}
-	data, err := json.Marshal(payload)
 	if err != nil {
-		return "", e.Wrap(err, "failed to marshal refresh token")
+		return "", err
 	}
-	token := uuid.NewString()
-	if err := r.storage.Set(ctx, tokenKey(token), data, ttl).Err(); err != nil {
+
+	var payload RefreshToken
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return "", e.Wrap(err, "failed to unmarshal refresh token")
+	}
+	// Токены, выданные до появления цепочек, начинают новую цепочку
+	if payload.FamilyID == "" {
+		payload.FamilyID = uuid.NewString()
+		if data, err = json.Marshal(payload); err != nil {
+			return "", e.Wrap(err, "failed to marshal refresh token")
+		}
+	}
+
+	if err := r.storage.Set(ctx, usedTokenKey(token), data, ttl).Err(); err != nil {
 		return "", err
 	}
-	return token, nil
+	if err := r.touchSession(ctx, payload.UserID, payload.FamilyID, ttl); err != nil {
+		return "", err
+	}
+	return r.issue(ctx, payload.UserID, payload.FamilyID, ttl)
 }
 
+// Если токен уже был ротирован, возвращает информацию о нем вместе с ErrTokenReused,
+// чтобы можно было отозвать всю цепочку
 func (r *tokenRepo) GetInfoByToken(ctx context.Context, token string) (entities.RefreshToken, error) {
 	data, err := r.storage.Get(ctx, tokenKey(token)).Bytes()
 	if errors.Is(err, redis.Nil) {
-		return entities.RefreshToken{}, service.ErrInvalidToken
+		return r.getUsedInfo(ctx, token)
 	}
 	if err != nil {
 		return entities.RefreshToken{}, err
@@ -57,17 +94,204 @@ func (r *tokenRepo) GetInfoByToken(ctx context.Context, token string) (entities.
 	return payload.ToEntity(), nil
 }
 
+// Отзывает токен вместе со всей его цепочкой
 func (r *tokenRepo) Revoke(ctx context.Context, token string) (bool, error) {
-	res, err := r.storage.Del(ctx, tokenKey(token)).Result()
+	data, err := r.storage.GetDel(ctx, tokenKey(token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
 	if err != nil {
 		return false, err
 	}
-	if res == 0 {
-		return false, nil
+
+	var payload RefreshToken
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return false, e.Wrap(err, "failed to unmarshal refresh token")
+	}
+	if payload.FamilyID == "" {
+		return true, nil
+	}
+
+	if err := r.RevokeFamily(ctx, payload.FamilyID); err != nil {
+		return false, err
 	}
 	return true, nil
 }
 
+// Удаляет все токены цепочки, как действующие, так и использованные, вместе с сессией
+func (r *tokenRepo) RevokeFamily(ctx context.Context, familyID string) error {
+	tokens, err := r.storage.SMembers(ctx, familyKey(familyID)).Result()
+	if err != nil {
+		return err
+	}
+
+	session, err := r.GetSession(ctx, familyID)
+	if err != nil && !errors.Is(err, service.ErrSessionNotFound) {
+		return err
+	}
+
+	keys := make([]string, 0, 2*len(tokens)+2)
+	keys = append(keys, familyKey(familyID), sessionKey(familyID))
+	for _, token := range tokens {
+		keys = append(keys, tokenKey(token), usedTokenKey(token))
+	}
+
+	pipe := r.storage.TxPipeline()
+	pipe.Del(ctx, keys...)
+	if session.UserID != "" {
+		pipe.SRem(ctx, userSessionsKey(session.UserID), familyID)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// Завершает все сессии пользователя
+func (r *tokenRepo) RevokeAll(ctx context.Context, userID string) error {
+	sessionIDs, err := r.storage.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, sessionID := range sessionIDs {
+		if err := r.RevokeFamily(ctx, sessionID); err != nil {
+			return e.Wrap(err, "failed to revoke session")
+		}
+	}
+	return r.storage.Del(ctx, userSessionsKey(userID)).Err()
+}
+
+func (r *tokenRepo) GetSession(ctx context.Context, sessionID string) (entities.Session, error) {
+	data, err := r.storage.Get(ctx, sessionKey(sessionID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return entities.Session{}, service.ErrSessionNotFound
+	}
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return entities.Session{}, e.Wrap(err, "failed to unmarshal session")
+	}
+	return session.ToEntity(sessionID), nil
+}
+
+// Возвращает активные сессии пользователя, начиная с последней использованной.
+// Истекшие сессии удаляются из индекса пользователя
+func (r *tokenRepo) ListSessions(ctx context.Context, userID string) ([]entities.Session, error) {
+	sessionIDs, err := r.storage.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entities.Session, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		session, err := r.GetSession(ctx, sessionID)
+		if errors.Is(err, service.ErrSessionNotFound) {
+			r.storage.SRem(ctx, userSessionsKey(userID), sessionID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+func (r *tokenRepo) issue(ctx context.Context, userID, familyID string, ttl time.Duration) (string, error) {
+	payload := RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", e.Wrap(err, "failed to marshal refresh token")
+	}
+
+	token := uuid.NewString()
+	pipe := r.storage.TxPipeline()
+	pipe.Set(ctx, tokenKey(token), data, ttl)
+	pipe.SAdd(ctx, familyKey(familyID), token)
+	pipe.Expire(ctx, familyKey(familyID), ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Обновляет время последнего использования сессии и продлевает ее.
+// Для токенов, выданных до появления сессий, сессия создается заново
+func (r *tokenRepo) touchSession(ctx context.Context, userID, sessionID string, ttl time.Duration) error {
+	now := time.Now().Unix()
+	session := Session{UserID: userID, CreatedAt: now}
+
+	data, err := r.storage.Get(ctx, sessionKey(sessionID)).Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &session); err != nil {
+			return e.Wrap(err, "failed to unmarshal session")
+		}
+	}
+
+	session.LastUsedAt = now
+	return r.saveSession(ctx, sessionID, session, ttl)
+}
+
+func (r *tokenRepo) saveSession(ctx context.Context, sessionID string, session Session, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return e.Wrap(err, "failed to marshal session")
+	}
+
+	pipe := r.storage.TxPipeline()
+	pipe.Set(ctx, sessionKey(sessionID), data, ttl)
+	pipe.SAdd(ctx, userSessionsKey(session.UserID), sessionID)
+	pipe.Expire(ctx, userSessionsKey(session.UserID), ttl)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (r *tokenRepo) getUsedInfo(ctx context.Context, token string) (entities.RefreshToken, error) {
+	data, err := r.storage.Get(ctx, usedTokenKey(token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return entities.RefreshToken{}, service.ErrInvalidToken
+	}
+	if err != nil {
+		return entities.RefreshToken{}, err
+	}
+
+	var payload RefreshToken
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return entities.RefreshToken{}, e.Wrap(err, "failed to unmarshal refresh token")
+	}
+
+	return payload.ToEntity(), service.ErrTokenReused
+}
+
 func tokenKey(token string) string {
 	return fmt.Sprintf("refreshToken:%s", token)
 }
+
+func usedTokenKey(token string) string {
+	return fmt.Sprintf("usedRefreshToken:%s", token)
+}
+
+func familyKey(familyID string) string {
+	return fmt.Sprintf("refreshFamily:%s", familyID)
+}
+
+func sessionKey(sessionID string) string {
+	return fmt.Sprintf("session:%s", sessionID)
+}
+
+func userSessionsKey(userID string) string {
+	return fmt.Sprintf("userSessions:%s", userID)
+}
@@ -6,6 +6,7 @@ import (
 	"Classroom/Auth/internal/service"
 	"context"
 	"errors"
+	"strings"
 
 	"database/sql"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/lib/pq"
 )
 
+var userColumns = []string{"user_id", "email", "password_hash", "is_superuser", "first_name", "last_name", "email_verified_at", "disabled_at"}
+
 type userRepo struct {
 	storage *sqlx.DB
 	qb      sq.StatementBuilderType
@@ -47,7 +50,7 @@ func (r *userRepo) Create(ctx context.Context, dto dto.CreateUserDTO) (entities.
 
 func (r *userRepo) GetByEmail(ctx context.Context, email string) (entities.User, error) {
 	query, args := r.qb.
-		Select("user_id", "email", "password_hash", "is_superuser", "first_name", "last_name").
+		Select(userColumns...).
 		From("users").
 		Where(sq.Eq{"email": email}).
 		MustSql()
@@ -65,7 +68,7 @@ func (r *userRepo) GetByEmail(ctx context.Context, email string) (entities.User,
 
 func (r *userRepo) GetByID(ctx context.Context, id string) (entities.User, error) {
 	query, args := r.qb.
-		Select("user_id", "email", "password_hash", "is_superuser", "first_name", "last_name").
+		Select(userColumns...).
 		From("users").
 		Where(sq.Eq{"user_id": id}).
 		MustSql()
@@ -81,6 +84,219 @@ func (r *userRepo) GetByID(ctx context.Context, id string) (entities.User, error
 	return user.ToEntity(), nil
 }
 
+func (r *userRepo) UpdatePassword(ctx context.Context, id string, passwordHash []byte) error {
+	query, args := r.qb.
+		Update("users").
+		Set("password_hash", passwordHash).
+		Where(sq.Eq{"user_id": id}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrUserNotFound
+	}
+	return nil
+}
+
+// Почта подтверждается, только если у пользователя всё ещё этот адрес
+func (r *userRepo) MarkEmailVerified(ctx context.Context, id, email string) error {
+	query, args := r.qb.
+		Update("users").
+		Set("email_verified_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"user_id": id, "email": email}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrUserNotFound
+	}
+	return nil
+}
+
+// Обновляет только заданные поля и возвращает пользователя после изменения
+func (r *userRepo) UpdateProfile(ctx context.Context, dto dto.UpdateProfileDTO) (entities.User, error) {
+	fields := map[string]any{}
+	if dto.FirstName != nil {
+		fields["first_name"] = *dto.FirstName
+	}
+	if dto.LastName != nil {
+		fields["last_name"] = *dto.LastName
+	}
+	if len(fields) == 0 {
+		return r.GetByID(ctx, dto.UserID)
+	}
+
+	query, args := r.qb.
+		Update("users").
+		SetMap(fields).
+		Where(sq.Eq{"user_id": dto.UserID}).
+		Suffix("RETURNING " + strings.Join(userColumns, ", ")).
+		MustSql()
+
+	var user User
+	err := r.storage.GetContext(ctx, &user, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.User{}, service.ErrUserNotFound
+	}
+	if err != nil {
+		return entities.User{}, err
+	}
+	return user.ToEntity(), nil
+}
+
+// Меняет почту и сбрасывает ее подтверждение. Если почта занята, возвращает ErrUserAlreadyExists
+func (r *userRepo) UpdateEmail(ctx context.Context, id, email string) error {
+	query, args := r.qb.
+		Update("users").
+		Set("email", email).
+		Set("email_verified_at", nil).
+		Where(sq.Eq{"user_id": id}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if isUniqueViolation(err) {
+		return service.ErrUserAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrUserNotFound
+	}
+	return nil
+}
+
+// Возвращает страницу пользователей и их общее количество. Поиск идет по подстроке в почте, имени и фамилии
+func (r *userRepo) List(ctx context.Context, dto dto.ListUsersDTO) ([]entities.User, int, error) {
+	filter := sq.And{}
+	if dto.Query != "" {
+		pattern := "%" + escapeLike(dto.Query) + "%"
+		filter = append(filter, sq.Or{
+			sq.ILike{"email": pattern},
+			sq.ILike{"first_name": pattern},
+			sq.ILike{"last_name": pattern},
+		})
+	}
+
+	query, args := r.qb.
+		Select(userColumns...).
+		From("users").
+		Where(filter).
+		OrderBy("email").
+		Limit(uint64(dto.Limit)).
+		Offset(uint64(dto.Index * dto.Limit)).
+		MustSql()
+
+	var users []User
+	if err := r.storage.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, 0, err
+	}
+
+	countQuery, countArgs := r.qb.
+		Select("COUNT(*)").
+		From("users").
+		Where(filter).
+		MustSql()
+
+	var total int
+	if err := r.storage.GetContext(ctx, &total, countQuery, countArgs...); err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]entities.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, user.ToEntity())
+	}
+	return result, total, nil
+}
+
+func (r *userRepo) SetSuperuser(ctx context.Context, id string, isSuperUser bool) error {
+	query, args := r.qb.
+		Update("users").
+		Set("is_superuser", isSuperUser).
+		Where(sq.Eq{"user_id": id}).
+		MustSql()
+
+	return r.execOne(ctx, query, args...)
+}
+
+// Отключает пользователя или снова включает его, если disabled == false
+func (r *userRepo) SetDisabled(ctx context.Context, id string, disabled bool) error {
+	var disabledAt any
+	if disabled {
+		disabledAt = sq.Expr("COALESCE(disabled_at, NOW())")
+	}
+
+	query, args := r.qb.
+		Update("users").
+		Set("disabled_at", disabledAt).
+		Where(sq.Eq{"user_id": id}).
+		MustSql()
+
+	return r.execOne(ctx, query, args...)
+}
+
+// Удаляет пользователя. Если на пользователя ссылаются курсы или статусы заданий,
+// возвращает ErrUserInUse
+func (r *userRepo) Delete(ctx context.Context, id string) error {
+	query, args := r.qb.
+		Delete("users").
+		Where(sq.Eq{"user_id": id}).
+		MustSql()
+
+	err := r.execOne(ctx, query, args...)
+	if isForeignKeyViolation(err) {
+		return service.ErrUserInUse
+	}
+	return err
+}
+
+// Выполняет запрос, который должен затронуть одного пользователя, иначе возвращает ErrUserNotFound
+func (r *userRepo) execOne(ctx context.Context, query string, args ...any) error {
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return service.ErrUserNotFound
+	}
+	return nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Name() == "foreign_key_violation"
+	}
+	return false
+}
+
 func isUniqueViolation(err error) bool {
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
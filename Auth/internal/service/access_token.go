@@ -0,0 +1,101 @@
+package service
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/pkg/e"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Префикс позволяет отличить персональный токен от JWT без обращения к сервису
+const AccessTokenPrefix = "cpat_"
+
+// Создает персональный токен. Сам токен возвращается только здесь, в базе хранится его хеш
+func (a *authService) CreateAccessToken(ctx context.Context, payload dto.CreateAccessTokenDTO) (entities.AccessToken, string, error) {
+	now := time.Now()
+	if !payload.ExpiresAt.After(now) || payload.ExpiresAt.After(now.Add(a.conf.AccessTokenMaxTTL)) {
+		return entities.AccessToken{}, "", ErrInvalidAccessTokenExpiry
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return entities.AccessToken{}, "", e.Wrap(err, "failed to generate access token")
+	}
+	token := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	accessToken, err := a.accessTokens.Create(ctx, payload, hashAccessToken(token))
+	if err != nil {
+		return entities.AccessToken{}, "", e.Wrap(err, "failed to create access token")
+	}
+
+	a.logger.Info("access token created", "user_id", payload.UserID, "token_id", accessToken.ID)
+	return accessToken, token, nil
+}
+
+func (a *authService) ListAccessTokens(ctx context.Context, userID string) ([]entities.AccessToken, error) {
+	tokens, err := a.accessTokens.ListByUserID(ctx, userID)
+	return tokens, e.WrapIfErr(err, "failed to list access tokens")
+}
+
+func (a *authService) RevokeAccessToken(ctx context.Context, userID, tokenID string) error {
+	err := a.accessTokens.Delete(ctx, userID, tokenID)
+	if errors.Is(err, ErrAccessTokenNotFound) {
+		return ErrAccessTokenNotFound
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to delete access token")
+	}
+
+	a.logger.Info("access token revoked", "user_id", userID, "token_id", tokenID)
+	return nil
+}
+
+// Проверяет персональный токен и возвращает пользователя и разрешения
+func (a *authService) IntrospectAccessToken(ctx context.Context, token string) (dto.AccessTokenInfoDTO, error) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		return dto.AccessTokenInfoDTO{}, ErrInvalidToken
+	}
+
+	accessToken, err := a.accessTokens.GetByHash(ctx, hashAccessToken(token))
+	if errors.Is(err, ErrAccessTokenNotFound) {
+		return dto.AccessTokenInfoDTO{}, ErrInvalidToken
+	}
+	if err != nil {
+		return dto.AccessTokenInfoDTO{}, e.Wrap(err, "failed to get access token")
+	}
+	if !accessToken.ExpiresAt.After(time.Now()) {
+		return dto.AccessTokenInfoDTO{}, ErrInvalidToken
+	}
+
+	user, err := a.users.GetByID(ctx, accessToken.UserID)
+	if err != nil {
+		return dto.AccessTokenInfoDTO{}, e.Wrap(err, "failed to get user by id")
+	}
+	if user.DisabledAt != nil {
+		return dto.AccessTokenInfoDTO{}, ErrInvalidToken
+	}
+
+	// Время использования носит информационный характер, поэтому ошибку не возвращаем
+	if err := a.accessTokens.UpdateLastUsed(ctx, accessToken.ID); err != nil {
+		a.logger.Warn("failed to update access token last used", "token_id", accessToken.ID, "err", err)
+	}
+
+	return dto.AccessTokenInfoDTO{
+		UserID:      user.ID,
+		IsSuperUser: user.IsSuperUser,
+		Scopes:      accessToken.Scopes,
+		ExpiresAt:   accessToken.ExpiresAt,
+	}, nil
+}
+
+// Токен содержит 256 бит случайных данных, поэтому медленный хеш не нужен
+func hashAccessToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
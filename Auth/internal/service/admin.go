@@ -0,0 +1,75 @@
+package service
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/pkg/e"
+	"context"
+	"errors"
+)
+
+func (a *authService) ListUsers(ctx context.Context, payload dto.ListUsersDTO) ([]entities.User, int, error) {
+	users, total, err := a.users.List(ctx, payload)
+	return users, total, e.WrapIfErr(err, "failed to list users")
+}
+
+func (a *authService) GetUser(ctx context.Context, userID string) (entities.User, error) {
+	user, err := a.users.GetByID(ctx, userID)
+	if errors.Is(err, ErrUserNotFound) {
+		return entities.User{}, ErrUserNotFound
+	}
+	return user, e.WrapIfErr(err, "failed to get user by id")
+}
+
+// Права применяются к новым access токенам, уже выданные действуют до истечения access_ttl
+func (a *authService) SetSuperuser(ctx context.Context, userID string, isSuperUser bool) error {
+	err := a.users.SetSuperuser(ctx, userID, isSuperUser)
+	if errors.Is(err, ErrUserNotFound) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to set superuser")
+	}
+
+	a.logger.Info("superuser changed", "id", userID, "is_superuser", isSuperUser)
+	return nil
+}
+
+// Отключает пользователя и завершает его сессии, либо снова включает его
+func (a *authService) DisableUser(ctx context.Context, userID string, disabled bool) error {
+	err := a.users.SetDisabled(ctx, userID, disabled)
+	if errors.Is(err, ErrUserNotFound) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to set user disabled")
+	}
+
+	if disabled {
+		if err := a.tokens.RevokeAll(ctx, userID); err != nil {
+			return e.Wrap(err, "failed to revoke all sessions")
+		}
+	}
+
+	a.logger.Info("user disabled changed", "id", userID, "disabled", disabled)
+	return nil
+}
+
+// MFA и персональные токены удаляются вместе с пользователем. Пользователя,
+// у которого есть курсы или статусы заданий, удалить нельзя, его можно только отключить
+func (a *authService) DeleteUser(ctx context.Context, userID string) error {
+	err := a.users.Delete(ctx, userID)
+	if errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrUserInUse) {
+		return err
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to delete user")
+	}
+
+	if err := a.tokens.RevokeAll(ctx, userID); err != nil {
+		return e.Wrap(err, "failed to revoke all sessions")
+	}
+
+	a.logger.Info("user deleted", "id", userID)
+	return nil
+}
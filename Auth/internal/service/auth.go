@@ -5,6 +5,8 @@ import (
 	"Classroom/Auth/internal/dto"
 	"Classroom/Auth/internal/entities"
 	"Classroom/Auth/pkg/e"
+	"Classroom/Auth/pkg/events"
+	"Classroom/Auth/pkg/jwks"
 	"context"
 	"errors"
 	"log/slog"
@@ -17,27 +19,84 @@ type UserRepo interface {
 	Create(ctx context.Context, dto dto.CreateUserDTO) (entities.User, error)
 	GetByEmail(ctx context.Context, email string) (entities.User, error)
 	GetByID(ctx context.Context, id string) (entities.User, error)
+	UpdatePassword(ctx context.Context, id string, passwordHash []byte) error
+	MarkEmailVerified(ctx context.Context, id, email string) error
+	UpdateProfile(ctx context.Context, dto dto.UpdateProfileDTO) (entities.User, error)
+	UpdateEmail(ctx context.Context, id, email string) error
+	List(ctx context.Context, dto dto.ListUsersDTO) ([]entities.User, int, error)
+	SetSuperuser(ctx context.Context, id string, isSuperUser bool) error
+	SetDisabled(ctx context.Context, id string, disabled bool) error
+	Delete(ctx context.Context, id string) error
+	SaveMFASecret(ctx context.Context, userID, secret string) error
+	GetMFA(ctx context.Context, userID string) (entities.MFA, error)
+	ConfirmMFA(ctx context.Context, userID string, recoveryCodes []string) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+	DeleteMFA(ctx context.Context, userID string) error
 }
 
 type TokenRepo interface {
-	Create(ctx context.Context, userID string, ttl time.Duration) (string, error)
+	Create(ctx context.Context, dto dto.CreateSessionDTO, ttl time.Duration) (string, error)
+	Rotate(ctx context.Context, token string, ttl time.Duration) (string, error)
 	GetInfoByToken(ctx context.Context, token string) (entities.RefreshToken, error)
 	Revoke(ctx context.Context, token string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAll(ctx context.Context, userID string) error
+	GetSession(ctx context.Context, sessionID string) (entities.Session, error)
+	ListSessions(ctx context.Context, userID string) ([]entities.Session, error)
+	CreatePasswordReset(ctx context.Context, userID string, ttl time.Duration) (string, error)
+	ConsumePasswordReset(ctx context.Context, token string) (string, error)
+	CreateEmailVerification(ctx context.Context, userID, email string, ttl time.Duration) (string, error)
+	ConsumeEmailVerification(ctx context.Context, token string) (userID, email string, err error)
+	CreateMFAChallenge(ctx context.Context, dto dto.CreateSessionDTO, ttl time.Duration) (string, error)
+	ConsumeMFAChallenge(ctx context.Context, token string) (entities.MFAChallenge, error)
+	RetryMFAChallenge(ctx context.Context, token string, challenge entities.MFAChallenge) error
+}
+
+type LockoutRepo interface {
+	GetAttempts(ctx context.Context, kind, subject string) (entities.LoginAttempts, error)
+	RegisterFailure(ctx context.Context, kind, subject string, window time.Duration) (entities.LoginAttempts, error)
+	Lock(ctx context.Context, kind, subject string, until time.Time) error
+	ResetAttempts(ctx context.Context, kind, subject string) error
+	ListLockouts(ctx context.Context) ([]entities.LoginAttempts, error)
+	ClearLockout(ctx context.Context, kind, subject string) error
+}
+
+type AccessTokenRepo interface {
+	Create(ctx context.Context, dto dto.CreateAccessTokenDTO, tokenHash []byte) (entities.AccessToken, error)
+	GetByHash(ctx context.Context, tokenHash []byte) (entities.AccessToken, error)
+	ListByUserID(ctx context.Context, userID string) ([]entities.AccessToken, error)
+	UpdateLastUsed(ctx context.Context, id string) error
+	Delete(ctx context.Context, userID, id string) error
+}
+
+type Producer interface {
+	PublishPasswordResetRequested(event events.PasswordResetRequested) error
+	PublishUserRegistered(event events.UserRegistered) error
+	PublishEmailVerificationRequested(event events.EmailVerificationRequested) error
+	PublishUserUpdated(event events.UserUpdated) error
 }
 
 type authService struct {
-	logger *slog.Logger // Для дебага и информации, ошибки логируются выше
-	users  UserRepo
-	tokens TokenRepo
-	conf   config.Auth
+	logger       *slog.Logger // Для дебага и информации, ошибки логируются выше
+	users        UserRepo
+	tokens       TokenRepo
+	lockouts     LockoutRepo
+	accessTokens AccessTokenRepo
+	producer     Producer
+	keys         *jwks.KeySet // Ключи подписи access токенов
+	conf         config.Auth
 }
 
-func NewAuthService(logger *slog.Logger, users UserRepo, tokens TokenRepo, conf config.Auth) *authService {
+func NewAuthService(logger *slog.Logger, users UserRepo, tokens TokenRepo, lockouts LockoutRepo, accessTokens AccessTokenRepo, producer Producer, keys *jwks.KeySet, conf config.Auth) *authService {
 	return &authService{
-		logger: logger.With(slog.String("service", "auth")),
-		users:  users,
-		tokens: tokens,
-		conf:   conf,
+		logger:       logger.With(slog.String("service", "auth")),
+		users:        users,
+		tokens:       tokens,
+		lockouts:     lockouts,
+		accessTokens: accessTokens,
+		producer:     producer,
+		keys:         keys,
+		conf:         conf,
 	}
 }
 
@@ -60,14 +119,37 @@ func (a *authService) Register(ctx context.Context, payload dto.RegisterDTO) (st
 	}
 
 	a.logger.Info("user registered", "id", user.ID)
+
+	// Пользователь уже создан, поэтому ошибку отправки письма не возвращаем,
+	// письмо можно запросить повторно
+	token, err := a.tokens.CreateEmailVerification(ctx, user.ID, payload.Email, a.conf.EmailVerificationTTL)
+	if err != nil {
+		a.logger.Warn("failed to create email verification token", "id", user.ID, "err", err)
+		return user.ID, nil
+	}
+	err = a.producer.PublishUserRegistered(events.UserRegistered{
+		UserID:    user.ID,
+		Token:     token,
+		ExpiresAt: time.Now().Add(a.conf.EmailVerificationTTL),
+	})
+	if err != nil {
+		a.logger.Warn("failed to publish user registered", "id", user.ID, "err", err)
+	}
+
 	return user.ID, nil
 }
 
 func (a *authService) Login(ctx context.Context, payload dto.LoginDTO) (dto.TokensDTO, error) {
+	// Проверяем, не заблокирован ли вход по почте или IP после неудачных попыток
+	subjects := a.loginSubjects(payload)
+	if err := a.checkLoginAttempts(ctx, subjects); err != nil {
+		return dto.TokensDTO{}, err
+	}
+
 	// Проверяем существует ли пользователь
 	user, err := a.users.GetByEmail(ctx, payload.Email)
 	if errors.Is(err, ErrUserNotFound) {
-		return dto.TokensDTO{}, ErrInvalidCredentials
+		return dto.TokensDTO{}, a.loginFailed(ctx, subjects)
 	}
 	if err != nil {
 		return dto.TokensDTO{}, e.Wrap(err, "failed to get user by email")
@@ -75,51 +157,113 @@ func (a *authService) Login(ctx context.Context, payload dto.LoginDTO) (dto.Toke
 
 	// Проверяем пароль
 	if bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(payload.Password)) != nil {
-		return dto.TokensDTO{}, ErrInvalidCredentials
+		return dto.TokensDTO{}, a.loginFailed(ctx, subjects)
 	}
 
-	// Создаем refreshToken
-	refreshToken, err := a.tokens.Create(ctx, user.ID, a.conf.RefreshTTL)
+	// Пароль верный, сбрасываем счетчик неудачных попыток по почте.
+	// Счетчик по IP не сбрасываем, иначе его можно обнулять входом в свой аккаунт
+	if err := a.lockouts.ResetAttempts(ctx, entities.LockoutKindEmail, normalizeEmail(payload.Email)); err != nil {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to reset login attempts")
+	}
+
+	// Отключенный администратором пользователь не может войти
+	if user.DisabledAt != nil {
+		return dto.TokensDTO{}, ErrUserDisabled
+	}
+
+	// Проверяем подтверждение почты, если это требуется конфигурацией
+	if a.conf.RequireEmailVerification && user.EmailVerifiedAt == nil {
+		return dto.TokensDTO{}, ErrEmailNotVerified
+	}
+
+	session := dto.CreateSessionDTO{
+		UserID:    user.ID,
+		UserAgent: payload.UserAgent,
+		IP:        payload.IP,
+	}
+
+	// Если подключена MFA, токены выдаются только после проверки кода в VerifyMFA
+	mfa, err := a.users.GetMFA(ctx, user.ID)
+	if err != nil && !errors.Is(err, ErrMFANotFound) {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to get mfa")
+	}
+	if err == nil && mfa.ConfirmedAt != nil {
+		mfaToken, err := a.tokens.CreateMFAChallenge(ctx, session, a.conf.MFAChallengeTTL)
+		if err != nil {
+			return dto.TokensDTO{}, e.Wrap(err, "failed to create mfa challenge")
+		}
+
+		a.logger.Info("mfa challenge issued", "id", user.ID)
+		return dto.TokensDTO{MFAToken: mfaToken}, nil
+	}
+
+	tokens, err := a.issueTokens(ctx, user, session)
+	if err != nil {
+		return dto.TokensDTO{}, err
+	}
+
+	a.logger.Info("user logged in", "id", user.ID)
+	return tokens, nil
+}
+
+// Создает refresh токен с новой сессией и access токен для него
+func (a *authService) issueTokens(ctx context.Context, user entities.User, session dto.CreateSessionDTO) (dto.TokensDTO, error) {
+	// Создаем refreshToken вместе с новой сессией
+	refreshToken, err := a.tokens.Create(ctx, session, a.conf.RefreshTTL)
 	if err != nil {
 		return dto.TokensDTO{}, e.Wrap(err, "failed to create refresh token")
 	}
 
 	// Создаем accessToken
-	accessToken, err := SignJWT(user.ID, user.IsSuperUser, []byte(a.conf.JwtSecret), a.conf.AccessTTL)
+	accessToken, err := SignJWT(user.ID, user.IsSuperUser, a.keys.Active, a.conf.AccessTTL)
 	if err != nil {
 		return dto.TokensDTO{}, e.Wrap(err, "failed to sign access token")
 	}
 
-	a.logger.Info("user logged in", "id", user.ID)
 	return dto.TokensDTO{AccessToken: accessToken, RefreshToken: refreshToken}, nil
 }
 
-func (a *authService) Refresh(ctx context.Context, refreshToken string) (string, error) {
+func (a *authService) Refresh(ctx context.Context, refreshToken string) (dto.TokensDTO, error) {
 	// Получаем информацию по токену
 	info, err := a.tokens.GetInfoByToken(ctx, refreshToken)
+	if errors.Is(err, ErrTokenReused) {
+		return dto.TokensDTO{}, a.revokeFamily(ctx, info)
+	}
 	if errors.Is(err, ErrInvalidToken) {
-		return "", ErrInvalidToken
+		return dto.TokensDTO{}, ErrInvalidToken
 	}
 	if err != nil {
-		return "", e.Wrap(err, "failed to get refresh token info")
+		return dto.TokensDTO{}, e.Wrap(err, "failed to get refresh token info")
 	}
 
 	if info.ExpiresAt.Before(time.Now()) {
-		return "", ErrInvalidToken
+		return dto.TokensDTO{}, ErrInvalidToken
 	}
 
 	// Получаем актуальную информацию о пользователе
 	user, err := a.users.GetByID(ctx, info.UserID)
 	if err != nil {
-		return "", e.Wrap(err, "failed to get user by id")
+		return dto.TokensDTO{}, e.Wrap(err, "failed to get user by id")
+	}
+	if user.DisabledAt != nil {
+		return dto.TokensDTO{}, ErrUserDisabled
+	}
+
+	// Ротируем refreshToken, старый токен становится недействительным
+	newRefreshToken, err := a.tokens.Rotate(ctx, refreshToken, a.conf.RefreshTTL)
+	if errors.Is(err, ErrTokenReused) {
+		return dto.TokensDTO{}, a.revokeFamily(ctx, info)
+	}
+	if err != nil {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to rotate refresh token")
 	}
 
 	// Создаем accessToken
-	accessToken, err := SignJWT(user.ID, user.IsSuperUser, []byte(a.conf.JwtSecret), a.conf.AccessTTL)
+	accessToken, err := SignJWT(user.ID, user.IsSuperUser, a.keys.Active, a.conf.AccessTTL)
 	if err != nil {
-		return "", e.Wrap(err, "failed to sign access token")
+		return dto.TokensDTO{}, e.Wrap(err, "failed to sign access token")
 	}
-	return accessToken, nil
+	return dto.TokensDTO{AccessToken: accessToken, RefreshToken: newRefreshToken}, nil
 }
 
 func (a *authService) Logout(ctx context.Context, refreshToken string) error {
@@ -131,6 +275,169 @@ func (a *authService) Logout(ctx context.Context, refreshToken string) error {
 	return e.WrapIfErr(err, "failed to revoke refresh token")
 }
 
+// Повторное использование уже ротированного токена означает, что он мог быть украден,
+// поэтому отзываем всю цепочку и заставляем пользователя войти заново
+func (a *authService) revokeFamily(ctx context.Context, info entities.RefreshToken) error {
+	a.logger.Warn("refresh token reuse detected, revoking token family", "user_id", info.UserID, "family_id", info.FamilyID)
+	if err := a.tokens.RevokeFamily(ctx, info.FamilyID); err != nil {
+		return e.Wrap(err, "failed to revoke token family")
+	}
+	return ErrInvalidToken
+}
+
 func (a *authService) GetUserInfo(ctx context.Context, userID string) (entities.User, error) {
 	return a.users.GetByID(ctx, userID)
 }
+
+func (a *authService) RequestPasswordReset(ctx context.Context, payload dto.RequestPasswordResetDTO) error {
+	// Не сообщаем, существует ли пользователь, чтобы нельзя было перебирать почты
+	user, err := a.users.GetByEmail(ctx, payload.Email)
+	if errors.Is(err, ErrUserNotFound) {
+		a.logger.Debug("password reset requested for unknown email")
+		return nil
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to get user by email")
+	}
+
+	token, err := a.tokens.CreatePasswordReset(ctx, user.ID, a.conf.PasswordResetTTL)
+	if err != nil {
+		return e.Wrap(err, "failed to create password reset token")
+	}
+
+	// Письмо со ссылкой отправляет сервис уведомлений
+	err = a.producer.PublishPasswordResetRequested(events.PasswordResetRequested{
+		UserID:    user.ID,
+		Token:     token,
+		ExpiresAt: time.Now().Add(a.conf.PasswordResetTTL),
+	})
+	if err != nil {
+		return e.Wrap(err, "failed to publish password reset requested")
+	}
+
+	a.logger.Info("password reset requested", "id", user.ID)
+	return nil
+}
+
+func (a *authService) ResetPassword(ctx context.Context, payload dto.ResetPasswordDTO) error {
+	// Токен одноразовый, после получения он удаляется
+	userID, err := a.tokens.ConsumePasswordReset(ctx, payload.Token)
+	if errors.Is(err, ErrInvalidToken) {
+		return ErrInvalidToken
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to consume password reset token")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return e.Wrap(err, "failed to hash password")
+	}
+
+	if err := a.users.UpdatePassword(ctx, userID, passwordHash); err != nil {
+		return e.Wrap(err, "failed to update password")
+	}
+
+	// Завершаем все сессии, так как старый пароль мог быть скомпрометирован
+	if err := a.tokens.RevokeAll(ctx, userID); err != nil {
+		return e.Wrap(err, "failed to revoke all sessions")
+	}
+
+	a.logger.Info("password reset", "id", userID)
+	return nil
+}
+
+func (a *authService) VerifyEmail(ctx context.Context, payload dto.VerifyEmailDTO) (string, error) {
+	userID, email, err := a.tokens.ConsumeEmailVerification(ctx, payload.Token)
+	if errors.Is(err, ErrInvalidToken) {
+		return "", ErrInvalidToken
+	}
+	if err != nil {
+		return "", e.Wrap(err, "failed to consume email verification token")
+	}
+
+	// Почту успели сменить, ссылка из письма на старый адрес больше не действует
+	err = a.users.MarkEmailVerified(ctx, userID, email)
+	if errors.Is(err, ErrUserNotFound) {
+		return "", ErrInvalidToken
+	}
+	if err != nil {
+		return "", e.Wrap(err, "failed to mark email verified")
+	}
+
+	a.logger.Info("email verified", "id", userID)
+	return userID, nil
+}
+
+func (a *authService) ResendVerificationEmail(ctx context.Context, payload dto.ResendVerificationEmailDTO) error {
+	// Как и при сбросе пароля, не сообщаем, существует ли пользователь
+	user, err := a.users.GetByEmail(ctx, payload.Email)
+	if errors.Is(err, ErrUserNotFound) {
+		a.logger.Debug("email verification requested for unknown email")
+		return nil
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to get user by email")
+	}
+	if user.EmailVerifiedAt != nil {
+		a.logger.Debug("email verification requested for verified user", "id", user.ID)
+		return nil
+	}
+
+	token, err := a.tokens.CreateEmailVerification(ctx, user.ID, user.Email, a.conf.EmailVerificationTTL)
+	if err != nil {
+		return e.Wrap(err, "failed to create email verification token")
+	}
+
+	err = a.producer.PublishEmailVerificationRequested(events.EmailVerificationRequested{
+		UserID:    user.ID,
+		Token:     token,
+		ExpiresAt: time.Now().Add(a.conf.EmailVerificationTTL),
+	})
+	if err != nil {
+		return e.Wrap(err, "failed to publish email verification requested")
+	}
+
+	a.logger.Info("email verification requested", "id", user.ID)
+	return nil
+}
+
+// Возвращает публичные ключи для проверки access токенов
+func (a *authService) GetJWKS(ctx context.Context) []jwks.JWK {
+	return a.keys.Public()
+}
+
+func (a *authService) ListSessions(ctx context.Context, userID string) ([]entities.Session, error) {
+	sessions, err := a.tokens.ListSessions(ctx, userID)
+	return sessions, e.WrapIfErr(err, "failed to list sessions")
+}
+
+func (a *authService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	// Проверяем, что сессия принадлежит пользователю
+	session, err := a.tokens.GetSession(ctx, sessionID)
+	if errors.Is(err, ErrSessionNotFound) {
+		return ErrSessionNotFound
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to get session")
+	}
+	if session.UserID != userID {
+		return ErrSessionNotFound
+	}
+
+	if err := a.tokens.RevokeFamily(ctx, sessionID); err != nil {
+		return e.Wrap(err, "failed to revoke session")
+	}
+
+	a.logger.Info("session revoked", "user_id", userID, "session_id", sessionID)
+	return nil
+}
+
+func (a *authService) RevokeAllSessions(ctx context.Context, userID string) error {
+	if err := a.tokens.RevokeAll(ctx, userID); err != nil {
+		return e.Wrap(err, "failed to revoke all sessions")
+	}
+
+	a.logger.Info("all sessions revoked", "user_id", userID)
+	return nil
+}
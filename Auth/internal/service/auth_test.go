@@ -6,11 +6,17 @@ import (
 	"Classroom/Auth/internal/entities"
 	"Classroom/Auth/internal/service"
 	mocks "Classroom/Auth/internal/service/mocks"
+	"Classroom/Auth/pkg/events"
+	"Classroom/Auth/pkg/jwks"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/pquerna/otp/totp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -18,7 +24,7 @@ import (
 )
 
 func TestAuthService_Register(t *testing.T) {
-	type MockBehavior func(users *mocks.MockUserRepo, payload dto.RegisterDTO)
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.RegisterDTO)
 
 	testCases := []struct {
 		name         string
@@ -35,7 +41,7 @@ func TestAuthService_Register(t *testing.T) {
 				FirstName: "John",
 				LastName:  "Doe",
 			},
-			mockBehavior: func(users *mocks.MockUserRepo, payload dto.RegisterDTO) {
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.RegisterDTO) {
 				users.EXPECT().
 					Create(mock.Anything, mock.MatchedBy(func(dto dto.CreateUserDTO) bool {
 						return dto.Email == payload.Email &&
@@ -45,6 +51,16 @@ func TestAuthService_Register(t *testing.T) {
 							len(dto.PasswordHash) > 0
 					})).
 					Return(entities.User{ID: "user-id"}, nil)
+
+				tokens.EXPECT().
+					CreateEmailVerification(mock.Anything, "user-id", payload.Email, time.Minute).
+					Return("verification-token", nil)
+
+				producer.EXPECT().
+					PublishUserRegistered(mock.MatchedBy(func(event events.UserRegistered) bool {
+						return event.UserID == "user-id" && event.Token == "verification-token"
+					})).
+					Return(nil)
 			},
 			want:    "user-id",
 			wantErr: nil,
@@ -57,7 +73,7 @@ func TestAuthService_Register(t *testing.T) {
 				FirstName: "Jane",
 				LastName:  "Smith",
 			},
-			mockBehavior: func(users *mocks.MockUserRepo, payload dto.RegisterDTO) {
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.RegisterDTO) {
 				users.EXPECT().
 					Create(mock.Anything, mock.Anything).
 					Return(entities.User{}, service.ErrUserAlreadyExists)
@@ -71,9 +87,12 @@ func TestAuthService_Register(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tokenRepo := mocks.NewMockTokenRepo(t)
 			userRepo := mocks.NewMockUserRepo(t)
-			tc.mockBehavior(userRepo, tc.payload)
-			conf := config.Auth{JwtSecret: "secret", AccessTTL: time.Minute, RefreshTTL: time.Minute}
-			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, conf)
+			producer := mocks.NewMockProducer(t)
+			tc.mockBehavior(userRepo, tokenRepo, producer, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute, EmailVerificationTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), producer, keys, conf)
 			got, err := svc.Register(context.Background(), tc.payload)
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
@@ -86,7 +105,11 @@ func TestAuthService_Register(t *testing.T) {
 }
 
 func TestAuthService_Login(t *testing.T) {
-	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.LoginDTO)
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO)
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("correct-password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	verifiedAt := time.Now()
 
 	testCases := []struct {
 		name         string
@@ -97,25 +120,110 @@ func TestAuthService_Login(t *testing.T) {
 	}{
 		{
 			name: "success",
+			payload: dto.LoginDTO{
+				Email:     "user@example.com",
+				Password:  "correct-password",
+				UserAgent: "Mozilla/5.0",
+				IP:        "127.0.0.1",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{}, nil)
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindIP, payload.IP).
+					Return(entities.LoginAttempts{}, nil)
+
+				users.EXPECT().
+					GetByEmail(mock.Anything, payload.Email).
+					Return(entities.User{
+						ID:              "user-id",
+						PasswordHash:    hashedPassword,
+						EmailVerifiedAt: &verifiedAt,
+					}, nil)
+
+				lockouts.EXPECT().
+					ResetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(nil)
+
+				users.EXPECT().
+					GetMFA(mock.Anything, "user-id").
+					Return(entities.MFA{}, service.ErrMFANotFound)
+
+				tokens.EXPECT().
+					Create(mock.Anything, dto.CreateSessionDTO{
+						UserID:    "user-id",
+						UserAgent: payload.UserAgent,
+						IP:        payload.IP,
+					}, mock.Anything).
+					Return("refresh-token", nil)
+			},
+			want:    dto.TokensDTO{RefreshToken: "refresh-token"},
+			wantErr: nil,
+		},
+		{
+			name: "user disabled",
 			payload: dto.LoginDTO{
 				Email:    "user@example.com",
 				Password: "correct-password",
 			},
-			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.LoginDTO) {
-				hashedPassword, err := bcrypt.GenerateFromPassword([]byte("correct-password"), bcrypt.DefaultCost)
-				require.NoError(t, err)
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{}, nil)
+
 				users.EXPECT().
 					GetByEmail(mock.Anything, payload.Email).
 					Return(entities.User{
 						ID:           "user-id",
 						PasswordHash: hashedPassword,
+						DisabledAt:   &verifiedAt,
+					}, nil)
+
+				lockouts.EXPECT().
+					ResetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(nil)
+			},
+			wantErr: service.ErrUserDisabled,
+		},
+		{
+			name: "mfa required",
+			payload: dto.LoginDTO{
+				Email:     "user@example.com",
+				Password:  "correct-password",
+				UserAgent: "Mozilla/5.0",
+				IP:        "127.0.0.1",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, mock.Anything, mock.Anything).
+					Return(entities.LoginAttempts{}, nil)
+
+				users.EXPECT().
+					GetByEmail(mock.Anything, payload.Email).
+					Return(entities.User{
+						ID:              "user-id",
+						PasswordHash:    hashedPassword,
+						EmailVerifiedAt: &verifiedAt,
 					}, nil)
 
+				lockouts.EXPECT().
+					ResetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(nil)
+
+				users.EXPECT().
+					GetMFA(mock.Anything, "user-id").
+					Return(entities.MFA{UserID: "user-id", ConfirmedAt: &verifiedAt}, nil)
+
 				tokens.EXPECT().
-					Create(mock.Anything, "user-id", mock.Anything).
-					Return("refresh-token", nil)
+					CreateMFAChallenge(mock.Anything, dto.CreateSessionDTO{
+						UserID:    "user-id",
+						UserAgent: payload.UserAgent,
+						IP:        payload.IP,
+					}, mock.Anything).
+					Return("mfa-token", nil)
 			},
-			want:    dto.TokensDTO{RefreshToken: "refresh-token"},
+			want:    dto.TokensDTO{MFAToken: "mfa-token"},
 			wantErr: nil,
 		},
 		{
@@ -124,22 +232,32 @@ func TestAuthService_Login(t *testing.T) {
 				Email:    "notfound@example.com",
 				Password: "password",
 			},
-			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.LoginDTO) {
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{}, nil)
+
 				users.EXPECT().
 					GetByEmail(mock.Anything, payload.Email).
 					Return(entities.User{}, service.ErrUserNotFound)
+
+				lockouts.EXPECT().
+					RegisterFailure(mock.Anything, entities.LockoutKindEmail, payload.Email, mock.Anything).
+					Return(entities.LoginAttempts{Failures: 1}, nil)
 			},
 			want:    dto.TokensDTO{},
 			wantErr: service.ErrInvalidCredentials,
 		},
 		{
-			name: "wrong password",
+			name: "wrong password locks email",
 			payload: dto.LoginDTO{
 				Email:    "user@example.com",
 				Password: "wrong-password",
 			},
-			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.LoginDTO) {
-				hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("correct-password"), bcrypt.DefaultCost)
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{Failures: 9, LastFailureAt: time.Now().Add(-time.Hour)}, nil)
 
 				users.EXPECT().
 					GetByEmail(mock.Anything, payload.Email).
@@ -147,25 +265,109 @@ func TestAuthService_Login(t *testing.T) {
 						ID:           "user-id",
 						PasswordHash: hashedPassword,
 					}, nil)
+
+				lockouts.EXPECT().
+					RegisterFailure(mock.Anything, entities.LockoutKindEmail, payload.Email, mock.Anything).
+					Return(entities.LoginAttempts{Failures: 10}, nil)
+				lockouts.EXPECT().
+					Lock(mock.Anything, entities.LockoutKindEmail, payload.Email, mock.Anything).
+					Return(nil)
 			},
 			want:    dto.TokensDTO{},
 			wantErr: service.ErrInvalidCredentials,
 		},
+		{
+			name: "locked out",
+			payload: dto.LoginDTO{
+				Email:    "user@example.com",
+				Password: "correct-password",
+				IP:       "127.0.0.1",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{}, nil)
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindIP, payload.IP).
+					Return(entities.LoginAttempts{Failures: 50, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			want:    dto.TokensDTO{},
+			wantErr: service.ErrTooManyAttempts,
+		},
+		{
+			name: "backoff",
+			payload: dto.LoginDTO{
+				Email:    "user@example.com",
+				Password: "correct-password",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{Failures: 5, LastFailureAt: time.Now()}, nil)
+			},
+			want:    dto.TokensDTO{},
+			wantErr: service.ErrTooManyAttempts,
+		},
+		{
+			name: "email not verified",
+			payload: dto.LoginDTO{
+				Email:    "user@example.com",
+				Password: "correct-password",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.LoginDTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(entities.LoginAttempts{}, nil)
+
+				users.EXPECT().
+					GetByEmail(mock.Anything, payload.Email).
+					Return(entities.User{
+						ID:           "user-id",
+						PasswordHash: hashedPassword,
+					}, nil)
+
+				lockouts.EXPECT().
+					ResetAttempts(mock.Anything, entities.LockoutKindEmail, payload.Email).
+					Return(nil)
+			},
+			want:    dto.TokensDTO{},
+			wantErr: service.ErrEmailNotVerified,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tokenRepo := mocks.NewMockTokenRepo(t)
 			userRepo := mocks.NewMockUserRepo(t)
-			tc.mockBehavior(userRepo, tokenRepo, tc.payload)
-			conf := config.Auth{JwtSecret: "secret", AccessTTL: time.Minute, RefreshTTL: time.Minute}
-			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, conf)
+			lockoutRepo := mocks.NewMockLockoutRepo(t)
+			tc.mockBehavior(userRepo, tokenRepo, lockoutRepo, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{
+				AccessTTL:                time.Minute,
+				RefreshTTL:               time.Minute,
+				RequireEmailVerification: true,
+				Lockout: config.Lockout{
+					FailureWindow:    time.Minute,
+					BackoffAfter:     3,
+					BackoffBase:      time.Second,
+					BackoffMax:       time.Minute,
+					MaxEmailFailures: 10,
+					MaxIPFailures:    50,
+					Duration:         time.Minute,
+				},
+			}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, lockoutRepo, mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
 			got, err := svc.Login(context.Background(), tc.payload)
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
 				return
 			}
 			require.NoError(t, err)
+			if tc.want.MFAToken != "" {
+				assert.Equal(t, tc.want, got)
+				return
+			}
 			assert.Equal(t, tc.want.RefreshToken, got.RefreshToken)
 			assert.NotEmpty(t, got.AccessToken)
 		})
@@ -179,6 +381,7 @@ func TestAuthService_Refresh(t *testing.T) {
 		name         string
 		mockBehavior MockBehavior
 		refreshToken string
+		want         string
 		wantErr      error
 	}{
 		{
@@ -189,6 +392,7 @@ func TestAuthService_Refresh(t *testing.T) {
 					GetInfoByToken(mock.Anything, refreshToken).
 					Return(entities.RefreshToken{
 						UserID:    "user-id",
+						FamilyID:  "family-id",
 						ExpiresAt: time.Now().Add(time.Minute),
 					}, nil)
 
@@ -198,9 +402,36 @@ func TestAuthService_Refresh(t *testing.T) {
 						ID:          "user-id",
 						IsSuperUser: false,
 					}, nil)
+
+				tokens.EXPECT().
+					Rotate(mock.Anything, refreshToken, time.Minute).
+					Return("new-refresh-token", nil)
 			},
+			want:    "new-refresh-token",
 			wantErr: nil,
 		},
+		{
+			name:         "user disabled",
+			refreshToken: "valid-refresh-token",
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, refreshToken string) {
+				disabledAt := time.Now()
+				tokens.EXPECT().
+					GetInfoByToken(mock.Anything, refreshToken).
+					Return(entities.RefreshToken{
+						UserID:    "user-id",
+						FamilyID:  "family-id",
+						ExpiresAt: time.Now().Add(time.Minute),
+					}, nil)
+
+				users.EXPECT().
+					GetByID(mock.Anything, "user-id").
+					Return(entities.User{
+						ID:         "user-id",
+						DisabledAt: &disabledAt,
+					}, nil)
+			},
+			wantErr: service.ErrUserDisabled,
+		},
 		{
 			name:         "token expired",
 			refreshToken: "expired-refresh-token",
@@ -214,6 +445,50 @@ func TestAuthService_Refresh(t *testing.T) {
 			},
 			wantErr: service.ErrInvalidToken,
 		},
+		{
+			name:         "token reused",
+			refreshToken: "rotated-refresh-token",
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, refreshToken string) {
+				tokens.EXPECT().
+					GetInfoByToken(mock.Anything, refreshToken).
+					Return(entities.RefreshToken{
+						UserID:    "user-id",
+						FamilyID:  "family-id",
+						ExpiresAt: time.Now().Add(time.Minute),
+					}, service.ErrTokenReused)
+
+				tokens.EXPECT().
+					RevokeFamily(mock.Anything, "family-id").
+					Return(nil)
+			},
+			wantErr: service.ErrInvalidToken,
+		},
+		{
+			name:         "concurrent rotation",
+			refreshToken: "valid-refresh-token",
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, refreshToken string) {
+				tokens.EXPECT().
+					GetInfoByToken(mock.Anything, refreshToken).
+					Return(entities.RefreshToken{
+						UserID:    "user-id",
+						FamilyID:  "family-id",
+						ExpiresAt: time.Now().Add(time.Minute),
+					}, nil)
+
+				users.EXPECT().
+					GetByID(mock.Anything, "user-id").
+					Return(entities.User{ID: "user-id"}, nil)
+
+				tokens.EXPECT().
+					Rotate(mock.Anything, refreshToken, time.Minute).
+					Return("", service.ErrTokenReused)
+
+				tokens.EXPECT().
+					RevokeFamily(mock.Anything, "family-id").
+					Return(nil)
+			},
+			wantErr: service.ErrInvalidToken,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -221,15 +496,18 @@ func TestAuthService_Refresh(t *testing.T) {
 			tokenRepo := mocks.NewMockTokenRepo(t)
 			userRepo := mocks.NewMockUserRepo(t)
 			tc.mockBehavior(userRepo, tokenRepo, tc.refreshToken)
-			conf := config.Auth{JwtSecret: "secret", AccessTTL: time.Minute, RefreshTTL: time.Minute}
-			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, conf)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
 			got, err := svc.Refresh(context.Background(), tc.refreshToken)
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
 				return
 			}
 			require.NoError(t, err)
-			assert.NotEmpty(t, got)
+			assert.Equal(t, tc.want, got.RefreshToken)
+			assert.NotEmpty(t, got.AccessToken)
 		})
 	}
 }
@@ -280,11 +558,964 @@ func TestAuthService_Logout(t *testing.T) {
 			tokenRepo := mocks.NewMockTokenRepo(t)
 			userRepo := mocks.NewMockUserRepo(t)
 			tc.mockBehavior(tokenRepo, tc.refreshToken)
-			conf := config.Auth{JwtSecret: "secret", AccessTTL: time.Minute, RefreshTTL: time.Minute}
-			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, conf)
-			err := svc.Logout(context.Background(), tc.refreshToken)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+			err = svc.Logout(context.Background(), tc.refreshToken)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_RevokeSession(t *testing.T) {
+	type MockBehavior func(tokens *mocks.MockTokenRepo, userID, sessionID string)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		userID       string
+		sessionID    string
+		wantErr      error
+	}{
+		{
+			name:      "success",
+			userID:    "user-id",
+			sessionID: "session-id",
+			mockBehavior: func(tokens *mocks.MockTokenRepo, userID, sessionID string) {
+				tokens.EXPECT().
+					GetSession(mock.Anything, sessionID).
+					Return(entities.Session{ID: sessionID, UserID: userID}, nil)
+
+				tokens.EXPECT().
+					RevokeFamily(mock.Anything, sessionID).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "session not found",
+			userID:    "user-id",
+			sessionID: "session-id",
+			mockBehavior: func(tokens *mocks.MockTokenRepo, userID, sessionID string) {
+				tokens.EXPECT().
+					GetSession(mock.Anything, sessionID).
+					Return(entities.Session{}, service.ErrSessionNotFound)
+			},
+			wantErr: service.ErrSessionNotFound,
+		},
+		{
+			name:      "session of another user",
+			userID:    "user-id",
+			sessionID: "session-id",
+			mockBehavior: func(tokens *mocks.MockTokenRepo, userID, sessionID string) {
+				tokens.EXPECT().
+					GetSession(mock.Anything, sessionID).
+					Return(entities.Session{ID: sessionID, UserID: "another-user-id"}, nil)
+			},
+			wantErr: service.ErrSessionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			tc.mockBehavior(tokenRepo, tc.userID, tc.sessionID)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+			err = svc.RevokeSession(context.Background(), tc.userID, tc.sessionID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.ResetPasswordDTO)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.ResetPasswordDTO
+		wantErr      error
+	}{
+		{
+			name: "success",
+			payload: dto.ResetPasswordDTO{
+				Token:    "reset-token",
+				Password: "new-password",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.ResetPasswordDTO) {
+				tokens.EXPECT().
+					ConsumePasswordReset(mock.Anything, payload.Token).
+					Return("user-id", nil)
+
+				users.EXPECT().
+					UpdatePassword(mock.Anything, "user-id", mock.MatchedBy(func(hash []byte) bool {
+						return bcrypt.CompareHashAndPassword(hash, []byte(payload.Password)) == nil
+					})).
+					Return(nil)
+
+				tokens.EXPECT().
+					RevokeAll(mock.Anything, "user-id").
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid token",
+			payload: dto.ResetPasswordDTO{
+				Token:    "used-token",
+				Password: "new-password",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.ResetPasswordDTO) {
+				tokens.EXPECT().
+					ConsumePasswordReset(mock.Anything, payload.Token).
+					Return("", service.ErrInvalidToken)
+			},
+			wantErr: service.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			tc.mockBehavior(userRepo, tokenRepo, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+			err = svc.ResetPassword(context.Background(), tc.payload)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.VerifyEmailDTO)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.VerifyEmailDTO
+		want         string
+		wantErr      error
+	}{
+		{
+			name:    "success",
+			payload: dto.VerifyEmailDTO{Token: "verification-token"},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.VerifyEmailDTO) {
+				tokens.EXPECT().
+					ConsumeEmailVerification(mock.Anything, payload.Token).
+					Return("user-id", "test@example.com", nil)
 
+				users.EXPECT().
+					MarkEmailVerified(mock.Anything, "user-id", "test@example.com").
+					Return(nil)
+			},
+			want:    "user-id",
+			wantErr: nil,
+		},
+		{
+			name:    "email changed",
+			payload: dto.VerifyEmailDTO{Token: "verification-token"},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.VerifyEmailDTO) {
+				tokens.EXPECT().
+					ConsumeEmailVerification(mock.Anything, payload.Token).
+					Return("user-id", "old@example.com", nil)
+
+				users.EXPECT().
+					MarkEmailVerified(mock.Anything, "user-id", "old@example.com").
+					Return(service.ErrUserNotFound)
+			},
+			want:    "",
+			wantErr: service.ErrInvalidToken,
+		},
+		{
+			name:    "invalid token",
+			payload: dto.VerifyEmailDTO{Token: "used-token"},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, payload dto.VerifyEmailDTO) {
+				tokens.EXPECT().
+					ConsumeEmailVerification(mock.Anything, payload.Token).
+					Return("", "", service.ErrInvalidToken)
+			},
+			want:    "",
+			wantErr: service.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			tc.mockBehavior(userRepo, tokenRepo, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+			got, err := svc.VerifyEmail(context.Background(), tc.payload)
+
+			assert.Equal(t, tc.want, got)
 			assert.ErrorIs(t, err, tc.wantErr)
 		})
 	}
 }
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangePasswordDTO)
+
+	currentHash, err := bcrypt.GenerateFromPassword([]byte("current-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := entities.User{ID: "user-id", Email: "user@example.com", PasswordHash: currentHash}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.ChangePasswordDTO
+		wantErr      error
+	}{
+		{
+			name: "success keeps current session",
+			payload: dto.ChangePasswordDTO{
+				UserID:          "user-id",
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+				RefreshToken:    "refresh-token",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangePasswordDTO) {
+				users.EXPECT().
+					GetByID(mock.Anything, payload.UserID).
+					Return(user, nil)
+
+				users.EXPECT().
+					UpdatePassword(mock.Anything, "user-id", mock.MatchedBy(func(hash []byte) bool {
+						return bcrypt.CompareHashAndPassword(hash, []byte(payload.NewPassword)) == nil
+					})).
+					Return(nil)
+
+				tokens.EXPECT().
+					GetInfoByToken(mock.Anything, payload.RefreshToken).
+					Return(entities.RefreshToken{UserID: "user-id", FamilyID: "current-session"}, nil)
+
+				tokens.EXPECT().
+					ListSessions(mock.Anything, "user-id").
+					Return([]entities.Session{{ID: "current-session"}, {ID: "other-session"}}, nil)
+
+				tokens.EXPECT().
+					RevokeFamily(mock.Anything, "other-session").
+					Return(nil)
+
+				producer.EXPECT().
+					PublishUserUpdated(mock.MatchedBy(func(event events.UserUpdated) bool {
+						return event.UserID == "user-id" && event.PasswordChanged
+					})).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success without refresh token revokes all sessions",
+			payload: dto.ChangePasswordDTO{
+				UserID:          "user-id",
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangePasswordDTO) {
+				users.EXPECT().
+					GetByID(mock.Anything, payload.UserID).
+					Return(user, nil)
+
+				users.EXPECT().
+					UpdatePassword(mock.Anything, "user-id", mock.Anything).
+					Return(nil)
+
+				tokens.EXPECT().
+					RevokeAll(mock.Anything, "user-id").
+					Return(nil)
+
+				producer.EXPECT().
+					PublishUserUpdated(mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "wrong current password",
+			payload: dto.ChangePasswordDTO{
+				UserID:          "user-id",
+				CurrentPassword: "wrong-password",
+				NewPassword:     "new-password",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangePasswordDTO) {
+				users.EXPECT().
+					GetByID(mock.Anything, payload.UserID).
+					Return(user, nil)
+			},
+			wantErr: service.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			producer := mocks.NewMockProducer(t)
+			tc.mockBehavior(userRepo, tokenRepo, producer, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), producer, keys, config.Auth{})
+			err = svc.ChangePassword(context.Background(), tc.payload)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_ChangeEmail(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangeEmailDTO)
+
+	currentHash, err := bcrypt.GenerateFromPassword([]byte("current-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := entities.User{ID: "user-id", Email: "old@example.com", PasswordHash: currentHash}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.ChangeEmailDTO
+		wantErr      error
+	}{
+		{
+			name: "success",
+			payload: dto.ChangeEmailDTO{
+				UserID:          "user-id",
+				CurrentPassword: "current-password",
+				NewEmail:        "new@example.com",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangeEmailDTO) {
+				users.EXPECT().
+					GetByID(mock.Anything, payload.UserID).
+					Return(user, nil)
+
+				users.EXPECT().
+					UpdateEmail(mock.Anything, "user-id", payload.NewEmail).
+					Return(nil)
+
+				producer.EXPECT().
+					PublishUserUpdated(events.UserUpdated{
+						UserID:        "user-id",
+						Email:         payload.NewEmail,
+						PreviousEmail: user.Email,
+					}).
+					Return(nil)
+
+				tokens.EXPECT().
+					CreateEmailVerification(mock.Anything, "user-id", payload.NewEmail, time.Hour).
+					Return("verification-token", nil)
+
+				producer.EXPECT().
+					PublishEmailVerificationRequested(mock.MatchedBy(func(event events.EmailVerificationRequested) bool {
+						return event.UserID == "user-id" && event.Token == "verification-token"
+					})).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "email taken",
+			payload: dto.ChangeEmailDTO{
+				UserID:          "user-id",
+				CurrentPassword: "current-password",
+				NewEmail:        "taken@example.com",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangeEmailDTO) {
+				users.EXPECT().
+					GetByID(mock.Anything, payload.UserID).
+					Return(user, nil)
+
+				users.EXPECT().
+					UpdateEmail(mock.Anything, "user-id", payload.NewEmail).
+					Return(service.ErrUserAlreadyExists)
+			},
+			wantErr: service.ErrUserAlreadyExists,
+		},
+		{
+			name: "wrong current password",
+			payload: dto.ChangeEmailDTO{
+				UserID:          "user-id",
+				CurrentPassword: "wrong-password",
+				NewEmail:        "new@example.com",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, producer *mocks.MockProducer, payload dto.ChangeEmailDTO) {
+				users.EXPECT().
+					GetByID(mock.Anything, payload.UserID).
+					Return(user, nil)
+			},
+			wantErr: service.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			producer := mocks.NewMockProducer(t)
+			tc.mockBehavior(userRepo, tokenRepo, producer, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{EmailVerificationTTL: time.Hour}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), producer, keys, conf)
+			err = svc.ChangeEmail(context.Background(), tc.payload)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_DisableUser(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		disabled     bool
+		wantErr      error
+	}{
+		{
+			name:     "disable revokes sessions",
+			disabled: true,
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo) {
+				users.EXPECT().
+					SetDisabled(mock.Anything, "user-id", true).
+					Return(nil)
+
+				tokens.EXPECT().
+					RevokeAll(mock.Anything, "user-id").
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "enable",
+			disabled: false,
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo) {
+				users.EXPECT().
+					SetDisabled(mock.Anything, "user-id", false).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "user not found",
+			disabled: true,
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo) {
+				users.EXPECT().
+					SetDisabled(mock.Anything, "user-id", true).
+					Return(service.ErrUserNotFound)
+			},
+			wantErr: service.ErrUserNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			tc.mockBehavior(userRepo, tokenRepo)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, mocks.NewMockLockoutRepo(t), mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, config.Auth{})
+			err = svc.DisableUser(context.Background(), "user-id", tc.disabled)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_VerifyMFA(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO)
+
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "Classroom", AccountName: "user@example.com"})
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+
+	recoveryHash := sha256.Sum256([]byte("abcdefghij"))
+	confirmedAt := time.Now()
+	mfa := entities.MFA{
+		UserID:        "user-id",
+		Secret:        key.Secret(),
+		RecoveryCodes: []string{hex.EncodeToString(recoveryHash[:])},
+		ConfirmedAt:   &confirmedAt,
+	}
+	challenge := entities.MFAChallenge{
+		UserID:    "user-id",
+		UserAgent: "Mozilla/5.0",
+		IP:        "127.0.0.1",
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	session := dto.CreateSessionDTO{
+		UserID:    "user-id",
+		UserAgent: "Mozilla/5.0",
+		IP:        "127.0.0.1",
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.VerifyMFADTO
+		wantErr      error
+	}{
+		{
+			name: "success with totp",
+			payload: dto.VerifyMFADTO{
+				Token: "mfa-token",
+				Code:  code,
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO) {
+				tokens.EXPECT().ConsumeMFAChallenge(mock.Anything, payload.Token).Return(challenge, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+				lockouts.EXPECT().ResetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(nil)
+				users.EXPECT().GetByID(mock.Anything, "user-id").Return(entities.User{ID: "user-id"}, nil)
+				tokens.EXPECT().Create(mock.Anything, session, mock.Anything).Return("refresh-token", nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success with recovery code",
+			payload: dto.VerifyMFADTO{
+				Token: "mfa-token",
+				Code:  "ABCDE-FGHIJ",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO) {
+				tokens.EXPECT().ConsumeMFAChallenge(mock.Anything, payload.Token).Return(challenge, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+				users.EXPECT().UseRecoveryCode(mock.Anything, "user-id", mfa.RecoveryCodes[0]).Return(nil)
+				lockouts.EXPECT().ResetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(nil)
+				users.EXPECT().GetByID(mock.Anything, "user-id").Return(entities.User{ID: "user-id"}, nil)
+				tokens.EXPECT().Create(mock.Anything, session, mock.Anything).Return("refresh-token", nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid code",
+			payload: dto.VerifyMFADTO{
+				Token: "mfa-token",
+				Code:  "000000",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO) {
+				tokens.EXPECT().ConsumeMFAChallenge(mock.Anything, payload.Token).Return(challenge, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+
+				retried := challenge
+				retried.Attempts = 1
+				tokens.EXPECT().RetryMFAChallenge(mock.Anything, payload.Token, retried).Return(nil)
+				lockouts.EXPECT().
+					RegisterFailure(mock.Anything, entities.LockoutKindMFA, "user-id", mock.Anything).
+					Return(entities.LoginAttempts{Failures: 1}, nil)
+			},
+			wantErr: service.ErrInvalidMFACode,
+		},
+		{
+			name: "too many attempts",
+			payload: dto.VerifyMFADTO{
+				Token: "mfa-token",
+				Code:  "000000",
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO) {
+				exhausted := challenge
+				exhausted.Attempts = 4
+				tokens.EXPECT().ConsumeMFAChallenge(mock.Anything, payload.Token).Return(exhausted, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+				lockouts.EXPECT().
+					RegisterFailure(mock.Anything, entities.LockoutKindMFA, "user-id", mock.Anything).
+					Return(entities.LoginAttempts{Failures: 1}, nil)
+			},
+			wantErr: service.ErrInvalidMFACode,
+		},
+		{
+			name: "locked out",
+			payload: dto.VerifyMFADTO{
+				Token: "mfa-token",
+				Code:  code,
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO) {
+				tokens.EXPECT().ConsumeMFAChallenge(mock.Anything, payload.Token).Return(challenge, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").
+					Return(entities.LoginAttempts{Failures: 5, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			wantErr: service.ErrTooManyAttempts,
+		},
+		{
+			name: "invalid token",
+			payload: dto.VerifyMFADTO{
+				Token: "used-token",
+				Code:  code,
+			},
+			mockBehavior: func(users *mocks.MockUserRepo, tokens *mocks.MockTokenRepo, lockouts *mocks.MockLockoutRepo, payload dto.VerifyMFADTO) {
+				tokens.EXPECT().ConsumeMFAChallenge(mock.Anything, payload.Token).Return(entities.MFAChallenge{}, service.ErrInvalidToken)
+			},
+			wantErr: service.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo := mocks.NewMockTokenRepo(t)
+			userRepo := mocks.NewMockUserRepo(t)
+			lockoutRepo := mocks.NewMockLockoutRepo(t)
+			tc.mockBehavior(userRepo, tokenRepo, lockoutRepo, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, lockoutRepo, mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+			got, err := svc.VerifyMFA(context.Background(), tc.payload)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "refresh-token", got.RefreshToken)
+			assert.NotEmpty(t, got.AccessToken)
+		})
+	}
+}
+
+// Каждый верный пароль выдаёт новый токен входа, но неверные коды копятся
+// по пользователю, поэтому повторные входы не дают бесконечно подбирать код
+func TestAuthService_VerifyMFA_LockoutAcrossLogins(t *testing.T) {
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "Classroom", AccountName: "user@example.com"})
+	require.NoError(t, err)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("correct-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	confirmedAt := time.Now()
+	mfa := entities.MFA{UserID: "user-id", Secret: key.Secret(), ConfirmedAt: &confirmedAt}
+	user := entities.User{ID: "user-id", Email: "user@example.com", PasswordHash: passwordHash, EmailVerifiedAt: &confirmedAt}
+	login := dto.LoginDTO{Email: "user@example.com", Password: "correct-password", IP: "127.0.0.1"}
+
+	userRepo := mocks.NewMockUserRepo(t)
+	tokenRepo := mocks.NewMockTokenRepo(t)
+	lockoutRepo := mocks.NewMockLockoutRepo(t)
+
+	userRepo.EXPECT().GetByEmail(mock.Anything, login.Email).Return(user, nil)
+	userRepo.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+	tokenRepo.EXPECT().CreateMFAChallenge(mock.Anything, mock.Anything, mock.Anything).Return("mfa-token", nil)
+	tokenRepo.EXPECT().ConsumeMFAChallenge(mock.Anything, "mfa-token").Return(entities.MFAChallenge{UserID: "user-id"}, nil)
+	tokenRepo.EXPECT().RetryMFAChallenge(mock.Anything, "mfa-token", mock.Anything).Return(nil).Maybe()
+
+	// Счетчики хранятся как в Redis: по виду и субъекту блокировки
+	attempts := map[string]entities.LoginAttempts{}
+	lockoutRepo.EXPECT().GetAttempts(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, kind, subject string) (entities.LoginAttempts, error) {
+			return attempts[kind+":"+subject], nil
+		})
+	lockoutRepo.EXPECT().ResetAttempts(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, kind, subject string) error {
+			delete(attempts, kind+":"+subject)
+			return nil
+		})
+	lockoutRepo.EXPECT().RegisterFailure(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, kind, subject string, _ time.Duration) (entities.LoginAttempts, error) {
+			a := attempts[kind+":"+subject]
+			a.Failures++
+			attempts[kind+":"+subject] = a
+			return a, nil
+		})
+	lockoutRepo.EXPECT().Lock(mock.Anything, entities.LockoutKindMFA, "user-id", mock.Anything).
+		RunAndReturn(func(_ context.Context, kind, subject string, until time.Time) error {
+			a := attempts[kind+":"+subject]
+			a.LockedUntil = until
+			attempts[kind+":"+subject] = a
+			return nil
+		}).Once()
+
+	keys, err := jwks.Generate()
+	require.NoError(t, err)
+	conf := config.Auth{
+		AccessTTL:  time.Minute,
+		RefreshTTL: time.Minute,
+		Lockout: config.Lockout{
+			FailureWindow:    time.Hour,
+			MaxEmailFailures: 5,
+			MaxIPFailures:    20,
+			Duration:         15 * time.Minute,
+		},
+	}
+	svc := service.NewAuthService(slog.Default(), userRepo, tokenRepo, lockoutRepo, mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+
+	for i := range 6 {
+		tokens, err := svc.Login(context.Background(), login)
+		require.NoError(t, err)
+		require.Equal(t, "mfa-token", tokens.MFAToken)
+
+		_, err = svc.VerifyMFA(context.Background(), dto.VerifyMFADTO{Token: tokens.MFAToken, Code: "000000"})
+		if i < 5 {
+			assert.ErrorIs(t, err, service.ErrInvalidMFACode)
+			continue
+		}
+		assert.ErrorIs(t, err, service.ErrTooManyAttempts)
+	}
+}
+
+func TestAuthService_DisableMFA(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, lockouts *mocks.MockLockoutRepo, payload dto.DisableMFADTO)
+
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "Classroom", AccountName: "user@example.com"})
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("correct-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	confirmedAt := time.Now()
+	mfa := entities.MFA{UserID: "user-id", Secret: key.Secret(), ConfirmedAt: &confirmedAt}
+	user := entities.User{ID: "user-id", PasswordHash: passwordHash}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.DisableMFADTO
+		wantErr      error
+	}{
+		{
+			name:    "success",
+			payload: dto.DisableMFADTO{UserID: "user-id", Password: "correct-password", Code: code},
+			mockBehavior: func(users *mocks.MockUserRepo, lockouts *mocks.MockLockoutRepo, payload dto.DisableMFADTO) {
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+				users.EXPECT().GetByID(mock.Anything, "user-id").Return(user, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().ResetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(nil)
+				users.EXPECT().DeleteMFA(mock.Anything, "user-id").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid password",
+			payload: dto.DisableMFADTO{UserID: "user-id", Password: "wrong-password", Code: code},
+			mockBehavior: func(users *mocks.MockUserRepo, lockouts *mocks.MockLockoutRepo, payload dto.DisableMFADTO) {
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+				users.EXPECT().GetByID(mock.Anything, "user-id").Return(user, nil)
+				lockouts.EXPECT().
+					RegisterFailure(mock.Anything, entities.LockoutKindMFA, "user-id", mock.Anything).
+					Return(entities.LoginAttempts{Failures: 1}, nil)
+			},
+			wantErr: service.ErrInvalidCredentials,
+		},
+		{
+			name:    "invalid code locks out",
+			payload: dto.DisableMFADTO{UserID: "user-id", Password: "correct-password", Code: "000000"},
+			mockBehavior: func(users *mocks.MockUserRepo, lockouts *mocks.MockLockoutRepo, payload dto.DisableMFADTO) {
+				lockouts.EXPECT().GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").Return(entities.LoginAttempts{}, nil)
+				users.EXPECT().GetByID(mock.Anything, "user-id").Return(user, nil)
+				users.EXPECT().GetMFA(mock.Anything, "user-id").Return(mfa, nil)
+				lockouts.EXPECT().
+					RegisterFailure(mock.Anything, entities.LockoutKindMFA, "user-id", mock.Anything).
+					Return(entities.LoginAttempts{Failures: 5}, nil)
+				lockouts.EXPECT().Lock(mock.Anything, entities.LockoutKindMFA, "user-id", mock.Anything).Return(nil)
+			},
+			wantErr: service.ErrInvalidMFACode,
+		},
+		{
+			name:    "locked out",
+			payload: dto.DisableMFADTO{UserID: "user-id", Password: "correct-password", Code: code},
+			mockBehavior: func(users *mocks.MockUserRepo, lockouts *mocks.MockLockoutRepo, payload dto.DisableMFADTO) {
+				lockouts.EXPECT().
+					GetAttempts(mock.Anything, entities.LockoutKindMFA, "user-id").
+					Return(entities.LoginAttempts{Failures: 5, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			wantErr: service.ErrTooManyAttempts,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userRepo := mocks.NewMockUserRepo(t)
+			lockoutRepo := mocks.NewMockLockoutRepo(t)
+			tc.mockBehavior(userRepo, lockoutRepo, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTTL: time.Minute, RefreshTTL: time.Minute}
+			svc := service.NewAuthService(slog.Default(), userRepo, mocks.NewMockTokenRepo(t), lockoutRepo, mocks.NewMockAccessTokenRepo(t), mocks.NewMockProducer(t), keys, conf)
+			err = svc.DisableMFA(context.Background(), tc.payload)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_CreateAccessToken(t *testing.T) {
+	type MockBehavior func(accessTokens *mocks.MockAccessTokenRepo, payload dto.CreateAccessTokenDTO)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.CreateAccessTokenDTO
+		wantErr      error
+	}{
+		{
+			name: "success",
+			payload: dto.CreateAccessTokenDTO{
+				UserID:    "user-id",
+				Name:      "roster sync",
+				Scopes:    []string{"courses:read"},
+				ExpiresAt: time.Now().Add(24 * time.Hour),
+			},
+			mockBehavior: func(accessTokens *mocks.MockAccessTokenRepo, payload dto.CreateAccessTokenDTO) {
+				accessTokens.EXPECT().
+					Create(mock.Anything, payload, mock.MatchedBy(func(hash []byte) bool { return len(hash) == sha256.Size })).
+					Return(entities.AccessToken{ID: "token-id", UserID: payload.UserID}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "expired",
+			payload: dto.CreateAccessTokenDTO{
+				UserID:    "user-id",
+				Name:      "roster sync",
+				Scopes:    []string{"courses:read"},
+				ExpiresAt: time.Now().Add(-time.Hour),
+			},
+			mockBehavior: func(accessTokens *mocks.MockAccessTokenRepo, payload dto.CreateAccessTokenDTO) {},
+			wantErr:      service.ErrInvalidAccessTokenExpiry,
+		},
+		{
+			name: "expiry exceeds max ttl",
+			payload: dto.CreateAccessTokenDTO{
+				UserID:    "user-id",
+				Name:      "roster sync",
+				Scopes:    []string{"courses:read"},
+				ExpiresAt: time.Now().Add(1000 * time.Hour),
+			},
+			mockBehavior: func(accessTokens *mocks.MockAccessTokenRepo, payload dto.CreateAccessTokenDTO) {},
+			wantErr:      service.ErrInvalidAccessTokenExpiry,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accessTokenRepo := mocks.NewMockAccessTokenRepo(t)
+			tc.mockBehavior(accessTokenRepo, tc.payload)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			conf := config.Auth{AccessTokenMaxTTL: 720 * time.Hour}
+			svc := service.NewAuthService(slog.Default(), mocks.NewMockUserRepo(t), mocks.NewMockTokenRepo(t), mocks.NewMockLockoutRepo(t), accessTokenRepo, mocks.NewMockProducer(t), keys, conf)
+			got, token, err := svc.CreateAccessToken(context.Background(), tc.payload)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "token-id", got.ID)
+			assert.True(t, strings.HasPrefix(token, service.AccessTokenPrefix))
+		})
+	}
+}
+
+func TestAuthService_IntrospectAccessToken(t *testing.T) {
+	type MockBehavior func(users *mocks.MockUserRepo, accessTokens *mocks.MockAccessTokenRepo, token string)
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		token        string
+		want         dto.AccessTokenInfoDTO
+		wantErr      error
+	}{
+		{
+			name:  "success",
+			token: service.AccessTokenPrefix + "token",
+			mockBehavior: func(users *mocks.MockUserRepo, accessTokens *mocks.MockAccessTokenRepo, token string) {
+				hash := sha256.Sum256([]byte(token))
+				accessTokens.EXPECT().
+					GetByHash(mock.Anything, hash[:]).
+					Return(entities.AccessToken{ID: "token-id", UserID: "user-id", Scopes: []string{"tasks:write"}, ExpiresAt: expiresAt}, nil)
+				users.EXPECT().
+					GetByID(mock.Anything, "user-id").
+					Return(entities.User{ID: "user-id"}, nil)
+				accessTokens.EXPECT().
+					UpdateLastUsed(mock.Anything, "token-id").
+					Return(nil)
+			},
+			want: dto.AccessTokenInfoDTO{UserID: "user-id", Scopes: []string{"tasks:write"}, ExpiresAt: expiresAt},
+		},
+		{
+			name:         "not an access token",
+			token:        "eyJhbGciOiJFZERTQSJ9",
+			mockBehavior: func(users *mocks.MockUserRepo, accessTokens *mocks.MockAccessTokenRepo, token string) {},
+			wantErr:      service.ErrInvalidToken,
+		},
+		{
+			name:  "not found",
+			token: service.AccessTokenPrefix + "revoked",
+			mockBehavior: func(users *mocks.MockUserRepo, accessTokens *mocks.MockAccessTokenRepo, token string) {
+				accessTokens.EXPECT().
+					GetByHash(mock.Anything, mock.Anything).
+					Return(entities.AccessToken{}, service.ErrAccessTokenNotFound)
+			},
+			wantErr: service.ErrInvalidToken,
+		},
+		{
+			name:  "expired",
+			token: service.AccessTokenPrefix + "expired",
+			mockBehavior: func(users *mocks.MockUserRepo, accessTokens *mocks.MockAccessTokenRepo, token string) {
+				accessTokens.EXPECT().
+					GetByHash(mock.Anything, mock.Anything).
+					Return(entities.AccessToken{ID: "token-id", UserID: "user-id", ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+			},
+			wantErr: service.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userRepo := mocks.NewMockUserRepo(t)
+			accessTokenRepo := mocks.NewMockAccessTokenRepo(t)
+			tc.mockBehavior(userRepo, accessTokenRepo, tc.token)
+			keys, err := jwks.Generate()
+			require.NoError(t, err)
+			svc := service.NewAuthService(slog.Default(), userRepo, mocks.NewMockTokenRepo(t), mocks.NewMockLockoutRepo(t), accessTokenRepo, mocks.NewMockProducer(t), keys, config.Auth{})
+			got, err := svc.IntrospectAccessToken(context.Background(), tc.token)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestVerifyJWT(t *testing.T) {
+	oldKeys, err := jwks.Generate()
+	require.NoError(t, err)
+	newKeys, err := jwks.Generate()
+	require.NoError(t, err)
+
+	token, err := service.SignJWT("user-id", true, oldKeys.Active, time.Minute)
+	require.NoError(t, err)
+
+	// После ротации старый ключ остается в наборе, пока не истекут его токены
+	rotated := &jwks.KeySet{Active: newKeys.Active, Keys: []jwks.Key{newKeys.Active, oldKeys.Active}}
+	claims, err := service.VerifyJWT(token, rotated)
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", claims.UserID)
+	assert.True(t, claims.IsSuperUser)
+
+	// Токен, подписанный удаленным ключом, не проходит проверку
+	_, err = service.VerifyJWT(token, newKeys)
+	assert.Error(t, err)
+}
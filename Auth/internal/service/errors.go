@@ -1,10 +1,39 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrUserNotFound       = errors.New("user not found")
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrInvalidToken       = errors.New("invalid token")
+	ErrTokenReused        = errors.New("refresh token reused")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrEmailNotVerified   = errors.New("email not verified")
+	ErrMFANotFound        = errors.New("mfa not enabled")
+	ErrMFAAlreadyEnabled  = errors.New("mfa already enabled")
+	ErrInvalidMFACode     = errors.New("invalid mfa code")
+	ErrTooManyAttempts    = errors.New("too many login attempts")
+	ErrLockoutNotFound    = errors.New("lockout not found")
+	ErrUserDisabled       = errors.New("user disabled")
+	ErrUserInUse          = errors.New("user is referenced by courses or task statuses")
+
+	ErrAccessTokenNotFound      = errors.New("access token not found")
+	ErrInvalidAccessTokenExpiry = errors.New("invalid access token expiry")
 )
+
+// Возвращается, когда вход временно заблокирован, содержит время до следующей попытки
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+func (e *TooManyAttemptsError) Unwrap() error {
+	return ErrTooManyAttempts
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"Classroom/Auth/pkg/jwks"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,11 +14,17 @@ type JwtClaims struct {
 	jwt.RegisteredClaims
 }
 
-func VerifyJWT(tokenString string, secret []byte) (*JwtClaims, error) {
+func VerifyJWT(tokenString string, keys *jwks.KeySet) (*JwtClaims, error) {
 	claims := &JwtClaims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
-		return secret, nil
-	})
+		kid, _ := t.Header["kid"].(string)
+		for _, key := range keys.Keys {
+			if key.ID == kid {
+				return key.Private.Public(), nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +34,8 @@ func VerifyJWT(tokenString string, secret []byte) (*JwtClaims, error) {
 	return claims, nil
 }
 
-func SignJWT(userID string, isSuperUser bool, secretKey []byte, ttl time.Duration) (string, error) {
+// Подписывает токен приватным ключом, kid в заголовке позволяет проверяющей стороне найти ключ в JWKS
+func SignJWT(userID string, isSuperUser bool, key jwks.Key, ttl time.Duration) (string, error) {
 	claims := JwtClaims{
 		UserID:      userID,
 		IsSuperUser: isSuperUser,
@@ -35,9 +44,12 @@ func SignJWT(userID string, isSuperUser bool, secretKey []byte, ttl time.Duratio
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 		},
 	}
-	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secretKey)
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+
+	signed, err := token.SignedString(key.Private)
 	if err != nil {
 		return "", err
 	}
-	return token, nil
+	return signed, nil
 }
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/pkg/e"
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Почта или IP адрес, по которым считаются неудачные попытки входа
+type loginSubject struct {
+	kind        string
+	value       string
+	maxFailures int
+}
+
+func (a *authService) loginSubjects(payload dto.LoginDTO) []loginSubject {
+	subjects := []loginSubject{{
+		kind:        entities.LockoutKindEmail,
+		value:       normalizeEmail(payload.Email),
+		maxFailures: a.conf.Lockout.MaxEmailFailures,
+	}}
+	if payload.IP != "" {
+		subjects = append(subjects, loginSubject{
+			kind:        entities.LockoutKindIP,
+			value:       payload.IP,
+			maxFailures: a.conf.Lockout.MaxIPFailures,
+		})
+	}
+	return subjects
+}
+
+// Возвращает TooManyAttemptsError, если вход заблокирован или не прошла задержка после прошлой неудачи
+func (a *authService) checkLoginAttempts(ctx context.Context, subjects []loginSubject) error {
+	now := time.Now()
+
+	var retryAfter time.Duration
+	for _, subject := range subjects {
+		attempts, err := a.lockouts.GetAttempts(ctx, subject.kind, subject.value)
+		if err != nil {
+			return e.Wrap(err, "failed to get login attempts")
+		}
+
+		wait := attempts.LockedUntil.Sub(now)
+		if delay := a.backoff(attempts.Failures); delay > 0 {
+			wait = max(wait, attempts.LastFailureAt.Add(delay).Sub(now))
+		}
+		retryAfter = max(retryAfter, wait)
+	}
+
+	if retryAfter > 0 {
+		return &TooManyAttemptsError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// Учитывает неудачную попытку входа и блокирует вход при превышении порога
+func (a *authService) loginFailed(ctx context.Context, subjects []loginSubject) error {
+	if err := a.registerFailures(ctx, subjects); err != nil {
+		return err
+	}
+	return ErrInvalidCredentials
+}
+
+func (a *authService) registerFailures(ctx context.Context, subjects []loginSubject) error {
+	for _, subject := range subjects {
+		attempts, err := a.lockouts.RegisterFailure(ctx, subject.kind, subject.value, a.conf.Lockout.FailureWindow)
+		if err != nil {
+			return e.Wrap(err, "failed to register login failure")
+		}
+
+		if subject.maxFailures <= 0 || attempts.Failures < subject.maxFailures {
+			continue
+		}
+		if err := a.lockouts.Lock(ctx, subject.kind, subject.value, time.Now().Add(a.conf.Lockout.Duration)); err != nil {
+			return e.Wrap(err, "failed to lock login")
+		}
+		a.logger.Warn("login locked out", "kind", subject.kind, "subject", subject.value, "failures", attempts.Failures)
+	}
+	return nil
+}
+
+// Задержка удваивается с каждой неудачей после BackoffAfter, но не превышает BackoffMax
+func (a *authService) backoff(failures int) time.Duration {
+	conf := a.conf.Lockout
+	if conf.BackoffAfter <= 0 || failures < conf.BackoffAfter {
+		return 0
+	}
+
+	delay := conf.BackoffBase
+	for i := conf.BackoffAfter; i < failures && delay < conf.BackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, conf.BackoffMax)
+}
+
+func (a *authService) ListLockouts(ctx context.Context) ([]entities.LoginAttempts, error) {
+	lockouts, err := a.lockouts.ListLockouts(ctx)
+	return lockouts, e.WrapIfErr(err, "failed to list lockouts")
+}
+
+func (a *authService) ClearLockout(ctx context.Context, payload dto.ClearLockoutDTO) error {
+	subject := payload.Subject
+	if payload.Kind == entities.LockoutKindEmail {
+		subject = normalizeEmail(subject)
+	}
+
+	err := a.lockouts.ClearLockout(ctx, payload.Kind, subject)
+	if errors.Is(err, ErrLockoutNotFound) {
+		return ErrLockoutNotFound
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to clear lockout")
+	}
+
+	a.logger.Info("lockout cleared", "kind", payload.Kind, "subject", subject)
+	return nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
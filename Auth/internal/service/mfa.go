@@ -0,0 +1,253 @@
+package service
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/pkg/e"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	recoveryCodesCount = 10
+	// После стольких неверных кодов токен входа удаляется и нужно заново ввести пароль,
+	// а после стольких неудач по пользователю за Lockout.FailureWindow проверка кода
+	// при входе, подключении и отключении MFA блокируется на Lockout.Duration
+	maxMFAAttempts = 5
+)
+
+// Генерирует секрет TOTP. Пока подключение не подтверждено кодом, MFA не действует
+func (a *authService) SetupMFA(ctx context.Context, userID string) (dto.MFASetupDTO, error) {
+	user, err := a.users.GetByID(ctx, userID)
+	if err != nil {
+		return dto.MFASetupDTO{}, e.Wrap(err, "failed to get user by id")
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      a.conf.MFAIssuer,
+		AccountName: user.Email,
+	})
+	if err != nil {
+		return dto.MFASetupDTO{}, e.Wrap(err, "failed to generate totp secret")
+	}
+
+	// Метод возвращает ErrMFAAlreadyEnabled, если MFA уже подтверждена
+	if err := a.users.SaveMFASecret(ctx, userID, key.Secret()); err != nil {
+		return dto.MFASetupDTO{}, e.Wrap(err, "failed to save mfa secret")
+	}
+
+	a.logger.Info("mfa setup started", "id", userID)
+	return dto.MFASetupDTO{Secret: key.Secret(), URI: key.URL()}, nil
+}
+
+// Подтверждает подключение MFA и возвращает коды восстановления,
+// они показываются пользователю только один раз
+func (a *authService) ConfirmMFA(ctx context.Context, payload dto.ConfirmMFADTO) ([]string, error) {
+	mfa, err := a.users.GetMFA(ctx, payload.UserID)
+	if errors.Is(err, ErrMFANotFound) {
+		return nil, ErrMFANotFound
+	}
+	if err != nil {
+		return nil, e.Wrap(err, "failed to get mfa")
+	}
+	if mfa.ConfirmedAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	subjects := mfaSubjects(payload.UserID)
+	if err := a.checkLoginAttempts(ctx, subjects); err != nil {
+		return nil, err
+	}
+	if !totp.Validate(payload.Code, mfa.Secret) {
+		return nil, a.mfaFailed(ctx, subjects, ErrInvalidMFACode)
+	}
+	if err := a.lockouts.ResetAttempts(ctx, entities.LockoutKindMFA, payload.UserID); err != nil {
+		return nil, e.Wrap(err, "failed to reset mfa attempts")
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, e.Wrap(err, "failed to generate recovery codes")
+	}
+	if err := a.users.ConfirmMFA(ctx, payload.UserID, hashes); err != nil {
+		return nil, e.Wrap(err, "failed to confirm mfa")
+	}
+
+	a.logger.Info("mfa enabled", "id", payload.UserID)
+	return codes, nil
+}
+
+// Для отключения нужны пароль и код, чтобы MFA не снял тот, у кого есть только токен доступа
+func (a *authService) DisableMFA(ctx context.Context, payload dto.DisableMFADTO) error {
+	subjects := mfaSubjects(payload.UserID)
+	if err := a.checkLoginAttempts(ctx, subjects); err != nil {
+		return err
+	}
+
+	_, err := a.checkPassword(ctx, payload.UserID, payload.Password)
+	if errors.Is(err, ErrInvalidCredentials) {
+		return a.mfaFailed(ctx, subjects, ErrInvalidCredentials)
+	}
+	if err != nil {
+		return err
+	}
+
+	mfa, err := a.users.GetMFA(ctx, payload.UserID)
+	if errors.Is(err, ErrMFANotFound) {
+		return ErrMFANotFound
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to get mfa")
+	}
+	if mfa.ConfirmedAt == nil {
+		return ErrMFANotFound
+	}
+
+	err = a.checkMFACode(ctx, mfa, payload.Code)
+	if errors.Is(err, ErrInvalidMFACode) {
+		return a.mfaFailed(ctx, subjects, ErrInvalidMFACode)
+	}
+	if err != nil {
+		return err
+	}
+	if err := a.lockouts.ResetAttempts(ctx, entities.LockoutKindMFA, payload.UserID); err != nil {
+		return e.Wrap(err, "failed to reset mfa attempts")
+	}
+
+	if err := a.users.DeleteMFA(ctx, payload.UserID); err != nil {
+		return e.Wrap(err, "failed to delete mfa")
+	}
+
+	a.logger.Info("mfa disabled", "id", payload.UserID)
+	return nil
+}
+
+// Второй шаг входа: проверяет код по токену из Login и выдает токены
+func (a *authService) VerifyMFA(ctx context.Context, payload dto.VerifyMFADTO) (dto.TokensDTO, error) {
+	challenge, err := a.tokens.ConsumeMFAChallenge(ctx, payload.Token)
+	if errors.Is(err, ErrInvalidToken) {
+		return dto.TokensDTO{}, ErrInvalidToken
+	}
+	if err != nil {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to consume mfa challenge")
+	}
+
+	// MFA могла быть отключена после первого шага
+	mfa, err := a.users.GetMFA(ctx, challenge.UserID)
+	if errors.Is(err, ErrMFANotFound) {
+		return dto.TokensDTO{}, ErrInvalidToken
+	}
+	if err != nil {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to get mfa")
+	}
+
+	// Новый токен выдаётся после каждого верного пароля, поэтому неверные коды
+	// считаются ещё и по пользователю. Верный пароль этот счетчик не сбрасывает
+	subjects := mfaSubjects(challenge.UserID)
+	if err := a.checkLoginAttempts(ctx, subjects); err != nil {
+		return dto.TokensDTO{}, err
+	}
+
+	err = a.checkMFACode(ctx, mfa, payload.Code)
+	if errors.Is(err, ErrInvalidMFACode) {
+		challenge.Attempts++
+		if challenge.Attempts < maxMFAAttempts {
+			if err := a.tokens.RetryMFAChallenge(ctx, payload.Token, challenge); err != nil {
+				return dto.TokensDTO{}, e.Wrap(err, "failed to retry mfa challenge")
+			}
+		}
+		return dto.TokensDTO{}, a.mfaFailed(ctx, subjects, ErrInvalidMFACode)
+	}
+	if err != nil {
+		return dto.TokensDTO{}, err
+	}
+	if err := a.lockouts.ResetAttempts(ctx, entities.LockoutKindMFA, challenge.UserID); err != nil {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to reset mfa attempts")
+	}
+
+	user, err := a.users.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		return dto.TokensDTO{}, e.Wrap(err, "failed to get user by id")
+	}
+	if user.DisabledAt != nil {
+		return dto.TokensDTO{}, ErrUserDisabled
+	}
+
+	tokens, err := a.issueTokens(ctx, user, dto.CreateSessionDTO{
+		UserID:    challenge.UserID,
+		UserAgent: challenge.UserAgent,
+		IP:        challenge.IP,
+	})
+	if err != nil {
+		return dto.TokensDTO{}, err
+	}
+
+	a.logger.Info("user logged in", "id", user.ID)
+	return tokens, nil
+}
+
+// Неверные коды при входе, подключении и отключении MFA считаются по пользователю, как попытки входа
+func mfaSubjects(userID string) []loginSubject {
+	return []loginSubject{{kind: entities.LockoutKindMFA, value: userID, maxFailures: maxMFAAttempts}}
+}
+
+// Учитывает неудачную проверку и возвращает её причину
+func (a *authService) mfaFailed(ctx context.Context, subjects []loginSubject, cause error) error {
+	if err := a.registerFailures(ctx, subjects); err != nil {
+		return err
+	}
+	return cause
+}
+
+// Принимает код из приложения или одноразовый код восстановления
+func (a *authService) checkMFACode(ctx context.Context, mfa entities.MFA, code string) error {
+	if totp.Validate(code, mfa.Secret) {
+		return nil
+	}
+
+	hash := hashRecoveryCode(code)
+	if !slices.Contains(mfa.RecoveryCodes, hash) {
+		return ErrInvalidMFACode
+	}
+
+	// Метод возвращает ErrInvalidMFACode, если код уже использован
+	if err := a.users.UseRecoveryCode(ctx, mfa.UserID, hash); err != nil {
+		return e.Wrap(err, "failed to use recovery code")
+	}
+
+	a.logger.Info("recovery code used", "id", mfa.UserID, "left", len(mfa.RecoveryCodes)-1)
+	return nil
+}
+
+// Возвращает коды восстановления вида xxxxx-xxxxx и их хеши для хранения
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+	for range recoveryCodesCount {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(buf))
+		code = code[:5] + "-" + code[5:10]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// Коды восстановления случайные и достаточно длинные, поэтому медленный хеш не нужен
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
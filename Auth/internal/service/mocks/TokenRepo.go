@@ -5,6 +5,7 @@
 package service
 
 import (
+	"Classroom/Auth/internal/dto"
 	"Classroom/Auth/internal/entities"
 	"context"
 	"time"
@@ -39,14 +40,354 @@ func (_m *MockTokenRepo) EXPECT() *MockTokenRepo_Expecter {
 	return &MockTokenRepo_Expecter{mock: &_m.Mock}
 }
 
+// ConsumeEmailVerification provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) ConsumeEmailVerification(ctx context.Context, token string) (string, string, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeEmailVerification")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, string, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, token)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockTokenRepo_ConsumeEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeEmailVerification'
+type MockTokenRepo_ConsumeEmailVerification_Call struct {
+	*mock.Call
+}
+
+// ConsumeEmailVerification is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockTokenRepo_Expecter) ConsumeEmailVerification(ctx interface{}, token interface{}) *MockTokenRepo_ConsumeEmailVerification_Call {
+	return &MockTokenRepo_ConsumeEmailVerification_Call{Call: _e.mock.On("ConsumeEmailVerification", ctx, token)}
+}
+
+func (_c *MockTokenRepo_ConsumeEmailVerification_Call) Run(run func(ctx context.Context, token string)) *MockTokenRepo_ConsumeEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_ConsumeEmailVerification_Call) Return(s string, s1 string, err error) *MockTokenRepo_ConsumeEmailVerification_Call {
+	_c.Call.Return(s, s1, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_ConsumeEmailVerification_Call) RunAndReturn(run func(ctx context.Context, token string) (string, string, error)) *MockTokenRepo_ConsumeEmailVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeMFAChallenge provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) ConsumeMFAChallenge(ctx context.Context, token string) (entities.MFAChallenge, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeMFAChallenge")
+	}
+
+	var r0 entities.MFAChallenge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.MFAChallenge, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.MFAChallenge); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(entities.MFAChallenge)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_ConsumeMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeMFAChallenge'
+type MockTokenRepo_ConsumeMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// ConsumeMFAChallenge is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockTokenRepo_Expecter) ConsumeMFAChallenge(ctx interface{}, token interface{}) *MockTokenRepo_ConsumeMFAChallenge_Call {
+	return &MockTokenRepo_ConsumeMFAChallenge_Call{Call: _e.mock.On("ConsumeMFAChallenge", ctx, token)}
+}
+
+func (_c *MockTokenRepo_ConsumeMFAChallenge_Call) Run(run func(ctx context.Context, token string)) *MockTokenRepo_ConsumeMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_ConsumeMFAChallenge_Call) Return(mfaChallenge entities.MFAChallenge, err error) *MockTokenRepo_ConsumeMFAChallenge_Call {
+	_c.Call.Return(mfaChallenge, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_ConsumeMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, token string) (entities.MFAChallenge, error)) *MockTokenRepo_ConsumeMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumePasswordReset provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) ConsumePasswordReset(ctx context.Context, token string) (string, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumePasswordReset")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_ConsumePasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumePasswordReset'
+type MockTokenRepo_ConsumePasswordReset_Call struct {
+	*mock.Call
+}
+
+// ConsumePasswordReset is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockTokenRepo_Expecter) ConsumePasswordReset(ctx interface{}, token interface{}) *MockTokenRepo_ConsumePasswordReset_Call {
+	return &MockTokenRepo_ConsumePasswordReset_Call{Call: _e.mock.On("ConsumePasswordReset", ctx, token)}
+}
+
+func (_c *MockTokenRepo_ConsumePasswordReset_Call) Run(run func(ctx context.Context, token string)) *MockTokenRepo_ConsumePasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_ConsumePasswordReset_Call) Return(s string, err error) *MockTokenRepo_ConsumePasswordReset_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_ConsumePasswordReset_Call) RunAndReturn(run func(ctx context.Context, token string) (string, error)) *MockTokenRepo_ConsumePasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockTokenRepo
-func (_mock *MockTokenRepo) Create(ctx context.Context, userID string, ttl time.Duration) (string, error) {
-	ret := _mock.Called(ctx, userID, ttl)
+func (_mock *MockTokenRepo) Create(ctx context.Context, dto1 dto.CreateSessionDTO, ttl time.Duration) (string, error) {
+	ret := _mock.Called(ctx, dto1, ttl)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateSessionDTO, time.Duration) (string, error)); ok {
+		return returnFunc(ctx, dto1, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateSessionDTO, time.Duration) string); ok {
+		r0 = returnFunc(ctx, dto1, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.CreateSessionDTO, time.Duration) error); ok {
+		r1 = returnFunc(ctx, dto1, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTokenRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+//   - ttl
+func (_e *MockTokenRepo_Expecter) Create(ctx interface{}, dto1 interface{}, ttl interface{}) *MockTokenRepo_Create_Call {
+	return &MockTokenRepo_Create_Call{Call: _e.mock.On("Create", ctx, dto1, ttl)}
+}
+
+func (_c *MockTokenRepo_Create_Call) Run(run func(ctx context.Context, dto1 dto.CreateSessionDTO, ttl time.Duration)) *MockTokenRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateSessionDTO), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_Create_Call) Return(s string, err error) *MockTokenRepo_Create_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_Create_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.CreateSessionDTO, ttl time.Duration) (string, error)) *MockTokenRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEmailVerification provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) CreateEmailVerification(ctx context.Context, userID string, email string, ttl time.Duration) (string, error) {
+	ret := _mock.Called(ctx, userID, email, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmailVerification")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (string, error)); ok {
+		return returnFunc(ctx, userID, email, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) string); ok {
+		r0 = returnFunc(ctx, userID, email, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, userID, email, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_CreateEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmailVerification'
+type MockTokenRepo_CreateEmailVerification_Call struct {
+	*mock.Call
+}
+
+// CreateEmailVerification is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - email
+//   - ttl
+func (_e *MockTokenRepo_Expecter) CreateEmailVerification(ctx interface{}, userID interface{}, email interface{}, ttl interface{}) *MockTokenRepo_CreateEmailVerification_Call {
+	return &MockTokenRepo_CreateEmailVerification_Call{Call: _e.mock.On("CreateEmailVerification", ctx, userID, email, ttl)}
+}
+
+func (_c *MockTokenRepo_CreateEmailVerification_Call) Run(run func(ctx context.Context, userID string, email string, ttl time.Duration)) *MockTokenRepo_CreateEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_CreateEmailVerification_Call) Return(s string, err error) *MockTokenRepo_CreateEmailVerification_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_CreateEmailVerification_Call) RunAndReturn(run func(ctx context.Context, userID string, email string, ttl time.Duration) (string, error)) *MockTokenRepo_CreateEmailVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMFAChallenge provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) CreateMFAChallenge(ctx context.Context, dto1 dto.CreateSessionDTO, ttl time.Duration) (string, error) {
+	ret := _mock.Called(ctx, dto1, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMFAChallenge")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateSessionDTO, time.Duration) (string, error)); ok {
+		return returnFunc(ctx, dto1, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateSessionDTO, time.Duration) string); ok {
+		r0 = returnFunc(ctx, dto1, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.CreateSessionDTO, time.Duration) error); ok {
+		r1 = returnFunc(ctx, dto1, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_CreateMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMFAChallenge'
+type MockTokenRepo_CreateMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// CreateMFAChallenge is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+//   - ttl
+func (_e *MockTokenRepo_Expecter) CreateMFAChallenge(ctx interface{}, dto1 interface{}, ttl interface{}) *MockTokenRepo_CreateMFAChallenge_Call {
+	return &MockTokenRepo_CreateMFAChallenge_Call{Call: _e.mock.On("CreateMFAChallenge", ctx, dto1, ttl)}
+}
+
+func (_c *MockTokenRepo_CreateMFAChallenge_Call) Run(run func(ctx context.Context, dto1 dto.CreateSessionDTO, ttl time.Duration)) *MockTokenRepo_CreateMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateSessionDTO), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_CreateMFAChallenge_Call) Return(s string, err error) *MockTokenRepo_CreateMFAChallenge_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_CreateMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.CreateSessionDTO, ttl time.Duration) (string, error)) *MockTokenRepo_CreateMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePasswordReset provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) CreatePasswordReset(ctx context.Context, userID string, ttl time.Duration) (string, error) {
+	ret := _mock.Called(ctx, userID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePasswordReset")
+	}
+
 	var r0 string
 	var r1 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) (string, error)); ok {
@@ -65,32 +406,32 @@ func (_mock *MockTokenRepo) Create(ctx context.Context, userID string, ttl time.
 	return r0, r1
 }
 
-// MockTokenRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockTokenRepo_Create_Call struct {
+// MockTokenRepo_CreatePasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasswordReset'
+type MockTokenRepo_CreatePasswordReset_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// CreatePasswordReset is a helper method to define mock.On call
 //   - ctx
 //   - userID
 //   - ttl
-func (_e *MockTokenRepo_Expecter) Create(ctx interface{}, userID interface{}, ttl interface{}) *MockTokenRepo_Create_Call {
-	return &MockTokenRepo_Create_Call{Call: _e.mock.On("Create", ctx, userID, ttl)}
+func (_e *MockTokenRepo_Expecter) CreatePasswordReset(ctx interface{}, userID interface{}, ttl interface{}) *MockTokenRepo_CreatePasswordReset_Call {
+	return &MockTokenRepo_CreatePasswordReset_Call{Call: _e.mock.On("CreatePasswordReset", ctx, userID, ttl)}
 }
 
-func (_c *MockTokenRepo_Create_Call) Run(run func(ctx context.Context, userID string, ttl time.Duration)) *MockTokenRepo_Create_Call {
+func (_c *MockTokenRepo_CreatePasswordReset_Call) Run(run func(ctx context.Context, userID string, ttl time.Duration)) *MockTokenRepo_CreatePasswordReset_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
 	})
 	return _c
 }
 
-func (_c *MockTokenRepo_Create_Call) Return(s string, err error) *MockTokenRepo_Create_Call {
+func (_c *MockTokenRepo_CreatePasswordReset_Call) Return(s string, err error) *MockTokenRepo_CreatePasswordReset_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockTokenRepo_Create_Call) RunAndReturn(run func(ctx context.Context, userID string, ttl time.Duration) (string, error)) *MockTokenRepo_Create_Call {
+func (_c *MockTokenRepo_CreatePasswordReset_Call) RunAndReturn(run func(ctx context.Context, userID string, ttl time.Duration) (string, error)) *MockTokenRepo_CreatePasswordReset_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -150,6 +491,165 @@ func (_c *MockTokenRepo_GetInfoByToken_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetSession provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) GetSession(ctx context.Context, sessionID string) (entities.Session, error) {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSession")
+	}
+
+	var r0 entities.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.Session, error)); ok {
+		return returnFunc(ctx, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.Session); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Get(0).(entities.Session)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type MockTokenRepo_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//   - ctx
+//   - sessionID
+func (_e *MockTokenRepo_Expecter) GetSession(ctx interface{}, sessionID interface{}) *MockTokenRepo_GetSession_Call {
+	return &MockTokenRepo_GetSession_Call{Call: _e.mock.On("GetSession", ctx, sessionID)}
+}
+
+func (_c *MockTokenRepo_GetSession_Call) Run(run func(ctx context.Context, sessionID string)) *MockTokenRepo_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_GetSession_Call) Return(session entities.Session, err error) *MockTokenRepo_GetSession_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_GetSession_Call) RunAndReturn(run func(ctx context.Context, sessionID string) (entities.Session, error)) *MockTokenRepo_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) ListSessions(ctx context.Context, userID string) ([]entities.Session, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []entities.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Session, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Session); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockTokenRepo_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockTokenRepo_Expecter) ListSessions(ctx interface{}, userID interface{}) *MockTokenRepo_ListSessions_Call {
+	return &MockTokenRepo_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID)}
+}
+
+func (_c *MockTokenRepo_ListSessions_Call) Run(run func(ctx context.Context, userID string)) *MockTokenRepo_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_ListSessions_Call) Return(sessions []entities.Session, err error) *MockTokenRepo_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]entities.Session, error)) *MockTokenRepo_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryMFAChallenge provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) RetryMFAChallenge(ctx context.Context, token string, challenge entities.MFAChallenge) error {
+	ret := _mock.Called(ctx, token, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryMFAChallenge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, entities.MFAChallenge) error); ok {
+		r0 = returnFunc(ctx, token, challenge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTokenRepo_RetryMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryMFAChallenge'
+type MockTokenRepo_RetryMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// RetryMFAChallenge is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - challenge
+func (_e *MockTokenRepo_Expecter) RetryMFAChallenge(ctx interface{}, token interface{}, challenge interface{}) *MockTokenRepo_RetryMFAChallenge_Call {
+	return &MockTokenRepo_RetryMFAChallenge_Call{Call: _e.mock.On("RetryMFAChallenge", ctx, token, challenge)}
+}
+
+func (_c *MockTokenRepo_RetryMFAChallenge_Call) Run(run func(ctx context.Context, token string, challenge entities.MFAChallenge)) *MockTokenRepo_RetryMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entities.MFAChallenge))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_RetryMFAChallenge_Call) Return(err error) *MockTokenRepo_RetryMFAChallenge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTokenRepo_RetryMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, token string, challenge entities.MFAChallenge) error) *MockTokenRepo_RetryMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Revoke provides a mock function for the type MockTokenRepo
 func (_mock *MockTokenRepo) Revoke(ctx context.Context, token string) (bool, error) {
 	ret := _mock.Called(ctx, token)
@@ -204,3 +704,151 @@ func (_c *MockTokenRepo_Revoke_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// RevokeAll provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) RevokeAll(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTokenRepo_RevokeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAll'
+type MockTokenRepo_RevokeAll_Call struct {
+	*mock.Call
+}
+
+// RevokeAll is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockTokenRepo_Expecter) RevokeAll(ctx interface{}, userID interface{}) *MockTokenRepo_RevokeAll_Call {
+	return &MockTokenRepo_RevokeAll_Call{Call: _e.mock.On("RevokeAll", ctx, userID)}
+}
+
+func (_c *MockTokenRepo_RevokeAll_Call) Run(run func(ctx context.Context, userID string)) *MockTokenRepo_RevokeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_RevokeAll_Call) Return(err error) *MockTokenRepo_RevokeAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTokenRepo_RevokeAll_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockTokenRepo_RevokeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) RevokeFamily(ctx context.Context, familyID string) error {
+	ret := _mock.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTokenRepo_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type MockTokenRepo_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx
+//   - familyID
+func (_e *MockTokenRepo_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *MockTokenRepo_RevokeFamily_Call {
+	return &MockTokenRepo_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *MockTokenRepo_RevokeFamily_Call) Run(run func(ctx context.Context, familyID string)) *MockTokenRepo_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_RevokeFamily_Call) Return(err error) *MockTokenRepo_RevokeFamily_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTokenRepo_RevokeFamily_Call) RunAndReturn(run func(ctx context.Context, familyID string) error) *MockTokenRepo_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function for the type MockTokenRepo
+func (_mock *MockTokenRepo) Rotate(ctx context.Context, token string, ttl time.Duration) (string, error) {
+	ret := _mock.Called(ctx, token, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) (string, error)); ok {
+		return returnFunc(ctx, token, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) string); ok {
+		r0 = returnFunc(ctx, token, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, token, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRepo_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockTokenRepo_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - ttl
+func (_e *MockTokenRepo_Expecter) Rotate(ctx interface{}, token interface{}, ttl interface{}) *MockTokenRepo_Rotate_Call {
+	return &MockTokenRepo_Rotate_Call{Call: _e.mock.On("Rotate", ctx, token, ttl)}
+}
+
+func (_c *MockTokenRepo_Rotate_Call) Run(run func(ctx context.Context, token string, ttl time.Duration)) *MockTokenRepo_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepo_Rotate_Call) Return(s string, err error) *MockTokenRepo_Rotate_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockTokenRepo_Rotate_Call) RunAndReturn(run func(ctx context.Context, token string, ttl time.Duration) (string, error)) *MockTokenRepo_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
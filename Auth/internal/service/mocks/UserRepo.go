@@ -39,6 +39,53 @@ func (_m *MockUserRepo) EXPECT() *MockUserRepo_Expecter {
 	return &MockUserRepo_Expecter{mock: &_m.Mock}
 }
 
+// ConfirmMFA provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) ConfirmMFA(ctx context.Context, userID string, recoveryCodes []string) error {
+	ret := _mock.Called(ctx, userID, recoveryCodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, userID, recoveryCodes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_ConfirmMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmMFA'
+type MockUserRepo_ConfirmMFA_Call struct {
+	*mock.Call
+}
+
+// ConfirmMFA is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - recoveryCodes
+func (_e *MockUserRepo_Expecter) ConfirmMFA(ctx interface{}, userID interface{}, recoveryCodes interface{}) *MockUserRepo_ConfirmMFA_Call {
+	return &MockUserRepo_ConfirmMFA_Call{Call: _e.mock.On("ConfirmMFA", ctx, userID, recoveryCodes)}
+}
+
+func (_c *MockUserRepo_ConfirmMFA_Call) Run(run func(ctx context.Context, userID string, recoveryCodes []string)) *MockUserRepo_ConfirmMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_ConfirmMFA_Call) Return(err error) *MockUserRepo_ConfirmMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_ConfirmMFA_Call) RunAndReturn(run func(ctx context.Context, userID string, recoveryCodes []string) error) *MockUserRepo_ConfirmMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockUserRepo
 func (_mock *MockUserRepo) Create(ctx context.Context, dto1 dto.CreateUserDTO) (entities.User, error) {
 	ret := _mock.Called(ctx, dto1)
@@ -94,6 +141,98 @@ func (_c *MockUserRepo_Create_Call) RunAndReturn(run func(ctx context.Context, d
 	return _c
 }
 
+// Delete provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockUserRepo_Delete_Call {
+	return &MockUserRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockUserRepo_Delete_Call) Run(run func(ctx context.Context, id string)) *MockUserRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Delete_Call) Return(err error) *MockUserRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockUserRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMFA provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) DeleteMFA(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_DeleteMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMFA'
+type MockUserRepo_DeleteMFA_Call struct {
+	*mock.Call
+}
+
+// DeleteMFA is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockUserRepo_Expecter) DeleteMFA(ctx interface{}, userID interface{}) *MockUserRepo_DeleteMFA_Call {
+	return &MockUserRepo_DeleteMFA_Call{Call: _e.mock.On("DeleteMFA", ctx, userID)}
+}
+
+func (_c *MockUserRepo_DeleteMFA_Call) Run(run func(ctx context.Context, userID string)) *MockUserRepo_DeleteMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_DeleteMFA_Call) Return(err error) *MockUserRepo_DeleteMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_DeleteMFA_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockUserRepo_DeleteMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEmail provides a mock function for the type MockUserRepo
 func (_mock *MockUserRepo) GetByEmail(ctx context.Context, email string) (entities.User, error) {
 	ret := _mock.Called(ctx, email)
@@ -203,3 +342,505 @@ func (_c *MockUserRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetMFA provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetMFA(ctx context.Context, userID string) (entities.MFA, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMFA")
+	}
+
+	var r0 entities.MFA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.MFA, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.MFA); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entities.MFA)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMFA'
+type MockUserRepo_GetMFA_Call struct {
+	*mock.Call
+}
+
+// GetMFA is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockUserRepo_Expecter) GetMFA(ctx interface{}, userID interface{}) *MockUserRepo_GetMFA_Call {
+	return &MockUserRepo_GetMFA_Call{Call: _e.mock.On("GetMFA", ctx, userID)}
+}
+
+func (_c *MockUserRepo_GetMFA_Call) Run(run func(ctx context.Context, userID string)) *MockUserRepo_GetMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetMFA_Call) Return(mfa entities.MFA, err error) *MockUserRepo_GetMFA_Call {
+	_c.Call.Return(mfa, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetMFA_Call) RunAndReturn(run func(ctx context.Context, userID string) (entities.MFA, error)) *MockUserRepo_GetMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) List(ctx context.Context, dto1 dto.ListUsersDTO) ([]entities.User, int, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []entities.User
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ListUsersDTO) ([]entities.User, int, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ListUsersDTO) []entities.User); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.ListUsersDTO) int); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dto.ListUsersDTO) error); ok {
+		r2 = returnFunc(ctx, dto1)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockUserRepo_Expecter) List(ctx interface{}, dto1 interface{}) *MockUserRepo_List_Call {
+	return &MockUserRepo_List_Call{Call: _e.mock.On("List", ctx, dto1)}
+}
+
+func (_c *MockUserRepo_List_Call) Run(run func(ctx context.Context, dto1 dto.ListUsersDTO)) *MockUserRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ListUsersDTO))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_List_Call) Return(users []entities.User, n int, err error) *MockUserRepo_List_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockUserRepo_List_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ListUsersDTO) ([]entities.User, int, error)) *MockUserRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailVerified provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) MarkEmailVerified(ctx context.Context, id string, email string) error {
+	ret := _mock.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type MockUserRepo_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - email
+func (_e *MockUserRepo_Expecter) MarkEmailVerified(ctx interface{}, id interface{}, email interface{}) *MockUserRepo_MarkEmailVerified_Call {
+	return &MockUserRepo_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, id, email)}
+}
+
+func (_c *MockUserRepo_MarkEmailVerified_Call) Run(run func(ctx context.Context, id string, email string)) *MockUserRepo_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_MarkEmailVerified_Call) Return(err error) *MockUserRepo_MarkEmailVerified_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_MarkEmailVerified_Call) RunAndReturn(run func(ctx context.Context, id string, email string) error) *MockUserRepo_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMFASecret provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) SaveMFASecret(ctx context.Context, userID string, secret string) error {
+	ret := _mock.Called(ctx, userID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMFASecret")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_SaveMFASecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMFASecret'
+type MockUserRepo_SaveMFASecret_Call struct {
+	*mock.Call
+}
+
+// SaveMFASecret is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - secret
+func (_e *MockUserRepo_Expecter) SaveMFASecret(ctx interface{}, userID interface{}, secret interface{}) *MockUserRepo_SaveMFASecret_Call {
+	return &MockUserRepo_SaveMFASecret_Call{Call: _e.mock.On("SaveMFASecret", ctx, userID, secret)}
+}
+
+func (_c *MockUserRepo_SaveMFASecret_Call) Run(run func(ctx context.Context, userID string, secret string)) *MockUserRepo_SaveMFASecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_SaveMFASecret_Call) Return(err error) *MockUserRepo_SaveMFASecret_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_SaveMFASecret_Call) RunAndReturn(run func(ctx context.Context, userID string, secret string) error) *MockUserRepo_SaveMFASecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDisabled provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) SetDisabled(ctx context.Context, id string, disabled bool) error {
+	ret := _mock.Called(ctx, id, disabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDisabled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, id, disabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_SetDisabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDisabled'
+type MockUserRepo_SetDisabled_Call struct {
+	*mock.Call
+}
+
+// SetDisabled is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - disabled
+func (_e *MockUserRepo_Expecter) SetDisabled(ctx interface{}, id interface{}, disabled interface{}) *MockUserRepo_SetDisabled_Call {
+	return &MockUserRepo_SetDisabled_Call{Call: _e.mock.On("SetDisabled", ctx, id, disabled)}
+}
+
+func (_c *MockUserRepo_SetDisabled_Call) Run(run func(ctx context.Context, id string, disabled bool)) *MockUserRepo_SetDisabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_SetDisabled_Call) Return(err error) *MockUserRepo_SetDisabled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_SetDisabled_Call) RunAndReturn(run func(ctx context.Context, id string, disabled bool) error) *MockUserRepo_SetDisabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSuperuser provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) SetSuperuser(ctx context.Context, id string, isSuperUser bool) error {
+	ret := _mock.Called(ctx, id, isSuperUser)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSuperuser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, id, isSuperUser)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_SetSuperuser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSuperuser'
+type MockUserRepo_SetSuperuser_Call struct {
+	*mock.Call
+}
+
+// SetSuperuser is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - isSuperUser
+func (_e *MockUserRepo_Expecter) SetSuperuser(ctx interface{}, id interface{}, isSuperUser interface{}) *MockUserRepo_SetSuperuser_Call {
+	return &MockUserRepo_SetSuperuser_Call{Call: _e.mock.On("SetSuperuser", ctx, id, isSuperUser)}
+}
+
+func (_c *MockUserRepo_SetSuperuser_Call) Run(run func(ctx context.Context, id string, isSuperUser bool)) *MockUserRepo_SetSuperuser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_SetSuperuser_Call) Return(err error) *MockUserRepo_SetSuperuser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_SetSuperuser_Call) RunAndReturn(run func(ctx context.Context, id string, isSuperUser bool) error) *MockUserRepo_SetSuperuser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEmail provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) UpdateEmail(ctx context.Context, id string, email string) error {
+	ret := _mock.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_UpdateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmail'
+type MockUserRepo_UpdateEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateEmail is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - email
+func (_e *MockUserRepo_Expecter) UpdateEmail(ctx interface{}, id interface{}, email interface{}) *MockUserRepo_UpdateEmail_Call {
+	return &MockUserRepo_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", ctx, id, email)}
+}
+
+func (_c *MockUserRepo_UpdateEmail_Call) Run(run func(ctx context.Context, id string, email string)) *MockUserRepo_UpdateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateEmail_Call) Return(err error) *MockUserRepo_UpdateEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateEmail_Call) RunAndReturn(run func(ctx context.Context, id string, email string) error) *MockUserRepo_UpdateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) UpdatePassword(ctx context.Context, id string, passwordHash []byte) error {
+	ret := _mock.Called(ctx, id, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) error); ok {
+		r0 = returnFunc(ctx, id, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserRepo_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - passwordHash
+func (_e *MockUserRepo_Expecter) UpdatePassword(ctx interface{}, id interface{}, passwordHash interface{}) *MockUserRepo_UpdatePassword_Call {
+	return &MockUserRepo_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, passwordHash)}
+}
+
+func (_c *MockUserRepo_UpdatePassword_Call) Run(run func(ctx context.Context, id string, passwordHash []byte)) *MockUserRepo_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdatePassword_Call) Return(err error) *MockUserRepo_UpdatePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdatePassword_Call) RunAndReturn(run func(ctx context.Context, id string, passwordHash []byte) error) *MockUserRepo_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) UpdateProfile(ctx context.Context, dto1 dto.UpdateProfileDTO) (entities.User, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateProfileDTO) (entities.User, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateProfileDTO) entities.User); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(entities.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.UpdateProfileDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserRepo_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockUserRepo_Expecter) UpdateProfile(ctx interface{}, dto1 interface{}) *MockUserRepo_UpdateProfile_Call {
+	return &MockUserRepo_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, dto1)}
+}
+
+func (_c *MockUserRepo_UpdateProfile_Call) Run(run func(ctx context.Context, dto1 dto.UpdateProfileDTO)) *MockUserRepo_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.UpdateProfileDTO))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateProfile_Call) Return(user entities.User, err error) *MockUserRepo_UpdateProfile_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.UpdateProfileDTO) (entities.User, error)) *MockUserRepo_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseRecoveryCode provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) UseRecoveryCode(ctx context.Context, userID string, codeHash string) error {
+	ret := _mock.Called(ctx, userID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, codeHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_UseRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseRecoveryCode'
+type MockUserRepo_UseRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// UseRecoveryCode is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - codeHash
+func (_e *MockUserRepo_Expecter) UseRecoveryCode(ctx interface{}, userID interface{}, codeHash interface{}) *MockUserRepo_UseRecoveryCode_Call {
+	return &MockUserRepo_UseRecoveryCode_Call{Call: _e.mock.On("UseRecoveryCode", ctx, userID, codeHash)}
+}
+
+func (_c *MockUserRepo_UseRecoveryCode_Call) Run(run func(ctx context.Context, userID string, codeHash string)) *MockUserRepo_UseRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UseRecoveryCode_Call) Return(err error) *MockUserRepo_UseRecoveryCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_UseRecoveryCode_Call) RunAndReturn(run func(ctx context.Context, userID string, codeHash string) error) *MockUserRepo_UseRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
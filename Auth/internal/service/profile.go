@@ -0,0 +1,165 @@
+package service
+
+import (
+	"Classroom/Auth/internal/dto"
+	"Classroom/Auth/internal/entities"
+	"Classroom/Auth/pkg/e"
+	"Classroom/Auth/pkg/events"
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (a *authService) UpdateProfile(ctx context.Context, payload dto.UpdateProfileDTO) (entities.User, error) {
+	user, err := a.users.UpdateProfile(ctx, payload)
+	if errors.Is(err, ErrUserNotFound) {
+		return entities.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return entities.User{}, e.Wrap(err, "failed to update profile")
+	}
+
+	a.logger.Info("profile updated", "id", user.ID)
+	a.publishUserUpdated(events.UserUpdated{
+		UserID:    user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	})
+	return user, nil
+}
+
+// Меняет пароль и завершает все сессии, кроме сессии переданного refresh токена
+func (a *authService) ChangePassword(ctx context.Context, payload dto.ChangePasswordDTO) error {
+	user, err := a.checkPassword(ctx, payload.UserID, payload.CurrentPassword)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(payload.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return e.Wrap(err, "failed to hash password")
+	}
+
+	if err := a.users.UpdatePassword(ctx, user.ID, passwordHash); err != nil {
+		return e.Wrap(err, "failed to update password")
+	}
+
+	if err := a.revokeOtherSessions(ctx, user.ID, payload.RefreshToken); err != nil {
+		return err
+	}
+
+	a.logger.Info("password changed", "id", user.ID)
+	a.publishUserUpdated(events.UserUpdated{
+		UserID:          user.ID,
+		Email:           user.Email,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		PasswordChanged: true,
+	})
+	return nil
+}
+
+// Меняет почту, после чего новую почту нужно подтвердить по ссылке из письма
+func (a *authService) ChangeEmail(ctx context.Context, payload dto.ChangeEmailDTO) error {
+	user, err := a.checkPassword(ctx, payload.UserID, payload.CurrentPassword)
+	if err != nil {
+		return err
+	}
+	if user.Email == payload.NewEmail {
+		return nil
+	}
+
+	// Метод проверяет unique constraint на email, и возвращает ErrUserAlreadyExists
+	err = a.users.UpdateEmail(ctx, user.ID, payload.NewEmail)
+	if errors.Is(err, ErrUserAlreadyExists) {
+		return ErrUserAlreadyExists
+	}
+	if err != nil {
+		return e.Wrap(err, "failed to update email")
+	}
+
+	a.logger.Info("email changed", "id", user.ID)
+	a.publishUserUpdated(events.UserUpdated{
+		UserID:        user.ID,
+		Email:         payload.NewEmail,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		PreviousEmail: user.Email,
+	})
+
+	// Почта уже изменена, поэтому ошибку отправки письма не возвращаем,
+	// письмо можно запросить повторно
+	token, err := a.tokens.CreateEmailVerification(ctx, user.ID, payload.NewEmail, a.conf.EmailVerificationTTL)
+	if err != nil {
+		a.logger.Warn("failed to create email verification token", "id", user.ID, "err", err)
+		return nil
+	}
+	err = a.producer.PublishEmailVerificationRequested(events.EmailVerificationRequested{
+		UserID:    user.ID,
+		Token:     token,
+		ExpiresAt: time.Now().Add(a.conf.EmailVerificationTTL),
+	})
+	if err != nil {
+		a.logger.Warn("failed to publish email verification requested", "id", user.ID, "err", err)
+	}
+	return nil
+}
+
+// Возвращает ErrInvalidCredentials, если пароль не совпадает с текущим
+func (a *authService) checkPassword(ctx context.Context, userID, password string) (entities.User, error) {
+	user, err := a.users.GetByID(ctx, userID)
+	if errors.Is(err, ErrUserNotFound) {
+		return entities.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return entities.User{}, e.Wrap(err, "failed to get user by id")
+	}
+
+	if bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password)) != nil {
+		return entities.User{}, ErrInvalidCredentials
+	}
+	return user, nil
+}
+
+// Завершает все сессии пользователя, кроме сессии refresh токена. Если токен
+// не задан или недействителен, завершаются все сессии
+func (a *authService) revokeOtherSessions(ctx context.Context, userID, refreshToken string) error {
+	var keepID string
+	if refreshToken != "" {
+		info, err := a.tokens.GetInfoByToken(ctx, refreshToken)
+		if err != nil && !errors.Is(err, ErrInvalidToken) && !errors.Is(err, ErrTokenReused) {
+			return e.Wrap(err, "failed to get refresh token info")
+		}
+		if err == nil && info.UserID == userID {
+			keepID = info.FamilyID
+		}
+	}
+
+	if keepID == "" {
+		return e.WrapIfErr(a.tokens.RevokeAll(ctx, userID), "failed to revoke all sessions")
+	}
+
+	sessions, err := a.tokens.ListSessions(ctx, userID)
+	if err != nil {
+		return e.Wrap(err, "failed to list sessions")
+	}
+	for _, session := range sessions {
+		if session.ID == keepID {
+			continue
+		}
+		if err := a.tokens.RevokeFamily(ctx, session.ID); err != nil {
+			return e.Wrap(err, "failed to revoke session")
+		}
+	}
+	return nil
+}
+
+// Изменения уже сохранены, поэтому ошибку публикации только логируем
+func (a *authService) publishUserUpdated(event events.UserUpdated) {
+	if err := a.producer.PublishUserUpdated(event); err != nil {
+		a.logger.Warn("failed to publish user updated", "id", event.UserID, "err", err)
+	}
+}
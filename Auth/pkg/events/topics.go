@@ -0,0 +1,8 @@
+package events
+
+const (
+	PasswordResetRequestedTopic     = "auth.password_reset_requested"
+	EmailVerificationRequestedTopic = "auth.email_verification_requested"
+	UserRegisteredTopic             = "user.registered"
+	UserUpdatedTopic                = "user.updated"
+)
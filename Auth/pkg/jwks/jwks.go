@@ -0,0 +1,156 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var ErrUnsupportedKey = errors.New("unsupported key type")
+
+// Ключ подписи, kid попадает в заголовок токена
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+}
+
+// Публичный ключ в формате JWK (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// Набор ключей: активным ключом подписываются новые токены,
+// остальные публикуются, пока не истекут выпущенные ими токены
+type KeySet struct {
+	Active Key
+	Keys   []Key
+}
+
+// Загружает все *.pem ключи из директории, kid - имя файла без расширения.
+// Для ротации достаточно положить новый ключ и сменить activeID,
+// старый ключ удаляется после истечения access_ttl
+func MustLoad(dir, activeID string) *KeySet {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		log.Fatalf("failed to list signing keys: %v", err)
+	}
+	sort.Strings(files)
+
+	set := &KeySet{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("failed to read signing key %s: %v", file, err)
+		}
+
+		id := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		key, err := ParseKey(id, data)
+		if err != nil {
+			log.Fatalf("failed to parse signing key %s: %v", file, err)
+		}
+
+		set.Keys = append(set.Keys, key)
+		if id == activeID {
+			set.Active = key
+		}
+	}
+
+	if set.Active.Private == nil {
+		log.Fatalf("active signing key %q not found in %s", activeID, dir)
+	}
+	return set
+}
+
+// Создает набор из одного Ed25519 ключа, используется если ключи не настроены.
+// Токены становятся недействительными после перезапуска
+func Generate() (*KeySet, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	key := Key{ID: uuid.NewString(), Method: jwt.SigningMethodEdDSA, Private: private}
+	return &KeySet{Active: key, Keys: []Key{key}}, nil
+}
+
+// Разбирает приватный ключ в PEM: RSA (PKCS#1, PKCS#8) подписывает RS256, Ed25519 (PKCS#8) - EdDSA
+func ParseKey(id string, data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, errors.New("no PEM data found")
+	}
+
+	var private any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return Key{}, fmt.Errorf("%w: %s", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch private := private.(type) {
+	case *rsa.PrivateKey:
+		return Key{ID: id, Method: jwt.SigningMethodRS256, Private: private}, nil
+	case ed25519.PrivateKey:
+		return Key{ID: id, Method: jwt.SigningMethodEdDSA, Private: private}, nil
+	default:
+		return Key{}, ErrUnsupportedKey
+	}
+}
+
+func (s *KeySet) Public() []JWK {
+	keys := make([]JWK, 0, len(s.Keys))
+	for _, key := range s.Keys {
+		keys = append(keys, key.Public())
+	}
+	return keys
+}
+
+func (k Key) Public() JWK {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+
+	switch public := k.Private.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encode(public.N.Bytes())
+		jwk.E = encode(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encode(public)
+	}
+	return jwk
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,3 @@
+-- Существующие пользователи считаются подтвержденными
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP;
+UPDATE users SET email_verified_at = NOW();
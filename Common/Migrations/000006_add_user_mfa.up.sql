@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS user_mfa (
+ user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+ secret TEXT NOT NULL,
+ -- sha256-хеши неиспользованных кодов восстановления
+ recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+ created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ -- NULL, пока пользователь не подтвердил подключение кодом из приложения
+ confirmed_at TIMESTAMP
+);
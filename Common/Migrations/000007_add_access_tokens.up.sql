@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS access_tokens (
+ token_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ name TEXT NOT NULL,
+ -- sha256-хеш токена, сам токен показывается только при создании
+ token_hash BYTEA NOT NULL UNIQUE,
+ scopes TEXT[] NOT NULL,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ expires_at TIMESTAMP NOT NULL,
+ last_used_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS access_tokens_user_id_idx ON access_tokens(user_id);
@@ -0,0 +1,2 @@
+-- Отключенные пользователи не могут войти и обновить токены
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP;
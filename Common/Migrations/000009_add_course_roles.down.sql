@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS course_permissions;
+
+ALTER TABLE enrollments DROP COLUMN IF EXISTS role;
@@ -0,0 +1,41 @@
+-- Роль записанного пользователя: student или auditor
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'student';
+
+-- Наборы прав ролей курса, права владельца не хранятся
+CREATE TABLE IF NOT EXISTS course_permissions (
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ role TEXT NOT NULL,
+ permission TEXT NOT NULL,
+ PRIMARY KEY (course_id, role, permission)
+);
+
+INSERT INTO course_permissions (course_id, role, permission)
+SELECT c.course_id, p.role, p.permission
+FROM courses c
+CROSS JOIN (VALUES
+ ('co_teacher', 'course.read'),
+ ('co_teacher', 'course.update'),
+ ('co_teacher', 'members.read'),
+ ('co_teacher', 'members.manage'),
+ ('co_teacher', 'lessons.read'),
+ ('co_teacher', 'lessons.write'),
+ ('co_teacher', 'lessons.delete'),
+ ('co_teacher', 'tasks.read'),
+ ('co_teacher', 'tasks.write'),
+ ('co_teacher', 'tasks.delete'),
+ ('co_teacher', 'tasks.grade'),
+ ('assistant', 'course.read'),
+ ('assistant', 'members.read'),
+ ('assistant', 'lessons.read'),
+ ('assistant', 'tasks.read'),
+ ('assistant', 'tasks.grade'),
+ ('student', 'course.read'),
+ ('student', 'members.read'),
+ ('student', 'lessons.read'),
+ ('student', 'tasks.read'),
+ ('student', 'tasks.submit'),
+ ('auditor', 'course.read'),
+ ('auditor', 'lessons.read'),
+ ('auditor', 'tasks.read')
+) AS p(role, permission)
+ON CONFLICT DO NOTHING;
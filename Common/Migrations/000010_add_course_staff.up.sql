@@ -0,0 +1,10 @@
+-- Преподаватели курса помимо владельца: co_teacher или assistant
+CREATE TABLE IF NOT EXISTS course_staff (
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ role TEXT NOT NULL CHECK (role IN ('co_teacher', 'assistant')),
+ added_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ PRIMARY KEY (course_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS course_staff_user_id_idx ON course_staff(user_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS course_invites (
+ invite_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ code TEXT NOT NULL UNIQUE,
+ -- Роль, с которой пользователь записывается по коду: student или auditor
+ role TEXT NOT NULL DEFAULT 'student',
+ created_by UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ expires_at TIMESTAMP,
+ max_uses INTEGER,
+ uses INTEGER NOT NULL DEFAULT 0,
+ revoked_at TIMESTAMP,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS course_invites_course_id_idx ON course_invites(course_id);
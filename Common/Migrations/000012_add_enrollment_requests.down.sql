@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS enrollment_requests;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS capacity;
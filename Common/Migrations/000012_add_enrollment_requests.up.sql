@@ -0,0 +1,17 @@
+-- NULL означает курс без ограничения мест
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS capacity INTEGER CHECK (capacity > 0);
+
+CREATE TABLE IF NOT EXISTS enrollment_requests (
+ request_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'waitlisted')),
+ decided_by UUID REFERENCES users(user_id) ON DELETE SET NULL,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- У пользователя может быть только одна незакрытая заявка на курс
+CREATE UNIQUE INDEX IF NOT EXISTS enrollment_requests_active_idx ON enrollment_requests(course_id, user_id)
+ WHERE status IN ('pending', 'waitlisted');
+CREATE INDEX IF NOT EXISTS enrollment_requests_course_status_idx ON enrollment_requests(course_id, status, updated_at);
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS task_course_states;
+DROP TABLE IF EXISTS lesson_course_states;
+
+ALTER TABLE task_submissions DROP CONSTRAINT IF EXISTS task_submissions_task_id_fkey;
+ALTER TABLE task_submissions ADD CONSTRAINT task_submissions_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(task_id);
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS tasks_course_id_fkey;
+ALTER TABLE tasks ADD CONSTRAINT tasks_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses(course_id);
+
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_course_id_fkey;
+ALTER TABLE lessons ADD CONSTRAINT lessons_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses(course_id);
+
+DROP INDEX IF EXISTS courses_deleted_at_idx;
+ALTER TABLE courses DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE courses DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,28 @@
+-- Архивный курс доступен только для чтения, удалённый скрыт и окончательно удаляется после срока хранения
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS courses_deleted_at_idx ON courses(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- При окончательном удалении курса уроки, задания и их статусы удаляются вместе с ним
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_course_id_fkey;
+ALTER TABLE lessons ADD CONSTRAINT lessons_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses(course_id) ON DELETE CASCADE;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS tasks_course_id_fkey;
+ALTER TABLE tasks ADD CONSTRAINT tasks_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses(course_id) ON DELETE CASCADE;
+
+ALTER TABLE task_submissions DROP CONSTRAINT IF EXISTS task_submissions_task_id_fkey;
+ALTER TABLE task_submissions ADD CONSTRAINT task_submissions_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(task_id) ON DELETE CASCADE;
+
+-- Состояние курсов, которое Lessons и Tasks получают из событий course.archived, course.deleted и course.restored
+CREATE TABLE IF NOT EXISTS lesson_course_states (
+ course_id UUID PRIMARY KEY REFERENCES courses(course_id) ON DELETE CASCADE,
+ archived_at TIMESTAMP,
+ deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS task_course_states (
+ course_id UUID PRIMARY KEY REFERENCES courses(course_id) ON DELETE CASCADE,
+ archived_at TIMESTAMP,
+ deleted_at TIMESTAMP
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS course_clone_jobs;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS is_template;
@@ -0,0 +1,19 @@
+-- На шаблон курса нельзя записаться, из него создаются курсы через CloneCourse
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS is_template BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Фоновые задачи копирования уроков и заданий в новый курс
+CREATE TABLE IF NOT EXISTS course_clone_jobs (
+ job_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ source_course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ target_course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+ lessons_copied INTEGER,
+ tasks_copied INTEGER,
+ error TEXT,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS course_clone_jobs_active_idx ON course_clone_jobs(created_at)
+ WHERE status IN ('pending', 'running');
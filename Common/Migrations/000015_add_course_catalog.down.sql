@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS courses_search_idx;
+DROP INDEX IF EXISTS courses_catalog_idx;
+DROP INDEX IF EXISTS courses_category_idx;
+
+DROP TABLE IF EXISTS course_tags;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS category;
+ALTER TABLE courses DROP COLUMN IF EXISTS listed;
@@ -0,0 +1,19 @@
+-- Курс в каталоге видят все пользователи, а не только участники
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS listed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS category TEXT;
+
+CREATE TABLE IF NOT EXISTS course_tags (
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ tag TEXT NOT NULL,
+ PRIMARY KEY (course_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS course_tags_tag_idx ON course_tags(tag);
+CREATE INDEX IF NOT EXISTS courses_category_idx ON courses(category) WHERE listed;
+CREATE INDEX IF NOT EXISTS courses_catalog_idx ON courses(created_at DESC, course_id DESC) WHERE listed;
+
+-- Полнотекстовый поиск по названию и описанию. Выражение должно совпадать с поиском в репозитории Courses,
+-- иначе индекс не используется. Конфигурация russian приводит английские слова к основе тоже
+CREATE INDEX IF NOT EXISTS courses_search_idx ON courses USING GIN (
+ (setweight(to_tsvector('russian', title), 'A') || setweight(to_tsvector('russian', description), 'B'))
+);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS tasks_module_id_idx;
+DROP INDEX IF EXISTS lessons_module_id_idx;
+DROP INDEX IF EXISTS tasks_course_position_idx;
+DROP INDEX IF EXISTS lessons_course_position_idx;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS position;
+ALTER TABLE tasks DROP COLUMN IF EXISTS module_id;
+ALTER TABLE lessons DROP COLUMN IF EXISTS position;
+ALTER TABLE lessons DROP COLUMN IF EXISTS module_id;
+
+DROP TABLE IF EXISTS course_modules;
@@ -0,0 +1,31 @@
+-- Модули (разделы) курса, порядок задаётся position
+CREATE TABLE IF NOT EXISTS course_modules (
+ module_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ title TEXT NOT NULL,
+ position INTEGER NOT NULL,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS course_modules_course_id_idx ON course_modules(course_id, position);
+
+-- Уроки и задания без модуля показываются после всех модулей. position - порядок
+-- в курсе, внутри модуля уроки и задания идут в том же порядке
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS module_id UUID REFERENCES course_modules(module_id) ON DELETE SET NULL;
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS module_id UUID REFERENCES course_modules(module_id) ON DELETE SET NULL;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+
+-- Существующие уроки и задания выстраиваются в порядке создания
+UPDATE lessons l SET position = o.position
+FROM (SELECT lesson_id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY created_at, lesson_id) - 1 AS position FROM lessons) o
+WHERE l.lesson_id = o.lesson_id;
+
+UPDATE tasks t SET position = o.position
+FROM (SELECT task_id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY created_at, task_id) - 1 AS position FROM tasks) o
+WHERE t.task_id = o.task_id;
+
+CREATE INDEX IF NOT EXISTS lessons_course_position_idx ON lessons(course_id, position);
+CREATE INDEX IF NOT EXISTS tasks_course_position_idx ON tasks(course_id, position);
+CREATE INDEX IF NOT EXISTS lessons_module_id_idx ON lessons(module_id);
+CREATE INDEX IF NOT EXISTS tasks_module_id_idx ON tasks(module_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS course_ownership_transfers (
+ transfer_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ course_id UUID NOT NULL REFERENCES courses(course_id) ON DELETE CASCADE,
+ from_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ to_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+ status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+ forced BOOLEAN NOT NULL DEFAULT FALSE,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ decided_at TIMESTAMP
+);
+
+-- У курса может быть только одна ожидающая подтверждения передача
+CREATE UNIQUE INDEX IF NOT EXISTS course_ownership_transfers_pending_idx ON course_ownership_transfers(course_id)
+ WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS course_ownership_transfers_to_user_idx ON course_ownership_transfers(to_user_id)
+ WHERE status = 'pending';
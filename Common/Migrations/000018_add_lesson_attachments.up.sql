@@ -0,0 +1,13 @@
+-- Файлы уроков. Содержимое лежит в файловом хранилище Lessons по ключу storage_key
+CREATE TABLE IF NOT EXISTS attachments (
+ attachment_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ lesson_id UUID NOT NULL REFERENCES lessons(lesson_id) ON DELETE CASCADE,
+ file_name TEXT NOT NULL,
+ content_type TEXT NOT NULL,
+ size BIGINT NOT NULL CHECK (size >= 0),
+ storage_key TEXT NOT NULL UNIQUE,
+ uploaded_by UUID NOT NULL,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS attachments_lesson_id_idx ON attachments(lesson_id, created_at);
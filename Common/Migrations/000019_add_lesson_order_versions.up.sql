@@ -0,0 +1,6 @@
+-- Версия порядка уроков курса. Растёт при создании, удалении, копировании и
+-- перестановке уроков, по ней ReorderLessons находит одновременные изменения
+CREATE TABLE IF NOT EXISTS lesson_order_versions (
+ course_id UUID PRIMARY KEY REFERENCES courses(course_id) ON DELETE CASCADE,
+ version BIGINT NOT NULL DEFAULT 0
+);
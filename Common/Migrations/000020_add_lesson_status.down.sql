@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS lessons_scheduled_publish_at_idx;
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_publish_at_check;
+ALTER TABLE lessons DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE lessons DROP COLUMN IF EXISTS status;
@@ -0,0 +1,11 @@
+-- Состояние публикации урока. Уже созданные уроки считаются опубликованными в момент создания.
+-- У запланированного урока publish_at - время публикации, у опубликованного - когда он был опубликован
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'published'
+ CHECK (status IN ('draft', 'scheduled', 'published'));
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;
+UPDATE lessons SET publish_at = created_at WHERE publish_at IS NULL AND status = 'published';
+ALTER TABLE lessons ALTER COLUMN status SET DEFAULT 'draft';
+ALTER TABLE lessons ADD CONSTRAINT lessons_publish_at_check CHECK ((status = 'draft') = (publish_at IS NULL));
+
+-- Планировщик ищет запланированные уроки, время публикации которых наступило
+CREATE INDEX IF NOT EXISTS lessons_scheduled_publish_at_idx ON lessons(publish_at) WHERE status = 'scheduled';
@@ -0,0 +1,18 @@
+-- История изменений уроков. Каждая ревизия - снимок названия и содержания урока после
+-- создания, изменения или восстановления. Последняя ревизия совпадает с текущим уроком
+CREATE TABLE IF NOT EXISTS lesson_revisions (
+ revision_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+ lesson_id UUID NOT NULL REFERENCES lessons(lesson_id) ON DELETE CASCADE,
+ revision INT NOT NULL CHECK (revision > 0),
+ title TEXT NOT NULL,
+ content TEXT NOT NULL,
+ author_id UUID,
+ restored_from INT,
+ created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ UNIQUE (lesson_id, revision)
+);
+
+-- Уже созданные уроки получают первую ревизию без автора
+INSERT INTO lesson_revisions (lesson_id, revision, title, content, created_at)
+SELECT lesson_id, 1, title, content, created_at FROM lessons
+ON CONFLICT (lesson_id, revision) DO NOTHING;
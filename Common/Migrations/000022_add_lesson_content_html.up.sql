@@ -0,0 +1,3 @@
+-- HTML, отрисованный из Markdown содержания урока и очищенный по списку разрешённых тегов.
+-- NULL - урок ещё не отрисован, такие уроки отрисовывает Lessons при запуске
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS content_html TEXT;
@@ -0,0 +1 @@
+ALTER TABLE course_clone_jobs DROP COLUMN IF EXISTS shift_days;
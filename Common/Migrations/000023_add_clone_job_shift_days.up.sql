@@ -0,0 +1,2 @@
+-- Сдвиг дат копии курса в днях, Lessons сдвигает на него время публикации запланированных уроков
+ALTER TABLE course_clone_jobs ADD COLUMN IF NOT EXISTS shift_days INTEGER NOT NULL DEFAULT 0;
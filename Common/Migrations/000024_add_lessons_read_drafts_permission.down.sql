@@ -0,0 +1 @@
+DELETE FROM course_permissions WHERE permission = 'lessons.read_drafts';
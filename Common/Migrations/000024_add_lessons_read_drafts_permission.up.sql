@@ -0,0 +1,6 @@
+-- Черновики и запланированные уроки видят роли с lessons.read_drafts, раньше их видели все сотрудники курса
+INSERT INTO course_permissions (course_id, role, permission)
+SELECT c.course_id, p.role, 'lessons.read_drafts'
+FROM courses c
+CROSS JOIN (VALUES ('co_teacher'), ('assistant')) AS p(role)
+ON CONFLICT DO NOTHING;
@@ -2,6 +2,7 @@ package main
 
 import (
 	"Classroom/Courses/internal/config"
+	"Classroom/Courses/internal/content"
 	"Classroom/Courses/internal/producer"
 	"Classroom/Courses/internal/repo"
 	"Classroom/Courses/internal/service"
@@ -15,6 +16,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -30,8 +32,12 @@ func main() {
 	producer := producer.MustNewProducer([]string{conf.KafkaBroker})
 	defer producer.Close()
 
+	contentClient := content.MustNewClient(conf.LessonsAddress, conf.LessonsPort, conf.TasksAddress, conf.TasksPort)
+	defer contentClient.Close()
+
 	courseRepo := repo.NewCoursesRepo(postgres)
-	courseService := service.NewCoursesService(logger, courseRepo, producer)
+	courseService := service.NewCoursesService(logger, courseRepo, producer, conf.DeletedRetention)
+	cloneWorker := service.NewCloneWorker(logger, courseRepo, producer, contentClient, conf.DeletedRetention)
 
 	server := grpc.NewServer()
 	pb.RegisterCoursesServiceServer(server, courseService)
@@ -41,6 +47,8 @@ func main() {
 
 	logger.Info("starting grpc server", "port", conf.Port)
 	go startServer(server, conf.Port)
+	go startPurge(ctx, logger, courseService, conf.PurgeInterval)
+	go startCloneWorker(ctx, logger, cloneWorker, conf.ClonePollInterval)
 
 	<-ctx.Done()
 
@@ -58,6 +66,40 @@ func startServer(server *grpc.Server, port int) {
 	}
 }
 
+// Окончательно удаляет курсы с истёкшим сроком хранения, пока не отменён контекст
+func startPurge(ctx context.Context, logger *slog.Logger, courseService *service.CoursesService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := courseService.PurgeDeletedCourses(ctx); err != nil {
+			logger.Error("failed to purge deleted courses", "error", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Выполняет задачи копирования курсов, пока не отменён контекст
+func startCloneWorker(ctx context.Context, logger *slog.Logger, cloneWorker *service.CloneWorker, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := cloneWorker.ProcessCloneJobs(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("failed to process clone jobs", "error", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func init() {
 	godotenv.Load()
 }
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -12,8 +13,25 @@ type Config struct {
 	Port        int    `mapstructure:"port"`
 	PostgresURL string `mapstructure:"postgres_url"`
 	KafkaBroker string `mapstructure:"kafka_broker"`
+	// Сколько удалённый курс можно восстановить до окончательного удаления
+	DeletedRetention time.Duration `mapstructure:"deleted_retention"`
+	// Как часто удаляются курсы с истёкшим сроком хранения
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+	// Lessons и Tasks, в которые ходит копирование курса
+	LessonsAddress string `mapstructure:"lessons_address"`
+	LessonsPort    int    `mapstructure:"lessons_port"`
+	TasksAddress   string `mapstructure:"tasks_address"`
+	TasksPort      int    `mapstructure:"tasks_port"`
+	// Как часто проверяются задачи копирования курса
+	ClonePollInterval time.Duration `mapstructure:"clone_poll_interval"`
 }
 
+const (
+	DefaultDeletedRetention  = 30 * 24 * time.Hour
+	DefaultPurgeInterval     = time.Hour
+	DefaultClonePollInterval = 5 * time.Second
+)
+
 func MustNew() *Config {
 	configPath := flag.String("config", "./config/config.yaml", "path to config file")
 	flag.Parse()
@@ -25,6 +43,16 @@ func MustNew() *Config {
 	v.BindEnv("postgres_url")
 	v.BindEnv("kafka_broker")
 	v.BindEnv("port")
+	v.BindEnv("deleted_retention")
+	v.BindEnv("purge_interval")
+	v.BindEnv("lessons_address")
+	v.BindEnv("lessons_port")
+	v.BindEnv("tasks_address")
+	v.BindEnv("tasks_port")
+	v.BindEnv("clone_poll_interval")
+	v.SetDefault("deleted_retention", DefaultDeletedRetention)
+	v.SetDefault("purge_interval", DefaultPurgeInterval)
+	v.SetDefault("clone_poll_interval", DefaultClonePollInterval)
 
 	v.SetConfigFile(*configPath)
 
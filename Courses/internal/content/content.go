@@ -0,0 +1,70 @@
+package content
+
+import (
+	lessonspb "Classroom/Courses/pkg/api/lessons"
+	taskspb "Classroom/Courses/pkg/api/tasks"
+	"context"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Клиент Lessons и Tasks для копирования уроков и заданий курса
+type contentClient struct {
+	lessonsConn *grpc.ClientConn
+	tasksConn   *grpc.ClientConn
+	lessons     lessonspb.LessonsServiceClient
+	tasks       taskspb.TasksServiceClient
+}
+
+func MustNewClient(lessonsAddress string, lessonsPort int, tasksAddress string, tasksPort int) *contentClient {
+	lessonsConn := mustDial(lessonsAddress, lessonsPort)
+	tasksConn := mustDial(tasksAddress, tasksPort)
+	return &contentClient{
+		lessonsConn: lessonsConn,
+		tasksConn:   tasksConn,
+		lessons:     lessonspb.NewLessonsServiceClient(lessonsConn),
+		tasks:       taskspb.NewTasksServiceClient(tasksConn),
+	}
+}
+
+func mustDial(address string, port int) *grpc.ClientConn {
+	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", address, port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to dial %s:%d: %v", address, port, err)
+	}
+	return conn
+}
+
+func (c *contentClient) Close() error {
+	lessonsErr := c.lessonsConn.Close()
+	if err := c.tasksConn.Close(); err != nil {
+		return err
+	}
+	return lessonsErr
+}
+
+func (c *contentClient) CloneLessons(ctx context.Context, sourceCourseID, targetCourseID string, shiftDays int32) (int32, error) {
+	resp, err := c.lessons.CloneFromCourse(ctx, &lessonspb.CloneFromCourseRequest{
+		SourceCourseId: sourceCourseID,
+		TargetCourseId: targetCourseID,
+		ShiftDays:      shiftDays,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Copied, nil
+}
+
+func (c *contentClient) CloneTasks(ctx context.Context, sourceCourseID, targetCourseID string) (int32, error) {
+	resp, err := c.tasks.CloneFromCourse(ctx, &taskspb.CloneFromCourseRequest{
+		SourceCourseId: sourceCourseID,
+		TargetCourseId: targetCourseID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Copied, nil
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Позиция последнего курса страницы каталога, следующая страница начинается после неё
+type CatalogCursor struct {
+	Rank      float32   `json:"r,omitempty"` // Релевантность, только при поиске по тексту
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// Страница каталога курсов
+type CatalogPage struct {
+	Courses []Course
+	Next    *CatalogCursor // nil, если страниц больше нет
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Состояние задачи копирования курса
+type CloneStatus string
+
+const (
+	ClonePending   CloneStatus = "pending"   // Курс создан, уроки и задания ещё не копировались
+	CloneRunning   CloneStatus = "running"   // Уроки и задания копируются
+	CloneCompleted CloneStatus = "completed" // Курс скопирован полностью
+	CloneFailed    CloneStatus = "failed"    // Копирование не удалось, новый курс удалён
+)
+
+// Задача копирования уроков и заданий в курс, созданный через CloneCourse
+type CloneJob struct {
+	ID             string
+	SourceCourseID string
+	TargetCourseID string
+	UserID         string
+	ShiftDays      int32 // На сколько дней сдвигаются даты копии
+	Status         CloneStatus
+	LessonsCopied  *int32 // nil - уроки ещё не копировались
+	TasksCopied    *int32 // nil - задания ещё не копировались
+	Error          *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -10,5 +10,20 @@ type Course struct {
 	Visibility  bool
 	StartTime   *time.Time
 	EndTime     *time.Time
+	Capacity    *int32 // Максимум записанных пользователей, nil - без ограничения
 	CreatedAt   time.Time
+	ArchivedAt  *time.Time // Архивный курс доступен только для чтения
+	DeletedAt   *time.Time // Удалённый курс скрыт и окончательно удаляется после срока хранения
+	IsTemplate  bool       // На шаблон нельзя записаться, из него создаются курсы копированием
+	Listed      bool       // Курс показывается в каталоге
+	Category    *string
+	Tags        []string // В нижнем регистре, отсортированы
+}
+
+func (c Course) Archived() bool {
+	return c.ArchivedAt != nil
+}
+
+func (c Course) Deleted() bool {
+	return c.DeletedAt != nil
 }
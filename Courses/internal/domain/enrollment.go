@@ -5,5 +5,6 @@ import "time"
 type Enrollment struct {
 	StudentID  string
 	CourseID   string
+	Role       Role
 	EnrolledAt time.Time
 }
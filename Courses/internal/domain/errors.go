@@ -3,5 +3,8 @@ package domain
 import "errors"
 
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound     = errors.New("not found")
+	ErrUserNotFound = errors.New("user not found")
+	ErrConflict     = errors.New("conflict")
+	ErrCourseFull   = errors.New("course is full")
 )
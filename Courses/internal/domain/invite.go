@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Код приглашения для самостоятельной записи на курс
+type Invite struct {
+	ID        string
+	CourseID  string
+	Code      string
+	Role      Role
+	CreatedBy string
+	ExpiresAt *time.Time
+	MaxUses   *int32
+	Uses      int32
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (i Invite) Expired(now time.Time) bool {
+	return i.ExpiresAt != nil && !i.ExpiresAt.After(now)
+}
+
+func (i Invite) Exhausted() bool {
+	return i.MaxUses != nil && i.Uses >= *i.MaxUses
+}
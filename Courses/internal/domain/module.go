@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// Модуль (раздел) курса, объединяет уроки и задания
+type Module struct {
+	ID        string
+	CourseID  string
+	Title     string
+	Position  int32 // Позиция в курсе, начиная с 0
+	CreatedAt time.Time
+}
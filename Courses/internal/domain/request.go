@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Состояние заявки на запись
+type RequestStatus string
+
+const (
+	RequestPending    RequestStatus = "pending"    // Ждёт решения преподавателя
+	RequestApproved   RequestStatus = "approved"   // Одобрена, пользователь записан
+	RequestRejected   RequestStatus = "rejected"   // Отклонена
+	RequestWaitlisted RequestStatus = "waitlisted" // Одобрена, но мест нет, ждёт освобождения
+)
+
+var RequestStatuses = []RequestStatus{RequestPending, RequestApproved, RequestRejected, RequestWaitlisted}
+
+// Заявку можно одобрить или отклонить, пока она не закрыта
+func (s RequestStatus) Open() bool {
+	return s == RequestPending || s == RequestWaitlisted
+}
+
+// Заявка пользователя на запись на курс
+type EnrollmentRequest struct {
+	ID        string
+	CourseID  string
+	UserID    string
+	Email     string
+	FirstName string
+	LastName  string
+	Status    RequestStatus
+	DecidedBy *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
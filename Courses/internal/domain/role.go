@@ -0,0 +1,126 @@
+package domain
+
+import "slices"
+
+// Роль пользователя в курсе
+type Role string
+
+const (
+	RoleOwner     Role = "owner"      // Владелец курса, всегда имеет все права
+	RoleCoTeacher Role = "co_teacher" // Соавтор курса
+	RoleAssistant Role = "assistant"  // Ассистент преподавателя
+	RoleStudent   Role = "student"    // Студент
+	RoleAuditor   Role = "auditor"    // Слушатель, только просматривает материалы
+)
+
+// Роли, которые можно выдать через AddStaff
+var StaffRoles = []Role{RoleCoTeacher, RoleAssistant}
+
+func (r Role) Staff() bool {
+	return slices.Contains(StaffRoles, r)
+}
+
+// Роли, наборы прав которых можно менять
+var ConfigurableRoles = []Role{RoleCoTeacher, RoleAssistant, RoleStudent, RoleAuditor}
+
+func (r Role) Configurable() bool {
+	return slices.Contains(ConfigurableRoles, r)
+}
+
+// Право на действие в курсе
+type Permission string
+
+const (
+	PermissionCourseRead    Permission = "course.read"
+	PermissionCourseUpdate  Permission = "course.update"
+	PermissionCourseDelete  Permission = "course.delete"
+	PermissionMembersRead   Permission = "members.read"
+	PermissionMembersManage Permission = "members.manage"
+	PermissionRolesManage   Permission = "roles.manage"
+	PermissionLessonsRead   Permission = "lessons.read"
+	PermissionLessonsDrafts Permission = "lessons.read_drafts" // Просмотр черновиков и запланированных уроков
+	PermissionLessonsWrite  Permission = "lessons.write"
+	PermissionLessonsDelete Permission = "lessons.delete"
+	PermissionTasksRead     Permission = "tasks.read"
+	PermissionTasksWrite    Permission = "tasks.write"
+	PermissionTasksDelete   Permission = "tasks.delete"
+	PermissionTasksSubmit   Permission = "tasks.submit"
+	PermissionTasksGrade    Permission = "tasks.grade"
+)
+
+// Все права, которые есть у владельца курса
+var AllPermissions = []Permission{
+	PermissionCourseRead,
+	PermissionCourseUpdate,
+	PermissionCourseDelete,
+	PermissionMembersRead,
+	PermissionMembersManage,
+	PermissionRolesManage,
+	PermissionLessonsRead,
+	PermissionLessonsDrafts,
+	PermissionLessonsWrite,
+	PermissionLessonsDelete,
+	PermissionTasksRead,
+	PermissionTasksWrite,
+	PermissionTasksDelete,
+	PermissionTasksSubmit,
+	PermissionTasksGrade,
+}
+
+func (p Permission) Valid() bool {
+	return slices.Contains(AllPermissions, p)
+}
+
+// Права, которые остаются в архивном курсе. Удаление оставлено, чтобы архивный курс можно было удалить
+var ArchivedPermissions = []Permission{
+	PermissionCourseRead,
+	PermissionCourseDelete,
+	PermissionMembersRead,
+	PermissionLessonsRead,
+	PermissionLessonsDrafts,
+	PermissionTasksRead,
+}
+
+// Наборы прав, которые получает новый курс. Права владельца не хранятся и не меняются
+var DefaultPermissions = map[Role][]Permission{
+	RoleCoTeacher: {
+		PermissionCourseRead,
+		PermissionCourseUpdate,
+		PermissionMembersRead,
+		PermissionMembersManage,
+		PermissionLessonsRead,
+		PermissionLessonsDrafts,
+		PermissionLessonsWrite,
+		PermissionLessonsDelete,
+		PermissionTasksRead,
+		PermissionTasksWrite,
+		PermissionTasksDelete,
+		PermissionTasksGrade,
+	},
+	RoleAssistant: {
+		PermissionCourseRead,
+		PermissionMembersRead,
+		PermissionLessonsRead,
+		PermissionLessonsDrafts,
+		PermissionTasksRead,
+		PermissionTasksGrade,
+	},
+	RoleStudent: {
+		PermissionCourseRead,
+		PermissionMembersRead,
+		PermissionLessonsRead,
+		PermissionTasksRead,
+		PermissionTasksSubmit,
+	},
+	RoleAuditor: {
+		PermissionCourseRead,
+		PermissionLessonsRead,
+		PermissionTasksRead,
+	},
+}
+
+// Набор прав роли в курсе
+type RolePermissions struct {
+	Role        Role
+	Permissions []Permission
+}
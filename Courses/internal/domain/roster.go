@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Запись ведомости курса для выгрузки
+type RosterEntry struct {
+	UserID     string
+	Email      string
+	FirstName  string
+	LastName   string
+	Role       Role
+	EnrolledAt time.Time
+}
+
+// Результат строки массовой записи
+type BulkEnrollStatus string
+
+const (
+	BulkEnrolled      BulkEnrollStatus = "enrolled"       // Пользователь записан
+	BulkAlreadyMember BulkEnrollStatus = "already_member" // Уже студент или преподаватель курса
+	BulkInvited       BulkEnrollStatus = "invited"        // Пользователя нет, на почту отправлен код приглашения
+	BulkNotFound      BulkEnrollStatus = "not_found"      // Пользователя нет, приглашение не запрошено
+	BulkInvalidEmail  BulkEnrollStatus = "invalid_email"  // Строка не похожа на почту
+	BulkDuplicate     BulkEnrollStatus = "duplicate"      // Почта уже встречалась выше в списке
+	BulkCourseFull    BulkEnrollStatus = "course_full"    // На курсе не осталось мест
+	BulkFailed        BulkEnrollStatus = "failed"         // Не удалось создать приглашение
+)
+
+type BulkEnrollResult struct {
+	Email      string
+	Status     BulkEnrollStatus
+	UserID     string
+	InviteCode string
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Преподаватель курса помимо владельца
+type Staff struct {
+	CourseID  string
+	UserID    string
+	Email     string
+	FirstName string
+	LastName  string
+	Role      Role
+	AddedAt   time.Time
+}
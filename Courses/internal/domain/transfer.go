@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// Состояние передачи курса
+type TransferStatus string
+
+const (
+	TransferPending   TransferStatus = "pending"   // Ждёт подтверждения нового владельца
+	TransferAccepted  TransferStatus = "accepted"  // Подтверждена, владелец сменился
+	TransferDeclined  TransferStatus = "declined"  // Новый владелец отказался
+	TransferCancelled TransferStatus = "cancelled" // Заменена новой передачей или владелец сменился иначе
+)
+
+// Передача курса другому владельцу
+type OwnershipTransfer struct {
+	ID          string
+	CourseID    string
+	CourseTitle string
+	FromUserID  string
+	ToUserID    string
+	Status      TransferStatus
+	// Передача выполнена суперпользователем без подтверждения
+	Forced    bool
+	CreatedAt time.Time
+	DecidedAt *time.Time
+}
@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"Classroom/Courses/internal/domain"
+	"time"
+)
 
 type CreateCourseDTO struct {
 	TeacherID   string `validate:"required,uuid"`
@@ -9,6 +12,11 @@ type CreateCourseDTO struct {
 	Visibility  bool
 	StartTime   *time.Time
 	EndTime     *time.Time
+	Capacity    *int32 `validate:"omitempty,min=1"`
+	IsTemplate  bool
+	Listed      bool
+	Category    *string
+	Tags        []string
 }
 
 type UpdateCourseDTO struct {
@@ -18,4 +26,29 @@ type UpdateCourseDTO struct {
 	Visibility  *bool
 	StartTime   *time.Time
 	EndTime     *time.Time
+	Capacity    *int32 `validate:"omitempty,min=0"`
+	IsTemplate  *bool
+	Listed      *bool
+	Category    *string   // Пустая строка убирает категорию
+	Tags        *[]string // nil - теги не меняются
+}
+
+type SearchCoursesDTO struct {
+	Query        string
+	TeacherID    *string `validate:"omitempty,uuid"`
+	Tag          *string
+	Category     *string
+	StartsAfter  *time.Time
+	StartsBefore *time.Time
+	Limit        int32 `validate:"min=1,max=100"`
+	After        *domain.CatalogCursor
+}
+
+type CreateInviteDTO struct {
+	CourseID  string `validate:"required,uuid"`
+	CreatedBy string `validate:"required,uuid"`
+	Code      string
+	Role      string `validate:"oneof=student auditor"`
+	ExpiresAt *time.Time
+	MaxUses   *int32 `validate:"omitempty,min=1"`
 }
@@ -38,6 +38,34 @@ func (p *kafkaProducer) PublishUserExpelled(event events.UserExpelled) error {
 	return p.publish(events.CourseExpelledTopic, event)
 }
 
+func (p *kafkaProducer) PublishEnrollmentRequestUpdated(event events.EnrollmentRequestUpdated) error {
+	return p.publish(events.CourseEnrollmentRequestUpdatedTopic, event)
+}
+
+func (p *kafkaProducer) PublishUserInvited(event events.UserInvited) error {
+	return p.publish(events.CourseInvitedTopic, event)
+}
+
+func (p *kafkaProducer) PublishCourseArchived(event events.CourseArchived) error {
+	return p.publish(events.CourseArchivedTopic, event)
+}
+
+func (p *kafkaProducer) PublishCourseDeleted(event events.CourseDeleted) error {
+	return p.publish(events.CourseDeletedTopic, event)
+}
+
+func (p *kafkaProducer) PublishCourseRestored(event events.CourseRestored) error {
+	return p.publish(events.CourseRestoredTopic, event)
+}
+
+func (p *kafkaProducer) PublishCoursePurged(event events.CoursePurged) error {
+	return p.publish(events.CoursePurgedTopic, event)
+}
+
+func (p *kafkaProducer) PublishCourseOwnershipTransferred(event events.CourseOwnershipTransferred) error {
+	return p.publish(events.CourseOwnershipTransferredTopic, event)
+}
+
 func (p *kafkaProducer) publish(topic string, msg any) error {
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Должно совпадать с выражением индекса courses_search_idx
+const searchVector = "(setweight(to_tsvector('russian', c.title), 'A') || setweight(to_tsvector('russian', c.description), 'B'))"
+
+// Курс каталога вместе с релевантностью поиска
+type CatalogCourse struct {
+	Course
+	Rank float32 `db:"rank"`
+}
+
+// Ищет опубликованные в каталоге курсы. Шаблоны, скрытые, архивные, удалённые и закончившиеся курсы не возвращаются.
+// При поиске по тексту курсы сортируются по релевантности, иначе сначала новые
+func (r *courseRepo) Search(ctx context.Context, dto dto.SearchCoursesDTO) (domain.CatalogPage, error) {
+	rank := "0::real"
+	if dto.Query != "" {
+		rank = fmt.Sprintf("ts_rank(%s, websearch_to_tsquery('russian', ?))", searchVector)
+	}
+
+	qb := r.qb.
+		Select("c.*", tagsColumn("c")).
+		From("courses c").
+		Where(sq.Eq{"c.listed": true, "c.visibility": true, "c.is_template": false, "c.archived_at": nil, "c.deleted_at": nil}).
+		Where(sq.Or{sq.Eq{"c.end_time": nil}, sq.Expr("c.end_time >= NOW()")})
+
+	if dto.Query != "" {
+		qb = qb.
+			Column(sq.Alias(sq.Expr(rank, dto.Query), "rank")).
+			Where(sq.Expr(searchVector+" @@ websearch_to_tsquery('russian', ?)", dto.Query))
+	} else {
+		qb = qb.Column(rank + " AS rank")
+	}
+	if dto.TeacherID != nil {
+		qb = qb.Where(sq.Or{
+			sq.Eq{"c.teacher_id": *dto.TeacherID},
+			sq.Expr("EXISTS (SELECT 1 FROM course_staff s WHERE s.course_id = c.course_id AND s.user_id = ?)", *dto.TeacherID),
+		})
+	}
+	if dto.Tag != nil {
+		qb = qb.Where(sq.Expr("EXISTS (SELECT 1 FROM course_tags t WHERE t.course_id = c.course_id AND t.tag = ?)", *dto.Tag))
+	}
+	if dto.Category != nil {
+		qb = qb.Where(sq.Eq{"c.category": *dto.Category})
+	}
+	if dto.StartsAfter != nil {
+		qb = qb.Where(sq.GtOrEq{"c.start_time": *dto.StartsAfter})
+	}
+	if dto.StartsBefore != nil {
+		qb = qb.Where(sq.LtOrEq{"c.start_time": *dto.StartsBefore})
+	}
+
+	if dto.Query != "" {
+		if dto.After != nil {
+			qb = qb.Where(sq.Expr(fmt.Sprintf("(%s, c.created_at, c.course_id) < (?::real, ?, ?::uuid)", rank), dto.Query, dto.After.Rank, dto.After.CreatedAt, dto.After.ID))
+		}
+		qb = qb.OrderBy("rank DESC", "c.created_at DESC", "c.course_id DESC")
+	} else {
+		if dto.After != nil {
+			qb = qb.Where(sq.Expr("(c.created_at, c.course_id) < (?, ?::uuid)", dto.After.CreatedAt, dto.After.ID))
+		}
+		qb = qb.OrderBy("c.created_at DESC", "c.course_id DESC")
+	}
+
+	// Лишняя строка показывает, что есть следующая страница
+	query, args := qb.Limit(uint64(dto.Limit) + 1).MustSql()
+
+	var courses []CatalogCourse
+	if err := r.storage.SelectContext(ctx, &courses, query, args...); err != nil {
+		return domain.CatalogPage{}, fmt.Errorf("failed to search courses: %v", err)
+	}
+
+	var page domain.CatalogPage
+	if len(courses) > int(dto.Limit) {
+		courses = courses[:dto.Limit]
+		last := courses[len(courses)-1]
+		page.Next = &domain.CatalogCursor{Rank: last.Rank, CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	page.Courses = make([]domain.Course, 0, len(courses))
+	for _, course := range courses {
+		page.Courses = append(page.Courses, course.ToDomain())
+	}
+	return page, nil
+}
@@ -0,0 +1,146 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Создаёт курс по dto, копирует в него наборы прав ролей и модули исходного курса и ставит
+// задачу копирования уроков и заданий. Всё выполняется в одной транзакции
+func (r *courseRepo) CloneCourse(ctx context.Context, sourceCourseID string, shiftDays int32, dto dto.CreateCourseDTO) (domain.Course, domain.CloneJob, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Course{}, domain.CloneJob{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	course, err := r.insertCourse(ctx, tx, dto)
+	if err != nil {
+		return domain.Course{}, domain.CloneJob{}, err
+	}
+
+	query, args := r.qb.
+		Insert("course_permissions").
+		Columns("course_id", "role", "permission").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", course.ID).
+			Columns("role", "permission").
+			From("course_permissions").
+			Where(sq.Eq{"course_id": sourceCourseID})).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return domain.Course{}, domain.CloneJob{}, fmt.Errorf("failed to copy course permissions: %w", err)
+	}
+
+	// Уроки и задания попадают в модули копии по позиции модуля
+	query, args = r.qb.
+		Insert("course_modules").
+		Columns("course_id", "title", "position").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", course.ID).
+			Columns("title", "position").
+			From("course_modules").
+			Where(sq.Eq{"course_id": sourceCourseID})).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return domain.Course{}, domain.CloneJob{}, fmt.Errorf("failed to copy course modules: %w", err)
+	}
+
+	query, args = r.qb.
+		Insert("course_clone_jobs").
+		Columns("source_course_id", "target_course_id", "user_id", "shift_days").
+		Values(sourceCourseID, course.ID, dto.TeacherID, shiftDays).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var job CloneJob
+	if err := tx.GetContext(ctx, &job, query, args...); err != nil {
+		return domain.Course{}, domain.CloneJob{}, fmt.Errorf("failed to create clone job: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Course{}, domain.CloneJob{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return course.ToDomain(), job.ToDomain(), nil
+}
+
+func (r *courseRepo) GetCloneJob(ctx context.Context, jobID string) (domain.CloneJob, error) {
+	query, args := r.qb.
+		Select("*").
+		From("course_clone_jobs").
+		Where(sq.Eq{"job_id": jobID}).
+		MustSql()
+
+	var job CloneJob
+	err := r.storage.GetContext(ctx, &job, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.CloneJob{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.CloneJob{}, fmt.Errorf("failed to get clone job: %v", err)
+	}
+
+	return job.ToDomain(), nil
+}
+
+// Переводит самую старую ожидающую задачу в running. Задача в running, которая не
+// обновлялась с staleBefore, считается брошенной и тоже забирается. Несколько
+// экземпляров сервиса не заберут одну задачу благодаря SKIP LOCKED
+func (r *courseRepo) ClaimCloneJob(ctx context.Context, staleBefore time.Time) (domain.CloneJob, error) {
+	claimable := r.qb.
+		Select("job_id").
+		From("course_clone_jobs").
+		Where(sq.Or{
+			sq.Eq{"status": domain.ClonePending},
+			sq.And{sq.Eq{"status": domain.CloneRunning}, sq.Lt{"updated_at": staleBefore}},
+		}).
+		OrderBy("created_at").
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args := r.qb.
+		Update("course_clone_jobs").
+		Set("status", domain.CloneRunning).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(claimable.Prefix("job_id = (").Suffix(")")).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var job CloneJob
+	err := r.storage.GetContext(ctx, &job, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.CloneJob{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.CloneJob{}, fmt.Errorf("failed to claim clone job: %v", err)
+	}
+
+	return job.ToDomain(), nil
+}
+
+func (r *courseRepo) UpdateCloneJob(ctx context.Context, job domain.CloneJob) error {
+	query, args := r.qb.
+		Update("course_clone_jobs").
+		Set("status", job.Status).
+		Set("lessons_copied", job.LessonsCopied).
+		Set("tasks_copied", job.TasksCopied).
+		Set("error", job.Error).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"job_id": job.ID}).
+		MustSql()
+
+	if _, err := r.storage.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update clone job: %v", err)
+	}
+	return nil
+}
@@ -7,9 +7,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type courseRepo struct {
@@ -26,18 +28,57 @@ func NewCoursesRepo(db *sqlx.DB) *courseRepo {
 }
 
 func (r *courseRepo) Create(ctx context.Context, dto dto.CreateCourseDTO) (domain.Course, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Course{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	course, err := r.insertCourse(ctx, tx, dto)
+	if err != nil {
+		return domain.Course{}, err
+	}
+
+	// Курс сразу получает стандартные наборы прав ролей
+	insert := r.qb.Insert("course_permissions").Columns("course_id", "role", "permission")
+	for role, permissions := range domain.DefaultPermissions {
+		for _, permission := range permissions {
+			insert = insert.Values(course.ID, role, permission)
+		}
+	}
+	query, args := insert.MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return domain.Course{}, fmt.Errorf("failed to create course permissions: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Course{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return course.ToDomain(), nil
+}
+
+func (r *courseRepo) insertCourse(ctx context.Context, tx *sqlx.Tx, dto dto.CreateCourseDTO) (Course, error) {
 	m := make(map[string]any)
 	m["teacher_id"] = dto.TeacherID
 	m["title"] = dto.Title
 	m["description"] = dto.Description
 	m["visibility"] = dto.Visibility
+	m["is_template"] = dto.IsTemplate
+	m["listed"] = dto.Listed
 
+	if dto.Category != nil {
+		m["category"] = *dto.Category
+	}
 	if dto.StartTime != nil {
 		m["start_time"] = *dto.StartTime
 	}
 	if dto.EndTime != nil {
 		m["end_time"] = *dto.EndTime
 	}
+	if dto.Capacity != nil {
+		m["capacity"] = *dto.Capacity
+	}
 
 	query, args := r.qb.
 		Insert("courses").
@@ -45,20 +86,98 @@ func (r *courseRepo) Create(ctx context.Context, dto dto.CreateCourseDTO) (domai
 		Suffix("RETURNING *").
 		MustSql()
 
+	var course Course
+	if err := tx.GetContext(ctx, &course, query, args...); err != nil {
+		return Course{}, fmt.Errorf("failed to create course: %w", err)
+	}
+
+	if err := r.setTags(ctx, tx, course.ID, dto.Tags); err != nil {
+		return Course{}, err
+	}
+	course.Tags = dto.Tags
+	return course, nil
+}
+
+// Заменяет теги курса целиком
+func (r *courseRepo) setTags(ctx context.Context, tx *sqlx.Tx, courseID string, tags []string) error {
+	query, args := r.qb.
+		Delete("course_tags").
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete course tags: %w", err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	insert := r.qb.Insert("course_tags").Columns("course_id", "tag")
+	for _, tag := range tags {
+		insert = insert.Values(courseID, tag)
+	}
+	query, args = insert.MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create course tags: %w", err)
+	}
+	return nil
+}
+
+// Подзапрос тегов курса для выборки, table - имя или псевдоним таблицы courses в запросе
+func tagsColumn(table string) string {
+	return fmt.Sprintf("ARRAY(SELECT tag FROM course_tags t WHERE t.course_id = %s.course_id ORDER BY t.tag) AS tags", table)
+}
+
+// Помечает курс удалённым, окончательно курс удаляет Purge
+func (r *courseRepo) Delete(ctx context.Context, courseID string) (domain.Course, error) {
+	query, args := r.qb.
+		Update("courses").
+		Set("deleted_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"course_id": courseID, "deleted_at": nil}).
+		Suffix("RETURNING *, " + tagsColumn("courses")).
+		MustSql()
+
 	var course Course
 	err := r.storage.GetContext(ctx, &course, query, args...)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Course{}, domain.ErrNotFound
+	}
 	if err != nil {
-		return domain.Course{}, fmt.Errorf("failed to create course: %w", err)
+		return domain.Course{}, fmt.Errorf("failed to delete course: %w", err)
 	}
 
 	return course.ToDomain(), nil
 }
 
-func (r *courseRepo) Delete(ctx context.Context, courseID string) (domain.Course, error) {
+func (r *courseRepo) Archive(ctx context.Context, courseID string) (domain.Course, error) {
 	query, args := r.qb.
-		Delete("courses").
+		Update("courses").
+		Set("archived_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"course_id": courseID, "archived_at": nil, "deleted_at": nil}).
+		Suffix("RETURNING *, " + tagsColumn("courses")).
+		MustSql()
+
+	var course Course
+	err := r.storage.GetContext(ctx, &course, query, args...)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Course{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Course{}, fmt.Errorf("failed to archive course: %v", err)
+	}
+
+	return course.ToDomain(), nil
+}
+
+// Снимает и архивацию, и удаление
+func (r *courseRepo) Restore(ctx context.Context, courseID string) (domain.Course, error) {
+	query, args := r.qb.
+		Update("courses").
+		Set("archived_at", nil).
+		Set("deleted_at", nil).
 		Where(sq.Eq{"course_id": courseID}).
-		Suffix("RETURNING *").
+		Suffix("RETURNING *, " + tagsColumn("courses")).
 		MustSql()
 
 	var course Course
@@ -68,15 +187,31 @@ func (r *courseRepo) Delete(ctx context.Context, courseID string) (domain.Course
 		return domain.Course{}, domain.ErrNotFound
 	}
 	if err != nil {
-		return domain.Course{}, fmt.Errorf("failed to delete course: %w", err)
+		return domain.Course{}, fmt.Errorf("failed to restore course: %v", err)
 	}
 
 	return course.ToDomain(), nil
 }
 
+// Окончательно удаляет курсы, удалённые раньше before. Остальные данные курса удаляются каскадно
+func (r *courseRepo) Purge(ctx context.Context, before time.Time) ([]string, error) {
+	query, args := r.qb.
+		Delete("courses").
+		Where(sq.Lt{"deleted_at": before}).
+		Suffix("RETURNING course_id").
+		MustSql()
+
+	var ids []string
+	if err := r.storage.SelectContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to purge courses: %v", err)
+	}
+
+	return ids, nil
+}
+
 func (r *courseRepo) GetByID(ctx context.Context, courseID string) (domain.Course, error) {
 	query, args := r.qb.
-		Select("*").
+		Select("*", tagsColumn("courses")).
 		From("courses").
 		Where(sq.Eq{"course_id": courseID}).
 		MustSql()
@@ -94,18 +229,22 @@ func (r *courseRepo) GetByID(ctx context.Context, courseID string) (domain.Cours
 	return course.ToDomain(), nil
 }
 
-// Делает фильтрацию по visibility, start_time, end_time и teacher_id
-func (r *courseRepo) ListByStudentID(ctx context.Context, studentID string) ([]domain.Course, error) {
-	query, args := r.qb.
-		Select("c.course_id", "c.teacher_id", "c.title", "c.description", "c.visibility", "c.start_time", "c.end_time", "c.created_at").
+// Делает фильтрацию по visibility, start_time, end_time и teacher_id.
+// Шаблоны и удалённые курсы не возвращаются, архивные - только при includeArchived
+func (r *courseRepo) ListByStudentID(ctx context.Context, studentID string, includeArchived bool) ([]domain.Course, error) {
+	qb := r.qb.
+		Select("c.course_id", "c.teacher_id", "c.title", "c.description", "c.visibility", "c.start_time", "c.end_time", "c.created_at", "c.capacity", "c.archived_at", "c.deleted_at", "c.is_template", "c.listed", "c.category", tagsColumn("c")).
 		From("enrollments e").
 		Join("courses c ON e.course_id = c.course_id").
-		Where(sq.Eq{"e.student_id": studentID, "c.visibility": true}).
+		Where(sq.Eq{"e.student_id": studentID, "c.visibility": true, "c.deleted_at": nil, "c.is_template": false}).
 		Where(sq.Expr("c.teacher_id <> e.student_id")).
 		Where(sq.Or{sq.Expr("c.start_time IS NULL"), sq.Expr("c.start_time <= NOW()")}).
 		Where(sq.Or{sq.Expr("c.end_time IS NULL"), sq.Expr("c.end_time >= NOW()")}).
-		OrderBy("c.created_at DESC").
-		MustSql()
+		OrderBy("c.created_at DESC")
+	if !includeArchived {
+		qb = qb.Where(sq.Eq{"c.archived_at": nil})
+	}
+	query, args := qb.MustSql()
 
 	var courses []Course
 	err := r.storage.SelectContext(ctx, &courses, query, args...)
@@ -123,13 +262,22 @@ func (r *courseRepo) ListByStudentID(ctx context.Context, studentID string) ([]d
 	return res, nil
 }
 
-func (r *courseRepo) ListByTeacherID(ctx context.Context, teacherID string) ([]domain.Course, error) {
-	query, args := r.qb.
-		Select("course_id", "teacher_id", "title", "description", "visibility", "start_time", "end_time", "created_at").
+// Возвращает курсы, где пользователь владелец или входит в состав преподавателей.
+// Удалённые курсы не возвращаются, архивные - только при includeArchived
+func (r *courseRepo) ListByTeacherID(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error) {
+	qb := r.qb.
+		Select("course_id", "teacher_id", "title", "description", "visibility", "start_time", "end_time", "created_at", "capacity", "archived_at", "deleted_at", "is_template", "listed", "category", tagsColumn("courses")).
 		From("courses").
-		Where(sq.Eq{"teacher_id": teacherID}).
-		OrderBy("created_at DESC").
-		MustSql()
+		Where(sq.Or{
+			sq.Eq{"teacher_id": teacherID},
+			sq.Expr("course_id IN (SELECT course_id FROM course_staff WHERE user_id = ?)", teacherID),
+		}).
+		Where(sq.Eq{"deleted_at": nil}).
+		OrderBy("created_at DESC")
+	if !includeArchived {
+		qb = qb.Where(sq.Eq{"archived_at": nil})
+	}
+	query, args := qb.MustSql()
 
 	var courses []Course
 	err := r.storage.SelectContext(ctx, &courses, query, args...)
@@ -164,15 +312,48 @@ func (r *courseRepo) Update(ctx context.Context, dto dto.UpdateCourseDTO) (domai
 	if dto.EndTime != nil {
 		m["end_time"] = *dto.EndTime
 	}
+	if dto.IsTemplate != nil {
+		m["is_template"] = *dto.IsTemplate
+	}
+	if dto.Listed != nil {
+		m["listed"] = *dto.Listed
+	}
+	if dto.Category != nil {
+		// Пустая строка убирает категорию
+		if *dto.Category == "" {
+			m["category"] = nil
+		} else {
+			m["category"] = *dto.Category
+		}
+	}
+	if dto.Capacity != nil {
+		// 0 снимает ограничение мест
+		if *dto.Capacity == 0 {
+			m["capacity"] = nil
+		} else {
+			m["capacity"] = *dto.Capacity
+		}
+	}
+	if len(m) == 0 {
+		// Меняются только теги, UPDATE нужен, чтобы проверить курс и вернуть его
+		m["course_id"] = sq.Expr("course_id")
+	}
+
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Course{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query, args := r.qb.
 		Update("courses").
 		SetMap(m).
-		Where(sq.Eq{"course_id": dto.ID}).
-		Suffix("RETURNING *").
+		Where(sq.Eq{"course_id": dto.ID, "archived_at": nil, "deleted_at": nil}).
+		Suffix("RETURNING *, " + tagsColumn("courses")).
 		MustSql()
 
 	var course Course
-	err := r.storage.GetContext(ctx, &course, query, args...)
+	err = tx.GetContext(ctx, &course, query, args...)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Course{}, domain.ErrNotFound
 	}
@@ -180,31 +361,64 @@ func (r *courseRepo) Update(ctx context.Context, dto dto.UpdateCourseDTO) (domai
 		return domain.Course{}, fmt.Errorf("failed to update course: %v", err)
 	}
 
+	if dto.Tags != nil {
+		if err := r.setTags(ctx, tx, dto.ID, *dto.Tags); err != nil {
+			return domain.Course{}, err
+		}
+		course.Tags = *dto.Tags
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Course{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return course.ToDomain(), nil
 }
 
 // TODO fix return on conflict
-func (r *courseRepo) EnrollUser(ctx context.Context, courseID, studentID string) (domain.Enrollment, error) {
+// Записывает пользователя с учётом ограничения мест, как одобрение заявки. Уже
+// записанный пользователь место не занимает, возвращается его запись
+func (r *courseRepo) EnrollUser(ctx context.Context, courseID, studentID string, role domain.Role) (domain.Enrollment, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	freeSeats, err := r.lockFreeSeats(ctx, tx, courseID)
+	if err != nil {
+		return domain.Enrollment{}, err
+	}
+
 	query, args := r.qb.
-		Insert("enrollments").
-		Columns("course_id", "student_id").
-		Values(courseID, studentID).
-		Suffix("ON CONFLICT DO NOTHING RETURNING *").
+		Select("*").
+		From("enrollments").
+		Where(sq.Eq{"course_id": courseID, "student_id": studentID}).
 		MustSql()
 
 	var enrollment Enrollment
-	err := r.storage.GetContext(ctx, &enrollment, query, args...)
-	if errors.Is(err, sql.ErrNoRows) {
-		query, args = r.qb.
-			Select("*").
-			From("enrollments").
-			Where(sq.Eq{"course_id": courseID, "student_id": studentID}).
-			MustSql()
-		err = r.storage.GetContext(ctx, &enrollment, query, args...)
+	err = tx.GetContext(ctx, &enrollment, query, args...)
+	if err == nil {
+		return enrollment.ToDomain(), nil
 	}
-	if err != nil {
-		return domain.Enrollment{}, fmt.Errorf("failed to enroll user: %v", err)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return domain.Enrollment{}, fmt.Errorf("failed to get enrollment: %v", err)
+	}
+	if freeSeats != nil && *freeSeats <= 0 {
+		return domain.Enrollment{}, domain.ErrCourseFull
+	}
+
+	if err := r.enrollTx(ctx, tx, courseID, studentID, role); err != nil {
+		return domain.Enrollment{}, err
+	}
+	if err := tx.GetContext(ctx, &enrollment, query, args...); err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to get enrollment: %v", err)
 	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return enrollment.ToDomain(), nil
 }
 
@@ -227,11 +441,13 @@ func (r *courseRepo) ExpelUser(ctx context.Context, courseID, studentID string)
 	return enrollment.ToDomain(), nil
 }
 
+// Учитывает и владельца курса, и преподавателей из course_staff
 func (r *courseRepo) IsTeacher(ctx context.Context, courseID, teacherID string) (bool, error) {
 	query, args := r.qb.
 		Select("TRUE").
 		From("courses").
 		Where(sq.Eq{"teacher_id": teacherID, "course_id": courseID}).
+		Suffix("UNION ALL SELECT TRUE FROM course_staff WHERE course_id = ? AND user_id = ? LIMIT 1", courseID, teacherID).
 		MustSql()
 
 	var isTeacher bool
@@ -303,3 +519,197 @@ func (r *courseRepo) ListCourseStudents(ctx context.Context, courseID string, in
 
 	return res, total, nil
 }
+
+// Возвращает ErrNotFound, если пользователь не участник курса. Роль преподавателя
+// важнее роли записи на курс
+func (r *courseRepo) GetRole(ctx context.Context, courseID, userID string) (domain.Role, error) {
+	query, args := r.qb.
+		Select().
+		Column(sq.Expr("CASE WHEN c.teacher_id = ? THEN 'owner' ELSE COALESCE(s.role, e.role) END", userID)).
+		From("courses c").
+		LeftJoin("course_staff s ON s.course_id = c.course_id AND s.user_id = ?", userID).
+		LeftJoin("enrollments e ON e.course_id = c.course_id AND e.student_id = ?", userID).
+		Where(sq.Eq{"c.course_id": courseID}).
+		Where(sq.Or{sq.Eq{"c.teacher_id": userID}, sq.NotEq{"s.role": nil}, sq.NotEq{"e.role": nil}}).
+		MustSql()
+
+	var role string
+	err := r.storage.GetContext(ctx, &role, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get role: %v", err)
+	}
+
+	return domain.Role(role), nil
+}
+
+func (r *courseRepo) ListRolePermissions(ctx context.Context, courseID string, role domain.Role) ([]domain.Permission, error) {
+	query, args := r.qb.
+		Select("permission").
+		From("course_permissions").
+		Where(sq.Eq{"course_id": courseID, "role": role}).
+		OrderBy("permission").
+		MustSql()
+
+	var permissions []string
+	err := r.storage.SelectContext(ctx, &permissions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role permissions: %v", err)
+	}
+
+	res := make([]domain.Permission, len(permissions))
+	for i, p := range permissions {
+		res[i] = domain.Permission(p)
+	}
+	return res, nil
+}
+
+func (r *courseRepo) ListCoursePermissions(ctx context.Context, courseID string) ([]domain.RolePermissions, error) {
+	query, args := r.qb.
+		Select("role", "permission").
+		From("course_permissions").
+		Where(sq.Eq{"course_id": courseID}).
+		OrderBy("role", "permission").
+		MustSql()
+
+	var rows []CoursePermission
+	err := r.storage.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list course permissions: %v", err)
+	}
+
+	res := make([]domain.RolePermissions, 0)
+	for _, row := range rows {
+		if len(res) == 0 || res[len(res)-1].Role != domain.Role(row.Role) {
+			res = append(res, domain.RolePermissions{Role: domain.Role(row.Role)})
+		}
+		last := &res[len(res)-1]
+		last.Permissions = append(last.Permissions, domain.Permission(row.Permission))
+	}
+	return res, nil
+}
+
+// Заменяет набор прав роли целиком
+func (r *courseRepo) SetRolePermissions(ctx context.Context, courseID string, role domain.Role, permissions []domain.Permission) error {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args := r.qb.
+		Delete("course_permissions").
+		Where(sq.Eq{"course_id": courseID, "role": role}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete role permissions: %w", err)
+	}
+
+	if len(permissions) > 0 {
+		insert := r.qb.Insert("course_permissions").Columns("course_id", "role", "permission")
+		for _, permission := range permissions {
+			insert = insert.Values(courseID, role, permission)
+		}
+		query, args = insert.Suffix("ON CONFLICT DO NOTHING").MustSql()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert role permissions: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Добавляет преподавателя или меняет его роль. Возвращает ErrNotFound, если курса нет,
+// и ErrUserNotFound, если нет пользователя
+func (r *courseRepo) AddStaff(ctx context.Context, courseID, userID string, role domain.Role) (domain.Staff, error) {
+	query, args := r.qb.
+		Insert("course_staff").
+		Columns("course_id", "user_id", "role").
+		Values(courseID, userID, role).
+		Suffix("ON CONFLICT (course_id, user_id) DO UPDATE SET role = EXCLUDED.role").
+		MustSql()
+
+	_, err := r.storage.ExecContext(ctx, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		if pqErr.Constraint == "course_staff_user_id_fkey" {
+			return domain.Staff{}, domain.ErrUserNotFound
+		}
+		return domain.Staff{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Staff{}, fmt.Errorf("failed to add staff: %v", err)
+	}
+
+	return r.getStaff(ctx, courseID, userID)
+}
+
+func (r *courseRepo) RemoveStaff(ctx context.Context, courseID, userID string) (domain.Staff, error) {
+	staff, err := r.getStaff(ctx, courseID, userID)
+	if err != nil {
+		return domain.Staff{}, err
+	}
+
+	query, args := r.qb.
+		Delete("course_staff").
+		Where(sq.Eq{"course_id": courseID, "user_id": userID}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return domain.Staff{}, fmt.Errorf("failed to remove staff: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.Staff{}, domain.ErrNotFound
+	}
+
+	return staff, nil
+}
+
+func (r *courseRepo) ListStaff(ctx context.Context, courseID string) ([]domain.Staff, error) {
+	query, args := r.staffQuery().
+		Where(sq.Eq{"s.course_id": courseID}).
+		OrderBy("s.added_at").
+		MustSql()
+
+	var staff []Staff
+	err := r.storage.SelectContext(ctx, &staff, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staff: %v", err)
+	}
+
+	res := make([]domain.Staff, len(staff))
+	for i, s := range staff {
+		res[i] = s.ToDomain()
+	}
+	return res, nil
+}
+
+func (r *courseRepo) getStaff(ctx context.Context, courseID, userID string) (domain.Staff, error) {
+	query, args := r.staffQuery().
+		Where(sq.Eq{"s.course_id": courseID, "s.user_id": userID}).
+		MustSql()
+
+	var staff Staff
+	err := r.storage.GetContext(ctx, &staff, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Staff{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Staff{}, fmt.Errorf("failed to get staff: %v", err)
+	}
+
+	return staff.ToDomain(), nil
+}
+
+func (r *courseRepo) staffQuery() sq.SelectBuilder {
+	return r.qb.
+		Select("s.course_id", "s.user_id", "u.email", "u.first_name", "u.last_name", "s.role", "s.added_at").
+		From("course_staff s").
+		Join("users u ON u.user_id = s.user_id")
+}
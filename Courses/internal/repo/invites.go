@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Возвращает ErrNotFound, если курса нет, и ErrConflict, если код уже занят
+func (r *courseRepo) CreateInvite(ctx context.Context, dto dto.CreateInviteDTO) (domain.Invite, error) {
+	m := make(map[string]any)
+	m["course_id"] = dto.CourseID
+	m["created_by"] = dto.CreatedBy
+	m["code"] = dto.Code
+	m["role"] = dto.Role
+
+	if dto.ExpiresAt != nil {
+		m["expires_at"] = *dto.ExpiresAt
+	}
+	if dto.MaxUses != nil {
+		m["max_uses"] = *dto.MaxUses
+	}
+
+	query, args := r.qb.
+		Insert("course_invites").
+		SetMap(m).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var invite Invite
+	err := r.storage.GetContext(ctx, &invite, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Name() {
+		case "unique_violation":
+			return domain.Invite{}, domain.ErrConflict
+		case "foreign_key_violation":
+			return domain.Invite{}, domain.ErrNotFound
+		}
+	}
+	if err != nil {
+		return domain.Invite{}, fmt.Errorf("failed to create invite: %v", err)
+	}
+
+	return invite.ToDomain(), nil
+}
+
+func (r *courseRepo) ListInvites(ctx context.Context, courseID string) ([]domain.Invite, error) {
+	query, args := r.qb.
+		Select("*").
+		From("course_invites").
+		Where(sq.Eq{"course_id": courseID}).
+		OrderBy("created_at DESC").
+		MustSql()
+
+	var invites []Invite
+	err := r.storage.SelectContext(ctx, &invites, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invites: %v", err)
+	}
+
+	res := make([]domain.Invite, len(invites))
+	for i, invite := range invites {
+		res[i] = invite.ToDomain()
+	}
+	return res, nil
+}
+
+// Коды шаблонов, архивных и удалённых курсов не находятся
+func (r *courseRepo) GetInviteByCode(ctx context.Context, code string) (domain.Invite, error) {
+	query, args := r.qb.
+		Select("i.*").
+		From("course_invites i").
+		Join("courses c ON c.course_id = i.course_id").
+		Where(sq.Eq{"i.code": code, "c.archived_at": nil, "c.deleted_at": nil, "c.is_template": false}).
+		MustSql()
+
+	var invite Invite
+	err := r.storage.GetContext(ctx, &invite, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Invite{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Invite{}, fmt.Errorf("failed to get invite: %v", err)
+	}
+
+	return invite.ToDomain(), nil
+}
+
+// Повторный отзыв не меняет время отзыва
+func (r *courseRepo) RevokeInvite(ctx context.Context, courseID, inviteID string) (domain.Invite, error) {
+	query, args := r.qb.
+		Update("course_invites").
+		Set("revoked_at", sq.Expr("COALESCE(revoked_at, NOW())")).
+		Where(sq.Eq{"course_id": courseID, "invite_id": inviteID}).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var invite Invite
+	err := r.storage.GetContext(ctx, &invite, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Invite{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Invite{}, fmt.Errorf("failed to revoke invite: %v", err)
+	}
+
+	return invite.ToDomain(), nil
+}
+
+// Записывает пользователя по коду приглашения в одной транзакции: занимает место на курсе,
+// увеличивает счётчик использований и создаёт запись. Возвращает ErrNotFound, если код
+// к этому моменту отозван, истёк или исчерпан, и ErrCourseFull, если мест нет
+func (r *courseRepo) JoinByInvite(ctx context.Context, inviteID, userID string) (domain.Enrollment, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args := r.qb.
+		Select("course_id").
+		From("course_invites").
+		Where(sq.Eq{"invite_id": inviteID}).
+		MustSql()
+
+	var courseID string
+	err = tx.GetContext(ctx, &courseID, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Enrollment{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to get invite: %v", err)
+	}
+
+	// Курс блокируется первым, как при одобрении заявок
+	freeSeats, err := r.lockFreeSeats(ctx, tx, courseID)
+	if err != nil {
+		return domain.Enrollment{}, err
+	}
+	if freeSeats != nil && *freeSeats <= 0 {
+		return domain.Enrollment{}, domain.ErrCourseFull
+	}
+
+	invite, err := r.useInviteTx(ctx, tx, inviteID)
+	if err != nil {
+		return domain.Enrollment{}, err
+	}
+
+	if err := r.enrollTx(ctx, tx, courseID, userID, invite.Role); err != nil {
+		return domain.Enrollment{}, err
+	}
+
+	query, args = r.qb.
+		Select("*").
+		From("enrollments").
+		Where(sq.Eq{"course_id": courseID, "student_id": userID}).
+		MustSql()
+
+	var enrollment Enrollment
+	if err := tx.GetContext(ctx, &enrollment, query, args...); err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to get enrollment: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Enrollment{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return enrollment.ToDomain(), nil
+}
+
+// Атомарно увеличивает счётчик использований. Возвращает ErrNotFound, если код
+// к этому моменту отозван, истёк или исчерпан
+func (r *courseRepo) useInviteTx(ctx context.Context, tx *sqlx.Tx, inviteID string) (domain.Invite, error) {
+	query, args := r.qb.
+		Update("course_invites").
+		Set("uses", sq.Expr("uses + 1")).
+		Where(sq.Eq{"invite_id": inviteID, "revoked_at": nil}).
+		Where("(expires_at IS NULL OR expires_at > NOW())").
+		Where("(max_uses IS NULL OR uses < max_uses)").
+		Suffix("RETURNING *").
+		MustSql()
+
+	var invite Invite
+	err := tx.GetContext(ctx, &invite, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Invite{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Invite{}, fmt.Errorf("failed to use invite: %v", err)
+	}
+
+	return invite.ToDomain(), nil
+}
@@ -4,17 +4,26 @@ import (
 	"Classroom/Courses/internal/domain"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type Course struct {
-	ID          string       `db:"course_id"`
-	TeacherID   string       `db:"teacher_id"`
-	Title       string       `db:"title"`
-	Description string       `db:"description"`
-	Visibility  bool         `db:"visibility"`
-	StartTime   sql.NullTime `db:"start_time"`
-	EndTime     sql.NullTime `db:"end_time"`
-	CreatedAt   time.Time    `db:"created_at"`
+	ID          string         `db:"course_id"`
+	TeacherID   string         `db:"teacher_id"`
+	Title       string         `db:"title"`
+	Description string         `db:"description"`
+	Visibility  bool           `db:"visibility"`
+	StartTime   sql.NullTime   `db:"start_time"`
+	EndTime     sql.NullTime   `db:"end_time"`
+	CreatedAt   time.Time      `db:"created_at"`
+	Capacity    sql.NullInt32  `db:"capacity"`
+	ArchivedAt  sql.NullTime   `db:"archived_at"`
+	DeletedAt   sql.NullTime   `db:"deleted_at"`
+	IsTemplate  bool           `db:"is_template"`
+	Listed      bool           `db:"listed"`
+	Category    sql.NullString `db:"category"`
+	Tags        pq.StringArray `db:"tags"` // Заполняется подзапросом tagsColumn
 }
 
 func (c Course) ToDomain() domain.Course {
@@ -25,6 +34,21 @@ func (c Course) ToDomain() domain.Course {
 	if c.EndTime.Valid {
 		endTime = &c.EndTime.Time
 	}
+	var capacity *int32
+	if c.Capacity.Valid {
+		capacity = &c.Capacity.Int32
+	}
+	var category *string
+	if c.Category.Valid {
+		category = &c.Category.String
+	}
+	var archivedAt, deletedAt *time.Time
+	if c.ArchivedAt.Valid {
+		archivedAt = &c.ArchivedAt.Time
+	}
+	if c.DeletedAt.Valid {
+		deletedAt = &c.DeletedAt.Time
+	}
 	return domain.Course{
 		ID:          c.ID,
 		TeacherID:   c.TeacherID,
@@ -33,13 +57,21 @@ func (c Course) ToDomain() domain.Course {
 		Visibility:  c.Visibility,
 		StartTime:   startTime,
 		EndTime:     endTime,
+		Capacity:    capacity,
 		CreatedAt:   c.CreatedAt,
+		ArchivedAt:  archivedAt,
+		DeletedAt:   deletedAt,
+		IsTemplate:  c.IsTemplate,
+		Listed:      c.Listed,
+		Category:    category,
+		Tags:        c.Tags,
 	}
 }
 
 type Enrollment struct {
 	StudentID  string    `db:"student_id"`
 	CourseID   string    `db:"course_id"`
+	Role       string    `db:"role"`
 	EnrolledAt time.Time `db:"enrolled_at"`
 }
 
@@ -47,6 +79,7 @@ func (e Enrollment) ToDomain() domain.Enrollment {
 	return domain.Enrollment{
 		StudentID:  e.StudentID,
 		CourseID:   e.CourseID,
+		Role:       domain.Role(e.Role),
 		EnrolledAt: e.EnrolledAt,
 	}
 }
@@ -66,3 +99,200 @@ func (m Student) ToDomain() domain.Student {
 		LastName:  m.LastName,
 	}
 }
+
+type CoursePermission struct {
+	Role       string `db:"role"`
+	Permission string `db:"permission"`
+}
+
+type Staff struct {
+	CourseID  string    `db:"course_id"`
+	UserID    string    `db:"user_id"`
+	Email     string    `db:"email"`
+	FirstName string    `db:"first_name"`
+	LastName  string    `db:"last_name"`
+	Role      string    `db:"role"`
+	AddedAt   time.Time `db:"added_at"`
+}
+
+func (s Staff) ToDomain() domain.Staff {
+	return domain.Staff{
+		CourseID:  s.CourseID,
+		UserID:    s.UserID,
+		Email:     s.Email,
+		FirstName: s.FirstName,
+		LastName:  s.LastName,
+		Role:      domain.Role(s.Role),
+		AddedAt:   s.AddedAt,
+	}
+}
+
+type Invite struct {
+	ID        string        `db:"invite_id"`
+	CourseID  string        `db:"course_id"`
+	Code      string        `db:"code"`
+	Role      string        `db:"role"`
+	CreatedBy string        `db:"created_by"`
+	ExpiresAt sql.NullTime  `db:"expires_at"`
+	MaxUses   sql.NullInt32 `db:"max_uses"`
+	Uses      int32         `db:"uses"`
+	RevokedAt sql.NullTime  `db:"revoked_at"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+func (i Invite) ToDomain() domain.Invite {
+	invite := domain.Invite{
+		ID:        i.ID,
+		CourseID:  i.CourseID,
+		Code:      i.Code,
+		Role:      domain.Role(i.Role),
+		CreatedBy: i.CreatedBy,
+		Uses:      i.Uses,
+		CreatedAt: i.CreatedAt,
+	}
+	if i.ExpiresAt.Valid {
+		invite.ExpiresAt = &i.ExpiresAt.Time
+	}
+	if i.MaxUses.Valid {
+		invite.MaxUses = &i.MaxUses.Int32
+	}
+	if i.RevokedAt.Valid {
+		invite.RevokedAt = &i.RevokedAt.Time
+	}
+	return invite
+}
+
+type EnrollmentRequest struct {
+	ID        string         `db:"request_id"`
+	CourseID  string         `db:"course_id"`
+	UserID    string         `db:"user_id"`
+	Email     string         `db:"email"`
+	FirstName string         `db:"first_name"`
+	LastName  string         `db:"last_name"`
+	Status    string         `db:"status"`
+	DecidedBy sql.NullString `db:"decided_by"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (r EnrollmentRequest) ToDomain() domain.EnrollmentRequest {
+	request := domain.EnrollmentRequest{
+		ID:        r.ID,
+		CourseID:  r.CourseID,
+		UserID:    r.UserID,
+		Email:     r.Email,
+		FirstName: r.FirstName,
+		LastName:  r.LastName,
+		Status:    domain.RequestStatus(r.Status),
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+	if r.DecidedBy.Valid {
+		request.DecidedBy = &r.DecidedBy.String
+	}
+	return request
+}
+
+type RosterEntry struct {
+	UserID     string    `db:"user_id"`
+	Email      string    `db:"email"`
+	FirstName  string    `db:"first_name"`
+	LastName   string    `db:"last_name"`
+	Role       string    `db:"role"`
+	EnrolledAt time.Time `db:"enrolled_at"`
+}
+
+func (e RosterEntry) ToDomain() domain.RosterEntry {
+	return domain.RosterEntry{
+		UserID:     e.UserID,
+		Email:      e.Email,
+		FirstName:  e.FirstName,
+		LastName:   e.LastName,
+		Role:       domain.Role(e.Role),
+		EnrolledAt: e.EnrolledAt,
+	}
+}
+
+type CloneJob struct {
+	ID             string         `db:"job_id"`
+	SourceCourseID string         `db:"source_course_id"`
+	TargetCourseID string         `db:"target_course_id"`
+	UserID         string         `db:"user_id"`
+	ShiftDays      int32          `db:"shift_days"`
+	Status         string         `db:"status"`
+	LessonsCopied  sql.NullInt32  `db:"lessons_copied"`
+	TasksCopied    sql.NullInt32  `db:"tasks_copied"`
+	Error          sql.NullString `db:"error"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+func (j CloneJob) ToDomain() domain.CloneJob {
+	job := domain.CloneJob{
+		ID:             j.ID,
+		SourceCourseID: j.SourceCourseID,
+		TargetCourseID: j.TargetCourseID,
+		UserID:         j.UserID,
+		ShiftDays:      j.ShiftDays,
+		Status:         domain.CloneStatus(j.Status),
+		CreatedAt:      j.CreatedAt,
+		UpdatedAt:      j.UpdatedAt,
+	}
+	if j.LessonsCopied.Valid {
+		job.LessonsCopied = &j.LessonsCopied.Int32
+	}
+	if j.TasksCopied.Valid {
+		job.TasksCopied = &j.TasksCopied.Int32
+	}
+	if j.Error.Valid {
+		job.Error = &j.Error.String
+	}
+	return job
+}
+
+type Module struct {
+	ID        string    `db:"module_id"`
+	CourseID  string    `db:"course_id"`
+	Title     string    `db:"title"`
+	Position  int32     `db:"position"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m Module) ToDomain() domain.Module {
+	return domain.Module{
+		ID:        m.ID,
+		CourseID:  m.CourseID,
+		Title:     m.Title,
+		Position:  m.Position,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type OwnershipTransfer struct {
+	ID          string       `db:"transfer_id"`
+	CourseID    string       `db:"course_id"`
+	CourseTitle string       `db:"course_title"`
+	FromUserID  string       `db:"from_user_id"`
+	ToUserID    string       `db:"to_user_id"`
+	Status      string       `db:"status"`
+	Forced      bool         `db:"forced"`
+	CreatedAt   time.Time    `db:"created_at"`
+	DecidedAt   sql.NullTime `db:"decided_at"`
+}
+
+func (t OwnershipTransfer) ToDomain() domain.OwnershipTransfer {
+	transfer := domain.OwnershipTransfer{
+		ID:          t.ID,
+		CourseID:    t.CourseID,
+		CourseTitle: t.CourseTitle,
+		FromUserID:  t.FromUserID,
+		ToUserID:    t.ToUserID,
+		Status:      domain.TransferStatus(t.Status),
+		Forced:      t.Forced,
+		CreatedAt:   t.CreatedAt,
+	}
+	if t.DecidedAt.Valid {
+		transfer.DecidedAt = &t.DecidedAt.Time
+	}
+	return transfer
+}
@@ -0,0 +1,206 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Добавляет модуль в конец курса. Возвращает ErrNotFound, если курса нет
+func (r *courseRepo) CreateModule(ctx context.Context, courseID, title string) (domain.Module, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Module{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.lockModules(ctx, tx, courseID); err != nil {
+		return domain.Module{}, err
+	}
+
+	query, args := r.qb.
+		Insert("course_modules").
+		Columns("course_id", "title", "position").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", courseID).
+			Column("?", title).
+			Column("COALESCE(MAX(position) + 1, 0)").
+			From("course_modules").
+			Where(sq.Eq{"course_id": courseID})).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var module Module
+	if err := tx.GetContext(ctx, &module, query, args...); err != nil {
+		return domain.Module{}, fmt.Errorf("failed to create module: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Module{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return module.ToDomain(), nil
+}
+
+func (r *courseRepo) UpdateModule(ctx context.Context, courseID, moduleID, title string) (domain.Module, error) {
+	query, args := r.qb.
+		Update("course_modules").
+		Set("title", title).
+		Where(sq.Eq{"course_id": courseID, "module_id": moduleID}).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var module Module
+	err := r.storage.GetContext(ctx, &module, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Module{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Module{}, fmt.Errorf("failed to update module: %v", err)
+	}
+	return module.ToDomain(), nil
+}
+
+// Удаляет модуль и сдвигает следующие модули на его место. Уроки и задания модуля
+// остаются в курсе без модуля через ON DELETE SET NULL
+func (r *courseRepo) DeleteModule(ctx context.Context, courseID, moduleID string) (domain.Module, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Module{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.lockModules(ctx, tx, courseID); err != nil {
+		return domain.Module{}, err
+	}
+
+	query, args := r.qb.
+		Delete("course_modules").
+		Where(sq.Eq{"course_id": courseID, "module_id": moduleID}).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var module Module
+	err = tx.GetContext(ctx, &module, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Module{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Module{}, fmt.Errorf("failed to delete module: %v", err)
+	}
+
+	query, args = r.qb.
+		Update("course_modules").
+		Set("position", sq.Expr("position - 1")).
+		Where(sq.Eq{"course_id": courseID}).
+		Where(sq.Gt{"position": module.Position}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return domain.Module{}, fmt.Errorf("failed to shift modules: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Module{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return module.ToDomain(), nil
+}
+
+func (r *courseRepo) ListModules(ctx context.Context, courseID string) ([]domain.Module, error) {
+	return r.listModules(ctx, r.storage, courseID)
+}
+
+// Ставит модуль на позицию position, остальные модули сдвигаются. Весь порядок
+// меняется в одной транзакции под блокировкой курса
+func (r *courseRepo) ReorderModule(ctx context.Context, courseID, moduleID string, position int32) ([]domain.Module, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.lockModules(ctx, tx, courseID); err != nil {
+		return nil, err
+	}
+
+	modules, err := r.listModules(ctx, tx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(modules, func(m domain.Module) bool { return m.ID == moduleID })
+	if i == -1 {
+		return nil, domain.ErrNotFound
+	}
+
+	module := modules[i]
+	modules = slices.Delete(modules, i, i+1)
+	position = min(position, int32(len(modules)))
+	modules = slices.Insert(modules, int(position), module)
+
+	for i := range modules {
+		if modules[i].Position == int32(i) {
+			continue
+		}
+		modules[i].Position = int32(i)
+
+		query, args := r.qb.
+			Update("course_modules").
+			Set("position", i).
+			Where(sq.Eq{"module_id": modules[i].ID}).
+			MustSql()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, fmt.Errorf("failed to move module: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return modules, nil
+}
+
+func (r *courseRepo) listModules(ctx context.Context, q sqlx.QueryerContext, courseID string) ([]domain.Module, error) {
+	query, args := r.qb.
+		Select("*").
+		From("course_modules").
+		Where(sq.Eq{"course_id": courseID}).
+		OrderBy("position").
+		MustSql()
+
+	var modules []Module
+	if err := sqlx.SelectContext(ctx, q, &modules, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list modules: %v", err)
+	}
+
+	result := make([]domain.Module, len(modules))
+	for i, m := range modules {
+		result[i] = m.ToDomain()
+	}
+	return result, nil
+}
+
+// Блокирует строку курса до конца транзакции, чтобы одновременные изменения
+// модулей не перемешали позиции
+func (r *courseRepo) lockModules(ctx context.Context, tx *sqlx.Tx, courseID string) error {
+	query, args := r.qb.
+		Select("course_id").
+		From("courses").
+		Where(sq.Eq{"course_id": courseID}).
+		Suffix("FOR UPDATE").
+		MustSql()
+
+	var id string
+	err := tx.GetContext(ctx, &id, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock course: %v", err)
+	}
+	return nil
+}
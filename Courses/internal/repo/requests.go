@@ -0,0 +1,314 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Возвращает ErrNotFound, если курса нет, и ErrConflict, если у пользователя уже есть
+// незакрытая заявка на этот курс
+func (r *courseRepo) CreateEnrollmentRequest(ctx context.Context, courseID, userID string) (domain.EnrollmentRequest, error) {
+	query, args := r.qb.
+		Insert("enrollment_requests").
+		Columns("course_id", "user_id").
+		Values(courseID, userID).
+		Suffix("RETURNING request_id").
+		MustSql()
+
+	var requestID string
+	err := r.storage.GetContext(ctx, &requestID, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Name() {
+		case "unique_violation":
+			return domain.EnrollmentRequest{}, domain.ErrConflict
+		case "foreign_key_violation":
+			return domain.EnrollmentRequest{}, domain.ErrNotFound
+		}
+	}
+	if err != nil {
+		return domain.EnrollmentRequest{}, fmt.Errorf("failed to create enrollment request: %v", err)
+	}
+
+	return r.GetEnrollmentRequest(ctx, courseID, requestID)
+}
+
+// Пустой status возвращает заявки во всех состояниях
+func (r *courseRepo) ListEnrollmentRequests(ctx context.Context, courseID string, status domain.RequestStatus) ([]domain.EnrollmentRequest, error) {
+	q := r.requestQuery().
+		Where(sq.Eq{"er.course_id": courseID}).
+		OrderBy("er.created_at")
+	if status != "" {
+		q = q.Where(sq.Eq{"er.status": status})
+	}
+	query, args := q.MustSql()
+
+	var requests []EnrollmentRequest
+	err := r.storage.SelectContext(ctx, &requests, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enrollment requests: %v", err)
+	}
+
+	res := make([]domain.EnrollmentRequest, len(requests))
+	for i, request := range requests {
+		res[i] = request.ToDomain()
+	}
+	return res, nil
+}
+
+func (r *courseRepo) GetEnrollmentRequest(ctx context.Context, courseID, requestID string) (domain.EnrollmentRequest, error) {
+	query, args := r.requestQuery().
+		Where(sq.Eq{"er.course_id": courseID, "er.request_id": requestID}).
+		MustSql()
+
+	var request EnrollmentRequest
+	err := r.storage.GetContext(ctx, &request, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.EnrollmentRequest{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.EnrollmentRequest{}, fmt.Errorf("failed to get enrollment request: %v", err)
+	}
+
+	return request.ToDomain(), nil
+}
+
+// Записывает пользователя, если на курсе есть место, иначе переводит заявку в лист
+// ожидания. Возвращает ErrConflict, если заявка уже закрыта
+func (r *courseRepo) ApproveEnrollmentRequest(ctx context.Context, courseID, requestID, decidedBy string) (domain.EnrollmentRequest, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.EnrollmentRequest{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	freeSeats, err := r.lockFreeSeats(ctx, tx, courseID)
+	if err != nil {
+		return domain.EnrollmentRequest{}, err
+	}
+
+	query, args := r.qb.
+		Select("user_id", "status").
+		From("enrollment_requests").
+		Where(sq.Eq{"course_id": courseID, "request_id": requestID}).
+		Suffix("FOR UPDATE").
+		MustSql()
+
+	var request struct {
+		UserID string `db:"user_id"`
+		Status string `db:"status"`
+	}
+	err = tx.GetContext(ctx, &request, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.EnrollmentRequest{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.EnrollmentRequest{}, fmt.Errorf("failed to get enrollment request: %v", err)
+	}
+	if !domain.RequestStatus(request.Status).Open() {
+		return domain.EnrollmentRequest{}, domain.ErrConflict
+	}
+
+	status := domain.RequestWaitlisted
+	if freeSeats == nil || *freeSeats > 0 {
+		status = domain.RequestApproved
+		if err := r.enrollTx(ctx, tx, courseID, request.UserID, domain.RoleStudent); err != nil {
+			return domain.EnrollmentRequest{}, err
+		}
+	}
+
+	// Повторное одобрение заявки из листа ожидания не сдвигает её в очереди
+	if domain.RequestStatus(request.Status) != status {
+		if err := r.setRequestStatus(ctx, tx, requestID, status, &decidedBy); err != nil {
+			return domain.EnrollmentRequest{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.EnrollmentRequest{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return r.GetEnrollmentRequest(ctx, courseID, requestID)
+}
+
+// Возвращает ErrConflict, если заявка уже закрыта
+func (r *courseRepo) RejectEnrollmentRequest(ctx context.Context, courseID, requestID, decidedBy string) (domain.EnrollmentRequest, error) {
+	query, args := r.qb.
+		Update("enrollment_requests").
+		Set("status", domain.RequestRejected).
+		Set("decided_by", decidedBy).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{
+			"course_id":  courseID,
+			"request_id": requestID,
+			"status":     []domain.RequestStatus{domain.RequestPending, domain.RequestWaitlisted},
+		}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return domain.EnrollmentRequest{}, fmt.Errorf("failed to reject enrollment request: %v", err)
+	}
+
+	request, err := r.GetEnrollmentRequest(ctx, courseID, requestID)
+	if err != nil {
+		return domain.EnrollmentRequest{}, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.EnrollmentRequest{}, domain.ErrConflict
+	}
+
+	return request, nil
+}
+
+// Записывает пользователей из листа ожидания на свободные места в порядке очереди
+func (r *courseRepo) PromoteWaitlist(ctx context.Context, courseID string) ([]domain.EnrollmentRequest, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	freeSeats, err := r.lockFreeSeats(ctx, tx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if freeSeats != nil && *freeSeats <= 0 {
+		return []domain.EnrollmentRequest{}, nil
+	}
+
+	q := r.qb.
+		Select("request_id", "user_id").
+		From("enrollment_requests").
+		Where(sq.Eq{"course_id": courseID, "status": domain.RequestWaitlisted}).
+		OrderBy("updated_at").
+		Suffix("FOR UPDATE")
+	if freeSeats != nil {
+		q = q.Limit(uint64(*freeSeats))
+	}
+	query, args := q.MustSql()
+
+	var waitlisted []struct {
+		RequestID string `db:"request_id"`
+		UserID    string `db:"user_id"`
+	}
+	if err := tx.SelectContext(ctx, &waitlisted, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get waitlist: %v", err)
+	}
+	if len(waitlisted) == 0 {
+		return []domain.EnrollmentRequest{}, nil
+	}
+
+	requestIDs := make([]string, len(waitlisted))
+	for i, w := range waitlisted {
+		if err := r.enrollTx(ctx, tx, courseID, w.UserID, domain.RoleStudent); err != nil {
+			return nil, err
+		}
+		if err := r.setRequestStatus(ctx, tx, w.RequestID, domain.RequestApproved, nil); err != nil {
+			return nil, err
+		}
+		requestIDs[i] = w.RequestID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	query, args = r.requestQuery().
+		Where(sq.Eq{"er.request_id": requestIDs}).
+		OrderBy("er.updated_at").
+		MustSql()
+
+	var requests []EnrollmentRequest
+	if err := r.storage.SelectContext(ctx, &requests, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get promoted requests: %v", err)
+	}
+
+	res := make([]domain.EnrollmentRequest, len(requests))
+	for i, request := range requests {
+		res[i] = request.ToDomain()
+	}
+	return res, nil
+}
+
+// Блокирует строку курса до конца транзакции, чтобы одновременные записи не
+// превысили capacity. nil означает курс без ограничения мест
+func (r *courseRepo) lockFreeSeats(ctx context.Context, tx *sqlx.Tx, courseID string) (*int32, error) {
+	query, args := r.qb.
+		Select("capacity").
+		From("courses").
+		Where(sq.Eq{"course_id": courseID}).
+		Suffix("FOR UPDATE").
+		MustSql()
+
+	var capacity sql.NullInt32
+	err := tx.GetContext(ctx, &capacity, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock course: %v", err)
+	}
+	if !capacity.Valid {
+		return nil, nil
+	}
+
+	query, args = r.qb.
+		Select("COUNT(*)").
+		From("enrollments").
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+
+	var enrolled int32
+	if err := tx.GetContext(ctx, &enrolled, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to count enrollments: %v", err)
+	}
+
+	free := capacity.Int32 - enrolled
+	return &free, nil
+}
+
+func (r *courseRepo) enrollTx(ctx context.Context, tx *sqlx.Tx, courseID, userID string, role domain.Role) error {
+	query, args := r.qb.
+		Insert("enrollments").
+		Columns("course_id", "student_id", "role").
+		Values(courseID, userID, role).
+		Suffix("ON CONFLICT DO NOTHING").
+		MustSql()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to enroll user: %v", err)
+	}
+	return nil
+}
+
+func (r *courseRepo) setRequestStatus(ctx context.Context, tx *sqlx.Tx, requestID string, status domain.RequestStatus, decidedBy *string) error {
+	q := r.qb.
+		Update("enrollment_requests").
+		Set("status", status).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"request_id": requestID})
+	if decidedBy != nil {
+		q = q.Set("decided_by", *decidedBy)
+	}
+	query, args := q.MustSql()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update enrollment request: %v", err)
+	}
+	return nil
+}
+
+func (r *courseRepo) requestQuery() sq.SelectBuilder {
+	return r.qb.
+		Select("er.request_id", "er.course_id", "er.user_id", "u.email", "u.first_name", "u.last_name", "er.status", "er.decided_by", "er.created_at", "er.updated_at").
+		From("enrollment_requests er").
+		Join("users u ON u.user_id = er.user_id")
+}
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// Почта сравнивается без учета регистра, emails должны быть в нижнем регистре
+func (r *courseRepo) ListUsersByEmails(ctx context.Context, emails []string) ([]domain.Student, error) {
+	query, args := r.qb.
+		Select("user_id", "email", "first_name", "last_name").
+		From("users").
+		Where(sq.Eq{"LOWER(email)": emails}).
+		MustSql()
+
+	var users []Student
+	err := r.storage.SelectContext(ctx, &users, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users by emails: %v", err)
+	}
+
+	res := make([]domain.Student, len(users))
+	for i, u := range users {
+		res[i] = u.ToDomain()
+	}
+	return res, nil
+}
+
+// Записывает пользователей на свободные места в порядке userIDs и возвращает ID только что
+// записанных и тех, кому не хватило мест. Уже записанные, владелец и преподаватели курса пропускаются
+func (r *courseRepo) BulkEnroll(ctx context.Context, courseID string, userIDs []string, role domain.Role) ([]string, []string, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	freeSeats, err := r.lockFreeSeats(ctx, tx, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := `
+		SELECT u.id
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS u(id, n)
+		WHERE u.id <> (SELECT teacher_id FROM courses WHERE course_id = $1)
+		  AND NOT EXISTS (SELECT 1 FROM course_staff s WHERE s.course_id = $1 AND s.user_id = u.id)
+		  AND NOT EXISTS (SELECT 1 FROM enrollments e WHERE e.course_id = $1 AND e.student_id = u.id)
+		ORDER BY u.n`
+
+	var candidates []string
+	if err := tx.SelectContext(ctx, &candidates, query, courseID, pq.Array(userIDs)); err != nil {
+		return nil, nil, fmt.Errorf("failed to select users to enroll: %v", err)
+	}
+
+	var full []string
+	if freeSeats != nil {
+		seats := int(max(*freeSeats, 0))
+		if seats < len(candidates) {
+			candidates, full = candidates[:seats], candidates[seats:]
+		}
+	}
+	if len(candidates) == 0 {
+		return []string{}, full, nil
+	}
+
+	query = `
+		INSERT INTO enrollments (course_id, student_id, role)
+		SELECT $1, u.id, $2
+		FROM unnest($3::uuid[]) AS u(id)
+		ON CONFLICT DO NOTHING
+		RETURNING student_id`
+
+	var enrolled []string
+	if err := tx.SelectContext(ctx, &enrolled, query, courseID, role, pq.Array(candidates)); err != nil {
+		return nil, nil, fmt.Errorf("failed to bulk enroll users: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return enrolled, full, nil
+}
+
+func (r *courseRepo) ListRoster(ctx context.Context, courseID string) ([]domain.RosterEntry, error) {
+	query, args := r.qb.
+		Select("u.user_id", "u.email", "u.first_name", "u.last_name", "e.role", "e.enrolled_at").
+		From("enrollments e").
+		Join("users u ON u.user_id = e.student_id").
+		Where(sq.Eq{"e.course_id": courseID}).
+		OrderBy("u.last_name", "u.first_name", "u.email").
+		MustSql()
+
+	var entries []RosterEntry
+	err := r.storage.SelectContext(ctx, &entries, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roster: %v", err)
+	}
+
+	res := make([]domain.RosterEntry, len(entries))
+	for i, e := range entries {
+		res[i] = e.ToDomain()
+	}
+	return res, nil
+}
@@ -0,0 +1,315 @@
+package repo
+
+import (
+	"Classroom/Courses/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Создаёт передачу курса, ожидающую подтверждения, и отменяет прежнюю ожидающую передачу курса.
+// Возвращает ErrNotFound, если курса нет, ErrUserNotFound, если нет нового владельца,
+// и ErrConflict, если fromUserID уже не владелец
+func (r *courseRepo) CreateOwnershipTransfer(ctx context.Context, courseID, fromUserID, toUserID string) (domain.OwnershipTransfer, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	owner, err := r.lockOwner(ctx, tx, courseID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if owner != fromUserID {
+		return domain.OwnershipTransfer{}, domain.ErrConflict
+	}
+
+	if err := r.cancelPendingTransfers(ctx, tx, courseID); err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	transferID, err := r.insertTransfer(ctx, tx, courseID, fromUserID, toUserID, false)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetOwnershipTransfer(ctx, transferID)
+}
+
+// Сразу передаёт курс без подтверждения. Возвращает ErrNotFound, если курса нет,
+// ErrUserNotFound, если нет нового владельца, и ErrConflict, если он уже владелец
+func (r *courseRepo) ForceTransferOwnership(ctx context.Context, courseID, toUserID string) (domain.OwnershipTransfer, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	owner, err := r.lockOwner(ctx, tx, courseID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if owner == toUserID {
+		return domain.OwnershipTransfer{}, domain.ErrConflict
+	}
+
+	transferID, err := r.insertTransfer(ctx, tx, courseID, owner, toUserID, true)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if err := r.setOwner(ctx, tx, courseID, owner, toUserID); err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetOwnershipTransfer(ctx, transferID)
+}
+
+func (r *courseRepo) GetOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error) {
+	query, args := r.transferQuery().
+		Where(sq.Eq{"t.transfer_id": transferID}).
+		MustSql()
+
+	var transfer OwnershipTransfer
+	err := r.storage.GetContext(ctx, &transfer, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.OwnershipTransfer{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to get ownership transfer: %v", err)
+	}
+	return transfer.ToDomain(), nil
+}
+
+// Возвращает ожидающие подтверждения передачи пользователю, кроме передач удалённых курсов
+func (r *courseRepo) ListOwnershipTransfers(ctx context.Context, toUserID string) ([]domain.OwnershipTransfer, error) {
+	query, args := r.transferQuery().
+		Where(sq.Eq{"t.to_user_id": toUserID, "t.status": domain.TransferPending, "c.deleted_at": nil}).
+		OrderBy("t.created_at").
+		MustSql()
+
+	var transfers []OwnershipTransfer
+	if err := r.storage.SelectContext(ctx, &transfers, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list ownership transfers: %v", err)
+	}
+
+	res := make([]domain.OwnershipTransfer, len(transfers))
+	for i, transfer := range transfers {
+		res[i] = transfer.ToDomain()
+	}
+	return res, nil
+}
+
+// Меняет владельца курса. Возвращает ErrConflict, если передача уже закрыта
+func (r *courseRepo) AcceptOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args := r.qb.
+		Select("course_id", "from_user_id", "to_user_id", "status").
+		From("course_ownership_transfers").
+		Where(sq.Eq{"transfer_id": transferID}).
+		Suffix("FOR UPDATE").
+		MustSql()
+
+	var transfer struct {
+		CourseID   string `db:"course_id"`
+		FromUserID string `db:"from_user_id"`
+		ToUserID   string `db:"to_user_id"`
+		Status     string `db:"status"`
+	}
+	err = tx.GetContext(ctx, &transfer, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.OwnershipTransfer{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to get ownership transfer: %v", err)
+	}
+	if domain.TransferStatus(transfer.Status) != domain.TransferPending {
+		return domain.OwnershipTransfer{}, domain.ErrConflict
+	}
+
+	// Ожидающие передачи отменяются при любой смене владельца, но курс всё равно
+	// блокируется, чтобы владелец не сменился параллельно
+	owner, err := r.lockOwner(ctx, tx, transfer.CourseID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if owner != transfer.FromUserID {
+		return domain.OwnershipTransfer{}, domain.ErrConflict
+	}
+
+	if err := r.setTransferStatus(ctx, tx, transferID, domain.TransferAccepted); err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if err := r.setOwner(ctx, tx, transfer.CourseID, transfer.FromUserID, transfer.ToUserID); err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetOwnershipTransfer(ctx, transferID)
+}
+
+// Возвращает ErrConflict, если передача уже закрыта
+func (r *courseRepo) DeclineOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error) {
+	query, args := r.qb.
+		Update("course_ownership_transfers").
+		Set("status", domain.TransferDeclined).
+		Set("decided_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"transfer_id": transferID, "status": domain.TransferPending}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return domain.OwnershipTransfer{}, fmt.Errorf("failed to decline ownership transfer: %v", err)
+	}
+
+	transfer, err := r.GetOwnershipTransfer(ctx, transferID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.OwnershipTransfer{}, domain.ErrConflict
+	}
+
+	return transfer, nil
+}
+
+// Блокирует курс до конца транзакции и возвращает текущего владельца
+func (r *courseRepo) lockOwner(ctx context.Context, tx *sqlx.Tx, courseID string) (string, error) {
+	query, args := r.qb.
+		Select("teacher_id").
+		From("courses").
+		Where(sq.Eq{"course_id": courseID}).
+		Suffix("FOR UPDATE").
+		MustSql()
+
+	var owner string
+	err := tx.GetContext(ctx, &owner, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to lock course: %v", err)
+	}
+	return owner, nil
+}
+
+// Принудительная передача сразу создаётся подтверждённой
+func (r *courseRepo) insertTransfer(ctx context.Context, tx *sqlx.Tx, courseID, fromUserID, toUserID string, forced bool) (string, error) {
+	q := r.qb.
+		Insert("course_ownership_transfers").
+		Columns("course_id", "from_user_id", "to_user_id", "status", "forced", "decided_at")
+	if forced {
+		q = q.Values(courseID, fromUserID, toUserID, domain.TransferAccepted, true, sq.Expr("NOW()"))
+	} else {
+		q = q.Values(courseID, fromUserID, toUserID, domain.TransferPending, false, nil)
+	}
+	query, args := q.Suffix("RETURNING transfer_id").MustSql()
+
+	var transferID string
+	err := tx.GetContext(ctx, &transferID, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		if pqErr.Constraint == "course_ownership_transfers_to_user_id_fkey" {
+			return "", domain.ErrUserNotFound
+		}
+		return "", domain.ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create ownership transfer: %v", err)
+	}
+	return transferID, nil
+}
+
+// Делает toUserID владельцем курса. Новый владелец убирается из преподавателей и записанных,
+// прежний становится соавтором, а ожидающие передачи курса отменяются
+func (r *courseRepo) setOwner(ctx context.Context, tx *sqlx.Tx, courseID, fromUserID, toUserID string) error {
+	query, args := r.qb.
+		Update("courses").
+		Set("teacher_id", toUserID).
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update course owner: %v", err)
+	}
+
+	query, args = r.qb.
+		Delete("course_staff").
+		Where(sq.Eq{"course_id": courseID, "user_id": toUserID}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to remove new owner from staff: %v", err)
+	}
+
+	query, args = r.qb.
+		Delete("enrollments").
+		Where(sq.Eq{"course_id": courseID, "student_id": toUserID}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to expel new owner: %v", err)
+	}
+
+	query, args = r.qb.
+		Insert("course_staff").
+		Columns("course_id", "user_id", "role").
+		Values(courseID, fromUserID, domain.RoleCoTeacher).
+		Suffix("ON CONFLICT (course_id, user_id) DO UPDATE SET role = EXCLUDED.role").
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to add previous owner to staff: %v", err)
+	}
+
+	return r.cancelPendingTransfers(ctx, tx, courseID)
+}
+
+func (r *courseRepo) cancelPendingTransfers(ctx context.Context, tx *sqlx.Tx, courseID string) error {
+	query, args := r.qb.
+		Update("course_ownership_transfers").
+		Set("status", domain.TransferCancelled).
+		Set("decided_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"course_id": courseID, "status": domain.TransferPending}).
+		MustSql()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to cancel ownership transfers: %v", err)
+	}
+	return nil
+}
+
+func (r *courseRepo) setTransferStatus(ctx context.Context, tx *sqlx.Tx, transferID string, status domain.TransferStatus) error {
+	query, args := r.qb.
+		Update("course_ownership_transfers").
+		Set("status", status).
+		Set("decided_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"transfer_id": transferID}).
+		MustSql()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update ownership transfer: %v", err)
+	}
+	return nil
+}
+
+func (r *courseRepo) transferQuery() sq.SelectBuilder {
+	return r.qb.
+		Select("t.transfer_id", "t.course_id", "c.title AS course_title", "t.from_user_id", "t.to_user_id", "t.status", "t.forced", "t.created_at", "t.decided_at").
+		From("course_ownership_transfers t").
+		Join("courses c ON c.course_id = t.course_id")
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	pb "Classroom/Courses/pkg/api/courses"
+	"Classroom/Courses/pkg/events"
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CoursesService) ArchiveCourse(ctx context.Context, req *pb.ArchiveCourseRequest) (*pb.ArchiveCourseResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to archive course")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is already archived")
+	}
+
+	course, err = s.repo.Archive(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.FailedPrecondition, "course is already archived")
+	}
+	if err != nil {
+		s.logger.Error("failed to archive course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to archive course")
+	}
+
+	err = s.producer.PublishCourseArchived(events.CourseArchived{
+		CourseID:   course.ID,
+		ArchivedAt: *course.ArchivedAt,
+	})
+	if err != nil {
+		s.logger.Error("failed to publish course archived event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+
+	s.logger.Info("course archived", "id", course.ID, "title", course.Title)
+	return &pb.ArchiveCourseResponse{Course: courseToPb(course)}, nil
+}
+
+// Восстанавливает архивный или удалённый курс. Права удалённого курса не проверить
+// через Authorize, поэтому восстановить курс может только владелец
+func (s *CoursesService) RestoreCourse(ctx context.Context, req *pb.RestoreCourseRequest) (*pb.RestoreCourseResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to restore course")
+	}
+	// Чужой удалённый курс не должен отличаться от несуществующего
+	if course.TeacherID != req.UserId && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if course.TeacherID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the course owner can restore the course")
+	}
+	if !course.Archived() && !course.Deleted() {
+		return nil, status.Error(codes.FailedPrecondition, "course is not archived or deleted")
+	}
+
+	course, err = s.repo.Restore(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to restore course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to restore course")
+	}
+
+	if err := s.producer.PublishCourseRestored(events.CourseRestored{CourseID: course.ID}); err != nil {
+		s.logger.Error("failed to publish course restored event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+
+	s.logger.Info("course restored", "id", course.ID, "title", course.Title)
+	return &pb.RestoreCourseResponse{Course: courseToPb(course)}, nil
+}
+
+// Окончательно удаляет курсы, срок хранения которых истёк. Вызывается по расписанию
+func (s *CoursesService) PurgeDeletedCourses(ctx context.Context) error {
+	ids, err := s.repo.Purge(ctx, time.Now().Add(-s.retention))
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		s.logger.Info("deleted courses purged", "count", len(ids), "ids", ids)
+	}
+
+	// Файлы уроков хранятся вне бд, их удаляет Lessons по этому событию
+	for _, id := range ids {
+		if err := s.producer.PublishCoursePurged(events.CoursePurged{CourseID: id}); err != nil {
+			s.logger.Error("failed to publish course purged event", "course_id", id, "error", err)
+			// не возвращаем ошибку потому что курс уже удалён из бд, фикс будет через логи
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	pb "Classroom/Courses/pkg/api/courses"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCatalogLimit = 20
+	maxTags             = 10
+	maxTagLength        = 32
+)
+
+func (s *CoursesService) SearchCourses(ctx context.Context, req *pb.SearchCoursesRequest) (*pb.SearchCoursesResponse, error) {
+	dto := dto.SearchCoursesDTO{
+		Query:        strings.TrimSpace(req.Query),
+		TeacherID:    req.TeacherId,
+		Category:     req.Category,
+		StartsAfter:  timestampToTime(req.StartsAfter),
+		StartsBefore: timestampToTime(req.StartsBefore),
+		Limit:        req.Limit,
+	}
+	if dto.Limit == 0 {
+		dto.Limit = defaultCatalogLimit
+	}
+	if req.Tag != nil {
+		tag := strings.ToLower(strings.TrimSpace(*req.Tag))
+		dto.Tag = &tag
+	}
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err == nil {
+			err = s.validate.Var(cursor.ID, "uuid")
+		}
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		dto.After = &cursor
+	}
+
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err.Error())
+	}
+
+	page, err := s.repo.Search(ctx, dto)
+	if err != nil {
+		s.logger.Error("failed to search courses", "error", err)
+		return nil, status.Error(codes.Internal, "failed to search courses")
+	}
+
+	courses := make([]*pb.Course, 0, len(page.Courses))
+	for _, course := range page.Courses {
+		courses = append(courses, courseToPb(course))
+	}
+
+	var next string
+	if page.Next != nil {
+		next = encodeCursor(*page.Next)
+	}
+	return &pb.SearchCoursesResponse{Courses: courses, NextCursor: next}, nil
+}
+
+func encodeCursor(cursor domain.CatalogCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (domain.CatalogCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return domain.CatalogCursor{}, err
+	}
+
+	var cursor domain.CatalogCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return domain.CatalogCursor{}, err
+	}
+	if cursor.ID == "" || cursor.CreatedAt.IsZero() {
+		return domain.CatalogCursor{}, fmt.Errorf("incomplete cursor")
+	}
+	return cursor, nil
+}
+
+// Приводит теги к нижнему регистру, убирает пустые и повторы и сортирует
+func normalizeTags(tags []string) ([]string, error) {
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+		if len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+		}
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, fmt.Errorf("course can have at most %d tags", maxTags)
+	}
+
+	slices.Sort(normalized)
+	return normalized, nil
+}
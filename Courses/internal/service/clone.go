@@ -0,0 +1,229 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	pb "Classroom/Courses/pkg/api/courses"
+	"Classroom/Courses/pkg/events"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Задача в running без обновлений дольше этого срока считается брошенной,
+// например после перезапуска сервиса, и забирается повторно
+const cloneJobStaleAfter = 10 * time.Minute
+
+// Создаёт копию курса и ставит задачу копирования уроков и заданий. Записи на курс,
+// заявки, коды приглашения и статусы заданий не копируются
+func (s *CoursesService) CloneCourse(ctx context.Context, req *pb.CloneCourseRequest) (*pb.CloneCourseResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid title")
+	}
+
+	// Копировать можно и архивный курс, так курс прошлого семестра становится новым
+	source, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && source.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to clone course")
+	}
+
+	isTeacher, err := s.repo.IsTeacher(ctx, req.CourseId, req.UserId)
+	if err != nil {
+		s.logger.Error("failed to check teacher", "error", err)
+		return nil, status.Error(codes.Internal, "failed to clone course")
+	}
+	if !isTeacher {
+		return nil, status.Error(codes.PermissionDenied, "only course teachers can clone the course")
+	}
+
+	dto := dto.CreateCourseDTO{
+		TeacherID:   req.UserId,
+		Title:       source.Title,
+		Description: source.Description,
+		Visibility:  source.Visibility,
+		StartTime:   shiftDays(source.StartTime, req.ShiftDays),
+		EndTime:     shiftDays(source.EndTime, req.ShiftDays),
+		Capacity:    source.Capacity,
+		IsTemplate:  req.IsTemplate,
+		// Копия не попадает в каталог, пока владелец сам её не опубликует
+		Category: source.Category,
+		Tags:     source.Tags,
+	}
+	if req.Title != nil {
+		dto.Title = strings.TrimSpace(*req.Title)
+	}
+
+	course, job, err := s.repo.CloneCourse(ctx, source.ID, req.ShiftDays, dto)
+	if err != nil {
+		s.logger.Error("failed to clone course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to clone course")
+	}
+
+	s.logger.Info("course cloned", "source_id", source.ID, "id", course.ID, "job_id", job.ID)
+	return &pb.CloneCourseResponse{Course: courseToPb(course), Job: cloneJobToPb(job)}, nil
+}
+
+func (s *CoursesService) GetCloneJob(ctx context.Context, req *pb.GetCloneJobRequest) (*pb.GetCloneJobResponse, error) {
+	if err := s.validate.Var(req.JobId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	job, err := s.repo.GetCloneJob(ctx, req.JobId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && job.UserID != req.UserId {
+		return nil, status.Error(codes.NotFound, "clone job not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get clone job", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get clone job")
+	}
+
+	return &pb.GetCloneJobResponse{Job: cloneJobToPb(job)}, nil
+}
+
+// Копирование уроков и заданий в Lessons и Tasks
+type ContentCloner interface {
+	// Заменяет уроки целевого курса, поэтому повторный вызов не создаёт дубликатов.
+	// Время публикации запланированных уроков сдвигается на shiftDays дней
+	CloneLessons(ctx context.Context, sourceCourseID, targetCourseID string, shiftDays int32) (int32, error)
+	// Заменяет задания целевого курса, поэтому повторный вызов не создаёт дубликатов
+	CloneTasks(ctx context.Context, sourceCourseID, targetCourseID string) (int32, error)
+}
+
+// CloneWorker выполняет задачи копирования, созданные CloneCourse. Курс уже создан
+// в Courses, worker копирует уроки и задания, а при ошибке удаляет новый курс
+type CloneWorker struct {
+	logger   *slog.Logger
+	repo     CourseRepo
+	producer Producer
+	content  ContentCloner
+	// Сколько удалённый курс хранится до окончательного удаления
+	retention time.Duration
+}
+
+func NewCloneWorker(logger *slog.Logger, repo CourseRepo, producer Producer, content ContentCloner, retention time.Duration) *CloneWorker {
+	return &CloneWorker{logger: logger, repo: repo, producer: producer, content: content, retention: retention}
+}
+
+// Выполняет задачи, пока они есть. Вызывается по расписанию
+func (w *CloneWorker) ProcessCloneJobs(ctx context.Context) error {
+	for {
+		job, err := w.repo.ClaimCloneJob(ctx, time.Now().Add(-cloneJobStaleAfter))
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		w.run(ctx, job)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *CloneWorker) run(ctx context.Context, job domain.CloneJob) {
+	w.logger.Info("clone job started", "job_id", job.ID, "source_id", job.SourceCourseID, "target_id", job.TargetCourseID)
+
+	lessons, err := w.content.CloneLessons(ctx, job.SourceCourseID, job.TargetCourseID, job.ShiftDays)
+	if err != nil {
+		w.fail(ctx, job, "failed to clone lessons", err)
+		return
+	}
+	job.LessonsCopied = &lessons
+	if err := w.repo.UpdateCloneJob(ctx, job); err != nil {
+		w.logger.Error("failed to update clone job", "job_id", job.ID, "error", err)
+	}
+
+	tasks, err := w.content.CloneTasks(ctx, job.SourceCourseID, job.TargetCourseID)
+	if err != nil {
+		w.fail(ctx, job, "failed to clone tasks", err)
+		return
+	}
+	job.TasksCopied = &tasks
+	job.Status = domain.CloneCompleted
+	if err := w.repo.UpdateCloneJob(ctx, job); err != nil {
+		// Задача останется в running и будет выполнена повторно, копирование идемпотентно
+		w.logger.Error("failed to update clone job", "job_id", job.ID, "error", err)
+		return
+	}
+
+	w.logger.Info("clone job completed", "job_id", job.ID, "lessons", lessons, "tasks", tasks)
+}
+
+// Помечает задачу неудачной и удаляет новый курс так же, как DeleteCourse,
+// чтобы в списках не оставался курс без части уроков и заданий
+func (w *CloneWorker) fail(ctx context.Context, job domain.CloneJob, reason string, err error) {
+	if ctx.Err() != nil {
+		// Сервис останавливается, задача останется в running и будет забрана повторно
+		return
+	}
+	w.logger.Error("clone job failed", "job_id", job.ID, "reason", reason, "error", err)
+
+	job.Status = domain.CloneFailed
+	job.Error = &reason
+	if err := w.repo.UpdateCloneJob(ctx, job); err != nil {
+		w.logger.Error("failed to update clone job", "job_id", job.ID, "error", err)
+	}
+
+	course, err := w.repo.Delete(ctx, job.TargetCourseID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		w.logger.Error("failed to delete cloned course", "job_id", job.ID, "course_id", job.TargetCourseID, "error", err)
+		return
+	}
+
+	err = w.producer.PublishCourseDeleted(events.CourseDeleted{
+		CourseID:  course.ID,
+		DeletedAt: *course.DeletedAt,
+		PurgeAt:   course.DeletedAt.Add(w.retention),
+	})
+	if err != nil {
+		w.logger.Error("failed to publish course deleted event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+}
+
+func shiftDays(t *time.Time, days int32) *time.Time {
+	if t == nil {
+		return nil
+	}
+	shifted := t.AddDate(0, 0, int(days))
+	return &shifted
+}
+
+func cloneJobToPb(j domain.CloneJob) *pb.CloneJob {
+	return &pb.CloneJob{
+		JobId:          j.ID,
+		SourceCourseId: j.SourceCourseID,
+		TargetCourseId: j.TargetCourseID,
+		UserId:         j.UserID,
+		Status:         string(j.Status),
+		LessonsCopied:  j.LessonsCopied,
+		TasksCopied:    j.TasksCopied,
+		Error:          j.Error,
+		CreatedAt:      timestamppb.New(j.CreatedAt),
+		UpdatedAt:      timestamppb.New(j.UpdatedAt),
+	}
+}
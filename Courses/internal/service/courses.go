@@ -21,23 +21,105 @@ type CourseRepo interface {
 	Create(ctx context.Context, dto dto.CreateCourseDTO) (domain.Course, error)
 	GetByID(ctx context.Context, courseID string) (domain.Course, error)
 	Update(ctx context.Context, dto dto.UpdateCourseDTO) (domain.Course, error)
+	// Помечает курс удалённым, окончательно курс удаляет Purge
 	Delete(ctx context.Context, courseID string) (domain.Course, error)
+	// Возвращает ErrNotFound, если курс уже в архиве или удалён
+	Archive(ctx context.Context, courseID string) (domain.Course, error)
+	Restore(ctx context.Context, courseID string) (domain.Course, error)
+	// Возвращает ID окончательно удалённых курсов
+	Purge(ctx context.Context, before time.Time) ([]string, error)
 
 	// Делает фильтрацию по visibility, start_time, end_time и teacher_id
-	ListByStudentID(ctx context.Context, teacherID string) ([]domain.Course, error)
-	ListByTeacherID(ctx context.Context, teacherID string) ([]domain.Course, error)
+	ListByStudentID(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error)
+	ListByTeacherID(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error)
 
 	ListCourseStudents(ctx context.Context, courseID string, index, limit int32) ([]domain.Student, int32, error)
-	EnrollUser(ctx context.Context, courseID, studentID string) (domain.Enrollment, error)
+	EnrollUser(ctx context.Context, courseID, studentID string, role domain.Role) (domain.Enrollment, error)
 	ExpelUser(ctx context.Context, courseID, studentID string) (domain.Enrollment, error)
 
 	IsTeacher(ctx context.Context, courseID, teacherID string) (bool, error)
 	IsMember(ctx context.Context, courseID, userID string) (bool, error)
+
+	// Возвращает ErrNotFound, если пользователь не участник курса
+	GetRole(ctx context.Context, courseID, userID string) (domain.Role, error)
+	ListRolePermissions(ctx context.Context, courseID string, role domain.Role) ([]domain.Permission, error)
+	ListCoursePermissions(ctx context.Context, courseID string) ([]domain.RolePermissions, error)
+	SetRolePermissions(ctx context.Context, courseID string, role domain.Role, permissions []domain.Permission) error
+
+	// Возвращает ErrNotFound, если курса нет, и ErrUserNotFound, если нет пользователя
+	AddStaff(ctx context.Context, courseID, userID string, role domain.Role) (domain.Staff, error)
+	RemoveStaff(ctx context.Context, courseID, userID string) (domain.Staff, error)
+	ListStaff(ctx context.Context, courseID string) ([]domain.Staff, error)
+
+	// Возвращает ErrNotFound, если курса нет, и ErrConflict, если код уже занят
+	CreateInvite(ctx context.Context, dto dto.CreateInviteDTO) (domain.Invite, error)
+	ListInvites(ctx context.Context, courseID string) ([]domain.Invite, error)
+	GetInviteByCode(ctx context.Context, code string) (domain.Invite, error)
+	RevokeInvite(ctx context.Context, courseID, inviteID string) (domain.Invite, error)
+	// Записывает пользователя по коду с учётом мест на курсе. Возвращает ErrNotFound, если код
+	// отозван, истёк или исчерпан, и ErrCourseFull, если мест нет
+	JoinByInvite(ctx context.Context, inviteID, userID string) (domain.Enrollment, error)
+
+	// Возвращает ErrNotFound, если курса нет, и ErrConflict, если незакрытая заявка уже есть
+	CreateEnrollmentRequest(ctx context.Context, courseID, userID string) (domain.EnrollmentRequest, error)
+	ListEnrollmentRequests(ctx context.Context, courseID string, status domain.RequestStatus) ([]domain.EnrollmentRequest, error)
+	GetEnrollmentRequest(ctx context.Context, courseID, requestID string) (domain.EnrollmentRequest, error)
+	// Записывает пользователя или переводит заявку в лист ожидания, если мест нет.
+	// Возвращает ErrConflict, если заявка уже закрыта
+	ApproveEnrollmentRequest(ctx context.Context, courseID, requestID, decidedBy string) (domain.EnrollmentRequest, error)
+	RejectEnrollmentRequest(ctx context.Context, courseID, requestID, decidedBy string) (domain.EnrollmentRequest, error)
+	// Записывает пользователей из листа ожидания на свободные места
+	PromoteWaitlist(ctx context.Context, courseID string) ([]domain.EnrollmentRequest, error)
+
+	// emails должны быть в нижнем регистре
+	ListUsersByEmails(ctx context.Context, emails []string) ([]domain.Student, error)
+	// Возвращает ID только что записанных, уже записанные и преподаватели пропускаются
+	// Записывает пользователей на свободные места, возвращает записанных и тех, кому не хватило мест
+	BulkEnroll(ctx context.Context, courseID string, userIDs []string, role domain.Role) (enrolled, full []string, err error)
+	ListRoster(ctx context.Context, courseID string) ([]domain.RosterEntry, error)
+
+	// Ищет курсы каталога, страница начинается после dto.After
+	Search(ctx context.Context, dto dto.SearchCoursesDTO) (domain.CatalogPage, error)
+
+	// Возвращает ErrNotFound, если курса или модуля нет
+	CreateModule(ctx context.Context, courseID, title string) (domain.Module, error)
+	UpdateModule(ctx context.Context, courseID, moduleID, title string) (domain.Module, error)
+	// Следующие модули сдвигаются на место удалённого
+	DeleteModule(ctx context.Context, courseID, moduleID string) (domain.Module, error)
+	ListModules(ctx context.Context, courseID string) ([]domain.Module, error)
+	// Возвращает все модули курса в новом порядке
+	ReorderModule(ctx context.Context, courseID, moduleID string, position int32) ([]domain.Module, error)
+
+	// Возвращает ErrNotFound, если курса нет, ErrUserNotFound, если нет нового владельца,
+	// и ErrConflict, если владелец курса уже сменился
+	CreateOwnershipTransfer(ctx context.Context, courseID, fromUserID, toUserID string) (domain.OwnershipTransfer, error)
+	// Сразу меняет владельца, ErrConflict означает, что пользователь уже владелец
+	ForceTransferOwnership(ctx context.Context, courseID, toUserID string) (domain.OwnershipTransfer, error)
+	GetOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error)
+	// Возвращает ожидающие подтверждения передачи пользователю
+	ListOwnershipTransfers(ctx context.Context, toUserID string) ([]domain.OwnershipTransfer, error)
+	// Возвращает ErrConflict, если передача уже закрыта
+	AcceptOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error)
+	DeclineOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error)
+
+	// Создаёт курс с наборами прав исходного курса и задачу копирования в одной транзакции
+	CloneCourse(ctx context.Context, sourceCourseID string, shiftDays int32, dto dto.CreateCourseDTO) (domain.Course, domain.CloneJob, error)
+	GetCloneJob(ctx context.Context, jobID string) (domain.CloneJob, error)
+	// Возвращает ErrNotFound, если задач для выполнения нет
+	ClaimCloneJob(ctx context.Context, staleBefore time.Time) (domain.CloneJob, error)
+	UpdateCloneJob(ctx context.Context, job domain.CloneJob) error
 }
 
 type Producer interface {
 	PublishUserEnrolled(event events.UserEnrolled) error
 	PublishUserExpelled(event events.UserExpelled) error
+	PublishEnrollmentRequestUpdated(event events.EnrollmentRequestUpdated) error
+	PublishUserInvited(event events.UserInvited) error
+	PublishCourseArchived(event events.CourseArchived) error
+	PublishCourseDeleted(event events.CourseDeleted) error
+	PublishCourseRestored(event events.CourseRestored) error
+	PublishCoursePurged(event events.CoursePurged) error
+	PublishCourseOwnershipTransferred(event events.CourseOwnershipTransferred) error
 }
 
 type CoursesService struct {
@@ -46,11 +128,13 @@ type CoursesService struct {
 	validate *validator.Validate
 	repo     CourseRepo
 	producer Producer
+	// Сколько удалённый курс хранится до окончательного удаления
+	retention time.Duration
 }
 
-func NewCoursesService(logger *slog.Logger, repo CourseRepo, producer Producer) *CoursesService {
+func NewCoursesService(logger *slog.Logger, repo CourseRepo, producer Producer, retention time.Duration) *CoursesService {
 	validate := validator.New()
-	return &CoursesService{repo: repo, logger: logger, validate: validate, producer: producer}
+	return &CoursesService{repo: repo, logger: logger, validate: validate, producer: producer, retention: retention}
 }
 
 func (s *CoursesService) CreateCourse(ctx context.Context, req *pb.CreateCourseRequest) (*pb.CreateCourseResponse, error) {
@@ -61,11 +145,20 @@ func (s *CoursesService) CreateCourse(ctx context.Context, req *pb.CreateCourseR
 		Visibility:  req.Visibility,
 		StartTime:   timestampToTime(req.StartTime),
 		EndTime:     timestampToTime(req.EndTime),
+		Capacity:    req.Capacity,
+		IsTemplate:  req.IsTemplate,
+		Listed:      req.Listed,
+		Category:    req.Category,
 	}
 
 	if err := s.validate.Struct(dto); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err.Error())
 	}
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err.Error())
+	}
+	dto.Tags = tags
 
 	course, err := s.repo.Create(ctx, dto)
 	if err != nil {
@@ -86,7 +179,7 @@ func (s *CoursesService) GetCourse(ctx context.Context, req *pb.GetCourseRequest
 	}
 
 	course, err := s.repo.GetByID(ctx, req.CourseId)
-	if errors.Is(err, domain.ErrNotFound) {
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
 		return nil, status.Error(codes.NotFound, "course not found")
 	}
 	if err != nil {
@@ -97,6 +190,15 @@ func (s *CoursesService) GetCourse(ctx context.Context, req *pb.GetCourseRequest
 	if course.TeacherID == req.UserId {
 		return &pb.GetCourseResponse{Course: courseToPb(course)}, nil
 	}
+	// Преподаватели из course_staff видят курс так же, как владелец
+	isTeacher, err := s.repo.IsTeacher(ctx, req.CourseId, req.UserId)
+	if err != nil {
+		s.logger.Error("failed to check teacher", "error", err)
+		return nil, status.Error(codes.Internal, "failed to check teacher")
+	}
+	if isTeacher {
+		return &pb.GetCourseResponse{Course: courseToPb(course)}, nil
+	}
 	if !course.Visibility {
 		return nil, status.Error(codes.NotFound, "course is hidden")
 	}
@@ -118,13 +220,37 @@ func (s *CoursesService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseR
 		Visibility:  req.Visibility,
 		StartTime:   timestampToTime(req.StartTime),
 		EndTime:     timestampToTime(req.EndTime),
+		Capacity:    req.Capacity,
+		IsTemplate:  req.IsTemplate,
+		Listed:      req.Listed,
+		Category:    req.Category,
 	}
 
 	if err := s.validate.Struct(dto); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err.Error())
 	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(req.Tags.Values)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err.Error())
+		}
+		dto.Tags = &tags
+	}
 
-	course, err := s.repo.Update(ctx, dto)
+	course, err := s.repo.GetByID(ctx, dto.ID)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update course")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	previous := course
+
+	course, err = s.repo.Update(ctx, dto)
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "course not found")
 	}
@@ -134,9 +260,30 @@ func (s *CoursesService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseR
 	}
 
 	s.logger.Info("course updated", "id", course.ID, "title", course.Title)
+
+	// Появились свободные места, записываем пользователей из листа ожидания. Ошибка не
+	// отменяет изменение курса, лист ожидания продвинется при следующем освобождении места
+	if capacityRaised(previous.Capacity, dto.Capacity) {
+		promoted, err := s.repo.PromoteWaitlist(ctx, course.ID)
+		if err != nil {
+			s.logger.Error("failed to promote waitlist", "course_id", course.ID, "error", err)
+		}
+		for _, request := range promoted {
+			s.publishRequestApproved(request)
+		}
+	}
+
 	return &pb.UpdateCourseResponse{Course: courseToPb(course)}, nil
 }
 
+// Новое ограничение мест больше прежнего или снято (0 в UpdateCourse)
+func capacityRaised(previous, updated *int32) bool {
+	if previous == nil || updated == nil {
+		return false
+	}
+	return *updated == 0 || *updated > *previous
+}
+
 func (s *CoursesService) DeleteCourse(ctx context.Context, req *pb.DeleteCourseRequest) (*pb.DeleteCourseResponse, error) {
 	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid course id")
@@ -151,6 +298,16 @@ func (s *CoursesService) DeleteCourse(ctx context.Context, req *pb.DeleteCourseR
 		return nil, status.Error(codes.Internal, "failed to delete course")
 	}
 
+	err = s.producer.PublishCourseDeleted(events.CourseDeleted{
+		CourseID:  course.ID,
+		DeletedAt: *course.DeletedAt,
+		PurgeAt:   course.DeletedAt.Add(s.retention),
+	})
+	if err != nil {
+		s.logger.Error("failed to publish course deleted event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+
 	s.logger.Info("course deleted", "id", course.ID, "title", course.Title)
 	return &pb.DeleteCourseResponse{Course: courseToPb(course)}, nil
 }
@@ -162,7 +319,36 @@ func (s *CoursesService) EnrollUser(ctx context.Context, req *pb.EnrollUserReque
 	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid user id")
 	}
-	enrollment, err := s.repo.EnrollUser(ctx, req.CourseId, req.UserId)
+	role := domain.Role(req.Role)
+	if role == "" {
+		role = domain.RoleStudent
+	}
+	if role != domain.RoleStudent && role != domain.RoleAuditor {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to enroll user")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	if course.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "course is a template")
+	}
+
+	enrollment, err := s.repo.EnrollUser(ctx, req.CourseId, req.UserId, role)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if errors.Is(err, domain.ErrCourseFull) {
+		return nil, status.Error(codes.FailedPrecondition, "course is full")
+	}
 	if err != nil {
 		s.logger.Error("failed to enroll user", "error", err)
 		return nil, status.Error(codes.Internal, "failed to enroll user")
@@ -178,14 +364,8 @@ func (s *CoursesService) EnrollUser(ctx context.Context, req *pb.EnrollUserReque
 		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
 	}
 
-	s.logger.Info("user enrolled", "course_id", enrollment.CourseID, "student_id", enrollment.StudentID)
-	return &pb.EnrollUserResponse{
-		Enrollment: &pb.Enrollment{
-			CourseId:   enrollment.CourseID,
-			StudentId:  enrollment.StudentID,
-			EnrolledAt: timestamppb.New(enrollment.EnrolledAt),
-		},
-	}, nil
+	s.logger.Info("user enrolled", "course_id", enrollment.CourseID, "student_id", enrollment.StudentID, "role", enrollment.Role)
+	return &pb.EnrollUserResponse{Enrollment: enrollmentToPb(enrollment)}, nil
 }
 
 func (s *CoursesService) ExpelUser(ctx context.Context, req *pb.ExpelUserRequest) (*pb.ExpelUserResponse, error) {
@@ -216,13 +396,20 @@ func (s *CoursesService) ExpelUser(ctx context.Context, req *pb.ExpelUserRequest
 	}
 
 	s.logger.Info("user expelled", "course_id", enrollment.CourseID, "student_id", enrollment.StudentID)
-	return &pb.ExpelUserResponse{
-		Enrollment: &pb.Enrollment{
-			CourseId:   enrollment.CourseID,
-			StudentId:  enrollment.StudentID,
-			EnrolledAt: timestamppb.New(enrollment.EnrolledAt),
-		},
-	}, nil
+
+	// Освободилось место, записываем пользователей из листа ожидания. Ошибка не отменяет
+	// отчисление, лист ожидания продвинется при следующем отчислении
+	promoted, err := s.repo.PromoteWaitlist(ctx, enrollment.CourseID)
+	if err != nil {
+		s.logger.Error("failed to promote waitlist", "course_id", enrollment.CourseID, "error", err)
+	}
+	pbPromoted := make([]*pb.EnrollmentRequest, len(promoted))
+	for i, request := range promoted {
+		s.publishRequestApproved(request)
+		pbPromoted[i] = enrollmentRequestToPb(request)
+	}
+
+	return &pb.ExpelUserResponse{Enrollment: enrollmentToPb(enrollment), Promoted: pbPromoted}, nil
 }
 
 func (s *CoursesService) IsTeacher(ctx context.Context, req *pb.IsTeacherRequest) (*pb.IsTeacherResponse, error) {
@@ -272,13 +459,13 @@ func (s *CoursesService) GetCourses(ctx context.Context, req *pb.GetCoursesReque
 	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid user id")
 	}
-	studentCourses, err := s.repo.ListByStudentID(ctx, req.UserId)
+	studentCourses, err := s.repo.ListByStudentID(ctx, req.UserId, req.IncludeArchived)
 	if err != nil {
 		s.logger.Error("failed to get courses by student", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get courses by student")
 	}
 
-	teacherCourses, err := s.repo.ListByTeacherID(ctx, req.UserId)
+	teacherCourses, err := s.repo.ListByTeacherID(ctx, req.UserId, req.IncludeArchived)
 	if err != nil {
 		s.logger.Error("failed to get courses by teacher", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get courses by teacher")
@@ -306,7 +493,7 @@ func (s *CoursesService) GetCoursesByStudent(ctx context.Context, req *pb.GetCou
 	if err := s.validate.Var(req.StudentId, "required,uuid"); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid student id")
 	}
-	courses, err := s.repo.ListByStudentID(ctx, req.StudentId)
+	courses, err := s.repo.ListByStudentID(ctx, req.StudentId, req.IncludeArchived)
 	if err != nil {
 		s.logger.Error("failed to get courses by student", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get courses by student")
@@ -324,7 +511,7 @@ func (s *CoursesService) GetCoursesByTeacher(ctx context.Context, req *pb.GetCou
 	if err := s.validate.Var(req.TeacherId, "required,uuid"); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid teacher id")
 	}
-	courses, err := s.repo.ListByTeacherID(ctx, req.TeacherId)
+	courses, err := s.repo.ListByTeacherID(ctx, req.TeacherId, req.IncludeArchived)
 	if err != nil {
 		s.logger.Error("failed to get courses by teacher", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get courses by teacher")
@@ -371,6 +558,22 @@ func courseToPb(c domain.Course) *pb.Course {
 		StartTime:   timeToTimestamp(c.StartTime),
 		EndTime:     timeToTimestamp(c.EndTime),
 		CreatedAt:   timestamppb.New(c.CreatedAt),
+		Capacity:    c.Capacity,
+		ArchivedAt:  timeToTimestamp(c.ArchivedAt),
+		DeletedAt:   timeToTimestamp(c.DeletedAt),
+		IsTemplate:  c.IsTemplate,
+		Listed:      c.Listed,
+		Category:    c.Category,
+		Tags:        c.Tags,
+	}
+}
+
+func enrollmentToPb(e domain.Enrollment) *pb.Enrollment {
+	return &pb.Enrollment{
+		CourseId:   e.CourseID,
+		StudentId:  e.StudentID,
+		EnrolledAt: timestamppb.New(e.EnrolledAt),
+		Role:       string(e.Role),
 	}
 }
 
@@ -8,6 +8,8 @@ import (
 	pb "Classroom/Courses/pkg/api/courses"
 	"Classroom/Courses/pkg/events"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"testing"
@@ -71,7 +73,7 @@ func TestCoursesService_CreateCourse(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
-			svc := service.NewCoursesService(slog.Default(), repo, nil)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
 			tc.mockBehavior(repo, tc.req)
 			got, err := svc.CreateCourse(context.Background(), tc.req)
 
@@ -118,6 +120,38 @@ func TestCoursesService_GetCourse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "success - hidden course for staff",
+			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.GetCourseRequest) {
+				svc.EXPECT().GetByID(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: req.CourseId, TeacherID: "owner-id", Visibility: false, CreatedAt: now}, nil)
+				svc.EXPECT().IsTeacher(mock.Anything, req.CourseId, req.UserId).Return(true, nil)
+			},
+			req: &pb.GetCourseRequest{
+				CourseId: courseID,
+				UserId:   userID,
+			},
+			want: &pb.GetCourseResponse{
+				Course: &pb.Course{
+					CourseId:  courseID,
+					TeacherId: "owner-id",
+					CreatedAt: timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name: "hidden course for student",
+			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.GetCourseRequest) {
+				svc.EXPECT().GetByID(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: req.CourseId, TeacherID: "owner-id", Visibility: false, CreatedAt: now}, nil)
+				svc.EXPECT().IsTeacher(mock.Anything, req.CourseId, req.UserId).Return(false, nil)
+			},
+			req: &pb.GetCourseRequest{
+				CourseId: courseID,
+				UserId:   userID,
+			},
+			wantErr: status.Error(codes.NotFound, "course is hidden"),
+		},
 		{
 			name: "course not found",
 			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.GetCourseRequest) {
@@ -153,7 +187,7 @@ func TestCoursesService_GetCourse(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
-			svc := service.NewCoursesService(slog.Default(), repo, nil)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
 			tc.mockBehavior(repo, tc.req)
 			got, err := svc.GetCourse(context.Background(), tc.req)
 
@@ -168,7 +202,14 @@ func TestCoursesService_GetCourse(t *testing.T) {
 	}
 }
 func TestCoursesService_UpdateCourse(t *testing.T) {
-	type MockBehavior func(svc *mocks.MockCourseRepo, req *pb.UpdateCourseRequest)
+	type MockBehavior func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.UpdateCourseRequest)
+
+	now := time.Now()
+	courseID := uuid.NewString()
+	capacity, raised, unlimited := int32(10), int32(20), int32(0)
+	title := "Algebra"
+	course := domain.Course{ID: courseID, Title: "Math", Capacity: &capacity, CreatedAt: now}
+	waitlisted := domain.EnrollmentRequest{ID: uuid.NewString(), CourseID: courseID, UserID: uuid.NewString(), Status: domain.RequestApproved}
 
 	testCases := []struct {
 		name         string
@@ -176,13 +217,58 @@ func TestCoursesService_UpdateCourse(t *testing.T) {
 		req          *pb.UpdateCourseRequest
 		want         *pb.UpdateCourseResponse
 		wantErr      error
-	}{}
+	}{
+		{
+			name: "success - raised capacity promotes waitlist",
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.UpdateCourseRequest) {
+				updated := course
+				updated.Capacity = &raised
+				svc.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+				svc.EXPECT().Update(mock.Anything, dto.UpdateCourseDTO{ID: courseID, Capacity: &raised}).Return(updated, nil)
+				svc.EXPECT().PromoteWaitlist(mock.Anything, courseID).Return([]domain.EnrollmentRequest{waitlisted}, nil)
+				pr.EXPECT().PublishEnrollmentRequestUpdated(mock.Anything).Return(nil)
+				pr.EXPECT().PublishUserEnrolled(events.UserEnrolled{CourseID: courseID, UserID: waitlisted.UserID}).Return(nil)
+			},
+			req: &pb.UpdateCourseRequest{CourseId: courseID, Capacity: &raised},
+			want: &pb.UpdateCourseResponse{
+				Course: &pb.Course{CourseId: courseID, Title: "Math", Capacity: &raised, CreatedAt: timestamppb.New(now)},
+			},
+		},
+		{
+			name: "success - removed capacity promotes waitlist",
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.UpdateCourseRequest) {
+				updated := course
+				updated.Capacity = nil
+				svc.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+				svc.EXPECT().Update(mock.Anything, dto.UpdateCourseDTO{ID: courseID, Capacity: &unlimited}).Return(updated, nil)
+				svc.EXPECT().PromoteWaitlist(mock.Anything, courseID).Return(nil, nil)
+			},
+			req: &pb.UpdateCourseRequest{CourseId: courseID, Capacity: &unlimited},
+			want: &pb.UpdateCourseResponse{
+				Course: &pb.Course{CourseId: courseID, Title: "Math", CreatedAt: timestamppb.New(now)},
+			},
+		},
+		{
+			name: "success - capacity unchanged",
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.UpdateCourseRequest) {
+				updated := course
+				updated.Title = title
+				svc.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+				svc.EXPECT().Update(mock.Anything, dto.UpdateCourseDTO{ID: courseID, Title: &title}).Return(updated, nil)
+			},
+			req: &pb.UpdateCourseRequest{CourseId: courseID, Title: &title},
+			want: &pb.UpdateCourseResponse{
+				Course: &pb.Course{CourseId: courseID, Title: "Algebra", Capacity: &capacity, CreatedAt: timestamppb.New(now)},
+			},
+		},
+	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
-			svc := service.NewCoursesService(slog.Default(), repo, nil)
-			tc.mockBehavior(repo, tc.req)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
 			got, err := svc.UpdateCourse(context.Background(), tc.req)
 
 			if tc.wantErr != nil {
@@ -196,7 +282,7 @@ func TestCoursesService_UpdateCourse(t *testing.T) {
 }
 
 func TestCoursesService_DeleteCourse(t *testing.T) {
-	type MockBehavior func(svc *mocks.MockCourseRepo, req *pb.DeleteCourseRequest)
+	type MockBehavior func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.DeleteCourseRequest)
 
 	now := time.Now()
 	courseID := uuid.NewString()
@@ -211,7 +297,7 @@ func TestCoursesService_DeleteCourse(t *testing.T) {
 	}{
 		{
 			name: "success - course deleted",
-			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.DeleteCourseRequest) {
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.DeleteCourseRequest) {
 				svc.EXPECT().Delete(mock.Anything, req.CourseId).
 					Return(domain.Course{
 						ID:          courseID,
@@ -220,7 +306,13 @@ func TestCoursesService_DeleteCourse(t *testing.T) {
 						Description: "course description",
 						Visibility:  true,
 						CreatedAt:   now,
+						DeletedAt:   &now,
 					}, nil)
+				pr.EXPECT().PublishCourseDeleted(events.CourseDeleted{
+					CourseID:  courseID,
+					DeletedAt: now,
+					PurgeAt:   now.Add(time.Hour),
+				}).Return(nil)
 			},
 			req: &pb.DeleteCourseRequest{
 				CourseId: courseID,
@@ -233,12 +325,13 @@ func TestCoursesService_DeleteCourse(t *testing.T) {
 					Description: "course description",
 					Visibility:  true,
 					CreatedAt:   timestamppb.New(now),
+					DeletedAt:   timestamppb.New(now),
 				},
 			},
 		},
 		{
 			name: "error - course not found",
-			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.DeleteCourseRequest) {
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.DeleteCourseRequest) {
 				svc.EXPECT().Delete(mock.Anything, req.CourseId).
 					Return(domain.Course{}, domain.ErrNotFound)
 			},
@@ -249,7 +342,7 @@ func TestCoursesService_DeleteCourse(t *testing.T) {
 		},
 		{
 			name:         "error - invalid course id",
-			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.DeleteCourseRequest) {},
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.DeleteCourseRequest) {},
 			req: &pb.DeleteCourseRequest{
 				CourseId: "invalid-uuid",
 			},
@@ -257,7 +350,7 @@ func TestCoursesService_DeleteCourse(t *testing.T) {
 		},
 		{
 			name: "error - internal server error",
-			mockBehavior: func(svc *mocks.MockCourseRepo, req *pb.DeleteCourseRequest) {
+			mockBehavior: func(svc *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.DeleteCourseRequest) {
 				svc.EXPECT().Delete(mock.Anything, req.CourseId).
 					Return(domain.Course{}, errors.New("internal error"))
 			},
@@ -271,8 +364,9 @@ func TestCoursesService_DeleteCourse(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
-			svc := service.NewCoursesService(slog.Default(), repo, nil)
-			tc.mockBehavior(repo, tc.req)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
 			got, err := svc.DeleteCourse(context.Background(), tc.req)
 
 			if tc.wantErr != nil {
@@ -303,10 +397,12 @@ func TestCoursesService_EnrollUser(t *testing.T) {
 		{
 			name: "success - user enrolled",
 			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {
-				repo.EXPECT().EnrollUser(mock.Anything, req.CourseId, req.UserId).
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().EnrollUser(mock.Anything, req.CourseId, req.UserId, domain.RoleStudent).
 					Return(domain.Enrollment{
 						CourseID:   req.CourseId,
 						StudentID:  req.UserId,
+						Role:       domain.RoleStudent,
 						EnrolledAt: now,
 					}, nil)
 
@@ -324,9 +420,55 @@ func TestCoursesService_EnrollUser(t *testing.T) {
 					CourseId:   courseID,
 					StudentId:  studentID,
 					EnrolledAt: timestamppb.New(now),
+					Role:       "student",
 				},
 			},
 		},
+		{
+			name: "error - course is full",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().EnrollUser(mock.Anything, req.CourseId, req.UserId, domain.RoleStudent).
+					Return(domain.Enrollment{}, domain.ErrCourseFull)
+			},
+			req: &pb.EnrollUserRequest{
+				CourseId: courseID,
+				UserId:   studentID,
+			},
+			wantErr: status.Error(codes.FailedPrecondition, "course is full"),
+		},
+		{
+			name: "error - course is a template",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, IsTemplate: true}, nil)
+			},
+			req: &pb.EnrollUserRequest{
+				CourseId: courseID,
+				UserId:   studentID,
+			},
+			wantErr: status.Error(codes.FailedPrecondition, "course is a template"),
+		},
+		{
+			name: "error - course archived",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, ArchivedAt: &now}, nil)
+			},
+			req: &pb.EnrollUserRequest{
+				CourseId: courseID,
+				UserId:   studentID,
+			},
+			wantErr: status.Error(codes.FailedPrecondition, "course is archived"),
+		},
+		{
+			name:         "error - invalid role",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {},
+			req: &pb.EnrollUserRequest{
+				CourseId: courseID,
+				UserId:   studentID,
+				Role:     "owner",
+			},
+			wantErr: status.Error(codes.InvalidArgument, "invalid role"),
+		},
 		{
 			name:         "error - invalid course id",
 			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {},
@@ -348,7 +490,8 @@ func TestCoursesService_EnrollUser(t *testing.T) {
 		{
 			name: "error - internal server error",
 			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.EnrollUserRequest) {
-				repo.EXPECT().EnrollUser(mock.Anything, req.CourseId, req.UserId).
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().EnrollUser(mock.Anything, req.CourseId, req.UserId, domain.RoleStudent).
 					Return(domain.Enrollment{}, errors.New("internal error"))
 			},
 			req: &pb.EnrollUserRequest{
@@ -363,7 +506,7 @@ func TestCoursesService_EnrollUser(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
 			pr := mocks.NewMockProducer(t)
-			svc := service.NewCoursesService(slog.Default(), repo, pr)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
 			tc.mockBehavior(repo, pr, tc.req)
 			got, err := svc.EnrollUser(context.Background(), tc.req)
 
@@ -455,7 +598,7 @@ func TestCoursesService_IsTeacher(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
-			svc := service.NewCoursesService(slog.Default(), repo, nil)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
 			tc.mockBehavior(repo, tc.req)
 			got, err := svc.IsTeacher(context.Background(), tc.req)
 
@@ -580,7 +723,7 @@ func TestCoursesService_IsMember(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := mocks.NewMockCourseRepo(t)
-			svc := service.NewCoursesService(slog.Default(), repo, nil)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
 			tc.mockBehavior(repo, tc.req)
 			got, err := svc.IsMember(context.Background(), tc.req)
 
@@ -594,3 +737,1264 @@ func TestCoursesService_IsMember(t *testing.T) {
 		})
 	}
 }
+
+func TestCoursesService_Authorize(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest)
+
+	now := time.Now()
+	courseID := uuid.NewString()
+	userID := uuid.NewString()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.AuthorizeRequest
+		want         *pb.AuthorizeResponse
+		wantErr      error
+	}{
+		{
+			name: "owner has every permission",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return(domain.RoleOwner, nil)
+			},
+			req: &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "course.delete"},
+			want: &pb.AuthorizeResponse{
+				Allowed: true,
+				Role:    "owner",
+				Permissions: []string{
+					"course.read", "course.update", "course.delete", "members.read", "members.manage", "roles.manage",
+					"lessons.read", "lessons.read_drafts", "lessons.write", "lessons.delete", "tasks.read", "tasks.write", "tasks.delete",
+					"tasks.submit", "tasks.grade",
+				},
+			},
+		},
+		{
+			name: "student allowed",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return(domain.RoleStudent, nil)
+				repo.EXPECT().ListRolePermissions(mock.Anything, req.CourseId, domain.RoleStudent).
+					Return([]domain.Permission{domain.PermissionLessonsRead, domain.PermissionTasksSubmit}, nil)
+			},
+			req: &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "tasks.submit"},
+			want: &pb.AuthorizeResponse{
+				Allowed:     true,
+				Role:        "student",
+				Permissions: []string{"lessons.read", "tasks.submit"},
+			},
+		},
+		{
+			name: "auditor denied",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return(domain.RoleAuditor, nil)
+				repo.EXPECT().ListRolePermissions(mock.Anything, req.CourseId, domain.RoleAuditor).
+					Return([]domain.Permission{domain.PermissionLessonsRead}, nil)
+			},
+			req: &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "tasks.submit"},
+			want: &pb.AuthorizeResponse{
+				Allowed:     false,
+				Role:        "auditor",
+				Permissions: []string{"lessons.read"},
+			},
+		},
+		{
+			name: "not a member",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return("", domain.ErrNotFound)
+			},
+			req:  &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "course.read"},
+			want: &pb.AuthorizeResponse{Allowed: false, Permissions: []string{}},
+		},
+		{
+			name: "archived course is read-only",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, ArchivedAt: &now}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return(domain.RoleOwner, nil)
+			},
+			req: &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "lessons.write"},
+			want: &pb.AuthorizeResponse{
+				Allowed:     false,
+				Role:        "owner",
+				Permissions: []string{"course.read", "course.delete", "members.read", "lessons.read", "lessons.read_drafts", "tasks.read"},
+			},
+		},
+		{
+			name: "deleted course",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, DeletedAt: &now}, nil)
+			},
+			req:     &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "course.read"},
+			wantErr: status.Error(codes.NotFound, "course not found"),
+		},
+		{
+			name: "course not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{}, domain.ErrNotFound)
+			},
+			req:     &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "course.read"},
+			wantErr: status.Error(codes.NotFound, "course not found"),
+		},
+		{
+			name:         "invalid permission",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {},
+			req:          &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "course.fly"},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid permission"),
+		},
+		{
+			name: "internal error",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AuthorizeRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return("", errors.New("db error"))
+			},
+			req:     &pb.AuthorizeRequest{CourseId: courseID, UserId: userID, Permission: "course.read"},
+			wantErr: status.Error(codes.Internal, "failed to get role"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
+			tc.mockBehavior(repo, tc.req)
+			got, err := svc.Authorize(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_SetRolePermissions(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, req *pb.SetRolePermissionsRequest)
+
+	courseID := uuid.NewString()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.SetRolePermissionsRequest
+		want         *pb.SetRolePermissionsResponse
+		wantErr      error
+	}{
+		{
+			name: "success - duplicates removed",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.SetRolePermissionsRequest) {
+				repo.EXPECT().SetRolePermissions(mock.Anything, req.CourseId, domain.RoleAssistant,
+					[]domain.Permission{domain.PermissionTasksGrade, domain.PermissionLessonsRead}).Return(nil)
+			},
+			req: &pb.SetRolePermissionsRequest{
+				CourseId:    courseID,
+				Role:        "assistant",
+				Permissions: []string{"tasks.grade", "lessons.read", "tasks.grade"},
+			},
+			want: &pb.SetRolePermissionsResponse{
+				Role: &pb.RolePermissions{Role: "assistant", Permissions: []string{"tasks.grade", "lessons.read"}},
+			},
+		},
+		{
+			name:         "owner is not configurable",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.SetRolePermissionsRequest) {},
+			req:          &pb.SetRolePermissionsRequest{CourseId: courseID, Role: "owner"},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid role"),
+		},
+		{
+			name:         "unknown permission",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.SetRolePermissionsRequest) {},
+			req:          &pb.SetRolePermissionsRequest{CourseId: courseID, Role: "student", Permissions: []string{"course.fly"}},
+			wantErr:      status.Error(codes.InvalidArgument, `invalid permission "course.fly"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
+			tc.mockBehavior(repo, tc.req)
+			got, err := svc.SetRolePermissions(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_AddStaff(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, req *pb.AddStaffRequest)
+
+	now := time.Now()
+	courseID := uuid.NewString()
+	userID := uuid.NewString()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.AddStaffRequest
+		want         *pb.AddStaffResponse
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AddStaffRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, TeacherID: "owner-id"}, nil)
+				repo.EXPECT().AddStaff(mock.Anything, req.CourseId, req.UserId, domain.RoleAssistant).
+					Return(domain.Staff{CourseID: req.CourseId, UserID: req.UserId, Email: "ta@example.com", Role: domain.RoleAssistant, AddedAt: now}, nil)
+			},
+			req: &pb.AddStaffRequest{CourseId: courseID, UserId: userID, Role: "assistant"},
+			want: &pb.AddStaffResponse{
+				Staff: &pb.Staff{
+					CourseId: courseID,
+					UserId:   userID,
+					Email:    "ta@example.com",
+					Role:     "assistant",
+					AddedAt:  timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name:         "student role is not a staff role",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AddStaffRequest) {},
+			req:          &pb.AddStaffRequest{CourseId: courseID, UserId: userID, Role: "student"},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid role"),
+		},
+		{
+			name: "owner cannot be staff",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AddStaffRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, TeacherID: req.UserId}, nil)
+			},
+			req:     &pb.AddStaffRequest{CourseId: courseID, UserId: userID, Role: "co_teacher"},
+			wantErr: status.Error(codes.FailedPrecondition, "user is the course owner"),
+		},
+		{
+			name: "user not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AddStaffRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, TeacherID: "owner-id"}, nil)
+				repo.EXPECT().AddStaff(mock.Anything, req.CourseId, req.UserId, domain.RoleCoTeacher).
+					Return(domain.Staff{}, domain.ErrUserNotFound)
+			},
+			req:     &pb.AddStaffRequest{CourseId: courseID, UserId: userID, Role: "co_teacher"},
+			wantErr: status.Error(codes.NotFound, "user not found"),
+		},
+		{
+			name: "course not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.AddStaffRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{}, domain.ErrNotFound)
+			},
+			req:     &pb.AddStaffRequest{CourseId: courseID, UserId: userID, Role: "co_teacher"},
+			wantErr: status.Error(codes.NotFound, "course not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
+			tc.mockBehavior(repo, tc.req)
+			got, err := svc.AddStaff(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_JoinByCode(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest)
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	courseID := uuid.NewString()
+	userID := uuid.NewString()
+	inviteID := uuid.NewString()
+	maxUses := int32(2)
+
+	invite := domain.Invite{ID: inviteID, CourseID: courseID, Code: "ABCD2345", Role: domain.RoleAuditor}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.JoinByCodeRequest
+		want         *pb.JoinByCodeResponse
+		wantErr      error
+	}{
+		{
+			name: "success - code is case insensitive",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID}, nil)
+				repo.EXPECT().GetRole(mock.Anything, courseID, req.UserId).Return("", domain.ErrNotFound)
+				repo.EXPECT().JoinByInvite(mock.Anything, inviteID, req.UserId).
+					Return(domain.Enrollment{CourseID: courseID, StudentID: req.UserId, Role: domain.RoleAuditor, EnrolledAt: now}, nil)
+				pr.EXPECT().PublishUserEnrolled(events.UserEnrolled{CourseID: courseID, UserID: req.UserId}).Return(nil)
+			},
+			req: &pb.JoinByCodeRequest{UserId: userID, Code: " abcd2345 "},
+			want: &pb.JoinByCodeResponse{
+				Enrollment: &pb.Enrollment{
+					CourseId:   courseID,
+					StudentId:  userID,
+					EnrolledAt: timestamppb.New(now),
+					Role:       "auditor",
+				},
+			},
+		},
+		{
+			name: "invite not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(domain.Invite{}, domain.ErrNotFound)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.NotFound, "invite not found"),
+		},
+		{
+			name: "invite revoked",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				revoked := invite
+				revoked.RevokedAt = &past
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(revoked, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "invite revoked"),
+		},
+		{
+			name: "invite expired",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				expired := invite
+				expired.ExpiresAt = &past
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(expired, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "invite expired"),
+		},
+		{
+			name: "invite exhausted",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				exhausted := invite
+				exhausted.MaxUses = &maxUses
+				exhausted.Uses = maxUses
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(exhausted, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "invite exhausted"),
+		},
+		{
+			name: "course deleted",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID, DeletedAt: &past}, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.NotFound, "course not found"),
+		},
+		{
+			name: "course archived",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID, ArchivedAt: &past}, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "course is archived"),
+		},
+		{
+			name: "course is a template",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID, IsTemplate: true}, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "course is a template"),
+		},
+		{
+			name: "already a member",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID}, nil)
+				repo.EXPECT().GetRole(mock.Anything, courseID, req.UserId).Return(domain.RoleStudent, nil)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.AlreadyExists, "user is already a member of the course"),
+		},
+		{
+			name: "invite used up concurrently",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID}, nil)
+				repo.EXPECT().GetRole(mock.Anything, courseID, req.UserId).Return("", domain.ErrNotFound)
+				repo.EXPECT().JoinByInvite(mock.Anything, inviteID, req.UserId).Return(domain.Enrollment{}, domain.ErrNotFound)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "invite is no longer valid"),
+		},
+		{
+			name: "course is full",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {
+				repo.EXPECT().GetInviteByCode(mock.Anything, "ABCD2345").Return(invite, nil)
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID}, nil)
+				repo.EXPECT().GetRole(mock.Anything, courseID, req.UserId).Return("", domain.ErrNotFound)
+				repo.EXPECT().JoinByInvite(mock.Anything, inviteID, req.UserId).Return(domain.Enrollment{}, domain.ErrCourseFull)
+			},
+			req:     &pb.JoinByCodeRequest{UserId: userID, Code: "ABCD2345"},
+			wantErr: status.Error(codes.FailedPrecondition, "course is full"),
+		},
+		{
+			name:         "empty code",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.JoinByCodeRequest) {},
+			req:          &pb.JoinByCodeRequest{UserId: userID, Code: "  "},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid code"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
+			got, err := svc.JoinByCode(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_RequestEnrollment(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RequestEnrollmentRequest)
+
+	now := time.Now()
+	courseID := uuid.NewString()
+	userID := uuid.NewString()
+	requestID := uuid.NewString()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.RequestEnrollmentRequest
+		want         *pb.RequestEnrollmentResponse
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RequestEnrollmentRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, Visibility: true}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return("", domain.ErrNotFound)
+				repo.EXPECT().CreateEnrollmentRequest(mock.Anything, req.CourseId, req.UserId).
+					Return(domain.EnrollmentRequest{ID: requestID, CourseID: req.CourseId, UserID: req.UserId, Status: domain.RequestPending, CreatedAt: now, UpdatedAt: now}, nil)
+				pr.EXPECT().PublishEnrollmentRequestUpdated(events.EnrollmentRequestUpdated{
+					RequestID: requestID,
+					CourseID:  req.CourseId,
+					UserID:    req.UserId,
+					Status:    "pending",
+				}).Return(nil)
+			},
+			req: &pb.RequestEnrollmentRequest{CourseId: courseID, UserId: userID},
+			want: &pb.RequestEnrollmentResponse{
+				Request: &pb.EnrollmentRequest{
+					RequestId: requestID,
+					CourseId:  courseID,
+					UserId:    userID,
+					Status:    "pending",
+					CreatedAt: timestamppb.New(now),
+					UpdatedAt: timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name: "hidden course",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RequestEnrollmentRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId}, nil)
+			},
+			req:     &pb.RequestEnrollmentRequest{CourseId: courseID, UserId: userID},
+			wantErr: status.Error(codes.NotFound, "course is hidden"),
+		},
+		{
+			name: "template course",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RequestEnrollmentRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, Visibility: true, IsTemplate: true}, nil)
+			},
+			req:     &pb.RequestEnrollmentRequest{CourseId: courseID, UserId: userID},
+			wantErr: status.Error(codes.FailedPrecondition, "course is a template"),
+		},
+		{
+			name: "already a member",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RequestEnrollmentRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, Visibility: true}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return(domain.RoleStudent, nil)
+			},
+			req:     &pb.RequestEnrollmentRequest{CourseId: courseID, UserId: userID},
+			wantErr: status.Error(codes.AlreadyExists, "user is already a member of the course"),
+		},
+		{
+			name: "request already exists",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RequestEnrollmentRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).Return(domain.Course{ID: req.CourseId, Visibility: true}, nil)
+				repo.EXPECT().GetRole(mock.Anything, req.CourseId, req.UserId).Return("", domain.ErrNotFound)
+				repo.EXPECT().CreateEnrollmentRequest(mock.Anything, req.CourseId, req.UserId).Return(domain.EnrollmentRequest{}, domain.ErrConflict)
+			},
+			req:     &pb.RequestEnrollmentRequest{CourseId: courseID, UserId: userID},
+			wantErr: status.Error(codes.AlreadyExists, "enrollment request already exists"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
+			got, err := svc.RequestEnrollment(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_ApproveEnrollment(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.ApproveEnrollmentRequest)
+
+	courseID := uuid.NewString()
+	teacherID := uuid.NewString()
+	studentID := uuid.NewString()
+	requestID := uuid.NewString()
+
+	request := func(status domain.RequestStatus) domain.EnrollmentRequest {
+		return domain.EnrollmentRequest{ID: requestID, CourseID: courseID, UserID: studentID, Status: status}
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.ApproveEnrollmentRequest
+		wantStatus   string
+		wantErr      error
+	}{
+		{
+			name: "approved - user enrolled",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.ApproveEnrollmentRequest) {
+				repo.EXPECT().GetEnrollmentRequest(mock.Anything, courseID, requestID).Return(request(domain.RequestPending), nil)
+				repo.EXPECT().ApproveEnrollmentRequest(mock.Anything, courseID, requestID, teacherID).Return(request(domain.RequestApproved), nil)
+				pr.EXPECT().PublishEnrollmentRequestUpdated(events.EnrollmentRequestUpdated{
+					RequestID: requestID, CourseID: courseID, UserID: studentID, Status: "approved",
+				}).Return(nil)
+				pr.EXPECT().PublishUserEnrolled(events.UserEnrolled{CourseID: courseID, UserID: studentID}).Return(nil)
+			},
+			req:        &pb.ApproveEnrollmentRequest{CourseId: courseID, RequestId: requestID, UserId: teacherID},
+			wantStatus: "approved",
+		},
+		{
+			name: "course is full - waitlisted",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.ApproveEnrollmentRequest) {
+				repo.EXPECT().GetEnrollmentRequest(mock.Anything, courseID, requestID).Return(request(domain.RequestPending), nil)
+				repo.EXPECT().ApproveEnrollmentRequest(mock.Anything, courseID, requestID, teacherID).Return(request(domain.RequestWaitlisted), nil)
+				pr.EXPECT().PublishEnrollmentRequestUpdated(events.EnrollmentRequestUpdated{
+					RequestID: requestID, CourseID: courseID, UserID: studentID, Status: "waitlisted",
+				}).Return(nil)
+			},
+			req:        &pb.ApproveEnrollmentRequest{CourseId: courseID, RequestId: requestID, UserId: teacherID},
+			wantStatus: "waitlisted",
+		},
+		{
+			name: "still waitlisted - no events",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.ApproveEnrollmentRequest) {
+				repo.EXPECT().GetEnrollmentRequest(mock.Anything, courseID, requestID).Return(request(domain.RequestWaitlisted), nil)
+				repo.EXPECT().ApproveEnrollmentRequest(mock.Anything, courseID, requestID, teacherID).Return(request(domain.RequestWaitlisted), nil)
+			},
+			req:        &pb.ApproveEnrollmentRequest{CourseId: courseID, RequestId: requestID, UserId: teacherID},
+			wantStatus: "waitlisted",
+		},
+		{
+			name: "request already rejected",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.ApproveEnrollmentRequest) {
+				repo.EXPECT().GetEnrollmentRequest(mock.Anything, courseID, requestID).Return(request(domain.RequestRejected), nil)
+			},
+			req:     &pb.ApproveEnrollmentRequest{CourseId: courseID, RequestId: requestID, UserId: teacherID},
+			wantErr: status.Error(codes.FailedPrecondition, "enrollment request is already closed"),
+		},
+		{
+			name: "request not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.ApproveEnrollmentRequest) {
+				repo.EXPECT().GetEnrollmentRequest(mock.Anything, courseID, requestID).Return(domain.EnrollmentRequest{}, domain.ErrNotFound)
+			},
+			req:     &pb.ApproveEnrollmentRequest{CourseId: courseID, RequestId: requestID, UserId: teacherID},
+			wantErr: status.Error(codes.NotFound, "enrollment request not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
+			got, err := svc.ApproveEnrollment(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Request.Status)
+		})
+	}
+}
+
+func TestCoursesService_BulkEnroll(t *testing.T) {
+	courseID := uuid.NewString()
+	teacherID := uuid.NewString()
+	newID := uuid.NewString()
+	memberID := uuid.NewString()
+	lateID := uuid.NewString()
+
+	repo := mocks.NewMockCourseRepo(t)
+	pr := mocks.NewMockProducer(t)
+	svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+
+	repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID, TeacherID: teacherID}, nil)
+	repo.EXPECT().ListUsersByEmails(mock.Anything, []string{"new@example.com", "member@example.com", "unknown@example.com", "late@example.com"}).
+		Return([]domain.Student{
+			{UserID: lateID, Email: "late@example.com"},
+			{UserID: newID, Email: "New@Example.com"},
+			{UserID: memberID, Email: "member@example.com"},
+		}, nil)
+	// Места достаются в порядке строк файла, последнему не хватило места
+	repo.EXPECT().BulkEnroll(mock.Anything, courseID, []string{newID, memberID, lateID}, domain.RoleStudent).
+		Return([]string{newID}, []string{lateID}, nil)
+	pr.EXPECT().PublishUserEnrolled(events.UserEnrolled{CourseID: courseID, UserID: newID}).Return(nil)
+	repo.EXPECT().CreateInvite(mock.Anything, mock.MatchedBy(func(dto dto.CreateInviteDTO) bool {
+		return dto.CourseID == courseID && dto.Role == "student" && *dto.MaxUses == 1 && dto.ExpiresAt != nil
+	})).Return(domain.Invite{Code: "ABCD2345"}, nil)
+	pr.EXPECT().PublishUserInvited(mock.MatchedBy(func(e events.UserInvited) bool {
+		return e.CourseID == courseID && e.Email == "unknown@example.com" && e.Code == "ABCD2345"
+	})).Return(nil)
+
+	got, err := svc.BulkEnroll(context.Background(), &pb.BulkEnrollRequest{
+		CourseId:      courseID,
+		UserId:        teacherID,
+		Emails:        []string{" New@Example.com", "member@example.com", "not-an-email", "new@example.com", "unknown@example.com", "late@example.com"},
+		InviteUnknown: true,
+	})
+	require.NoError(t, err)
+
+	statuses := make([]string, len(got.Results))
+	for i, r := range got.Results {
+		statuses[i] = r.Status
+	}
+	assert.Equal(t, []string{"enrolled", "already_member", "invalid_email", "duplicate", "invited", "course_full"}, statuses)
+	assert.Equal(t, newID, got.Results[0].GetUserId())
+	assert.Equal(t, "ABCD2345", got.Results[4].GetInviteCode())
+}
+
+func TestCoursesService_RestoreCourse(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RestoreCourseRequest)
+
+	now := time.Now()
+	courseID := uuid.NewString()
+	ownerID := uuid.NewString()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.RestoreCourseRequest
+		want         *pb.RestoreCourseResponse
+		wantErr      error
+	}{
+		{
+			name: "success - owner restores deleted course",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RestoreCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: courseID, TeacherID: ownerID, CreatedAt: now, DeletedAt: &now}, nil)
+				repo.EXPECT().Restore(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: courseID, TeacherID: ownerID, CreatedAt: now}, nil)
+				pr.EXPECT().PublishCourseRestored(events.CourseRestored{CourseID: courseID}).Return(nil)
+			},
+			req: &pb.RestoreCourseRequest{CourseId: courseID, UserId: ownerID},
+			want: &pb.RestoreCourseResponse{
+				Course: &pb.Course{CourseId: courseID, TeacherId: ownerID, CreatedAt: timestamppb.New(now)},
+			},
+		},
+		{
+			name: "error - deleted course of another user",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RestoreCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: courseID, TeacherID: ownerID, DeletedAt: &now}, nil)
+			},
+			req:     &pb.RestoreCourseRequest{CourseId: courseID, UserId: uuid.NewString()},
+			wantErr: status.Error(codes.NotFound, "course not found"),
+		},
+		{
+			name: "error - archived course of another user",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RestoreCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: courseID, TeacherID: ownerID, ArchivedAt: &now}, nil)
+			},
+			req:     &pb.RestoreCourseRequest{CourseId: courseID, UserId: uuid.NewString()},
+			wantErr: status.Error(codes.PermissionDenied, "only the course owner can restore the course"),
+		},
+		{
+			name: "error - course is active",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.RestoreCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, req.CourseId).
+					Return(domain.Course{ID: courseID, TeacherID: ownerID}, nil)
+			},
+			req:     &pb.RestoreCourseRequest{CourseId: courseID, UserId: ownerID},
+			wantErr: status.Error(codes.FailedPrecondition, "course is not archived or deleted"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
+			got, err := svc.RestoreCourse(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_CloneCourse(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, req *pb.CloneCourseRequest)
+
+	now := time.Now()
+	startTime := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	sourceID := uuid.NewString()
+	targetID := uuid.NewString()
+	jobID := uuid.NewString()
+	teacherID := uuid.NewString()
+	newTitle := "Math 2026"
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.CloneCourseRequest
+		want         *pb.CloneCourseResponse
+		wantErr      error
+	}{
+		{
+			name: "success - archived course with new title and shifted dates",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.CloneCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, sourceID).Return(domain.Course{
+					ID:          sourceID,
+					TeacherID:   teacherID,
+					Title:       "Math 2025",
+					Description: "Math",
+					StartTime:   &startTime,
+					ArchivedAt:  &now,
+				}, nil)
+				repo.EXPECT().IsTeacher(mock.Anything, sourceID, teacherID).Return(true, nil)
+
+				shifted := startTime.AddDate(0, 0, 365)
+				repo.EXPECT().CloneCourse(mock.Anything, sourceID, int32(365), dto.CreateCourseDTO{
+					TeacherID:   teacherID,
+					Title:       newTitle,
+					Description: "Math",
+					StartTime:   &shifted,
+				}).Return(
+					domain.Course{ID: targetID, TeacherID: teacherID, Title: newTitle, Description: "Math", StartTime: &shifted, CreatedAt: now},
+					domain.CloneJob{ID: jobID, SourceCourseID: sourceID, TargetCourseID: targetID, UserID: teacherID, ShiftDays: 365, Status: domain.ClonePending, CreatedAt: now, UpdatedAt: now},
+					nil,
+				)
+			},
+			req: &pb.CloneCourseRequest{CourseId: sourceID, UserId: teacherID, Title: &newTitle, ShiftDays: 365},
+			want: &pb.CloneCourseResponse{
+				Course: &pb.Course{
+					CourseId:    targetID,
+					TeacherId:   teacherID,
+					Title:       newTitle,
+					Description: "Math",
+					StartTime:   timestamppb.New(startTime.AddDate(0, 0, 365)),
+					CreatedAt:   timestamppb.New(now),
+				},
+				Job: &pb.CloneJob{
+					JobId:          jobID,
+					SourceCourseId: sourceID,
+					TargetCourseId: targetID,
+					UserId:         teacherID,
+					Status:         "pending",
+					CreatedAt:      timestamppb.New(now),
+					UpdatedAt:      timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name: "error - user is not a teacher",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.CloneCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, sourceID).Return(domain.Course{ID: sourceID, TeacherID: teacherID}, nil)
+				repo.EXPECT().IsTeacher(mock.Anything, sourceID, req.UserId).Return(false, nil)
+			},
+			req:     &pb.CloneCourseRequest{CourseId: sourceID, UserId: uuid.NewString()},
+			wantErr: status.Error(codes.PermissionDenied, "only course teachers can clone the course"),
+		},
+		{
+			name: "error - course is deleted",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.CloneCourseRequest) {
+				repo.EXPECT().GetByID(mock.Anything, sourceID).Return(domain.Course{ID: sourceID, TeacherID: teacherID, DeletedAt: &now}, nil)
+			},
+			req:     &pb.CloneCourseRequest{CourseId: sourceID, UserId: teacherID},
+			wantErr: status.Error(codes.NotFound, "course not found"),
+		},
+		{
+			name:         "error - empty title",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.CloneCourseRequest) {},
+			req:          &pb.CloneCourseRequest{CourseId: sourceID, UserId: teacherID, Title: new(string)},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid title"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, tc.req)
+			got, err := svc.CloneCourse(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCloneWorker_ProcessCloneJobs(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, content *mocks.MockContentCloner, job domain.CloneJob)
+
+	now := time.Now()
+	job := domain.CloneJob{
+		ID:             uuid.NewString(),
+		SourceCourseID: uuid.NewString(),
+		TargetCourseID: uuid.NewString(),
+		UserID:         uuid.NewString(),
+		ShiftDays:      365,
+		Status:         domain.CloneRunning,
+	}
+	lessons, tasks := int32(3), int32(5)
+	reason := "failed to clone tasks"
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, content *mocks.MockContentCloner, job domain.CloneJob) {
+				repo.EXPECT().ClaimCloneJob(mock.Anything, mock.Anything).Return(job, nil).Once()
+				content.EXPECT().CloneLessons(mock.Anything, job.SourceCourseID, job.TargetCourseID, job.ShiftDays).Return(lessons, nil)
+
+				progress := job
+				progress.LessonsCopied = &lessons
+				repo.EXPECT().UpdateCloneJob(mock.Anything, progress).Return(nil).Once()
+				content.EXPECT().CloneTasks(mock.Anything, job.SourceCourseID, job.TargetCourseID).Return(tasks, nil)
+
+				completed := progress
+				completed.TasksCopied = &tasks
+				completed.Status = domain.CloneCompleted
+				repo.EXPECT().UpdateCloneJob(mock.Anything, completed).Return(nil).Once()
+				repo.EXPECT().ClaimCloneJob(mock.Anything, mock.Anything).Return(domain.CloneJob{}, domain.ErrNotFound).Once()
+			},
+		},
+		{
+			name: "tasks failed - job failed and course deleted",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, content *mocks.MockContentCloner, job domain.CloneJob) {
+				repo.EXPECT().ClaimCloneJob(mock.Anything, mock.Anything).Return(job, nil).Once()
+				content.EXPECT().CloneLessons(mock.Anything, job.SourceCourseID, job.TargetCourseID, job.ShiftDays).Return(lessons, nil)
+
+				progress := job
+				progress.LessonsCopied = &lessons
+				repo.EXPECT().UpdateCloneJob(mock.Anything, progress).Return(nil).Once()
+				content.EXPECT().CloneTasks(mock.Anything, job.SourceCourseID, job.TargetCourseID).Return(0, status.Error(codes.Unavailable, "unavailable"))
+
+				failed := progress
+				failed.Status = domain.CloneFailed
+				failed.Error = &reason
+				repo.EXPECT().UpdateCloneJob(mock.Anything, failed).Return(nil).Once()
+				repo.EXPECT().Delete(mock.Anything, job.TargetCourseID).
+					Return(domain.Course{ID: job.TargetCourseID, DeletedAt: &now}, nil)
+				pr.EXPECT().PublishCourseDeleted(events.CourseDeleted{
+					CourseID:  job.TargetCourseID,
+					DeletedAt: now,
+					PurgeAt:   now.Add(time.Hour),
+				}).Return(nil)
+				repo.EXPECT().ClaimCloneJob(mock.Anything, mock.Anything).Return(domain.CloneJob{}, domain.ErrNotFound).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			content := mocks.NewMockContentCloner(t)
+			worker := service.NewCloneWorker(slog.Default(), repo, pr, content, time.Hour)
+			tc.mockBehavior(repo, pr, content, job)
+
+			require.NoError(t, worker.ProcessCloneJobs(context.Background()))
+		})
+	}
+}
+
+func TestCoursesService_SearchCourses(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo)
+
+	now := time.Now().UTC()
+	courseID := uuid.NewString()
+	cursor := domain.CatalogCursor{Rank: 0.5, CreatedAt: now, ID: courseID}
+	data, _ := json.Marshal(cursor)
+	page := base64.RawURLEncoding.EncodeToString(data)
+	rawTag := " Go"
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.SearchCoursesRequest
+		want         *pb.SearchCoursesResponse
+		wantErr      error
+	}{
+		{
+			name: "first page",
+			mockBehavior: func(repo *mocks.MockCourseRepo) {
+				tag := "go"
+				repo.EXPECT().Search(mock.Anything, dto.SearchCoursesDTO{Query: "основы", Tag: &tag, Limit: 20}).
+					Return(domain.CatalogPage{
+						Courses: []domain.Course{{ID: courseID, Title: "Основы Go", Listed: true, Tags: []string{"go"}, CreatedAt: now}},
+						Next:    &cursor,
+					}, nil)
+			},
+			req: &pb.SearchCoursesRequest{Query: " основы ", Tag: &rawTag},
+			want: &pb.SearchCoursesResponse{
+				Courses: []*pb.Course{{
+					CourseId:  courseID,
+					Title:     "Основы Go",
+					Listed:    true,
+					Tags:      []string{"go"},
+					CreatedAt: timestamppb.New(now),
+				}},
+				NextCursor: page,
+			},
+		},
+		{
+			name: "next page",
+			mockBehavior: func(repo *mocks.MockCourseRepo) {
+				repo.EXPECT().Search(mock.Anything, mock.MatchedBy(func(dto dto.SearchCoursesDTO) bool {
+					return dto.After != nil && dto.After.ID == courseID && dto.After.CreatedAt.Equal(now) && dto.Limit == 5
+				})).Return(domain.CatalogPage{}, nil)
+			},
+			req:  &pb.SearchCoursesRequest{Limit: 5, Cursor: page},
+			want: &pb.SearchCoursesResponse{Courses: []*pb.Course{}},
+		},
+		{
+			name:         "invalid cursor",
+			mockBehavior: func(repo *mocks.MockCourseRepo) {},
+			req:          &pb.SearchCoursesRequest{Cursor: "not a cursor"},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid cursor"),
+		},
+		{
+			name:         "limit too large",
+			mockBehavior: func(repo *mocks.MockCourseRepo) {},
+			req:          &pb.SearchCoursesRequest{Limit: 1000},
+			wantErr:      status.Error(codes.InvalidArgument, "invalid request: Key: 'SearchCoursesDTO.Limit' Error:Field validation for 'Limit' failed on the 'max' tag"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
+			tc.mockBehavior(repo)
+			got, err := svc.SearchCourses(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCoursesService_ReorderModule(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, req *pb.ReorderModuleRequest)
+
+	courseID := uuid.NewString()
+	moduleID := uuid.NewString()
+	otherID := uuid.NewString()
+	archivedAt := time.Now()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.ReorderModuleRequest
+		want         []string
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.ReorderModuleRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID}, nil)
+				repo.EXPECT().ReorderModule(mock.Anything, courseID, moduleID, int32(0)).Return([]domain.Module{
+					{ID: moduleID, CourseID: courseID, Position: 0},
+					{ID: otherID, CourseID: courseID, Position: 1},
+				}, nil)
+			},
+			req:  &pb.ReorderModuleRequest{CourseId: courseID, ModuleId: moduleID, Position: 0},
+			want: []string{moduleID, otherID},
+		},
+		{
+			name:         "negative position",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.ReorderModuleRequest) {},
+			req:          &pb.ReorderModuleRequest{CourseId: courseID, ModuleId: moduleID, Position: -1},
+			wantErr:      status.Error(codes.InvalidArgument, "position must not be negative"),
+		},
+		{
+			name: "course is archived",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.ReorderModuleRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID, ArchivedAt: &archivedAt}, nil)
+			},
+			req:     &pb.ReorderModuleRequest{CourseId: courseID, ModuleId: moduleID, Position: 1},
+			wantErr: status.Error(codes.FailedPrecondition, "course is archived"),
+		},
+		{
+			name: "module not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, req *pb.ReorderModuleRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(domain.Course{ID: courseID}, nil)
+				repo.EXPECT().ReorderModule(mock.Anything, courseID, moduleID, int32(1)).Return(nil, domain.ErrNotFound)
+			},
+			req:     &pb.ReorderModuleRequest{CourseId: courseID, ModuleId: moduleID, Position: 1},
+			wantErr: status.Error(codes.NotFound, "module not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			svc := service.NewCoursesService(slog.Default(), repo, nil, time.Hour)
+			tc.mockBehavior(repo, tc.req)
+			got, err := svc.ReorderModule(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, len(got.Modules))
+			for i, m := range got.Modules {
+				ids[i] = m.ModuleId
+			}
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestCoursesService_TransferOwnership(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.TransferOwnershipRequest)
+
+	courseID := uuid.NewString()
+	ownerID := uuid.NewString()
+	newOwnerID := uuid.NewString()
+	adminID := uuid.NewString()
+	transferID := uuid.NewString()
+
+	course := domain.Course{ID: courseID, TeacherID: ownerID}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.TransferOwnershipRequest
+		wantStatus   string
+		wantErr      error
+	}{
+		{
+			name: "pending until accepted - no events",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.TransferOwnershipRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+				repo.EXPECT().CreateOwnershipTransfer(mock.Anything, courseID, ownerID, newOwnerID).Return(domain.OwnershipTransfer{
+					ID: transferID, CourseID: courseID, FromUserID: ownerID, ToUserID: newOwnerID, Status: domain.TransferPending,
+				}, nil)
+			},
+			req:        &pb.TransferOwnershipRequest{CourseId: courseID, UserId: ownerID, NewOwnerId: newOwnerID},
+			wantStatus: "pending",
+		},
+		{
+			name: "forced by superuser",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.TransferOwnershipRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+				repo.EXPECT().ForceTransferOwnership(mock.Anything, courseID, newOwnerID).Return(domain.OwnershipTransfer{
+					ID: transferID, CourseID: courseID, FromUserID: ownerID, ToUserID: newOwnerID, Status: domain.TransferAccepted, Forced: true,
+				}, nil)
+				pr.EXPECT().PublishCourseOwnershipTransferred(events.CourseOwnershipTransferred{
+					CourseID: courseID, PreviousOwnerID: ownerID, NewOwnerID: newOwnerID, Forced: true,
+				}).Return(nil)
+				repo.EXPECT().PromoteWaitlist(mock.Anything, courseID).Return(nil, nil)
+			},
+			req:        &pb.TransferOwnershipRequest{CourseId: courseID, UserId: adminID, NewOwnerId: newOwnerID, Force: true},
+			wantStatus: "accepted",
+		},
+		{
+			name: "not the owner",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.TransferOwnershipRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+			},
+			req:     &pb.TransferOwnershipRequest{CourseId: courseID, UserId: adminID, NewOwnerId: newOwnerID},
+			wantErr: status.Error(codes.PermissionDenied, "only the course owner can transfer ownership"),
+		},
+		{
+			name: "already the owner",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.TransferOwnershipRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+			},
+			req:     &pb.TransferOwnershipRequest{CourseId: courseID, UserId: adminID, NewOwnerId: ownerID, Force: true},
+			wantErr: status.Error(codes.FailedPrecondition, "user is already the course owner"),
+		},
+		{
+			name: "new owner not found",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.TransferOwnershipRequest) {
+				repo.EXPECT().GetByID(mock.Anything, courseID).Return(course, nil)
+				repo.EXPECT().CreateOwnershipTransfer(mock.Anything, courseID, ownerID, newOwnerID).Return(domain.OwnershipTransfer{}, domain.ErrUserNotFound)
+			},
+			req:     &pb.TransferOwnershipRequest{CourseId: courseID, UserId: ownerID, NewOwnerId: newOwnerID},
+			wantErr: status.Error(codes.NotFound, "user not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
+			got, err := svc.TransferOwnership(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Transfer.Status)
+		})
+	}
+}
+
+func TestCoursesService_AcceptOwnershipTransfer(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.AcceptOwnershipTransferRequest)
+
+	courseID := uuid.NewString()
+	ownerID := uuid.NewString()
+	newOwnerID := uuid.NewString()
+	transferID := uuid.NewString()
+
+	transfer := func(status domain.TransferStatus) domain.OwnershipTransfer {
+		return domain.OwnershipTransfer{ID: transferID, CourseID: courseID, FromUserID: ownerID, ToUserID: newOwnerID, Status: status}
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.AcceptOwnershipTransferRequest
+		wantPromoted int
+		wantErr      error
+	}{
+		{
+			name: "accepted - seat freed for waitlist",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.AcceptOwnershipTransferRequest) {
+				repo.EXPECT().GetOwnershipTransfer(mock.Anything, transferID).Return(transfer(domain.TransferPending), nil)
+				repo.EXPECT().AcceptOwnershipTransfer(mock.Anything, transferID).Return(transfer(domain.TransferAccepted), nil)
+				pr.EXPECT().PublishCourseOwnershipTransferred(events.CourseOwnershipTransferred{
+					CourseID: courseID, PreviousOwnerID: ownerID, NewOwnerID: newOwnerID,
+				}).Return(nil)
+
+				waitlisted := domain.EnrollmentRequest{ID: uuid.NewString(), CourseID: courseID, UserID: uuid.NewString(), Status: domain.RequestApproved}
+				repo.EXPECT().PromoteWaitlist(mock.Anything, courseID).Return([]domain.EnrollmentRequest{waitlisted}, nil)
+				pr.EXPECT().PublishEnrollmentRequestUpdated(mock.Anything).Return(nil)
+				pr.EXPECT().PublishUserEnrolled(events.UserEnrolled{CourseID: courseID, UserID: waitlisted.UserID}).Return(nil)
+			},
+			req:          &pb.AcceptOwnershipTransferRequest{TransferId: transferID, UserId: newOwnerID},
+			wantPromoted: 1,
+		},
+		{
+			name: "not the recipient",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.AcceptOwnershipTransferRequest) {
+				repo.EXPECT().GetOwnershipTransfer(mock.Anything, transferID).Return(transfer(domain.TransferPending), nil)
+			},
+			req:     &pb.AcceptOwnershipTransferRequest{TransferId: transferID, UserId: ownerID},
+			wantErr: status.Error(codes.NotFound, "ownership transfer not found"),
+		},
+		{
+			name: "transfer cancelled",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.AcceptOwnershipTransferRequest) {
+				repo.EXPECT().GetOwnershipTransfer(mock.Anything, transferID).Return(transfer(domain.TransferCancelled), nil)
+			},
+			req:     &pb.AcceptOwnershipTransferRequest{TransferId: transferID, UserId: newOwnerID},
+			wantErr: status.Error(codes.FailedPrecondition, "ownership transfer is already closed"),
+		},
+		{
+			name: "closed concurrently",
+			mockBehavior: func(repo *mocks.MockCourseRepo, pr *mocks.MockProducer, req *pb.AcceptOwnershipTransferRequest) {
+				repo.EXPECT().GetOwnershipTransfer(mock.Anything, transferID).Return(transfer(domain.TransferPending), nil)
+				repo.EXPECT().AcceptOwnershipTransfer(mock.Anything, transferID).Return(domain.OwnershipTransfer{}, domain.ErrConflict)
+			},
+			req:     &pb.AcceptOwnershipTransferRequest{TransferId: transferID, UserId: newOwnerID},
+			wantErr: status.Error(codes.FailedPrecondition, "ownership transfer is already closed"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockCourseRepo(t)
+			pr := mocks.NewMockProducer(t)
+			svc := service.NewCoursesService(slog.Default(), repo, pr, time.Hour)
+			tc.mockBehavior(repo, pr, tc.req)
+			got, err := svc.AcceptOwnershipTransfer(context.Background(), tc.req)
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "accepted", got.Transfer.Status)
+			assert.Len(t, got.Promoted, tc.wantPromoted)
+		})
+	}
+}
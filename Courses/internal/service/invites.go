@@ -0,0 +1,226 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	pb "Classroom/Courses/pkg/api/courses"
+	"Classroom/Courses/pkg/events"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	inviteCodeLength = 8
+	// Без похожих символов: 0/O и 1/I
+	inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// Сколько раз пробуем сгенерировать код, если он уже занят
+	inviteCodeAttempts = 5
+)
+
+func (s *CoursesService) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.CreateInviteResponse, error) {
+	role := req.Role
+	if role == "" {
+		role = string(domain.RoleStudent)
+	}
+	dto := dto.CreateInviteDTO{
+		CourseID:  req.CourseId,
+		CreatedBy: req.UserId,
+		Role:      role,
+		ExpiresAt: timestampToTime(req.ExpiresAt),
+		MaxUses:   req.MaxUses,
+	}
+
+	if err := s.validate.Struct(dto); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err.Error())
+	}
+	if dto.ExpiresAt != nil && dto.ExpiresAt.Before(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at is in the past")
+	}
+
+	invite, err := s.createInvite(ctx, dto)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to create invite", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create invite")
+	}
+
+	s.logger.Info("invite created", "course_id", invite.CourseID, "invite_id", invite.ID)
+	return &pb.CreateInviteResponse{Invite: inviteToPb(invite)}, nil
+}
+
+func (s *CoursesService) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+
+	invites, err := s.repo.ListInvites(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("failed to list invites", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list invites")
+	}
+
+	pbInvites := make([]*pb.Invite, len(invites))
+	for i, invite := range invites {
+		pbInvites[i] = inviteToPb(invite)
+	}
+	return &pb.ListInvitesResponse{Invites: pbInvites}, nil
+}
+
+func (s *CoursesService) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.InviteId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invite id")
+	}
+
+	invite, err := s.repo.RevokeInvite(ctx, req.CourseId, req.InviteId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "invite not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to revoke invite", "error", err)
+		return nil, status.Error(codes.Internal, "failed to revoke invite")
+	}
+
+	s.logger.Info("invite revoked", "course_id", invite.CourseID, "invite_id", invite.ID)
+	return &pb.RevokeInviteResponse{Invite: inviteToPb(invite)}, nil
+}
+
+// Записывает пользователя на курс с ролью из кода приглашения. Код тратится и место
+// занимается в одной транзакции JoinByInvite, запись публикует course.enrolled
+func (s *CoursesService) JoinByCode(ctx context.Context, req *pb.JoinByCodeRequest) (*pb.JoinByCodeResponse, error) {
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	invite, err := s.repo.GetInviteByCode(ctx, code)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "invite not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get invite", "error", err)
+		return nil, status.Error(codes.Internal, "failed to join course")
+	}
+	switch {
+	case invite.RevokedAt != nil:
+		return nil, status.Error(codes.FailedPrecondition, "invite revoked")
+	case invite.Expired(time.Now()):
+		return nil, status.Error(codes.FailedPrecondition, "invite expired")
+	case invite.Exhausted():
+		return nil, status.Error(codes.FailedPrecondition, "invite exhausted")
+	}
+
+	// Коды, созданные до архивации или удаления, не действуют, пока курс не восстановят
+	course, err := s.repo.GetByID(ctx, invite.CourseID)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to join course")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	if course.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "course is a template")
+	}
+
+	// Участник курса не должен тратить использование кода
+	_, err = s.repo.GetRole(ctx, invite.CourseID, req.UserId)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "user is already a member of the course")
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.logger.Error("failed to get role", "error", err)
+		return nil, status.Error(codes.Internal, "failed to join course")
+	}
+
+	enrollment, err := s.repo.JoinByInvite(ctx, invite.ID, req.UserId)
+	if errors.Is(err, domain.ErrNotFound) {
+		// Код отозвали или исчерпали между проверкой и использованием
+		return nil, status.Error(codes.FailedPrecondition, "invite is no longer valid")
+	}
+	if errors.Is(err, domain.ErrCourseFull) {
+		return nil, status.Error(codes.FailedPrecondition, "course is full")
+	}
+	if err != nil {
+		s.logger.Error("failed to join course by invite", "error", err)
+		return nil, status.Error(codes.Internal, "failed to join course")
+	}
+
+	err = s.producer.PublishUserEnrolled(events.UserEnrolled{
+		CourseID: enrollment.CourseID,
+		UserID:   enrollment.StudentID,
+	})
+
+	if err != nil {
+		s.logger.Error("failed to publish user enrolled event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+
+	s.logger.Info("user joined by invite", "course_id", enrollment.CourseID, "student_id", enrollment.StudentID, "invite_id", invite.ID)
+	return &pb.JoinByCodeResponse{Enrollment: enrollmentToPb(enrollment)}, nil
+}
+
+// Генерирует код и повторяет попытку, если такой код уже занят
+func (s *CoursesService) createInvite(ctx context.Context, dto dto.CreateInviteDTO) (domain.Invite, error) {
+	var invite domain.Invite
+	var err error
+	for range inviteCodeAttempts {
+		dto.Code, err = generateInviteCode()
+		if err != nil {
+			return domain.Invite{}, fmt.Errorf("failed to generate invite code: %v", err)
+		}
+
+		invite, err = s.repo.CreateInvite(ctx, dto)
+		if !errors.Is(err, domain.ErrConflict) {
+			break
+		}
+	}
+	return invite, err
+}
+
+func generateInviteCode() (string, error) {
+	max := big.NewInt(int64(len(inviteCodeAlphabet)))
+	code := make([]byte, inviteCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = inviteCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+func inviteToPb(i domain.Invite) *pb.Invite {
+	return &pb.Invite{
+		InviteId:  i.ID,
+		CourseId:  i.CourseID,
+		Code:      i.Code,
+		Role:      string(i.Role),
+		CreatedBy: i.CreatedBy,
+		ExpiresAt: timeToTimestamp(i.ExpiresAt),
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		RevokedAt: timeToTimestamp(i.RevokedAt),
+		CreatedAt: timestamppb.New(i.CreatedAt),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"Classroom/Courses/internal/domain"
 	"Classroom/Courses/internal/dto"
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,6 +40,415 @@ func (_m *MockCourseRepo) EXPECT() *MockCourseRepo_Expecter {
 	return &MockCourseRepo_Expecter{mock: &_m.Mock}
 }
 
+// AcceptOwnershipTransfer provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) AcceptOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error) {
+	ret := _mock.Called(ctx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.OwnershipTransfer, error)); ok {
+		return returnFunc(ctx, transferID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.OwnershipTransfer); ok {
+		r0 = returnFunc(ctx, transferID)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_AcceptOwnershipTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptOwnershipTransfer'
+type MockCourseRepo_AcceptOwnershipTransfer_Call struct {
+	*mock.Call
+}
+
+// AcceptOwnershipTransfer is a helper method to define mock.On call
+//   - ctx
+//   - transferID
+func (_e *MockCourseRepo_Expecter) AcceptOwnershipTransfer(ctx interface{}, transferID interface{}) *MockCourseRepo_AcceptOwnershipTransfer_Call {
+	return &MockCourseRepo_AcceptOwnershipTransfer_Call{Call: _e.mock.On("AcceptOwnershipTransfer", ctx, transferID)}
+}
+
+func (_c *MockCourseRepo_AcceptOwnershipTransfer_Call) Run(run func(ctx context.Context, transferID string)) *MockCourseRepo_AcceptOwnershipTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_AcceptOwnershipTransfer_Call) Return(ownershipTransfer domain.OwnershipTransfer, err error) *MockCourseRepo_AcceptOwnershipTransfer_Call {
+	_c.Call.Return(ownershipTransfer, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_AcceptOwnershipTransfer_Call) RunAndReturn(run func(ctx context.Context, transferID string) (domain.OwnershipTransfer, error)) *MockCourseRepo_AcceptOwnershipTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddStaff provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) AddStaff(ctx context.Context, courseID string, userID string, role domain.Role) (domain.Staff, error) {
+	ret := _mock.Called(ctx, courseID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddStaff")
+	}
+
+	var r0 domain.Staff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Role) (domain.Staff, error)); ok {
+		return returnFunc(ctx, courseID, userID, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Role) domain.Staff); ok {
+		r0 = returnFunc(ctx, courseID, userID, role)
+	} else {
+		r0 = ret.Get(0).(domain.Staff)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Role) error); ok {
+		r1 = returnFunc(ctx, courseID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_AddStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddStaff'
+type MockCourseRepo_AddStaff_Call struct {
+	*mock.Call
+}
+
+// AddStaff is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - userID
+//   - role
+func (_e *MockCourseRepo_Expecter) AddStaff(ctx interface{}, courseID interface{}, userID interface{}, role interface{}) *MockCourseRepo_AddStaff_Call {
+	return &MockCourseRepo_AddStaff_Call{Call: _e.mock.On("AddStaff", ctx, courseID, userID, role)}
+}
+
+func (_c *MockCourseRepo_AddStaff_Call) Run(run func(ctx context.Context, courseID string, userID string, role domain.Role)) *MockCourseRepo_AddStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_AddStaff_Call) Return(staff domain.Staff, err error) *MockCourseRepo_AddStaff_Call {
+	_c.Call.Return(staff, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_AddStaff_Call) RunAndReturn(run func(ctx context.Context, courseID string, userID string, role domain.Role) (domain.Staff, error)) *MockCourseRepo_AddStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveEnrollmentRequest provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ApproveEnrollmentRequest(ctx context.Context, courseID string, requestID string, decidedBy string) (domain.EnrollmentRequest, error) {
+	ret := _mock.Called(ctx, courseID, requestID, decidedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveEnrollmentRequest")
+	}
+
+	var r0 domain.EnrollmentRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (domain.EnrollmentRequest, error)); ok {
+		return returnFunc(ctx, courseID, requestID, decidedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) domain.EnrollmentRequest); ok {
+		r0 = returnFunc(ctx, courseID, requestID, decidedBy)
+	} else {
+		r0 = ret.Get(0).(domain.EnrollmentRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, requestID, decidedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ApproveEnrollmentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveEnrollmentRequest'
+type MockCourseRepo_ApproveEnrollmentRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveEnrollmentRequest is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - requestID
+//   - decidedBy
+func (_e *MockCourseRepo_Expecter) ApproveEnrollmentRequest(ctx interface{}, courseID interface{}, requestID interface{}, decidedBy interface{}) *MockCourseRepo_ApproveEnrollmentRequest_Call {
+	return &MockCourseRepo_ApproveEnrollmentRequest_Call{Call: _e.mock.On("ApproveEnrollmentRequest", ctx, courseID, requestID, decidedBy)}
+}
+
+func (_c *MockCourseRepo_ApproveEnrollmentRequest_Call) Run(run func(ctx context.Context, courseID string, requestID string, decidedBy string)) *MockCourseRepo_ApproveEnrollmentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ApproveEnrollmentRequest_Call) Return(enrollmentRequest domain.EnrollmentRequest, err error) *MockCourseRepo_ApproveEnrollmentRequest_Call {
+	_c.Call.Return(enrollmentRequest, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ApproveEnrollmentRequest_Call) RunAndReturn(run func(ctx context.Context, courseID string, requestID string, decidedBy string) (domain.EnrollmentRequest, error)) *MockCourseRepo_ApproveEnrollmentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Archive provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) Archive(ctx context.Context, courseID string) (domain.Course, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 domain.Course
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Course, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Course); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Get(0).(domain.Course)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockCourseRepo_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) Archive(ctx interface{}, courseID interface{}) *MockCourseRepo_Archive_Call {
+	return &MockCourseRepo_Archive_Call{Call: _e.mock.On("Archive", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_Archive_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_Archive_Call) Return(course domain.Course, err error) *MockCourseRepo_Archive_Call {
+	_c.Call.Return(course, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_Archive_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.Course, error)) *MockCourseRepo_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkEnroll provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) BulkEnroll(ctx context.Context, courseID string, userIDs []string, role domain.Role) ([]string, []string, error) {
+	ret := _mock.Called(ctx, courseID, userIDs, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkEnroll")
+	}
+
+	var r0 []string
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, domain.Role) ([]string, []string, error)); ok {
+		return returnFunc(ctx, courseID, userIDs, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, domain.Role) []string); ok {
+		r0 = returnFunc(ctx, courseID, userIDs, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, domain.Role) []string); ok {
+		r1 = returnFunc(ctx, courseID, userIDs, role)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, []string, domain.Role) error); ok {
+		r2 = returnFunc(ctx, courseID, userIDs, role)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCourseRepo_BulkEnroll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkEnroll'
+type MockCourseRepo_BulkEnroll_Call struct {
+	*mock.Call
+}
+
+// BulkEnroll is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - userIDs
+//   - role
+func (_e *MockCourseRepo_Expecter) BulkEnroll(ctx interface{}, courseID interface{}, userIDs interface{}, role interface{}) *MockCourseRepo_BulkEnroll_Call {
+	return &MockCourseRepo_BulkEnroll_Call{Call: _e.mock.On("BulkEnroll", ctx, courseID, userIDs, role)}
+}
+
+func (_c *MockCourseRepo_BulkEnroll_Call) Run(run func(ctx context.Context, courseID string, userIDs []string, role domain.Role)) *MockCourseRepo_BulkEnroll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_BulkEnroll_Call) Return(strings []string, strings1 []string, err error) *MockCourseRepo_BulkEnroll_Call {
+	_c.Call.Return(strings, strings1, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_BulkEnroll_Call) RunAndReturn(run func(ctx context.Context, courseID string, userIDs []string, role domain.Role) ([]string, []string, error)) *MockCourseRepo_BulkEnroll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimCloneJob provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ClaimCloneJob(ctx context.Context, staleBefore time.Time) (domain.CloneJob, error) {
+	ret := _mock.Called(ctx, staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimCloneJob")
+	}
+
+	var r0 domain.CloneJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (domain.CloneJob, error)); ok {
+		return returnFunc(ctx, staleBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) domain.CloneJob); ok {
+		r0 = returnFunc(ctx, staleBefore)
+	} else {
+		r0 = ret.Get(0).(domain.CloneJob)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ClaimCloneJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimCloneJob'
+type MockCourseRepo_ClaimCloneJob_Call struct {
+	*mock.Call
+}
+
+// ClaimCloneJob is a helper method to define mock.On call
+//   - ctx
+//   - staleBefore
+func (_e *MockCourseRepo_Expecter) ClaimCloneJob(ctx interface{}, staleBefore interface{}) *MockCourseRepo_ClaimCloneJob_Call {
+	return &MockCourseRepo_ClaimCloneJob_Call{Call: _e.mock.On("ClaimCloneJob", ctx, staleBefore)}
+}
+
+func (_c *MockCourseRepo_ClaimCloneJob_Call) Run(run func(ctx context.Context, staleBefore time.Time)) *MockCourseRepo_ClaimCloneJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ClaimCloneJob_Call) Return(cloneJob domain.CloneJob, err error) *MockCourseRepo_ClaimCloneJob_Call {
+	_c.Call.Return(cloneJob, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ClaimCloneJob_Call) RunAndReturn(run func(ctx context.Context, staleBefore time.Time) (domain.CloneJob, error)) *MockCourseRepo_ClaimCloneJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneCourse provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) CloneCourse(ctx context.Context, sourceCourseID string, shiftDays int32, dto1 dto.CreateCourseDTO) (domain.Course, domain.CloneJob, error) {
+	ret := _mock.Called(ctx, sourceCourseID, shiftDays, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneCourse")
+	}
+
+	var r0 domain.Course
+	var r1 domain.CloneJob
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, dto.CreateCourseDTO) (domain.Course, domain.CloneJob, error)); ok {
+		return returnFunc(ctx, sourceCourseID, shiftDays, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, dto.CreateCourseDTO) domain.Course); ok {
+		r0 = returnFunc(ctx, sourceCourseID, shiftDays, dto1)
+	} else {
+		r0 = ret.Get(0).(domain.Course)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32, dto.CreateCourseDTO) domain.CloneJob); ok {
+		r1 = returnFunc(ctx, sourceCourseID, shiftDays, dto1)
+	} else {
+		r1 = ret.Get(1).(domain.CloneJob)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int32, dto.CreateCourseDTO) error); ok {
+		r2 = returnFunc(ctx, sourceCourseID, shiftDays, dto1)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCourseRepo_CloneCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCourse'
+type MockCourseRepo_CloneCourse_Call struct {
+	*mock.Call
+}
+
+// CloneCourse is a helper method to define mock.On call
+//   - ctx
+//   - sourceCourseID
+//   - shiftDays
+//   - dto1
+func (_e *MockCourseRepo_Expecter) CloneCourse(ctx interface{}, sourceCourseID interface{}, shiftDays interface{}, dto1 interface{}) *MockCourseRepo_CloneCourse_Call {
+	return &MockCourseRepo_CloneCourse_Call{Call: _e.mock.On("CloneCourse", ctx, sourceCourseID, shiftDays, dto1)}
+}
+
+func (_c *MockCourseRepo_CloneCourse_Call) Run(run func(ctx context.Context, sourceCourseID string, shiftDays int32, dto1 dto.CreateCourseDTO)) *MockCourseRepo_CloneCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(dto.CreateCourseDTO))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_CloneCourse_Call) Return(course domain.Course, cloneJob domain.CloneJob, err error) *MockCourseRepo_CloneCourse_Call {
+	_c.Call.Return(course, cloneJob, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_CloneCourse_Call) RunAndReturn(run func(ctx context.Context, sourceCourseID string, shiftDays int32, dto1 dto.CreateCourseDTO) (domain.Course, domain.CloneJob, error)) *MockCourseRepo_CloneCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockCourseRepo
 func (_mock *MockCourseRepo) Create(ctx context.Context, dto1 dto.CreateCourseDTO) (domain.Course, error) {
 	ret := _mock.Called(ctx, dto1)
@@ -77,196 +487,2070 @@ func (_e *MockCourseRepo_Expecter) Create(ctx interface{}, dto1 interface{}) *Mo
 	return &MockCourseRepo_Create_Call{Call: _e.mock.On("Create", ctx, dto1)}
 }
 
-func (_c *MockCourseRepo_Create_Call) Run(run func(ctx context.Context, dto1 dto.CreateCourseDTO)) *MockCourseRepo_Create_Call {
+func (_c *MockCourseRepo_Create_Call) Run(run func(ctx context.Context, dto1 dto.CreateCourseDTO)) *MockCourseRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateCourseDTO))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_Create_Call) Return(course domain.Course, err error) *MockCourseRepo_Create_Call {
+	_c.Call.Return(course, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_Create_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.CreateCourseDTO) (domain.Course, error)) *MockCourseRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEnrollmentRequest provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) CreateEnrollmentRequest(ctx context.Context, courseID string, userID string) (domain.EnrollmentRequest, error) {
+	ret := _mock.Called(ctx, courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEnrollmentRequest")
+	}
+
+	var r0 domain.EnrollmentRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.EnrollmentRequest, error)); ok {
+		return returnFunc(ctx, courseID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.EnrollmentRequest); ok {
+		r0 = returnFunc(ctx, courseID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.EnrollmentRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_CreateEnrollmentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnrollmentRequest'
+type MockCourseRepo_CreateEnrollmentRequest_Call struct {
+	*mock.Call
+}
+
+// CreateEnrollmentRequest is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - userID
+func (_e *MockCourseRepo_Expecter) CreateEnrollmentRequest(ctx interface{}, courseID interface{}, userID interface{}) *MockCourseRepo_CreateEnrollmentRequest_Call {
+	return &MockCourseRepo_CreateEnrollmentRequest_Call{Call: _e.mock.On("CreateEnrollmentRequest", ctx, courseID, userID)}
+}
+
+func (_c *MockCourseRepo_CreateEnrollmentRequest_Call) Run(run func(ctx context.Context, courseID string, userID string)) *MockCourseRepo_CreateEnrollmentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateEnrollmentRequest_Call) Return(enrollmentRequest domain.EnrollmentRequest, err error) *MockCourseRepo_CreateEnrollmentRequest_Call {
+	_c.Call.Return(enrollmentRequest, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateEnrollmentRequest_Call) RunAndReturn(run func(ctx context.Context, courseID string, userID string) (domain.EnrollmentRequest, error)) *MockCourseRepo_CreateEnrollmentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInvite provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) CreateInvite(ctx context.Context, dto1 dto.CreateInviteDTO) (domain.Invite, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvite")
+	}
+
+	var r0 domain.Invite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateInviteDTO) (domain.Invite, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.CreateInviteDTO) domain.Invite); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(domain.Invite)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.CreateInviteDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_CreateInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvite'
+type MockCourseRepo_CreateInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInvite is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockCourseRepo_Expecter) CreateInvite(ctx interface{}, dto1 interface{}) *MockCourseRepo_CreateInvite_Call {
+	return &MockCourseRepo_CreateInvite_Call{Call: _e.mock.On("CreateInvite", ctx, dto1)}
+}
+
+func (_c *MockCourseRepo_CreateInvite_Call) Run(run func(ctx context.Context, dto1 dto.CreateInviteDTO)) *MockCourseRepo_CreateInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateInviteDTO))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateInvite_Call) Return(invite domain.Invite, err error) *MockCourseRepo_CreateInvite_Call {
+	_c.Call.Return(invite, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateInvite_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.CreateInviteDTO) (domain.Invite, error)) *MockCourseRepo_CreateInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateModule provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) CreateModule(ctx context.Context, courseID string, title string) (domain.Module, error) {
+	ret := _mock.Called(ctx, courseID, title)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateModule")
+	}
+
+	var r0 domain.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Module, error)); ok {
+		return returnFunc(ctx, courseID, title)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Module); ok {
+		r0 = returnFunc(ctx, courseID, title)
+	} else {
+		r0 = ret.Get(0).(domain.Module)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, title)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_CreateModule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateModule'
+type MockCourseRepo_CreateModule_Call struct {
+	*mock.Call
+}
+
+// CreateModule is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - title
+func (_e *MockCourseRepo_Expecter) CreateModule(ctx interface{}, courseID interface{}, title interface{}) *MockCourseRepo_CreateModule_Call {
+	return &MockCourseRepo_CreateModule_Call{Call: _e.mock.On("CreateModule", ctx, courseID, title)}
+}
+
+func (_c *MockCourseRepo_CreateModule_Call) Run(run func(ctx context.Context, courseID string, title string)) *MockCourseRepo_CreateModule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateModule_Call) Return(module domain.Module, err error) *MockCourseRepo_CreateModule_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateModule_Call) RunAndReturn(run func(ctx context.Context, courseID string, title string) (domain.Module, error)) *MockCourseRepo_CreateModule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOwnershipTransfer provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) CreateOwnershipTransfer(ctx context.Context, courseID string, fromUserID string, toUserID string) (domain.OwnershipTransfer, error) {
+	ret := _mock.Called(ctx, courseID, fromUserID, toUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (domain.OwnershipTransfer, error)); ok {
+		return returnFunc(ctx, courseID, fromUserID, toUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) domain.OwnershipTransfer); ok {
+		r0 = returnFunc(ctx, courseID, fromUserID, toUserID)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, fromUserID, toUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_CreateOwnershipTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOwnershipTransfer'
+type MockCourseRepo_CreateOwnershipTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateOwnershipTransfer is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - fromUserID
+//   - toUserID
+func (_e *MockCourseRepo_Expecter) CreateOwnershipTransfer(ctx interface{}, courseID interface{}, fromUserID interface{}, toUserID interface{}) *MockCourseRepo_CreateOwnershipTransfer_Call {
+	return &MockCourseRepo_CreateOwnershipTransfer_Call{Call: _e.mock.On("CreateOwnershipTransfer", ctx, courseID, fromUserID, toUserID)}
+}
+
+func (_c *MockCourseRepo_CreateOwnershipTransfer_Call) Run(run func(ctx context.Context, courseID string, fromUserID string, toUserID string)) *MockCourseRepo_CreateOwnershipTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateOwnershipTransfer_Call) Return(ownershipTransfer domain.OwnershipTransfer, err error) *MockCourseRepo_CreateOwnershipTransfer_Call {
+	_c.Call.Return(ownershipTransfer, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_CreateOwnershipTransfer_Call) RunAndReturn(run func(ctx context.Context, courseID string, fromUserID string, toUserID string) (domain.OwnershipTransfer, error)) *MockCourseRepo_CreateOwnershipTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeclineOwnershipTransfer provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) DeclineOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error) {
+	ret := _mock.Called(ctx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeclineOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.OwnershipTransfer, error)); ok {
+		return returnFunc(ctx, transferID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.OwnershipTransfer); ok {
+		r0 = returnFunc(ctx, transferID)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_DeclineOwnershipTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeclineOwnershipTransfer'
+type MockCourseRepo_DeclineOwnershipTransfer_Call struct {
+	*mock.Call
+}
+
+// DeclineOwnershipTransfer is a helper method to define mock.On call
+//   - ctx
+//   - transferID
+func (_e *MockCourseRepo_Expecter) DeclineOwnershipTransfer(ctx interface{}, transferID interface{}) *MockCourseRepo_DeclineOwnershipTransfer_Call {
+	return &MockCourseRepo_DeclineOwnershipTransfer_Call{Call: _e.mock.On("DeclineOwnershipTransfer", ctx, transferID)}
+}
+
+func (_c *MockCourseRepo_DeclineOwnershipTransfer_Call) Run(run func(ctx context.Context, transferID string)) *MockCourseRepo_DeclineOwnershipTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_DeclineOwnershipTransfer_Call) Return(ownershipTransfer domain.OwnershipTransfer, err error) *MockCourseRepo_DeclineOwnershipTransfer_Call {
+	_c.Call.Return(ownershipTransfer, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_DeclineOwnershipTransfer_Call) RunAndReturn(run func(ctx context.Context, transferID string) (domain.OwnershipTransfer, error)) *MockCourseRepo_DeclineOwnershipTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) Delete(ctx context.Context, courseID string) (domain.Course, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 domain.Course
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Course, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Course); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Get(0).(domain.Course)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCourseRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) Delete(ctx interface{}, courseID interface{}) *MockCourseRepo_Delete_Call {
+	return &MockCourseRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_Delete_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_Delete_Call) Return(course domain.Course, err error) *MockCourseRepo_Delete_Call {
+	_c.Call.Return(course, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.Course, error)) *MockCourseRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteModule provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) DeleteModule(ctx context.Context, courseID string, moduleID string) (domain.Module, error) {
+	ret := _mock.Called(ctx, courseID, moduleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteModule")
+	}
+
+	var r0 domain.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Module, error)); ok {
+		return returnFunc(ctx, courseID, moduleID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Module); ok {
+		r0 = returnFunc(ctx, courseID, moduleID)
+	} else {
+		r0 = ret.Get(0).(domain.Module)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, moduleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_DeleteModule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteModule'
+type MockCourseRepo_DeleteModule_Call struct {
+	*mock.Call
+}
+
+// DeleteModule is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - moduleID
+func (_e *MockCourseRepo_Expecter) DeleteModule(ctx interface{}, courseID interface{}, moduleID interface{}) *MockCourseRepo_DeleteModule_Call {
+	return &MockCourseRepo_DeleteModule_Call{Call: _e.mock.On("DeleteModule", ctx, courseID, moduleID)}
+}
+
+func (_c *MockCourseRepo_DeleteModule_Call) Run(run func(ctx context.Context, courseID string, moduleID string)) *MockCourseRepo_DeleteModule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_DeleteModule_Call) Return(module domain.Module, err error) *MockCourseRepo_DeleteModule_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_DeleteModule_Call) RunAndReturn(run func(ctx context.Context, courseID string, moduleID string) (domain.Module, error)) *MockCourseRepo_DeleteModule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollUser provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) EnrollUser(ctx context.Context, courseID string, studentID string, role domain.Role) (domain.Enrollment, error) {
+	ret := _mock.Called(ctx, courseID, studentID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollUser")
+	}
+
+	var r0 domain.Enrollment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Role) (domain.Enrollment, error)); ok {
+		return returnFunc(ctx, courseID, studentID, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Role) domain.Enrollment); ok {
+		r0 = returnFunc(ctx, courseID, studentID, role)
+	} else {
+		r0 = ret.Get(0).(domain.Enrollment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Role) error); ok {
+		r1 = returnFunc(ctx, courseID, studentID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_EnrollUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollUser'
+type MockCourseRepo_EnrollUser_Call struct {
+	*mock.Call
+}
+
+// EnrollUser is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - studentID
+//   - role
+func (_e *MockCourseRepo_Expecter) EnrollUser(ctx interface{}, courseID interface{}, studentID interface{}, role interface{}) *MockCourseRepo_EnrollUser_Call {
+	return &MockCourseRepo_EnrollUser_Call{Call: _e.mock.On("EnrollUser", ctx, courseID, studentID, role)}
+}
+
+func (_c *MockCourseRepo_EnrollUser_Call) Run(run func(ctx context.Context, courseID string, studentID string, role domain.Role)) *MockCourseRepo_EnrollUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_EnrollUser_Call) Return(enrollment domain.Enrollment, err error) *MockCourseRepo_EnrollUser_Call {
+	_c.Call.Return(enrollment, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_EnrollUser_Call) RunAndReturn(run func(ctx context.Context, courseID string, studentID string, role domain.Role) (domain.Enrollment, error)) *MockCourseRepo_EnrollUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpelUser provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ExpelUser(ctx context.Context, courseID string, studentID string) (domain.Enrollment, error) {
+	ret := _mock.Called(ctx, courseID, studentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpelUser")
+	}
+
+	var r0 domain.Enrollment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Enrollment, error)); ok {
+		return returnFunc(ctx, courseID, studentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Enrollment); ok {
+		r0 = returnFunc(ctx, courseID, studentID)
+	} else {
+		r0 = ret.Get(0).(domain.Enrollment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, studentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ExpelUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpelUser'
+type MockCourseRepo_ExpelUser_Call struct {
+	*mock.Call
+}
+
+// ExpelUser is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - studentID
+func (_e *MockCourseRepo_Expecter) ExpelUser(ctx interface{}, courseID interface{}, studentID interface{}) *MockCourseRepo_ExpelUser_Call {
+	return &MockCourseRepo_ExpelUser_Call{Call: _e.mock.On("ExpelUser", ctx, courseID, studentID)}
+}
+
+func (_c *MockCourseRepo_ExpelUser_Call) Run(run func(ctx context.Context, courseID string, studentID string)) *MockCourseRepo_ExpelUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ExpelUser_Call) Return(enrollment domain.Enrollment, err error) *MockCourseRepo_ExpelUser_Call {
+	_c.Call.Return(enrollment, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ExpelUser_Call) RunAndReturn(run func(ctx context.Context, courseID string, studentID string) (domain.Enrollment, error)) *MockCourseRepo_ExpelUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceTransferOwnership provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ForceTransferOwnership(ctx context.Context, courseID string, toUserID string) (domain.OwnershipTransfer, error) {
+	ret := _mock.Called(ctx, courseID, toUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceTransferOwnership")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.OwnershipTransfer, error)); ok {
+		return returnFunc(ctx, courseID, toUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.OwnershipTransfer); ok {
+		r0 = returnFunc(ctx, courseID, toUserID)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, toUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ForceTransferOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceTransferOwnership'
+type MockCourseRepo_ForceTransferOwnership_Call struct {
+	*mock.Call
+}
+
+// ForceTransferOwnership is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - toUserID
+func (_e *MockCourseRepo_Expecter) ForceTransferOwnership(ctx interface{}, courseID interface{}, toUserID interface{}) *MockCourseRepo_ForceTransferOwnership_Call {
+	return &MockCourseRepo_ForceTransferOwnership_Call{Call: _e.mock.On("ForceTransferOwnership", ctx, courseID, toUserID)}
+}
+
+func (_c *MockCourseRepo_ForceTransferOwnership_Call) Run(run func(ctx context.Context, courseID string, toUserID string)) *MockCourseRepo_ForceTransferOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ForceTransferOwnership_Call) Return(ownershipTransfer domain.OwnershipTransfer, err error) *MockCourseRepo_ForceTransferOwnership_Call {
+	_c.Call.Return(ownershipTransfer, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ForceTransferOwnership_Call) RunAndReturn(run func(ctx context.Context, courseID string, toUserID string) (domain.OwnershipTransfer, error)) *MockCourseRepo_ForceTransferOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) GetByID(ctx context.Context, courseID string) (domain.Course, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Course
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Course, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Course); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Get(0).(domain.Course)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCourseRepo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) GetByID(ctx interface{}, courseID interface{}) *MockCourseRepo_GetByID_Call {
+	return &MockCourseRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_GetByID_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_GetByID_Call) Return(course domain.Course, err error) *MockCourseRepo_GetByID_Call {
+	_c.Call.Return(course, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.Course, error)) *MockCourseRepo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCloneJob provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) GetCloneJob(ctx context.Context, jobID string) (domain.CloneJob, error) {
+	ret := _mock.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCloneJob")
+	}
+
+	var r0 domain.CloneJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.CloneJob, error)); ok {
+		return returnFunc(ctx, jobID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.CloneJob); ok {
+		r0 = returnFunc(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(domain.CloneJob)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_GetCloneJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloneJob'
+type MockCourseRepo_GetCloneJob_Call struct {
+	*mock.Call
+}
+
+// GetCloneJob is a helper method to define mock.On call
+//   - ctx
+//   - jobID
+func (_e *MockCourseRepo_Expecter) GetCloneJob(ctx interface{}, jobID interface{}) *MockCourseRepo_GetCloneJob_Call {
+	return &MockCourseRepo_GetCloneJob_Call{Call: _e.mock.On("GetCloneJob", ctx, jobID)}
+}
+
+func (_c *MockCourseRepo_GetCloneJob_Call) Run(run func(ctx context.Context, jobID string)) *MockCourseRepo_GetCloneJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_GetCloneJob_Call) Return(cloneJob domain.CloneJob, err error) *MockCourseRepo_GetCloneJob_Call {
+	_c.Call.Return(cloneJob, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_GetCloneJob_Call) RunAndReturn(run func(ctx context.Context, jobID string) (domain.CloneJob, error)) *MockCourseRepo_GetCloneJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnrollmentRequest provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) GetEnrollmentRequest(ctx context.Context, courseID string, requestID string) (domain.EnrollmentRequest, error) {
+	ret := _mock.Called(ctx, courseID, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnrollmentRequest")
+	}
+
+	var r0 domain.EnrollmentRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.EnrollmentRequest, error)); ok {
+		return returnFunc(ctx, courseID, requestID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.EnrollmentRequest); ok {
+		r0 = returnFunc(ctx, courseID, requestID)
+	} else {
+		r0 = ret.Get(0).(domain.EnrollmentRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_GetEnrollmentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrollmentRequest'
+type MockCourseRepo_GetEnrollmentRequest_Call struct {
+	*mock.Call
+}
+
+// GetEnrollmentRequest is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - requestID
+func (_e *MockCourseRepo_Expecter) GetEnrollmentRequest(ctx interface{}, courseID interface{}, requestID interface{}) *MockCourseRepo_GetEnrollmentRequest_Call {
+	return &MockCourseRepo_GetEnrollmentRequest_Call{Call: _e.mock.On("GetEnrollmentRequest", ctx, courseID, requestID)}
+}
+
+func (_c *MockCourseRepo_GetEnrollmentRequest_Call) Run(run func(ctx context.Context, courseID string, requestID string)) *MockCourseRepo_GetEnrollmentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_GetEnrollmentRequest_Call) Return(enrollmentRequest domain.EnrollmentRequest, err error) *MockCourseRepo_GetEnrollmentRequest_Call {
+	_c.Call.Return(enrollmentRequest, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_GetEnrollmentRequest_Call) RunAndReturn(run func(ctx context.Context, courseID string, requestID string) (domain.EnrollmentRequest, error)) *MockCourseRepo_GetEnrollmentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInviteByCode provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) GetInviteByCode(ctx context.Context, code string) (domain.Invite, error) {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInviteByCode")
+	}
+
+	var r0 domain.Invite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Invite, error)); ok {
+		return returnFunc(ctx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Invite); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		r0 = ret.Get(0).(domain.Invite)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_GetInviteByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInviteByCode'
+type MockCourseRepo_GetInviteByCode_Call struct {
+	*mock.Call
+}
+
+// GetInviteByCode is a helper method to define mock.On call
+//   - ctx
+//   - code
+func (_e *MockCourseRepo_Expecter) GetInviteByCode(ctx interface{}, code interface{}) *MockCourseRepo_GetInviteByCode_Call {
+	return &MockCourseRepo_GetInviteByCode_Call{Call: _e.mock.On("GetInviteByCode", ctx, code)}
+}
+
+func (_c *MockCourseRepo_GetInviteByCode_Call) Run(run func(ctx context.Context, code string)) *MockCourseRepo_GetInviteByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_GetInviteByCode_Call) Return(invite domain.Invite, err error) *MockCourseRepo_GetInviteByCode_Call {
+	_c.Call.Return(invite, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_GetInviteByCode_Call) RunAndReturn(run func(ctx context.Context, code string) (domain.Invite, error)) *MockCourseRepo_GetInviteByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOwnershipTransfer provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) GetOwnershipTransfer(ctx context.Context, transferID string) (domain.OwnershipTransfer, error) {
+	ret := _mock.Called(ctx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.OwnershipTransfer, error)); ok {
+		return returnFunc(ctx, transferID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.OwnershipTransfer); ok {
+		r0 = returnFunc(ctx, transferID)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_GetOwnershipTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOwnershipTransfer'
+type MockCourseRepo_GetOwnershipTransfer_Call struct {
+	*mock.Call
+}
+
+// GetOwnershipTransfer is a helper method to define mock.On call
+//   - ctx
+//   - transferID
+func (_e *MockCourseRepo_Expecter) GetOwnershipTransfer(ctx interface{}, transferID interface{}) *MockCourseRepo_GetOwnershipTransfer_Call {
+	return &MockCourseRepo_GetOwnershipTransfer_Call{Call: _e.mock.On("GetOwnershipTransfer", ctx, transferID)}
+}
+
+func (_c *MockCourseRepo_GetOwnershipTransfer_Call) Run(run func(ctx context.Context, transferID string)) *MockCourseRepo_GetOwnershipTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_GetOwnershipTransfer_Call) Return(ownershipTransfer domain.OwnershipTransfer, err error) *MockCourseRepo_GetOwnershipTransfer_Call {
+	_c.Call.Return(ownershipTransfer, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_GetOwnershipTransfer_Call) RunAndReturn(run func(ctx context.Context, transferID string) (domain.OwnershipTransfer, error)) *MockCourseRepo_GetOwnershipTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRole provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) GetRole(ctx context.Context, courseID string, userID string) (domain.Role, error) {
+	ret := _mock.Called(ctx, courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 domain.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Role, error)); ok {
+		return returnFunc(ctx, courseID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Role); ok {
+		r0 = returnFunc(ctx, courseID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
+type MockCourseRepo_GetRole_Call struct {
+	*mock.Call
+}
+
+// GetRole is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - userID
+func (_e *MockCourseRepo_Expecter) GetRole(ctx interface{}, courseID interface{}, userID interface{}) *MockCourseRepo_GetRole_Call {
+	return &MockCourseRepo_GetRole_Call{Call: _e.mock.On("GetRole", ctx, courseID, userID)}
+}
+
+func (_c *MockCourseRepo_GetRole_Call) Run(run func(ctx context.Context, courseID string, userID string)) *MockCourseRepo_GetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_GetRole_Call) Return(role domain.Role, err error) *MockCourseRepo_GetRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_GetRole_Call) RunAndReturn(run func(ctx context.Context, courseID string, userID string) (domain.Role, error)) *MockCourseRepo_GetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMember provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) IsMember(ctx context.Context, courseID string, userID string) (bool, error) {
+	ret := _mock.Called(ctx, courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMember")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, courseID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, courseID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_IsMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMember'
+type MockCourseRepo_IsMember_Call struct {
+	*mock.Call
+}
+
+// IsMember is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - userID
+func (_e *MockCourseRepo_Expecter) IsMember(ctx interface{}, courseID interface{}, userID interface{}) *MockCourseRepo_IsMember_Call {
+	return &MockCourseRepo_IsMember_Call{Call: _e.mock.On("IsMember", ctx, courseID, userID)}
+}
+
+func (_c *MockCourseRepo_IsMember_Call) Run(run func(ctx context.Context, courseID string, userID string)) *MockCourseRepo_IsMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_IsMember_Call) Return(b bool, err error) *MockCourseRepo_IsMember_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_IsMember_Call) RunAndReturn(run func(ctx context.Context, courseID string, userID string) (bool, error)) *MockCourseRepo_IsMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsTeacher provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) IsTeacher(ctx context.Context, courseID string, teacherID string) (bool, error) {
+	ret := _mock.Called(ctx, courseID, teacherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTeacher")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, courseID, teacherID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, courseID, teacherID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, teacherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_IsTeacher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTeacher'
+type MockCourseRepo_IsTeacher_Call struct {
+	*mock.Call
+}
+
+// IsTeacher is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - teacherID
+func (_e *MockCourseRepo_Expecter) IsTeacher(ctx interface{}, courseID interface{}, teacherID interface{}) *MockCourseRepo_IsTeacher_Call {
+	return &MockCourseRepo_IsTeacher_Call{Call: _e.mock.On("IsTeacher", ctx, courseID, teacherID)}
+}
+
+func (_c *MockCourseRepo_IsTeacher_Call) Run(run func(ctx context.Context, courseID string, teacherID string)) *MockCourseRepo_IsTeacher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_IsTeacher_Call) Return(b bool, err error) *MockCourseRepo_IsTeacher_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_IsTeacher_Call) RunAndReturn(run func(ctx context.Context, courseID string, teacherID string) (bool, error)) *MockCourseRepo_IsTeacher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JoinByInvite provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) JoinByInvite(ctx context.Context, inviteID string, userID string) (domain.Enrollment, error) {
+	ret := _mock.Called(ctx, inviteID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinByInvite")
+	}
+
+	var r0 domain.Enrollment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Enrollment, error)); ok {
+		return returnFunc(ctx, inviteID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Enrollment); ok {
+		r0 = returnFunc(ctx, inviteID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Enrollment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, inviteID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_JoinByInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinByInvite'
+type MockCourseRepo_JoinByInvite_Call struct {
+	*mock.Call
+}
+
+// JoinByInvite is a helper method to define mock.On call
+//   - ctx
+//   - inviteID
+//   - userID
+func (_e *MockCourseRepo_Expecter) JoinByInvite(ctx interface{}, inviteID interface{}, userID interface{}) *MockCourseRepo_JoinByInvite_Call {
+	return &MockCourseRepo_JoinByInvite_Call{Call: _e.mock.On("JoinByInvite", ctx, inviteID, userID)}
+}
+
+func (_c *MockCourseRepo_JoinByInvite_Call) Run(run func(ctx context.Context, inviteID string, userID string)) *MockCourseRepo_JoinByInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_JoinByInvite_Call) Return(enrollment domain.Enrollment, err error) *MockCourseRepo_JoinByInvite_Call {
+	_c.Call.Return(enrollment, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_JoinByInvite_Call) RunAndReturn(run func(ctx context.Context, inviteID string, userID string) (domain.Enrollment, error)) *MockCourseRepo_JoinByInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByStudentID provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListByStudentID(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error) {
+	ret := _mock.Called(ctx, teacherID, includeArchived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStudentID")
+	}
+
+	var r0 []domain.Course
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) ([]domain.Course, error)); ok {
+		return returnFunc(ctx, teacherID, includeArchived)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) []domain.Course); ok {
+		r0 = returnFunc(ctx, teacherID, includeArchived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Course)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, teacherID, includeArchived)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListByStudentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByStudentID'
+type MockCourseRepo_ListByStudentID_Call struct {
+	*mock.Call
+}
+
+// ListByStudentID is a helper method to define mock.On call
+//   - ctx
+//   - teacherID
+//   - includeArchived
+func (_e *MockCourseRepo_Expecter) ListByStudentID(ctx interface{}, teacherID interface{}, includeArchived interface{}) *MockCourseRepo_ListByStudentID_Call {
+	return &MockCourseRepo_ListByStudentID_Call{Call: _e.mock.On("ListByStudentID", ctx, teacherID, includeArchived)}
+}
+
+func (_c *MockCourseRepo_ListByStudentID_Call) Run(run func(ctx context.Context, teacherID string, includeArchived bool)) *MockCourseRepo_ListByStudentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListByStudentID_Call) Return(courses []domain.Course, err error) *MockCourseRepo_ListByStudentID_Call {
+	_c.Call.Return(courses, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListByStudentID_Call) RunAndReturn(run func(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error)) *MockCourseRepo_ListByStudentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByTeacherID provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListByTeacherID(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error) {
+	ret := _mock.Called(ctx, teacherID, includeArchived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTeacherID")
+	}
+
+	var r0 []domain.Course
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) ([]domain.Course, error)); ok {
+		return returnFunc(ctx, teacherID, includeArchived)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) []domain.Course); ok {
+		r0 = returnFunc(ctx, teacherID, includeArchived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Course)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, teacherID, includeArchived)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListByTeacherID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTeacherID'
+type MockCourseRepo_ListByTeacherID_Call struct {
+	*mock.Call
+}
+
+// ListByTeacherID is a helper method to define mock.On call
+//   - ctx
+//   - teacherID
+//   - includeArchived
+func (_e *MockCourseRepo_Expecter) ListByTeacherID(ctx interface{}, teacherID interface{}, includeArchived interface{}) *MockCourseRepo_ListByTeacherID_Call {
+	return &MockCourseRepo_ListByTeacherID_Call{Call: _e.mock.On("ListByTeacherID", ctx, teacherID, includeArchived)}
+}
+
+func (_c *MockCourseRepo_ListByTeacherID_Call) Run(run func(ctx context.Context, teacherID string, includeArchived bool)) *MockCourseRepo_ListByTeacherID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListByTeacherID_Call) Return(courses []domain.Course, err error) *MockCourseRepo_ListByTeacherID_Call {
+	_c.Call.Return(courses, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListByTeacherID_Call) RunAndReturn(run func(ctx context.Context, teacherID string, includeArchived bool) ([]domain.Course, error)) *MockCourseRepo_ListByTeacherID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCoursePermissions provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListCoursePermissions(ctx context.Context, courseID string) ([]domain.RolePermissions, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCoursePermissions")
+	}
+
+	var r0 []domain.RolePermissions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.RolePermissions, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.RolePermissions); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RolePermissions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListCoursePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCoursePermissions'
+type MockCourseRepo_ListCoursePermissions_Call struct {
+	*mock.Call
+}
+
+// ListCoursePermissions is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) ListCoursePermissions(ctx interface{}, courseID interface{}) *MockCourseRepo_ListCoursePermissions_Call {
+	return &MockCourseRepo_ListCoursePermissions_Call{Call: _e.mock.On("ListCoursePermissions", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_ListCoursePermissions_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_ListCoursePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListCoursePermissions_Call) Return(rolePermissionss []domain.RolePermissions, err error) *MockCourseRepo_ListCoursePermissions_Call {
+	_c.Call.Return(rolePermissionss, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListCoursePermissions_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.RolePermissions, error)) *MockCourseRepo_ListCoursePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCourseStudents provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListCourseStudents(ctx context.Context, courseID string, index int32, limit int32) ([]domain.Student, int32, error) {
+	ret := _mock.Called(ctx, courseID, index, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCourseStudents")
+	}
+
+	var r0 []domain.Student
+	var r1 int32
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]domain.Student, int32, error)); ok {
+		return returnFunc(ctx, courseID, index, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, int32) []domain.Student); ok {
+		r0 = returnFunc(ctx, courseID, index, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Student)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32, int32) int32); ok {
+		r1 = returnFunc(ctx, courseID, index, limit)
+	} else {
+		r1 = ret.Get(1).(int32)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int32, int32) error); ok {
+		r2 = returnFunc(ctx, courseID, index, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCourseRepo_ListCourseStudents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCourseStudents'
+type MockCourseRepo_ListCourseStudents_Call struct {
+	*mock.Call
+}
+
+// ListCourseStudents is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - index
+//   - limit
+func (_e *MockCourseRepo_Expecter) ListCourseStudents(ctx interface{}, courseID interface{}, index interface{}, limit interface{}) *MockCourseRepo_ListCourseStudents_Call {
+	return &MockCourseRepo_ListCourseStudents_Call{Call: _e.mock.On("ListCourseStudents", ctx, courseID, index, limit)}
+}
+
+func (_c *MockCourseRepo_ListCourseStudents_Call) Run(run func(ctx context.Context, courseID string, index int32, limit int32)) *MockCourseRepo_ListCourseStudents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListCourseStudents_Call) Return(students []domain.Student, n int32, err error) *MockCourseRepo_ListCourseStudents_Call {
+	_c.Call.Return(students, n, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListCourseStudents_Call) RunAndReturn(run func(ctx context.Context, courseID string, index int32, limit int32) ([]domain.Student, int32, error)) *MockCourseRepo_ListCourseStudents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnrollmentRequests provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListEnrollmentRequests(ctx context.Context, courseID string, status domain.RequestStatus) ([]domain.EnrollmentRequest, error) {
+	ret := _mock.Called(ctx, courseID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnrollmentRequests")
+	}
+
+	var r0 []domain.EnrollmentRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.RequestStatus) ([]domain.EnrollmentRequest, error)); ok {
+		return returnFunc(ctx, courseID, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.RequestStatus) []domain.EnrollmentRequest); ok {
+		r0 = returnFunc(ctx, courseID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EnrollmentRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.RequestStatus) error); ok {
+		r1 = returnFunc(ctx, courseID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListEnrollmentRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnrollmentRequests'
+type MockCourseRepo_ListEnrollmentRequests_Call struct {
+	*mock.Call
+}
+
+// ListEnrollmentRequests is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - status
+func (_e *MockCourseRepo_Expecter) ListEnrollmentRequests(ctx interface{}, courseID interface{}, status interface{}) *MockCourseRepo_ListEnrollmentRequests_Call {
+	return &MockCourseRepo_ListEnrollmentRequests_Call{Call: _e.mock.On("ListEnrollmentRequests", ctx, courseID, status)}
+}
+
+func (_c *MockCourseRepo_ListEnrollmentRequests_Call) Run(run func(ctx context.Context, courseID string, status domain.RequestStatus)) *MockCourseRepo_ListEnrollmentRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.RequestStatus))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListEnrollmentRequests_Call) Return(enrollmentRequests []domain.EnrollmentRequest, err error) *MockCourseRepo_ListEnrollmentRequests_Call {
+	_c.Call.Return(enrollmentRequests, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListEnrollmentRequests_Call) RunAndReturn(run func(ctx context.Context, courseID string, status domain.RequestStatus) ([]domain.EnrollmentRequest, error)) *MockCourseRepo_ListEnrollmentRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvites provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListInvites(ctx context.Context, courseID string) ([]domain.Invite, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvites")
+	}
+
+	var r0 []domain.Invite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Invite, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Invite); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Invite)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvites'
+type MockCourseRepo_ListInvites_Call struct {
+	*mock.Call
+}
+
+// ListInvites is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) ListInvites(ctx interface{}, courseID interface{}) *MockCourseRepo_ListInvites_Call {
+	return &MockCourseRepo_ListInvites_Call{Call: _e.mock.On("ListInvites", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_ListInvites_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_ListInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListInvites_Call) Return(invites []domain.Invite, err error) *MockCourseRepo_ListInvites_Call {
+	_c.Call.Return(invites, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListInvites_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.Invite, error)) *MockCourseRepo_ListInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListModules provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListModules(ctx context.Context, courseID string) ([]domain.Module, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListModules")
+	}
+
+	var r0 []domain.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Module, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Module); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListModules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListModules'
+type MockCourseRepo_ListModules_Call struct {
+	*mock.Call
+}
+
+// ListModules is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) ListModules(ctx interface{}, courseID interface{}) *MockCourseRepo_ListModules_Call {
+	return &MockCourseRepo_ListModules_Call{Call: _e.mock.On("ListModules", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_ListModules_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_ListModules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListModules_Call) Return(modules []domain.Module, err error) *MockCourseRepo_ListModules_Call {
+	_c.Call.Return(modules, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListModules_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.Module, error)) *MockCourseRepo_ListModules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOwnershipTransfers provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListOwnershipTransfers(ctx context.Context, toUserID string) ([]domain.OwnershipTransfer, error) {
+	ret := _mock.Called(ctx, toUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOwnershipTransfers")
+	}
+
+	var r0 []domain.OwnershipTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.OwnershipTransfer, error)); ok {
+		return returnFunc(ctx, toUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.OwnershipTransfer); ok {
+		r0 = returnFunc(ctx, toUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OwnershipTransfer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, toUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListOwnershipTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOwnershipTransfers'
+type MockCourseRepo_ListOwnershipTransfers_Call struct {
+	*mock.Call
+}
+
+// ListOwnershipTransfers is a helper method to define mock.On call
+//   - ctx
+//   - toUserID
+func (_e *MockCourseRepo_Expecter) ListOwnershipTransfers(ctx interface{}, toUserID interface{}) *MockCourseRepo_ListOwnershipTransfers_Call {
+	return &MockCourseRepo_ListOwnershipTransfers_Call{Call: _e.mock.On("ListOwnershipTransfers", ctx, toUserID)}
+}
+
+func (_c *MockCourseRepo_ListOwnershipTransfers_Call) Run(run func(ctx context.Context, toUserID string)) *MockCourseRepo_ListOwnershipTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListOwnershipTransfers_Call) Return(ownershipTransfers []domain.OwnershipTransfer, err error) *MockCourseRepo_ListOwnershipTransfers_Call {
+	_c.Call.Return(ownershipTransfers, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListOwnershipTransfers_Call) RunAndReturn(run func(ctx context.Context, toUserID string) ([]domain.OwnershipTransfer, error)) *MockCourseRepo_ListOwnershipTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRolePermissions provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListRolePermissions(ctx context.Context, courseID string, role domain.Role) ([]domain.Permission, error) {
+	ret := _mock.Called(ctx, courseID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRolePermissions")
+	}
+
+	var r0 []domain.Permission
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Role) ([]domain.Permission, error)); ok {
+		return returnFunc(ctx, courseID, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Role) []domain.Permission); ok {
+		r0 = returnFunc(ctx, courseID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Permission)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.Role) error); ok {
+		r1 = returnFunc(ctx, courseID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListRolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRolePermissions'
+type MockCourseRepo_ListRolePermissions_Call struct {
+	*mock.Call
+}
+
+// ListRolePermissions is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - role
+func (_e *MockCourseRepo_Expecter) ListRolePermissions(ctx interface{}, courseID interface{}, role interface{}) *MockCourseRepo_ListRolePermissions_Call {
+	return &MockCourseRepo_ListRolePermissions_Call{Call: _e.mock.On("ListRolePermissions", ctx, courseID, role)}
+}
+
+func (_c *MockCourseRepo_ListRolePermissions_Call) Run(run func(ctx context.Context, courseID string, role domain.Role)) *MockCourseRepo_ListRolePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListRolePermissions_Call) Return(permissions []domain.Permission, err error) *MockCourseRepo_ListRolePermissions_Call {
+	_c.Call.Return(permissions, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListRolePermissions_Call) RunAndReturn(run func(ctx context.Context, courseID string, role domain.Role) ([]domain.Permission, error)) *MockCourseRepo_ListRolePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoster provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListRoster(ctx context.Context, courseID string) ([]domain.RosterEntry, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoster")
+	}
+
+	var r0 []domain.RosterEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.RosterEntry, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.RosterEntry); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RosterEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListRoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoster'
+type MockCourseRepo_ListRoster_Call struct {
+	*mock.Call
+}
+
+// ListRoster is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) ListRoster(ctx interface{}, courseID interface{}) *MockCourseRepo_ListRoster_Call {
+	return &MockCourseRepo_ListRoster_Call{Call: _e.mock.On("ListRoster", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_ListRoster_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_ListRoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListRoster_Call) Return(rosterEntrys []domain.RosterEntry, err error) *MockCourseRepo_ListRoster_Call {
+	_c.Call.Return(rosterEntrys, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListRoster_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.RosterEntry, error)) *MockCourseRepo_ListRoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStaff provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListStaff(ctx context.Context, courseID string) ([]domain.Staff, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStaff")
+	}
+
+	var r0 []domain.Staff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Staff, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Staff); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Staff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStaff'
+type MockCourseRepo_ListStaff_Call struct {
+	*mock.Call
+}
+
+// ListStaff is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) ListStaff(ctx interface{}, courseID interface{}) *MockCourseRepo_ListStaff_Call {
+	return &MockCourseRepo_ListStaff_Call{Call: _e.mock.On("ListStaff", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_ListStaff_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_ListStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListStaff_Call) Return(staffs []domain.Staff, err error) *MockCourseRepo_ListStaff_Call {
+	_c.Call.Return(staffs, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListStaff_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.Staff, error)) *MockCourseRepo_ListStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsersByEmails provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ListUsersByEmails(ctx context.Context, emails []string) ([]domain.Student, error) {
+	ret := _mock.Called(ctx, emails)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersByEmails")
+	}
+
+	var r0 []domain.Student
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Student, error)); ok {
+		return returnFunc(ctx, emails)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.Student); ok {
+		r0 = returnFunc(ctx, emails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Student)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, emails)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_ListUsersByEmails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersByEmails'
+type MockCourseRepo_ListUsersByEmails_Call struct {
+	*mock.Call
+}
+
+// ListUsersByEmails is a helper method to define mock.On call
+//   - ctx
+//   - emails
+func (_e *MockCourseRepo_Expecter) ListUsersByEmails(ctx interface{}, emails interface{}) *MockCourseRepo_ListUsersByEmails_Call {
+	return &MockCourseRepo_ListUsersByEmails_Call{Call: _e.mock.On("ListUsersByEmails", ctx, emails)}
+}
+
+func (_c *MockCourseRepo_ListUsersByEmails_Call) Run(run func(ctx context.Context, emails []string)) *MockCourseRepo_ListUsersByEmails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_ListUsersByEmails_Call) Return(students []domain.Student, err error) *MockCourseRepo_ListUsersByEmails_Call {
+	_c.Call.Return(students, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_ListUsersByEmails_Call) RunAndReturn(run func(ctx context.Context, emails []string) ([]domain.Student, error)) *MockCourseRepo_ListUsersByEmails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteWaitlist provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) PromoteWaitlist(ctx context.Context, courseID string) ([]domain.EnrollmentRequest, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteWaitlist")
+	}
+
+	var r0 []domain.EnrollmentRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.EnrollmentRequest, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.EnrollmentRequest); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EnrollmentRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_PromoteWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteWaitlist'
+type MockCourseRepo_PromoteWaitlist_Call struct {
+	*mock.Call
+}
+
+// PromoteWaitlist is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockCourseRepo_Expecter) PromoteWaitlist(ctx interface{}, courseID interface{}) *MockCourseRepo_PromoteWaitlist_Call {
+	return &MockCourseRepo_PromoteWaitlist_Call{Call: _e.mock.On("PromoteWaitlist", ctx, courseID)}
+}
+
+func (_c *MockCourseRepo_PromoteWaitlist_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_PromoteWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCourseRepo_PromoteWaitlist_Call) Return(enrollmentRequests []domain.EnrollmentRequest, err error) *MockCourseRepo_PromoteWaitlist_Call {
+	_c.Call.Return(enrollmentRequests, err)
+	return _c
+}
+
+func (_c *MockCourseRepo_PromoteWaitlist_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.EnrollmentRequest, error)) *MockCourseRepo_PromoteWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) Purge(ctx context.Context, before time.Time) ([]string, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCourseRepo_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockCourseRepo_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx
+//   - before
+func (_e *MockCourseRepo_Expecter) Purge(ctx interface{}, before interface{}) *MockCourseRepo_Purge_Call {
+	return &MockCourseRepo_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
+}
+
+func (_c *MockCourseRepo_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *MockCourseRepo_Purge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(dto.CreateCourseDTO))
+		run(args[0].(context.Context), args[1].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_Create_Call) Return(course domain.Course, err error) *MockCourseRepo_Create_Call {
-	_c.Call.Return(course, err)
+func (_c *MockCourseRepo_Purge_Call) Return(strings []string, err error) *MockCourseRepo_Purge_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_Create_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.CreateCourseDTO) (domain.Course, error)) *MockCourseRepo_Create_Call {
+func (_c *MockCourseRepo_Purge_Call) RunAndReturn(run func(ctx context.Context, before time.Time) ([]string, error)) *MockCourseRepo_Purge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) Delete(ctx context.Context, courseID string) (domain.Course, error) {
-	ret := _mock.Called(ctx, courseID)
+// RejectEnrollmentRequest provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) RejectEnrollmentRequest(ctx context.Context, courseID string, requestID string, decidedBy string) (domain.EnrollmentRequest, error) {
+	ret := _mock.Called(ctx, courseID, requestID, decidedBy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for RejectEnrollmentRequest")
 	}
 
-	var r0 domain.Course
+	var r0 domain.EnrollmentRequest
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Course, error)); ok {
-		return returnFunc(ctx, courseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (domain.EnrollmentRequest, error)); ok {
+		return returnFunc(ctx, courseID, requestID, decidedBy)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Course); ok {
-		r0 = returnFunc(ctx, courseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) domain.EnrollmentRequest); ok {
+		r0 = returnFunc(ctx, courseID, requestID, decidedBy)
 	} else {
-		r0 = ret.Get(0).(domain.Course)
+		r0 = ret.Get(0).(domain.EnrollmentRequest)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, courseID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, requestID, decidedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockCourseRepo_Delete_Call struct {
+// MockCourseRepo_RejectEnrollmentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectEnrollmentRequest'
+type MockCourseRepo_RejectEnrollmentRequest_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// RejectEnrollmentRequest is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-func (_e *MockCourseRepo_Expecter) Delete(ctx interface{}, courseID interface{}) *MockCourseRepo_Delete_Call {
-	return &MockCourseRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, courseID)}
+//   - requestID
+//   - decidedBy
+func (_e *MockCourseRepo_Expecter) RejectEnrollmentRequest(ctx interface{}, courseID interface{}, requestID interface{}, decidedBy interface{}) *MockCourseRepo_RejectEnrollmentRequest_Call {
+	return &MockCourseRepo_RejectEnrollmentRequest_Call{Call: _e.mock.On("RejectEnrollmentRequest", ctx, courseID, requestID, decidedBy)}
 }
 
-func (_c *MockCourseRepo_Delete_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_Delete_Call {
+func (_c *MockCourseRepo_RejectEnrollmentRequest_Call) Run(run func(ctx context.Context, courseID string, requestID string, decidedBy string)) *MockCourseRepo_RejectEnrollmentRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_Delete_Call) Return(course domain.Course, err error) *MockCourseRepo_Delete_Call {
-	_c.Call.Return(course, err)
+func (_c *MockCourseRepo_RejectEnrollmentRequest_Call) Return(enrollmentRequest domain.EnrollmentRequest, err error) *MockCourseRepo_RejectEnrollmentRequest_Call {
+	_c.Call.Return(enrollmentRequest, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.Course, error)) *MockCourseRepo_Delete_Call {
+func (_c *MockCourseRepo_RejectEnrollmentRequest_Call) RunAndReturn(run func(ctx context.Context, courseID string, requestID string, decidedBy string) (domain.EnrollmentRequest, error)) *MockCourseRepo_RejectEnrollmentRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// EnrollUser provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) EnrollUser(ctx context.Context, courseID string, studentID string) (domain.Enrollment, error) {
-	ret := _mock.Called(ctx, courseID, studentID)
+// RemoveStaff provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) RemoveStaff(ctx context.Context, courseID string, userID string) (domain.Staff, error) {
+	ret := _mock.Called(ctx, courseID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for EnrollUser")
+		panic("no return value specified for RemoveStaff")
 	}
 
-	var r0 domain.Enrollment
+	var r0 domain.Staff
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Enrollment, error)); ok {
-		return returnFunc(ctx, courseID, studentID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Staff, error)); ok {
+		return returnFunc(ctx, courseID, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Enrollment); ok {
-		r0 = returnFunc(ctx, courseID, studentID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Staff); ok {
+		r0 = returnFunc(ctx, courseID, userID)
 	} else {
-		r0 = ret.Get(0).(domain.Enrollment)
+		r0 = ret.Get(0).(domain.Staff)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, courseID, studentID)
+		r1 = returnFunc(ctx, courseID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_EnrollUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollUser'
-type MockCourseRepo_EnrollUser_Call struct {
+// MockCourseRepo_RemoveStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveStaff'
+type MockCourseRepo_RemoveStaff_Call struct {
 	*mock.Call
 }
 
-// EnrollUser is a helper method to define mock.On call
+// RemoveStaff is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-//   - studentID
-func (_e *MockCourseRepo_Expecter) EnrollUser(ctx interface{}, courseID interface{}, studentID interface{}) *MockCourseRepo_EnrollUser_Call {
-	return &MockCourseRepo_EnrollUser_Call{Call: _e.mock.On("EnrollUser", ctx, courseID, studentID)}
+//   - userID
+func (_e *MockCourseRepo_Expecter) RemoveStaff(ctx interface{}, courseID interface{}, userID interface{}) *MockCourseRepo_RemoveStaff_Call {
+	return &MockCourseRepo_RemoveStaff_Call{Call: _e.mock.On("RemoveStaff", ctx, courseID, userID)}
 }
 
-func (_c *MockCourseRepo_EnrollUser_Call) Run(run func(ctx context.Context, courseID string, studentID string)) *MockCourseRepo_EnrollUser_Call {
+func (_c *MockCourseRepo_RemoveStaff_Call) Run(run func(ctx context.Context, courseID string, userID string)) *MockCourseRepo_RemoveStaff_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_EnrollUser_Call) Return(enrollment domain.Enrollment, err error) *MockCourseRepo_EnrollUser_Call {
-	_c.Call.Return(enrollment, err)
+func (_c *MockCourseRepo_RemoveStaff_Call) Return(staff domain.Staff, err error) *MockCourseRepo_RemoveStaff_Call {
+	_c.Call.Return(staff, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_EnrollUser_Call) RunAndReturn(run func(ctx context.Context, courseID string, studentID string) (domain.Enrollment, error)) *MockCourseRepo_EnrollUser_Call {
+func (_c *MockCourseRepo_RemoveStaff_Call) RunAndReturn(run func(ctx context.Context, courseID string, userID string) (domain.Staff, error)) *MockCourseRepo_RemoveStaff_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExpelUser provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) ExpelUser(ctx context.Context, courseID string, studentID string) (domain.Enrollment, error) {
-	ret := _mock.Called(ctx, courseID, studentID)
+// ReorderModule provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) ReorderModule(ctx context.Context, courseID string, moduleID string, position int32) ([]domain.Module, error) {
+	ret := _mock.Called(ctx, courseID, moduleID, position)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExpelUser")
+		panic("no return value specified for ReorderModule")
 	}
 
-	var r0 domain.Enrollment
+	var r0 []domain.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Enrollment, error)); ok {
-		return returnFunc(ctx, courseID, studentID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]domain.Module, error)); ok {
+		return returnFunc(ctx, courseID, moduleID, position)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Enrollment); ok {
-		r0 = returnFunc(ctx, courseID, studentID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int32) []domain.Module); ok {
+		r0 = returnFunc(ctx, courseID, moduleID, position)
 	} else {
-		r0 = ret.Get(0).(domain.Enrollment)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Module)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, courseID, studentID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = returnFunc(ctx, courseID, moduleID, position)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_ExpelUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpelUser'
-type MockCourseRepo_ExpelUser_Call struct {
+// MockCourseRepo_ReorderModule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderModule'
+type MockCourseRepo_ReorderModule_Call struct {
 	*mock.Call
 }
 
-// ExpelUser is a helper method to define mock.On call
+// ReorderModule is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-//   - studentID
-func (_e *MockCourseRepo_Expecter) ExpelUser(ctx interface{}, courseID interface{}, studentID interface{}) *MockCourseRepo_ExpelUser_Call {
-	return &MockCourseRepo_ExpelUser_Call{Call: _e.mock.On("ExpelUser", ctx, courseID, studentID)}
+//   - moduleID
+//   - position
+func (_e *MockCourseRepo_Expecter) ReorderModule(ctx interface{}, courseID interface{}, moduleID interface{}, position interface{}) *MockCourseRepo_ReorderModule_Call {
+	return &MockCourseRepo_ReorderModule_Call{Call: _e.mock.On("ReorderModule", ctx, courseID, moduleID, position)}
 }
 
-func (_c *MockCourseRepo_ExpelUser_Call) Run(run func(ctx context.Context, courseID string, studentID string)) *MockCourseRepo_ExpelUser_Call {
+func (_c *MockCourseRepo_ReorderModule_Call) Run(run func(ctx context.Context, courseID string, moduleID string, position int32)) *MockCourseRepo_ReorderModule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_ExpelUser_Call) Return(enrollment domain.Enrollment, err error) *MockCourseRepo_ExpelUser_Call {
-	_c.Call.Return(enrollment, err)
+func (_c *MockCourseRepo_ReorderModule_Call) Return(modules []domain.Module, err error) *MockCourseRepo_ReorderModule_Call {
+	_c.Call.Return(modules, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_ExpelUser_Call) RunAndReturn(run func(ctx context.Context, courseID string, studentID string) (domain.Enrollment, error)) *MockCourseRepo_ExpelUser_Call {
+func (_c *MockCourseRepo_ReorderModule_Call) RunAndReturn(run func(ctx context.Context, courseID string, moduleID string, position int32) ([]domain.Module, error)) *MockCourseRepo_ReorderModule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) GetByID(ctx context.Context, courseID string) (domain.Course, error) {
+// Restore provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) Restore(ctx context.Context, courseID string) (domain.Course, error) {
 	ret := _mock.Called(ctx, courseID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Restore")
 	}
 
 	var r0 domain.Course
@@ -287,377 +2571,348 @@ func (_mock *MockCourseRepo) GetByID(ctx context.Context, courseID string) (doma
 	return r0, r1
 }
 
-// MockCourseRepo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockCourseRepo_GetByID_Call struct {
+// MockCourseRepo_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockCourseRepo_Restore_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-func (_e *MockCourseRepo_Expecter) GetByID(ctx interface{}, courseID interface{}) *MockCourseRepo_GetByID_Call {
-	return &MockCourseRepo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, courseID)}
+func (_e *MockCourseRepo_Expecter) Restore(ctx interface{}, courseID interface{}) *MockCourseRepo_Restore_Call {
+	return &MockCourseRepo_Restore_Call{Call: _e.mock.On("Restore", ctx, courseID)}
 }
 
-func (_c *MockCourseRepo_GetByID_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_GetByID_Call {
+func (_c *MockCourseRepo_Restore_Call) Run(run func(ctx context.Context, courseID string)) *MockCourseRepo_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_GetByID_Call) Return(course domain.Course, err error) *MockCourseRepo_GetByID_Call {
+func (_c *MockCourseRepo_Restore_Call) Return(course domain.Course, err error) *MockCourseRepo_Restore_Call {
 	_c.Call.Return(course, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.Course, error)) *MockCourseRepo_GetByID_Call {
+func (_c *MockCourseRepo_Restore_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.Course, error)) *MockCourseRepo_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsMember provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) IsMember(ctx context.Context, courseID string, userID string) (bool, error) {
-	ret := _mock.Called(ctx, courseID, userID)
+// RevokeInvite provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) RevokeInvite(ctx context.Context, courseID string, inviteID string) (domain.Invite, error) {
+	ret := _mock.Called(ctx, courseID, inviteID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsMember")
+		panic("no return value specified for RevokeInvite")
 	}
 
-	var r0 bool
+	var r0 domain.Invite
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
-		return returnFunc(ctx, courseID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Invite, error)); ok {
+		return returnFunc(ctx, courseID, inviteID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
-		r0 = returnFunc(ctx, courseID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Invite); ok {
+		r0 = returnFunc(ctx, courseID, inviteID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(domain.Invite)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, courseID, userID)
+		r1 = returnFunc(ctx, courseID, inviteID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_IsMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMember'
-type MockCourseRepo_IsMember_Call struct {
+// MockCourseRepo_RevokeInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeInvite'
+type MockCourseRepo_RevokeInvite_Call struct {
 	*mock.Call
 }
 
-// IsMember is a helper method to define mock.On call
+// RevokeInvite is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-//   - userID
-func (_e *MockCourseRepo_Expecter) IsMember(ctx interface{}, courseID interface{}, userID interface{}) *MockCourseRepo_IsMember_Call {
-	return &MockCourseRepo_IsMember_Call{Call: _e.mock.On("IsMember", ctx, courseID, userID)}
+//   - inviteID
+func (_e *MockCourseRepo_Expecter) RevokeInvite(ctx interface{}, courseID interface{}, inviteID interface{}) *MockCourseRepo_RevokeInvite_Call {
+	return &MockCourseRepo_RevokeInvite_Call{Call: _e.mock.On("RevokeInvite", ctx, courseID, inviteID)}
 }
 
-func (_c *MockCourseRepo_IsMember_Call) Run(run func(ctx context.Context, courseID string, userID string)) *MockCourseRepo_IsMember_Call {
+func (_c *MockCourseRepo_RevokeInvite_Call) Run(run func(ctx context.Context, courseID string, inviteID string)) *MockCourseRepo_RevokeInvite_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_IsMember_Call) Return(b bool, err error) *MockCourseRepo_IsMember_Call {
-	_c.Call.Return(b, err)
+func (_c *MockCourseRepo_RevokeInvite_Call) Return(invite domain.Invite, err error) *MockCourseRepo_RevokeInvite_Call {
+	_c.Call.Return(invite, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_IsMember_Call) RunAndReturn(run func(ctx context.Context, courseID string, userID string) (bool, error)) *MockCourseRepo_IsMember_Call {
+func (_c *MockCourseRepo_RevokeInvite_Call) RunAndReturn(run func(ctx context.Context, courseID string, inviteID string) (domain.Invite, error)) *MockCourseRepo_RevokeInvite_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsTeacher provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) IsTeacher(ctx context.Context, courseID string, teacherID string) (bool, error) {
-	ret := _mock.Called(ctx, courseID, teacherID)
+// Search provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) Search(ctx context.Context, dto1 dto.SearchCoursesDTO) (domain.CatalogPage, error) {
+	ret := _mock.Called(ctx, dto1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsTeacher")
+		panic("no return value specified for Search")
 	}
 
-	var r0 bool
+	var r0 domain.CatalogPage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
-		return returnFunc(ctx, courseID, teacherID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.SearchCoursesDTO) (domain.CatalogPage, error)); ok {
+		return returnFunc(ctx, dto1)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
-		r0 = returnFunc(ctx, courseID, teacherID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.SearchCoursesDTO) domain.CatalogPage); ok {
+		r0 = returnFunc(ctx, dto1)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(domain.CatalogPage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, courseID, teacherID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.SearchCoursesDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_IsTeacher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTeacher'
-type MockCourseRepo_IsTeacher_Call struct {
+// MockCourseRepo_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockCourseRepo_Search_Call struct {
 	*mock.Call
 }
 
-// IsTeacher is a helper method to define mock.On call
+// Search is a helper method to define mock.On call
 //   - ctx
-//   - courseID
-//   - teacherID
-func (_e *MockCourseRepo_Expecter) IsTeacher(ctx interface{}, courseID interface{}, teacherID interface{}) *MockCourseRepo_IsTeacher_Call {
-	return &MockCourseRepo_IsTeacher_Call{Call: _e.mock.On("IsTeacher", ctx, courseID, teacherID)}
+//   - dto1
+func (_e *MockCourseRepo_Expecter) Search(ctx interface{}, dto1 interface{}) *MockCourseRepo_Search_Call {
+	return &MockCourseRepo_Search_Call{Call: _e.mock.On("Search", ctx, dto1)}
 }
 
-func (_c *MockCourseRepo_IsTeacher_Call) Run(run func(ctx context.Context, courseID string, teacherID string)) *MockCourseRepo_IsTeacher_Call {
+func (_c *MockCourseRepo_Search_Call) Run(run func(ctx context.Context, dto1 dto.SearchCoursesDTO)) *MockCourseRepo_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(dto.SearchCoursesDTO))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_IsTeacher_Call) Return(b bool, err error) *MockCourseRepo_IsTeacher_Call {
-	_c.Call.Return(b, err)
+func (_c *MockCourseRepo_Search_Call) Return(catalogPage domain.CatalogPage, err error) *MockCourseRepo_Search_Call {
+	_c.Call.Return(catalogPage, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_IsTeacher_Call) RunAndReturn(run func(ctx context.Context, courseID string, teacherID string) (bool, error)) *MockCourseRepo_IsTeacher_Call {
+func (_c *MockCourseRepo_Search_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.SearchCoursesDTO) (domain.CatalogPage, error)) *MockCourseRepo_Search_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByStudentID provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) ListByStudentID(ctx context.Context, teacherID string) ([]domain.Course, error) {
-	ret := _mock.Called(ctx, teacherID)
+// SetRolePermissions provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) SetRolePermissions(ctx context.Context, courseID string, role domain.Role, permissions []domain.Permission) error {
+	ret := _mock.Called(ctx, courseID, role, permissions)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByStudentID")
+		panic("no return value specified for SetRolePermissions")
 	}
 
-	var r0 []domain.Course
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Course, error)); ok {
-		return returnFunc(ctx, teacherID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Course); ok {
-		r0 = returnFunc(ctx, teacherID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Course)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, teacherID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Role, []domain.Permission) error); ok {
+		r0 = returnFunc(ctx, courseID, role, permissions)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockCourseRepo_ListByStudentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByStudentID'
-type MockCourseRepo_ListByStudentID_Call struct {
+// MockCourseRepo_SetRolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRolePermissions'
+type MockCourseRepo_SetRolePermissions_Call struct {
 	*mock.Call
 }
 
-// ListByStudentID is a helper method to define mock.On call
+// SetRolePermissions is a helper method to define mock.On call
 //   - ctx
-//   - teacherID
-func (_e *MockCourseRepo_Expecter) ListByStudentID(ctx interface{}, teacherID interface{}) *MockCourseRepo_ListByStudentID_Call {
-	return &MockCourseRepo_ListByStudentID_Call{Call: _e.mock.On("ListByStudentID", ctx, teacherID)}
+//   - courseID
+//   - role
+//   - permissions
+func (_e *MockCourseRepo_Expecter) SetRolePermissions(ctx interface{}, courseID interface{}, role interface{}, permissions interface{}) *MockCourseRepo_SetRolePermissions_Call {
+	return &MockCourseRepo_SetRolePermissions_Call{Call: _e.mock.On("SetRolePermissions", ctx, courseID, role, permissions)}
 }
 
-func (_c *MockCourseRepo_ListByStudentID_Call) Run(run func(ctx context.Context, teacherID string)) *MockCourseRepo_ListByStudentID_Call {
+func (_c *MockCourseRepo_SetRolePermissions_Call) Run(run func(ctx context.Context, courseID string, role domain.Role, permissions []domain.Permission)) *MockCourseRepo_SetRolePermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.Role), args[3].([]domain.Permission))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_ListByStudentID_Call) Return(courses []domain.Course, err error) *MockCourseRepo_ListByStudentID_Call {
-	_c.Call.Return(courses, err)
+func (_c *MockCourseRepo_SetRolePermissions_Call) Return(err error) *MockCourseRepo_SetRolePermissions_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockCourseRepo_ListByStudentID_Call) RunAndReturn(run func(ctx context.Context, teacherID string) ([]domain.Course, error)) *MockCourseRepo_ListByStudentID_Call {
+func (_c *MockCourseRepo_SetRolePermissions_Call) RunAndReturn(run func(ctx context.Context, courseID string, role domain.Role, permissions []domain.Permission) error) *MockCourseRepo_SetRolePermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByTeacherID provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) ListByTeacherID(ctx context.Context, teacherID string) ([]domain.Course, error) {
-	ret := _mock.Called(ctx, teacherID)
+// Update provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) Update(ctx context.Context, dto1 dto.UpdateCourseDTO) (domain.Course, error) {
+	ret := _mock.Called(ctx, dto1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByTeacherID")
+		panic("no return value specified for Update")
 	}
 
-	var r0 []domain.Course
+	var r0 domain.Course
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Course, error)); ok {
-		return returnFunc(ctx, teacherID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateCourseDTO) (domain.Course, error)); ok {
+		return returnFunc(ctx, dto1)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Course); ok {
-		r0 = returnFunc(ctx, teacherID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateCourseDTO) domain.Course); ok {
+		r0 = returnFunc(ctx, dto1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Course)
-		}
+		r0 = ret.Get(0).(domain.Course)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, teacherID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.UpdateCourseDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_ListByTeacherID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTeacherID'
-type MockCourseRepo_ListByTeacherID_Call struct {
+// MockCourseRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCourseRepo_Update_Call struct {
 	*mock.Call
 }
 
-// ListByTeacherID is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
 //   - ctx
-//   - teacherID
-func (_e *MockCourseRepo_Expecter) ListByTeacherID(ctx interface{}, teacherID interface{}) *MockCourseRepo_ListByTeacherID_Call {
-	return &MockCourseRepo_ListByTeacherID_Call{Call: _e.mock.On("ListByTeacherID", ctx, teacherID)}
+//   - dto1
+func (_e *MockCourseRepo_Expecter) Update(ctx interface{}, dto1 interface{}) *MockCourseRepo_Update_Call {
+	return &MockCourseRepo_Update_Call{Call: _e.mock.On("Update", ctx, dto1)}
 }
 
-func (_c *MockCourseRepo_ListByTeacherID_Call) Run(run func(ctx context.Context, teacherID string)) *MockCourseRepo_ListByTeacherID_Call {
+func (_c *MockCourseRepo_Update_Call) Run(run func(ctx context.Context, dto1 dto.UpdateCourseDTO)) *MockCourseRepo_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(dto.UpdateCourseDTO))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_ListByTeacherID_Call) Return(courses []domain.Course, err error) *MockCourseRepo_ListByTeacherID_Call {
-	_c.Call.Return(courses, err)
+func (_c *MockCourseRepo_Update_Call) Return(course domain.Course, err error) *MockCourseRepo_Update_Call {
+	_c.Call.Return(course, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_ListByTeacherID_Call) RunAndReturn(run func(ctx context.Context, teacherID string) ([]domain.Course, error)) *MockCourseRepo_ListByTeacherID_Call {
+func (_c *MockCourseRepo_Update_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.UpdateCourseDTO) (domain.Course, error)) *MockCourseRepo_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListCourseStudents provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) ListCourseStudents(ctx context.Context, courseID string, index int32, limit int32) ([]domain.Student, int32, error) {
-	ret := _mock.Called(ctx, courseID, index, limit)
+// UpdateCloneJob provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) UpdateCloneJob(ctx context.Context, job domain.CloneJob) error {
+	ret := _mock.Called(ctx, job)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListCourseStudents")
+		panic("no return value specified for UpdateCloneJob")
 	}
 
-	var r0 []domain.Student
-	var r1 int32
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]domain.Student, int32, error)); ok {
-		return returnFunc(ctx, courseID, index, limit)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, int32) []domain.Student); ok {
-		r0 = returnFunc(ctx, courseID, index, limit)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Student)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32, int32) int32); ok {
-		r1 = returnFunc(ctx, courseID, index, limit)
-	} else {
-		r1 = ret.Get(1).(int32)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int32, int32) error); ok {
-		r2 = returnFunc(ctx, courseID, index, limit)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CloneJob) error); ok {
+		r0 = returnFunc(ctx, job)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Error(0)
 	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockCourseRepo_ListCourseStudents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCourseStudents'
-type MockCourseRepo_ListCourseStudents_Call struct {
+// MockCourseRepo_UpdateCloneJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCloneJob'
+type MockCourseRepo_UpdateCloneJob_Call struct {
 	*mock.Call
 }
 
-// ListCourseStudents is a helper method to define mock.On call
+// UpdateCloneJob is a helper method to define mock.On call
 //   - ctx
-//   - courseID
-//   - index
-//   - limit
-func (_e *MockCourseRepo_Expecter) ListCourseStudents(ctx interface{}, courseID interface{}, index interface{}, limit interface{}) *MockCourseRepo_ListCourseStudents_Call {
-	return &MockCourseRepo_ListCourseStudents_Call{Call: _e.mock.On("ListCourseStudents", ctx, courseID, index, limit)}
+//   - job
+func (_e *MockCourseRepo_Expecter) UpdateCloneJob(ctx interface{}, job interface{}) *MockCourseRepo_UpdateCloneJob_Call {
+	return &MockCourseRepo_UpdateCloneJob_Call{Call: _e.mock.On("UpdateCloneJob", ctx, job)}
 }
 
-func (_c *MockCourseRepo_ListCourseStudents_Call) Run(run func(ctx context.Context, courseID string, index int32, limit int32)) *MockCourseRepo_ListCourseStudents_Call {
+func (_c *MockCourseRepo_UpdateCloneJob_Call) Run(run func(ctx context.Context, job domain.CloneJob)) *MockCourseRepo_UpdateCloneJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(domain.CloneJob))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_ListCourseStudents_Call) Return(students []domain.Student, n int32, err error) *MockCourseRepo_ListCourseStudents_Call {
-	_c.Call.Return(students, n, err)
+func (_c *MockCourseRepo_UpdateCloneJob_Call) Return(err error) *MockCourseRepo_UpdateCloneJob_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockCourseRepo_ListCourseStudents_Call) RunAndReturn(run func(ctx context.Context, courseID string, index int32, limit int32) ([]domain.Student, int32, error)) *MockCourseRepo_ListCourseStudents_Call {
+func (_c *MockCourseRepo_UpdateCloneJob_Call) RunAndReturn(run func(ctx context.Context, job domain.CloneJob) error) *MockCourseRepo_UpdateCloneJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockCourseRepo
-func (_mock *MockCourseRepo) Update(ctx context.Context, dto1 dto.UpdateCourseDTO) (domain.Course, error) {
-	ret := _mock.Called(ctx, dto1)
+// UpdateModule provides a mock function for the type MockCourseRepo
+func (_mock *MockCourseRepo) UpdateModule(ctx context.Context, courseID string, moduleID string, title string) (domain.Module, error) {
+	ret := _mock.Called(ctx, courseID, moduleID, title)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for UpdateModule")
 	}
 
-	var r0 domain.Course
+	var r0 domain.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateCourseDTO) (domain.Course, error)); ok {
-		return returnFunc(ctx, dto1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (domain.Module, error)); ok {
+		return returnFunc(ctx, courseID, moduleID, title)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.UpdateCourseDTO) domain.Course); ok {
-		r0 = returnFunc(ctx, dto1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) domain.Module); ok {
+		r0 = returnFunc(ctx, courseID, moduleID, title)
 	} else {
-		r0 = ret.Get(0).(domain.Course)
+		r0 = ret.Get(0).(domain.Module)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.UpdateCourseDTO) error); ok {
-		r1 = returnFunc(ctx, dto1)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, moduleID, title)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCourseRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockCourseRepo_Update_Call struct {
+// MockCourseRepo_UpdateModule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateModule'
+type MockCourseRepo_UpdateModule_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// UpdateModule is a helper method to define mock.On call
 //   - ctx
-//   - dto1
-func (_e *MockCourseRepo_Expecter) Update(ctx interface{}, dto1 interface{}) *MockCourseRepo_Update_Call {
-	return &MockCourseRepo_Update_Call{Call: _e.mock.On("Update", ctx, dto1)}
+//   - courseID
+//   - moduleID
+//   - title
+func (_e *MockCourseRepo_Expecter) UpdateModule(ctx interface{}, courseID interface{}, moduleID interface{}, title interface{}) *MockCourseRepo_UpdateModule_Call {
+	return &MockCourseRepo_UpdateModule_Call{Call: _e.mock.On("UpdateModule", ctx, courseID, moduleID, title)}
 }
 
-func (_c *MockCourseRepo_Update_Call) Run(run func(ctx context.Context, dto1 dto.UpdateCourseDTO)) *MockCourseRepo_Update_Call {
+func (_c *MockCourseRepo_UpdateModule_Call) Run(run func(ctx context.Context, courseID string, moduleID string, title string)) *MockCourseRepo_UpdateModule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(dto.UpdateCourseDTO))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockCourseRepo_Update_Call) Return(course domain.Course, err error) *MockCourseRepo_Update_Call {
-	_c.Call.Return(course, err)
+func (_c *MockCourseRepo_UpdateModule_Call) Return(module domain.Module, err error) *MockCourseRepo_UpdateModule_Call {
+	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockCourseRepo_Update_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.UpdateCourseDTO) (domain.Course, error)) *MockCourseRepo_Update_Call {
+func (_c *MockCourseRepo_UpdateModule_Call) RunAndReturn(run func(ctx context.Context, courseID string, moduleID string, title string) (domain.Module, error)) *MockCourseRepo_UpdateModule_Call {
 	_c.Call.Return(run)
 	return _c
 }
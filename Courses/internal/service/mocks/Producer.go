@@ -37,6 +37,276 @@ func (_m *MockProducer) EXPECT() *MockProducer_Expecter {
 	return &MockProducer_Expecter{mock: &_m.Mock}
 }
 
+// PublishCourseArchived provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishCourseArchived(event events.CourseArchived) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishCourseArchived")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.CourseArchived) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishCourseArchived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishCourseArchived'
+type MockProducer_PublishCourseArchived_Call struct {
+	*mock.Call
+}
+
+// PublishCourseArchived is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishCourseArchived(event interface{}) *MockProducer_PublishCourseArchived_Call {
+	return &MockProducer_PublishCourseArchived_Call{Call: _e.mock.On("PublishCourseArchived", event)}
+}
+
+func (_c *MockProducer_PublishCourseArchived_Call) Run(run func(event events.CourseArchived)) *MockProducer_PublishCourseArchived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.CourseArchived))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseArchived_Call) Return(err error) *MockProducer_PublishCourseArchived_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseArchived_Call) RunAndReturn(run func(event events.CourseArchived) error) *MockProducer_PublishCourseArchived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishCourseDeleted provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishCourseDeleted(event events.CourseDeleted) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishCourseDeleted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.CourseDeleted) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishCourseDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishCourseDeleted'
+type MockProducer_PublishCourseDeleted_Call struct {
+	*mock.Call
+}
+
+// PublishCourseDeleted is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishCourseDeleted(event interface{}) *MockProducer_PublishCourseDeleted_Call {
+	return &MockProducer_PublishCourseDeleted_Call{Call: _e.mock.On("PublishCourseDeleted", event)}
+}
+
+func (_c *MockProducer_PublishCourseDeleted_Call) Run(run func(event events.CourseDeleted)) *MockProducer_PublishCourseDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.CourseDeleted))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseDeleted_Call) Return(err error) *MockProducer_PublishCourseDeleted_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseDeleted_Call) RunAndReturn(run func(event events.CourseDeleted) error) *MockProducer_PublishCourseDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishCourseOwnershipTransferred provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishCourseOwnershipTransferred(event events.CourseOwnershipTransferred) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishCourseOwnershipTransferred")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.CourseOwnershipTransferred) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishCourseOwnershipTransferred_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishCourseOwnershipTransferred'
+type MockProducer_PublishCourseOwnershipTransferred_Call struct {
+	*mock.Call
+}
+
+// PublishCourseOwnershipTransferred is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishCourseOwnershipTransferred(event interface{}) *MockProducer_PublishCourseOwnershipTransferred_Call {
+	return &MockProducer_PublishCourseOwnershipTransferred_Call{Call: _e.mock.On("PublishCourseOwnershipTransferred", event)}
+}
+
+func (_c *MockProducer_PublishCourseOwnershipTransferred_Call) Run(run func(event events.CourseOwnershipTransferred)) *MockProducer_PublishCourseOwnershipTransferred_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.CourseOwnershipTransferred))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseOwnershipTransferred_Call) Return(err error) *MockProducer_PublishCourseOwnershipTransferred_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseOwnershipTransferred_Call) RunAndReturn(run func(event events.CourseOwnershipTransferred) error) *MockProducer_PublishCourseOwnershipTransferred_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishCoursePurged provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishCoursePurged(event events.CoursePurged) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishCoursePurged")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.CoursePurged) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishCoursePurged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishCoursePurged'
+type MockProducer_PublishCoursePurged_Call struct {
+	*mock.Call
+}
+
+// PublishCoursePurged is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishCoursePurged(event interface{}) *MockProducer_PublishCoursePurged_Call {
+	return &MockProducer_PublishCoursePurged_Call{Call: _e.mock.On("PublishCoursePurged", event)}
+}
+
+func (_c *MockProducer_PublishCoursePurged_Call) Run(run func(event events.CoursePurged)) *MockProducer_PublishCoursePurged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.CoursePurged))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishCoursePurged_Call) Return(err error) *MockProducer_PublishCoursePurged_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishCoursePurged_Call) RunAndReturn(run func(event events.CoursePurged) error) *MockProducer_PublishCoursePurged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishCourseRestored provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishCourseRestored(event events.CourseRestored) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishCourseRestored")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.CourseRestored) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishCourseRestored_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishCourseRestored'
+type MockProducer_PublishCourseRestored_Call struct {
+	*mock.Call
+}
+
+// PublishCourseRestored is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishCourseRestored(event interface{}) *MockProducer_PublishCourseRestored_Call {
+	return &MockProducer_PublishCourseRestored_Call{Call: _e.mock.On("PublishCourseRestored", event)}
+}
+
+func (_c *MockProducer_PublishCourseRestored_Call) Run(run func(event events.CourseRestored)) *MockProducer_PublishCourseRestored_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.CourseRestored))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseRestored_Call) Return(err error) *MockProducer_PublishCourseRestored_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishCourseRestored_Call) RunAndReturn(run func(event events.CourseRestored) error) *MockProducer_PublishCourseRestored_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishEnrollmentRequestUpdated provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishEnrollmentRequestUpdated(event events.EnrollmentRequestUpdated) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishEnrollmentRequestUpdated")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.EnrollmentRequestUpdated) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishEnrollmentRequestUpdated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishEnrollmentRequestUpdated'
+type MockProducer_PublishEnrollmentRequestUpdated_Call struct {
+	*mock.Call
+}
+
+// PublishEnrollmentRequestUpdated is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishEnrollmentRequestUpdated(event interface{}) *MockProducer_PublishEnrollmentRequestUpdated_Call {
+	return &MockProducer_PublishEnrollmentRequestUpdated_Call{Call: _e.mock.On("PublishEnrollmentRequestUpdated", event)}
+}
+
+func (_c *MockProducer_PublishEnrollmentRequestUpdated_Call) Run(run func(event events.EnrollmentRequestUpdated)) *MockProducer_PublishEnrollmentRequestUpdated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.EnrollmentRequestUpdated))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishEnrollmentRequestUpdated_Call) Return(err error) *MockProducer_PublishEnrollmentRequestUpdated_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishEnrollmentRequestUpdated_Call) RunAndReturn(run func(event events.EnrollmentRequestUpdated) error) *MockProducer_PublishEnrollmentRequestUpdated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PublishUserEnrolled provides a mock function for the type MockProducer
 func (_mock *MockProducer) PublishUserEnrolled(event events.UserEnrolled) error {
 	ret := _mock.Called(event)
@@ -126,3 +396,48 @@ func (_c *MockProducer_PublishUserExpelled_Call) RunAndReturn(run func(event eve
 	_c.Call.Return(run)
 	return _c
 }
+
+// PublishUserInvited provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishUserInvited(event events.UserInvited) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishUserInvited")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.UserInvited) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishUserInvited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishUserInvited'
+type MockProducer_PublishUserInvited_Call struct {
+	*mock.Call
+}
+
+// PublishUserInvited is a helper method to define mock.On call
+//   - event
+func (_e *MockProducer_Expecter) PublishUserInvited(event interface{}) *MockProducer_PublishUserInvited_Call {
+	return &MockProducer_PublishUserInvited_Call{Call: _e.mock.On("PublishUserInvited", event)}
+}
+
+func (_c *MockProducer_PublishUserInvited_Call) Run(run func(event events.UserInvited)) *MockProducer_PublishUserInvited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.UserInvited))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishUserInvited_Call) Return(err error) *MockProducer_PublishUserInvited_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishUserInvited_Call) RunAndReturn(run func(event events.UserInvited) error) *MockProducer_PublishUserInvited_Call {
+	_c.Call.Return(run)
+	return _c
+}
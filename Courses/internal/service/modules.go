@@ -0,0 +1,165 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	pb "Classroom/Courses/pkg/api/courses"
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CoursesService) CreateModule(ctx context.Context, req *pb.CreateModuleRequest) (*pb.CreateModuleResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if err := s.checkEditable(ctx, req.CourseId, "failed to create module"); err != nil {
+		return nil, err
+	}
+
+	module, err := s.repo.CreateModule(ctx, req.CourseId, title)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to create module", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create module")
+	}
+
+	s.logger.Info("module created", "course_id", module.CourseID, "module_id", module.ID)
+	return &pb.CreateModuleResponse{Module: moduleToPb(module)}, nil
+}
+
+func (s *CoursesService) UpdateModule(ctx context.Context, req *pb.UpdateModuleRequest) (*pb.UpdateModuleResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.ModuleId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid module id")
+	}
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if err := s.checkEditable(ctx, req.CourseId, "failed to update module"); err != nil {
+		return nil, err
+	}
+
+	module, err := s.repo.UpdateModule(ctx, req.CourseId, req.ModuleId, title)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "module not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to update module", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update module")
+	}
+
+	s.logger.Info("module updated", "course_id", module.CourseID, "module_id", module.ID)
+	return &pb.UpdateModuleResponse{Module: moduleToPb(module)}, nil
+}
+
+func (s *CoursesService) DeleteModule(ctx context.Context, req *pb.DeleteModuleRequest) (*pb.DeleteModuleResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.ModuleId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid module id")
+	}
+	if err := s.checkEditable(ctx, req.CourseId, "failed to delete module"); err != nil {
+		return nil, err
+	}
+
+	module, err := s.repo.DeleteModule(ctx, req.CourseId, req.ModuleId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "module not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to delete module", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete module")
+	}
+
+	s.logger.Info("module deleted", "course_id", module.CourseID, "module_id", module.ID)
+	return &pb.DeleteModuleResponse{Module: moduleToPb(module)}, nil
+}
+
+func (s *CoursesService) ListModules(ctx context.Context, req *pb.ListModulesRequest) (*pb.ListModulesResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+
+	modules, err := s.repo.ListModules(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("failed to list modules", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list modules")
+	}
+
+	return &pb.ListModulesResponse{Modules: modulesToPb(modules)}, nil
+}
+
+func (s *CoursesService) ReorderModule(ctx context.Context, req *pb.ReorderModuleRequest) (*pb.ReorderModuleResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.ModuleId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid module id")
+	}
+	if req.Position < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position must not be negative")
+	}
+	if err := s.checkEditable(ctx, req.CourseId, "failed to reorder module"); err != nil {
+		return nil, err
+	}
+
+	modules, err := s.repo.ReorderModule(ctx, req.CourseId, req.ModuleId, req.Position)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "module not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to reorder module", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reorder module")
+	}
+
+	s.logger.Info("module reordered", "course_id", req.CourseId, "module_id", req.ModuleId, "position", req.Position)
+	return &pb.ReorderModuleResponse{Modules: modulesToPb(modules)}, nil
+}
+
+// Структуру курса можно менять, только пока курс не в архиве и не удалён
+func (s *CoursesService) checkEditable(ctx context.Context, courseID, internalMsg string) error {
+	course, err := s.repo.GetByID(ctx, courseID)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return status.Error(codes.Internal, internalMsg)
+	}
+	if course.Archived() {
+		return status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	return nil
+}
+
+func moduleToPb(m domain.Module) *pb.Module {
+	return &pb.Module{
+		ModuleId:  m.ID,
+		CourseId:  m.CourseID,
+		Title:     m.Title,
+		Position:  m.Position,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
+
+func modulesToPb(modules []domain.Module) []*pb.Module {
+	pbModules := make([]*pb.Module, len(modules))
+	for i, m := range modules {
+		pbModules[i] = moduleToPb(m)
+	}
+	return pbModules
+}
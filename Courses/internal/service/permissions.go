@@ -0,0 +1,139 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	pb "Classroom/Courses/pkg/api/courses"
+	"context"
+	"errors"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Проверяет право пользователя в курсе. Вместе с ответом возвращает роль и все права
+// пользователя, чтобы клиент мог закешировать их и проверять другие права без запроса
+func (s *CoursesService) Authorize(ctx context.Context, req *pb.AuthorizeRequest) (*pb.AuthorizeResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	permission := domain.Permission(req.Permission)
+	if !permission.Valid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid permission")
+	}
+
+	// Удалённый курс скрыт так же, как несуществующий
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get course")
+	}
+
+	role, err := s.repo.GetRole(ctx, req.CourseId, req.UserId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return &pb.AuthorizeResponse{Allowed: false, Permissions: []string{}}, nil
+	}
+	if err != nil {
+		s.logger.Error("failed to get role", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get role")
+	}
+
+	permissions := domain.AllPermissions
+	if role != domain.RoleOwner {
+		permissions, err = s.repo.ListRolePermissions(ctx, req.CourseId, role)
+		if err != nil {
+			s.logger.Error("failed to list role permissions", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list role permissions")
+		}
+	}
+
+	// Архивный курс доступен только для чтения
+	if course.Archived() {
+		permissions = slices.DeleteFunc(slices.Clone(permissions), func(p domain.Permission) bool {
+			return !slices.Contains(domain.ArchivedPermissions, p)
+		})
+	}
+
+	return &pb.AuthorizeResponse{
+		Allowed:     slices.Contains(permissions, permission),
+		Role:        string(role),
+		Permissions: permissionsToPb(permissions),
+	}, nil
+}
+
+func (s *CoursesService) GetCoursePermissions(ctx context.Context, req *pb.GetCoursePermissionsRequest) (*pb.GetCoursePermissionsResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+
+	stored, err := s.repo.ListCoursePermissions(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("failed to list course permissions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list course permissions")
+	}
+
+	// Роли без прав в базе не хранятся, но в ответе присутствуют с пустым набором
+	roles := make([]*pb.RolePermissions, 0, len(domain.ConfigurableRoles)+1)
+	roles = append(roles, rolePermissionsToPb(domain.RolePermissions{Role: domain.RoleOwner, Permissions: domain.AllPermissions}))
+	for _, role := range domain.ConfigurableRoles {
+		rp := domain.RolePermissions{Role: role}
+		if i := slices.IndexFunc(stored, func(p domain.RolePermissions) bool { return p.Role == role }); i != -1 {
+			rp = stored[i]
+		}
+		roles = append(roles, rolePermissionsToPb(rp))
+	}
+
+	return &pb.GetCoursePermissionsResponse{Roles: roles}, nil
+}
+
+func (s *CoursesService) SetRolePermissions(ctx context.Context, req *pb.SetRolePermissionsRequest) (*pb.SetRolePermissionsResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	role := domain.Role(req.Role)
+	if !role.Configurable() {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	permissions := make([]domain.Permission, 0, len(req.Permissions))
+	for _, p := range req.Permissions {
+		permission := domain.Permission(p)
+		if !permission.Valid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid permission %q", p)
+		}
+		if !slices.Contains(permissions, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	if err := s.repo.SetRolePermissions(ctx, req.CourseId, role, permissions); err != nil {
+		s.logger.Error("failed to set role permissions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to set role permissions")
+	}
+
+	s.logger.Info("role permissions updated", "course_id", req.CourseId, "role", role)
+	return &pb.SetRolePermissionsResponse{
+		Role: rolePermissionsToPb(domain.RolePermissions{Role: role, Permissions: permissions}),
+	}, nil
+}
+
+func rolePermissionsToPb(rp domain.RolePermissions) *pb.RolePermissions {
+	return &pb.RolePermissions{
+		Role:        string(rp.Role),
+		Permissions: permissionsToPb(rp.Permissions),
+	}
+}
+
+func permissionsToPb(permissions []domain.Permission) []string {
+	res := make([]string, len(permissions))
+	for i, p := range permissions {
+		res[i] = string(p)
+	}
+	return res
+}
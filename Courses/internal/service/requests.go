@@ -0,0 +1,209 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	pb "Classroom/Courses/pkg/api/courses"
+	"Classroom/Courses/pkg/events"
+	"context"
+	"errors"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CoursesService) RequestEnrollment(ctx context.Context, req *pb.RequestEnrollmentRequest) (*pb.RequestEnrollmentResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to request enrollment")
+	}
+	if !course.Visibility {
+		return nil, status.Error(codes.NotFound, "course is hidden")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	if course.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "course is a template")
+	}
+
+	_, err = s.repo.GetRole(ctx, req.CourseId, req.UserId)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "user is already a member of the course")
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.logger.Error("failed to get role", "error", err)
+		return nil, status.Error(codes.Internal, "failed to request enrollment")
+	}
+
+	request, err := s.repo.CreateEnrollmentRequest(ctx, req.CourseId, req.UserId)
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.AlreadyExists, "enrollment request already exists")
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to create enrollment request", "error", err)
+		return nil, status.Error(codes.Internal, "failed to request enrollment")
+	}
+
+	s.publishRequestUpdated(request)
+
+	s.logger.Info("enrollment requested", "course_id", request.CourseID, "user_id", request.UserID, "request_id", request.ID)
+	return &pb.RequestEnrollmentResponse{Request: enrollmentRequestToPb(request)}, nil
+}
+
+func (s *CoursesService) ListEnrollmentRequests(ctx context.Context, req *pb.ListEnrollmentRequestsRequest) (*pb.ListEnrollmentRequestsResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	requestStatus := domain.RequestStatus(req.GetStatus())
+	if requestStatus != "" && !slices.Contains(domain.RequestStatuses, requestStatus) {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	requests, err := s.repo.ListEnrollmentRequests(ctx, req.CourseId, requestStatus)
+	if err != nil {
+		s.logger.Error("failed to list enrollment requests", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list enrollment requests")
+	}
+
+	pbRequests := make([]*pb.EnrollmentRequest, len(requests))
+	for i, request := range requests {
+		pbRequests[i] = enrollmentRequestToPb(request)
+	}
+	return &pb.ListEnrollmentRequestsResponse{Requests: pbRequests}, nil
+}
+
+// Записывает пользователя, если на курсе есть место. Иначе заявка попадает в лист
+// ожидания и будет одобрена автоматически, когда место освободится
+func (s *CoursesService) ApproveEnrollment(ctx context.Context, req *pb.ApproveEnrollmentRequest) (*pb.ApproveEnrollmentResponse, error) {
+	if err := s.validateDecision(req.CourseId, req.RequestId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	before, err := s.repo.GetEnrollmentRequest(ctx, req.CourseId, req.RequestId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "enrollment request not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get enrollment request", "error", err)
+		return nil, status.Error(codes.Internal, "failed to approve enrollment")
+	}
+	if !before.Status.Open() {
+		return nil, status.Error(codes.FailedPrecondition, "enrollment request is already closed")
+	}
+
+	request, err := s.repo.ApproveEnrollmentRequest(ctx, req.CourseId, req.RequestId, req.UserId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "enrollment request not found")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.FailedPrecondition, "enrollment request is already closed")
+	}
+	if err != nil {
+		s.logger.Error("failed to approve enrollment request", "error", err)
+		return nil, status.Error(codes.Internal, "failed to approve enrollment")
+	}
+
+	switch {
+	case request.Status == domain.RequestApproved:
+		s.publishRequestApproved(request)
+	case request.Status != before.Status:
+		s.publishRequestUpdated(request)
+	}
+
+	s.logger.Info("enrollment request approved", "course_id", request.CourseID, "request_id", request.ID, "status", request.Status)
+	return &pb.ApproveEnrollmentResponse{Request: enrollmentRequestToPb(request)}, nil
+}
+
+func (s *CoursesService) RejectEnrollment(ctx context.Context, req *pb.RejectEnrollmentRequest) (*pb.RejectEnrollmentResponse, error) {
+	if err := s.validateDecision(req.CourseId, req.RequestId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	request, err := s.repo.RejectEnrollmentRequest(ctx, req.CourseId, req.RequestId, req.UserId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "enrollment request not found")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.FailedPrecondition, "enrollment request is already closed")
+	}
+	if err != nil {
+		s.logger.Error("failed to reject enrollment request", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reject enrollment")
+	}
+
+	s.publishRequestUpdated(request)
+
+	s.logger.Info("enrollment request rejected", "course_id", request.CourseID, "request_id", request.ID)
+	return &pb.RejectEnrollmentResponse{Request: enrollmentRequestToPb(request)}, nil
+}
+
+func (s *CoursesService) validateDecision(courseID, requestID, userID string) error {
+	if err := s.validate.Var(courseID, "required,uuid"); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(requestID, "required,uuid"); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid request id")
+	}
+	if err := s.validate.Var(userID, "required,uuid"); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return nil
+}
+
+// Одобренная заявка означает зачисление, поэтому кроме события заявки
+// публикуется и course.enrolled
+func (s *CoursesService) publishRequestApproved(request domain.EnrollmentRequest) {
+	s.publishRequestUpdated(request)
+
+	err := s.producer.PublishUserEnrolled(events.UserEnrolled{
+		CourseID: request.CourseID,
+		UserID:   request.UserID,
+	})
+	if err != nil {
+		s.logger.Error("failed to publish user enrolled event", "error", err)
+	}
+}
+
+func (s *CoursesService) publishRequestUpdated(request domain.EnrollmentRequest) {
+	err := s.producer.PublishEnrollmentRequestUpdated(events.EnrollmentRequestUpdated{
+		RequestID: request.ID,
+		CourseID:  request.CourseID,
+		UserID:    request.UserID,
+		Status:    string(request.Status),
+	})
+	if err != nil {
+		s.logger.Error("failed to publish enrollment request updated event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+}
+
+func enrollmentRequestToPb(r domain.EnrollmentRequest) *pb.EnrollmentRequest {
+	return &pb.EnrollmentRequest{
+		RequestId: r.ID,
+		CourseId:  r.CourseID,
+		UserId:    r.UserID,
+		Email:     r.Email,
+		FirstName: r.FirstName,
+		LastName:  r.LastName,
+		Status:    string(r.Status),
+		DecidedBy: r.DecidedBy,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
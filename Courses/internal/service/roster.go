@@ -0,0 +1,215 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	"Classroom/Courses/internal/dto"
+	pb "Classroom/Courses/pkg/api/courses"
+	"Classroom/Courses/pkg/events"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Максимум почт в одном запросе массовой записи
+	bulkEnrollLimit = 1000
+	// Срок действия кодов, которые массовая запись отправляет на неизвестные почты
+	bulkInviteTTL = 30 * 24 * time.Hour
+)
+
+// Записывает существующих пользователей по почте и возвращает результат для каждой
+// строки. Неизвестным почтам по запросу отправляются одноразовые коды приглашения
+func (s *CoursesService) BulkEnroll(ctx context.Context, req *pb.BulkEnrollRequest) (*pb.BulkEnrollResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if len(req.Emails) == 0 || len(req.Emails) > bulkEnrollLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "emails count must be between 1 and %d", bulkEnrollLimit)
+	}
+	role := domain.Role(req.Role)
+	if role == "" {
+		role = domain.RoleStudent
+	}
+	if role != domain.RoleStudent && role != domain.RoleAuditor {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to bulk enroll")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	if course.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "course is a template")
+	}
+
+	results := make([]domain.BulkEnrollResult, len(req.Emails))
+	seen := make(map[string]bool, len(req.Emails))
+	var emails []string
+	for i, raw := range req.Emails {
+		email := strings.ToLower(strings.TrimSpace(raw))
+		results[i].Email = email
+		switch {
+		case s.validate.Var(email, "required,email") != nil:
+			results[i].Email = raw
+			results[i].Status = domain.BulkInvalidEmail
+		case seen[email]:
+			results[i].Status = domain.BulkDuplicate
+		default:
+			seen[email] = true
+			emails = append(emails, email)
+		}
+	}
+
+	users, err := s.repo.ListUsersByEmails(ctx, emails)
+	if err != nil {
+		s.logger.Error("failed to list users by emails", "error", err)
+		return nil, status.Error(codes.Internal, "failed to bulk enroll")
+	}
+	userIDs := make(map[string]string, len(users))
+	for _, u := range users {
+		userIDs[strings.ToLower(u.Email)] = u.UserID
+	}
+	// Свободные места достаются строкам в порядке файла
+	ids := make([]string, 0, len(users))
+	for _, email := range emails {
+		if id, ok := userIDs[email]; ok {
+			ids = append(ids, id)
+		}
+	}
+
+	enrolled := make(map[string]bool)
+	full := make(map[string]bool)
+	if len(ids) > 0 {
+		enrolledIDs, fullIDs, err := s.repo.BulkEnroll(ctx, req.CourseId, ids, role)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		if err != nil {
+			s.logger.Error("failed to bulk enroll", "error", err)
+			return nil, status.Error(codes.Internal, "failed to bulk enroll")
+		}
+		for _, id := range enrolledIDs {
+			enrolled[id] = true
+		}
+		for _, id := range fullIDs {
+			full[id] = true
+		}
+	}
+
+	expiresAt := time.Now().Add(bulkInviteTTL)
+	maxUses := int32(1)
+	for i := range results {
+		r := &results[i]
+		if r.Status != "" {
+			continue
+		}
+
+		if id, ok := userIDs[r.Email]; ok {
+			r.UserID = id
+			if full[id] {
+				r.Status = domain.BulkCourseFull
+				continue
+			}
+			if !enrolled[id] {
+				r.Status = domain.BulkAlreadyMember
+				continue
+			}
+			r.Status = domain.BulkEnrolled
+			err := s.producer.PublishUserEnrolled(events.UserEnrolled{CourseID: req.CourseId, UserID: id})
+			if err != nil {
+				s.logger.Error("failed to publish user enrolled event", "error", err)
+			}
+			continue
+		}
+
+		if !req.InviteUnknown {
+			r.Status = domain.BulkNotFound
+			continue
+		}
+		invite, err := s.createInvite(ctx, dto.CreateInviteDTO{
+			CourseID:  req.CourseId,
+			CreatedBy: req.UserId,
+			Role:      string(role),
+			ExpiresAt: &expiresAt,
+			MaxUses:   &maxUses,
+		})
+		if err != nil {
+			s.logger.Error("failed to create invite", "email", r.Email, "error", err)
+			r.Status = domain.BulkFailed
+			continue
+		}
+		r.Status = domain.BulkInvited
+		r.InviteCode = invite.Code
+		err = s.producer.PublishUserInvited(events.UserInvited{
+			CourseID:  req.CourseId,
+			Email:     r.Email,
+			Code:      invite.Code,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			s.logger.Error("failed to publish user invited event", "error", err)
+		}
+	}
+
+	pbResults := make([]*pb.BulkEnrollResult, len(results))
+	for i, r := range results {
+		pbResults[i] = bulkEnrollResultToPb(r)
+	}
+
+	s.logger.Info("bulk enroll finished", "course_id", req.CourseId, "rows", len(results), "enrolled", len(enrolled))
+	return &pb.BulkEnrollResponse{Results: pbResults}, nil
+}
+
+func (s *CoursesService) ExportRoster(ctx context.Context, req *pb.ExportRosterRequest) (*pb.ExportRosterResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+
+	entries, err := s.repo.ListRoster(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("failed to list roster", "error", err)
+		return nil, status.Error(codes.Internal, "failed to export roster")
+	}
+
+	pbEntries := make([]*pb.RosterEntry, len(entries))
+	for i, e := range entries {
+		pbEntries[i] = &pb.RosterEntry{
+			UserId:     e.UserID,
+			Email:      e.Email,
+			FirstName:  e.FirstName,
+			LastName:   e.LastName,
+			Role:       string(e.Role),
+			EnrolledAt: timestamppb.New(e.EnrolledAt),
+		}
+	}
+	return &pb.ExportRosterResponse{Entries: pbEntries}, nil
+}
+
+func bulkEnrollResultToPb(r domain.BulkEnrollResult) *pb.BulkEnrollResult {
+	res := &pb.BulkEnrollResult{
+		Email:  r.Email,
+		Status: string(r.Status),
+	}
+	if r.UserID != "" {
+		res.UserId = &r.UserID
+	}
+	if r.InviteCode != "" {
+		res.InviteCode = &r.InviteCode
+	}
+	return res
+}
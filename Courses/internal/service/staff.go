@@ -0,0 +1,106 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	pb "Classroom/Courses/pkg/api/courses"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CoursesService) AddStaff(ctx context.Context, req *pb.AddStaffRequest) (*pb.AddStaffResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	role := domain.Role(req.Role)
+	if !role.Staff() {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get course")
+	}
+	if course.Archived() {
+		return nil, status.Error(codes.FailedPrecondition, "course is archived")
+	}
+	if course.TeacherID == req.UserId {
+		return nil, status.Error(codes.FailedPrecondition, "user is the course owner")
+	}
+
+	staff, err := s.repo.AddStaff(ctx, req.CourseId, req.UserId, role)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to add staff", "error", err)
+		return nil, status.Error(codes.Internal, "failed to add staff")
+	}
+
+	s.logger.Info("staff added", "course_id", staff.CourseID, "user_id", staff.UserID, "role", staff.Role)
+	return &pb.AddStaffResponse{Staff: staffToPb(staff)}, nil
+}
+
+func (s *CoursesService) RemoveStaff(ctx context.Context, req *pb.RemoveStaffRequest) (*pb.RemoveStaffResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	staff, err := s.repo.RemoveStaff(ctx, req.CourseId, req.UserId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "staff not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to remove staff", "error", err)
+		return nil, status.Error(codes.Internal, "failed to remove staff")
+	}
+
+	s.logger.Info("staff removed", "course_id", staff.CourseID, "user_id", staff.UserID, "role", staff.Role)
+	return &pb.RemoveStaffResponse{Staff: staffToPb(staff)}, nil
+}
+
+func (s *CoursesService) ListStaff(ctx context.Context, req *pb.ListStaffRequest) (*pb.ListStaffResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+
+	staff, err := s.repo.ListStaff(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("failed to list staff", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list staff")
+	}
+
+	pbStaff := make([]*pb.Staff, len(staff))
+	for i, st := range staff {
+		pbStaff[i] = staffToPb(st)
+	}
+	return &pb.ListStaffResponse{Staff: pbStaff}, nil
+}
+
+func staffToPb(s domain.Staff) *pb.Staff {
+	return &pb.Staff{
+		CourseId:  s.CourseID,
+		UserId:    s.UserID,
+		Email:     s.Email,
+		FirstName: s.FirstName,
+		LastName:  s.LastName,
+		Role:      string(s.Role),
+		AddedAt:   timestamppb.New(s.AddedAt),
+	}
+}
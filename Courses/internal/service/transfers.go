@@ -0,0 +1,198 @@
+package service
+
+import (
+	"Classroom/Courses/internal/domain"
+	pb "Classroom/Courses/pkg/api/courses"
+	"Classroom/Courses/pkg/events"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Создаёт передачу курса, которую должен подтвердить новый владелец. С force передаёт курс сразу,
+// право на force проверяет Gateway, он выставляет его только суперпользователям
+func (s *CoursesService) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.TransferOwnershipResponse, error) {
+	if err := s.validate.Var(req.CourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid course id")
+	}
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if err := s.validate.Var(req.NewOwnerId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid new owner id")
+	}
+
+	course, err := s.repo.GetByID(ctx, req.CourseId)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && course.Deleted() {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get course", "error", err)
+		return nil, status.Error(codes.Internal, "failed to transfer ownership")
+	}
+	if !req.Force && course.TeacherID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the course owner can transfer ownership")
+	}
+	if course.TeacherID == req.NewOwnerId {
+		return nil, status.Error(codes.FailedPrecondition, "user is already the course owner")
+	}
+
+	var transfer domain.OwnershipTransfer
+	if req.Force {
+		transfer, err = s.repo.ForceTransferOwnership(ctx, req.CourseId, req.NewOwnerId)
+	} else {
+		transfer, err = s.repo.CreateOwnershipTransfer(ctx, req.CourseId, req.UserId, req.NewOwnerId)
+	}
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.FailedPrecondition, "course owner has changed")
+	}
+	if err != nil {
+		s.logger.Error("failed to transfer ownership", "error", err)
+		return nil, status.Error(codes.Internal, "failed to transfer ownership")
+	}
+
+	if !req.Force {
+		s.logger.Info("ownership transfer created", "course_id", transfer.CourseID, "transfer_id", transfer.ID, "to_user_id", transfer.ToUserID)
+		return &pb.TransferOwnershipResponse{Transfer: transferToPb(transfer)}, nil
+	}
+
+	s.logger.Info("ownership transferred by force", "course_id", transfer.CourseID, "transfer_id", transfer.ID, "by", req.UserId)
+	promoted := s.ownershipTransferred(ctx, transfer)
+	return &pb.TransferOwnershipResponse{Transfer: transferToPb(transfer), Promoted: promoted}, nil
+}
+
+func (s *CoursesService) ListOwnershipTransfers(ctx context.Context, req *pb.ListOwnershipTransfersRequest) (*pb.ListOwnershipTransfersResponse, error) {
+	if err := s.validate.Var(req.UserId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	transfers, err := s.repo.ListOwnershipTransfers(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("failed to list ownership transfers", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list ownership transfers")
+	}
+
+	pbTransfers := make([]*pb.OwnershipTransfer, len(transfers))
+	for i, transfer := range transfers {
+		pbTransfers[i] = transferToPb(transfer)
+	}
+	return &pb.ListOwnershipTransfersResponse{Transfers: pbTransfers}, nil
+}
+
+func (s *CoursesService) AcceptOwnershipTransfer(ctx context.Context, req *pb.AcceptOwnershipTransferRequest) (*pb.AcceptOwnershipTransferResponse, error) {
+	if err := s.checkTransferRecipient(ctx, req.TransferId, req.UserId, "failed to accept ownership transfer"); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.repo.AcceptOwnershipTransfer(ctx, req.TransferId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "ownership transfer not found")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.FailedPrecondition, "ownership transfer is already closed")
+	}
+	if err != nil {
+		s.logger.Error("failed to accept ownership transfer", "error", err)
+		return nil, status.Error(codes.Internal, "failed to accept ownership transfer")
+	}
+
+	s.logger.Info("ownership transfer accepted", "course_id", transfer.CourseID, "transfer_id", transfer.ID)
+	promoted := s.ownershipTransferred(ctx, transfer)
+	return &pb.AcceptOwnershipTransferResponse{Transfer: transferToPb(transfer), Promoted: promoted}, nil
+}
+
+func (s *CoursesService) DeclineOwnershipTransfer(ctx context.Context, req *pb.DeclineOwnershipTransferRequest) (*pb.DeclineOwnershipTransferResponse, error) {
+	if err := s.checkTransferRecipient(ctx, req.TransferId, req.UserId, "failed to decline ownership transfer"); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.repo.DeclineOwnershipTransfer(ctx, req.TransferId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "ownership transfer not found")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.FailedPrecondition, "ownership transfer is already closed")
+	}
+	if err != nil {
+		s.logger.Error("failed to decline ownership transfer", "error", err)
+		return nil, status.Error(codes.Internal, "failed to decline ownership transfer")
+	}
+
+	s.logger.Info("ownership transfer declined", "course_id", transfer.CourseID, "transfer_id", transfer.ID)
+	return &pb.DeclineOwnershipTransferResponse{Transfer: transferToPb(transfer)}, nil
+}
+
+// Подтвердить или отклонить передачу может только её получатель, остальным она не видна
+func (s *CoursesService) checkTransferRecipient(ctx context.Context, transferID, userID, internalMsg string) error {
+	if err := s.validate.Var(transferID, "required,uuid"); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid transfer id")
+	}
+	if err := s.validate.Var(userID, "required,uuid"); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	transfer, err := s.repo.GetOwnershipTransfer(ctx, transferID)
+	if errors.Is(err, domain.ErrNotFound) || err == nil && transfer.ToUserID != userID {
+		return status.Error(codes.NotFound, "ownership transfer not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get ownership transfer", "error", err)
+		return status.Error(codes.Internal, internalMsg)
+	}
+	if transfer.Status != domain.TransferPending {
+		return status.Error(codes.FailedPrecondition, "ownership transfer is already closed")
+	}
+	return nil
+}
+
+// Публикует смену владельца и записывает пользователей из листа ожидания, если новый владелец
+// был записан на курс и освободил место. Ошибки не отменяют передачу
+func (s *CoursesService) ownershipTransferred(ctx context.Context, transfer domain.OwnershipTransfer) []*pb.EnrollmentRequest {
+	err := s.producer.PublishCourseOwnershipTransferred(events.CourseOwnershipTransferred{
+		CourseID:        transfer.CourseID,
+		PreviousOwnerID: transfer.FromUserID,
+		NewOwnerID:      transfer.ToUserID,
+		Forced:          transfer.Forced,
+	})
+	if err != nil {
+		s.logger.Error("failed to publish course ownership transferred event", "error", err)
+		// не возвращаем ошибку потому что действие и так было выполнено в бд, фикс будет через логи
+	}
+
+	promoted, err := s.repo.PromoteWaitlist(ctx, transfer.CourseID)
+	if err != nil {
+		s.logger.Error("failed to promote waitlist", "course_id", transfer.CourseID, "error", err)
+	}
+	pbPromoted := make([]*pb.EnrollmentRequest, len(promoted))
+	for i, request := range promoted {
+		s.publishRequestApproved(request)
+		pbPromoted[i] = enrollmentRequestToPb(request)
+	}
+	return pbPromoted
+}
+
+func transferToPb(t domain.OwnershipTransfer) *pb.OwnershipTransfer {
+	transfer := &pb.OwnershipTransfer{
+		TransferId:  t.ID,
+		CourseId:    t.CourseID,
+		CourseTitle: t.CourseTitle,
+		FromUserId:  t.FromUserID,
+		ToUserId:    t.ToUserID,
+		Status:      string(t.Status),
+		Forced:      t.Forced,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}
+	if t.DecidedAt != nil {
+		transfer.DecidedAt = timestamppb.New(*t.DecidedAt)
+	}
+	return transfer
+}
@@ -19,18 +19,48 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CoursesService_CreateCourse_FullMethodName        = "/courses.CoursesService/CreateCourse"
-	CoursesService_GetCourse_FullMethodName           = "/courses.CoursesService/GetCourse"
-	CoursesService_GetCourses_FullMethodName          = "/courses.CoursesService/GetCourses"
-	CoursesService_GetCoursesByStudent_FullMethodName = "/courses.CoursesService/GetCoursesByStudent"
-	CoursesService_GetCoursesByTeacher_FullMethodName = "/courses.CoursesService/GetCoursesByTeacher"
-	CoursesService_UpdateCourse_FullMethodName        = "/courses.CoursesService/UpdateCourse"
-	CoursesService_DeleteCourse_FullMethodName        = "/courses.CoursesService/DeleteCourse"
-	CoursesService_EnrollUser_FullMethodName          = "/courses.CoursesService/EnrollUser"
-	CoursesService_ExpelUser_FullMethodName           = "/courses.CoursesService/ExpelUser"
-	CoursesService_IsTeacher_FullMethodName           = "/courses.CoursesService/IsTeacher"
-	CoursesService_IsMember_FullMethodName            = "/courses.CoursesService/IsMember"
-	CoursesService_GetCourseStudents_FullMethodName   = "/courses.CoursesService/GetCourseStudents"
+	CoursesService_CreateCourse_FullMethodName             = "/courses.CoursesService/CreateCourse"
+	CoursesService_GetCourse_FullMethodName                = "/courses.CoursesService/GetCourse"
+	CoursesService_GetCourses_FullMethodName               = "/courses.CoursesService/GetCourses"
+	CoursesService_GetCoursesByStudent_FullMethodName      = "/courses.CoursesService/GetCoursesByStudent"
+	CoursesService_GetCoursesByTeacher_FullMethodName      = "/courses.CoursesService/GetCoursesByTeacher"
+	CoursesService_UpdateCourse_FullMethodName             = "/courses.CoursesService/UpdateCourse"
+	CoursesService_DeleteCourse_FullMethodName             = "/courses.CoursesService/DeleteCourse"
+	CoursesService_ArchiveCourse_FullMethodName            = "/courses.CoursesService/ArchiveCourse"
+	CoursesService_RestoreCourse_FullMethodName            = "/courses.CoursesService/RestoreCourse"
+	CoursesService_EnrollUser_FullMethodName               = "/courses.CoursesService/EnrollUser"
+	CoursesService_ExpelUser_FullMethodName                = "/courses.CoursesService/ExpelUser"
+	CoursesService_IsTeacher_FullMethodName                = "/courses.CoursesService/IsTeacher"
+	CoursesService_IsMember_FullMethodName                 = "/courses.CoursesService/IsMember"
+	CoursesService_GetCourseStudents_FullMethodName        = "/courses.CoursesService/GetCourseStudents"
+	CoursesService_Authorize_FullMethodName                = "/courses.CoursesService/Authorize"
+	CoursesService_GetCoursePermissions_FullMethodName     = "/courses.CoursesService/GetCoursePermissions"
+	CoursesService_SetRolePermissions_FullMethodName       = "/courses.CoursesService/SetRolePermissions"
+	CoursesService_AddStaff_FullMethodName                 = "/courses.CoursesService/AddStaff"
+	CoursesService_RemoveStaff_FullMethodName              = "/courses.CoursesService/RemoveStaff"
+	CoursesService_ListStaff_FullMethodName                = "/courses.CoursesService/ListStaff"
+	CoursesService_CreateInvite_FullMethodName             = "/courses.CoursesService/CreateInvite"
+	CoursesService_ListInvites_FullMethodName              = "/courses.CoursesService/ListInvites"
+	CoursesService_RevokeInvite_FullMethodName             = "/courses.CoursesService/RevokeInvite"
+	CoursesService_JoinByCode_FullMethodName               = "/courses.CoursesService/JoinByCode"
+	CoursesService_RequestEnrollment_FullMethodName        = "/courses.CoursesService/RequestEnrollment"
+	CoursesService_ListEnrollmentRequests_FullMethodName   = "/courses.CoursesService/ListEnrollmentRequests"
+	CoursesService_ApproveEnrollment_FullMethodName        = "/courses.CoursesService/ApproveEnrollment"
+	CoursesService_RejectEnrollment_FullMethodName         = "/courses.CoursesService/RejectEnrollment"
+	CoursesService_BulkEnroll_FullMethodName               = "/courses.CoursesService/BulkEnroll"
+	CoursesService_ExportRoster_FullMethodName             = "/courses.CoursesService/ExportRoster"
+	CoursesService_CloneCourse_FullMethodName              = "/courses.CoursesService/CloneCourse"
+	CoursesService_GetCloneJob_FullMethodName              = "/courses.CoursesService/GetCloneJob"
+	CoursesService_SearchCourses_FullMethodName            = "/courses.CoursesService/SearchCourses"
+	CoursesService_CreateModule_FullMethodName             = "/courses.CoursesService/CreateModule"
+	CoursesService_UpdateModule_FullMethodName             = "/courses.CoursesService/UpdateModule"
+	CoursesService_DeleteModule_FullMethodName             = "/courses.CoursesService/DeleteModule"
+	CoursesService_ListModules_FullMethodName              = "/courses.CoursesService/ListModules"
+	CoursesService_ReorderModule_FullMethodName            = "/courses.CoursesService/ReorderModule"
+	CoursesService_TransferOwnership_FullMethodName        = "/courses.CoursesService/TransferOwnership"
+	CoursesService_ListOwnershipTransfers_FullMethodName   = "/courses.CoursesService/ListOwnershipTransfers"
+	CoursesService_AcceptOwnershipTransfer_FullMethodName  = "/courses.CoursesService/AcceptOwnershipTransfer"
+	CoursesService_DeclineOwnershipTransfer_FullMethodName = "/courses.CoursesService/DeclineOwnershipTransfer"
 )
 
 // CoursesServiceClient is the client API for CoursesService service.
@@ -44,11 +74,41 @@ type CoursesServiceClient interface {
 	GetCoursesByTeacher(ctx context.Context, in *GetCoursesByTeacherRequest, opts ...grpc.CallOption) (*GetCoursesResponse, error)
 	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*UpdateCourseResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
+	ArchiveCourse(ctx context.Context, in *ArchiveCourseRequest, opts ...grpc.CallOption) (*ArchiveCourseResponse, error)
+	RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*RestoreCourseResponse, error)
 	EnrollUser(ctx context.Context, in *EnrollUserRequest, opts ...grpc.CallOption) (*EnrollUserResponse, error)
 	ExpelUser(ctx context.Context, in *ExpelUserRequest, opts ...grpc.CallOption) (*ExpelUserResponse, error)
 	IsTeacher(ctx context.Context, in *IsTeacherRequest, opts ...grpc.CallOption) (*IsTeacherResponse, error)
 	IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error)
 	GetCourseStudents(ctx context.Context, in *GetCourseStudentsRequest, opts ...grpc.CallOption) (*GetCourseStudentsResponse, error)
+	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
+	GetCoursePermissions(ctx context.Context, in *GetCoursePermissionsRequest, opts ...grpc.CallOption) (*GetCoursePermissionsResponse, error)
+	SetRolePermissions(ctx context.Context, in *SetRolePermissionsRequest, opts ...grpc.CallOption) (*SetRolePermissionsResponse, error)
+	AddStaff(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*AddStaffResponse, error)
+	RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*RemoveStaffResponse, error)
+	ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error)
+	JoinByCode(ctx context.Context, in *JoinByCodeRequest, opts ...grpc.CallOption) (*JoinByCodeResponse, error)
+	RequestEnrollment(ctx context.Context, in *RequestEnrollmentRequest, opts ...grpc.CallOption) (*RequestEnrollmentResponse, error)
+	ListEnrollmentRequests(ctx context.Context, in *ListEnrollmentRequestsRequest, opts ...grpc.CallOption) (*ListEnrollmentRequestsResponse, error)
+	ApproveEnrollment(ctx context.Context, in *ApproveEnrollmentRequest, opts ...grpc.CallOption) (*ApproveEnrollmentResponse, error)
+	RejectEnrollment(ctx context.Context, in *RejectEnrollmentRequest, opts ...grpc.CallOption) (*RejectEnrollmentResponse, error)
+	BulkEnroll(ctx context.Context, in *BulkEnrollRequest, opts ...grpc.CallOption) (*BulkEnrollResponse, error)
+	ExportRoster(ctx context.Context, in *ExportRosterRequest, opts ...grpc.CallOption) (*ExportRosterResponse, error)
+	CloneCourse(ctx context.Context, in *CloneCourseRequest, opts ...grpc.CallOption) (*CloneCourseResponse, error)
+	GetCloneJob(ctx context.Context, in *GetCloneJobRequest, opts ...grpc.CallOption) (*GetCloneJobResponse, error)
+	SearchCourses(ctx context.Context, in *SearchCoursesRequest, opts ...grpc.CallOption) (*SearchCoursesResponse, error)
+	CreateModule(ctx context.Context, in *CreateModuleRequest, opts ...grpc.CallOption) (*CreateModuleResponse, error)
+	UpdateModule(ctx context.Context, in *UpdateModuleRequest, opts ...grpc.CallOption) (*UpdateModuleResponse, error)
+	DeleteModule(ctx context.Context, in *DeleteModuleRequest, opts ...grpc.CallOption) (*DeleteModuleResponse, error)
+	ListModules(ctx context.Context, in *ListModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error)
+	ReorderModule(ctx context.Context, in *ReorderModuleRequest, opts ...grpc.CallOption) (*ReorderModuleResponse, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	ListOwnershipTransfers(ctx context.Context, in *ListOwnershipTransfersRequest, opts ...grpc.CallOption) (*ListOwnershipTransfersResponse, error)
+	AcceptOwnershipTransfer(ctx context.Context, in *AcceptOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptOwnershipTransferResponse, error)
+	DeclineOwnershipTransfer(ctx context.Context, in *DeclineOwnershipTransferRequest, opts ...grpc.CallOption) (*DeclineOwnershipTransferResponse, error)
 }
 
 type coursesServiceClient struct {
@@ -129,6 +189,26 @@ func (c *coursesServiceClient) DeleteCourse(ctx context.Context, in *DeleteCours
 	return out, nil
 }
 
+func (c *coursesServiceClient) ArchiveCourse(ctx context.Context, in *ArchiveCourseRequest, opts ...grpc.CallOption) (*ArchiveCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveCourseResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ArchiveCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*RestoreCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCourseResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RestoreCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coursesServiceClient) EnrollUser(ctx context.Context, in *EnrollUserRequest, opts ...grpc.CallOption) (*EnrollUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EnrollUserResponse)
@@ -179,6 +259,286 @@ func (c *coursesServiceClient) GetCourseStudents(ctx context.Context, in *GetCou
 	return out, nil
 }
 
+func (c *coursesServiceClient) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeResponse)
+	err := c.cc.Invoke(ctx, CoursesService_Authorize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCoursePermissions(ctx context.Context, in *GetCoursePermissionsRequest, opts ...grpc.CallOption) (*GetCoursePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoursePermissionsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCoursePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) SetRolePermissions(ctx context.Context, in *SetRolePermissionsRequest, opts ...grpc.CallOption) (*SetRolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetRolePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) AddStaff(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*AddStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddStaffResponse)
+	err := c.cc.Invoke(ctx, CoursesService_AddStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*RemoveStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStaffResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RemoveStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteResponse)
+	err := c.cc.Invoke(ctx, CoursesService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) JoinByCode(ctx context.Context, in *JoinByCodeRequest, opts ...grpc.CallOption) (*JoinByCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinByCodeResponse)
+	err := c.cc.Invoke(ctx, CoursesService_JoinByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) RequestEnrollment(ctx context.Context, in *RequestEnrollmentRequest, opts ...grpc.CallOption) (*RequestEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEnrollmentResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RequestEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListEnrollmentRequests(ctx context.Context, in *ListEnrollmentRequestsRequest, opts ...grpc.CallOption) (*ListEnrollmentRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnrollmentRequestsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListEnrollmentRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ApproveEnrollment(ctx context.Context, in *ApproveEnrollmentRequest, opts ...grpc.CallOption) (*ApproveEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveEnrollmentResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ApproveEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) RejectEnrollment(ctx context.Context, in *RejectEnrollmentRequest, opts ...grpc.CallOption) (*RejectEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectEnrollmentResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RejectEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) BulkEnroll(ctx context.Context, in *BulkEnrollRequest, opts ...grpc.CallOption) (*BulkEnrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkEnrollResponse)
+	err := c.cc.Invoke(ctx, CoursesService_BulkEnroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ExportRoster(ctx context.Context, in *ExportRosterRequest, opts ...grpc.CallOption) (*ExportRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportRosterResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ExportRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) CloneCourse(ctx context.Context, in *CloneCourseRequest, opts ...grpc.CallOption) (*CloneCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneCourseResponse)
+	err := c.cc.Invoke(ctx, CoursesService_CloneCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCloneJob(ctx context.Context, in *GetCloneJobRequest, opts ...grpc.CallOption) (*GetCloneJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCloneJobResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCloneJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) SearchCourses(ctx context.Context, in *SearchCoursesRequest, opts ...grpc.CallOption) (*SearchCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCoursesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SearchCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) CreateModule(ctx context.Context, in *CreateModuleRequest, opts ...grpc.CallOption) (*CreateModuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateModuleResponse)
+	err := c.cc.Invoke(ctx, CoursesService_CreateModule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) UpdateModule(ctx context.Context, in *UpdateModuleRequest, opts ...grpc.CallOption) (*UpdateModuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateModuleResponse)
+	err := c.cc.Invoke(ctx, CoursesService_UpdateModule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeleteModule(ctx context.Context, in *DeleteModuleRequest, opts ...grpc.CallOption) (*DeleteModuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteModuleResponse)
+	err := c.cc.Invoke(ctx, CoursesService_DeleteModule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListModules(ctx context.Context, in *ListModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModulesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListModules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ReorderModule(ctx context.Context, in *ReorderModuleRequest, opts ...grpc.CallOption) (*ReorderModuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderModuleResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ReorderModule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, CoursesService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListOwnershipTransfers(ctx context.Context, in *ListOwnershipTransfersRequest, opts ...grpc.CallOption) (*ListOwnershipTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOwnershipTransfersResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListOwnershipTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) AcceptOwnershipTransfer(ctx context.Context, in *AcceptOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, CoursesService_AcceptOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeclineOwnershipTransfer(ctx context.Context, in *DeclineOwnershipTransferRequest, opts ...grpc.CallOption) (*DeclineOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, CoursesService_DeclineOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoursesServiceServer is the server API for CoursesService service.
 // All implementations must embed UnimplementedCoursesServiceServer
 // for forward compatibility.
@@ -190,11 +550,41 @@ type CoursesServiceServer interface {
 	GetCoursesByTeacher(context.Context, *GetCoursesByTeacherRequest) (*GetCoursesResponse, error)
 	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
 	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	ArchiveCourse(context.Context, *ArchiveCourseRequest) (*ArchiveCourseResponse, error)
+	RestoreCourse(context.Context, *RestoreCourseRequest) (*RestoreCourseResponse, error)
 	EnrollUser(context.Context, *EnrollUserRequest) (*EnrollUserResponse, error)
 	ExpelUser(context.Context, *ExpelUserRequest) (*ExpelUserResponse, error)
 	IsTeacher(context.Context, *IsTeacherRequest) (*IsTeacherResponse, error)
 	IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error)
 	GetCourseStudents(context.Context, *GetCourseStudentsRequest) (*GetCourseStudentsResponse, error)
+	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	GetCoursePermissions(context.Context, *GetCoursePermissionsRequest) (*GetCoursePermissionsResponse, error)
+	SetRolePermissions(context.Context, *SetRolePermissionsRequest) (*SetRolePermissionsResponse, error)
+	AddStaff(context.Context, *AddStaffRequest) (*AddStaffResponse, error)
+	RemoveStaff(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error)
+	ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error)
+	JoinByCode(context.Context, *JoinByCodeRequest) (*JoinByCodeResponse, error)
+	RequestEnrollment(context.Context, *RequestEnrollmentRequest) (*RequestEnrollmentResponse, error)
+	ListEnrollmentRequests(context.Context, *ListEnrollmentRequestsRequest) (*ListEnrollmentRequestsResponse, error)
+	ApproveEnrollment(context.Context, *ApproveEnrollmentRequest) (*ApproveEnrollmentResponse, error)
+	RejectEnrollment(context.Context, *RejectEnrollmentRequest) (*RejectEnrollmentResponse, error)
+	BulkEnroll(context.Context, *BulkEnrollRequest) (*BulkEnrollResponse, error)
+	ExportRoster(context.Context, *ExportRosterRequest) (*ExportRosterResponse, error)
+	CloneCourse(context.Context, *CloneCourseRequest) (*CloneCourseResponse, error)
+	GetCloneJob(context.Context, *GetCloneJobRequest) (*GetCloneJobResponse, error)
+	SearchCourses(context.Context, *SearchCoursesRequest) (*SearchCoursesResponse, error)
+	CreateModule(context.Context, *CreateModuleRequest) (*CreateModuleResponse, error)
+	UpdateModule(context.Context, *UpdateModuleRequest) (*UpdateModuleResponse, error)
+	DeleteModule(context.Context, *DeleteModuleRequest) (*DeleteModuleResponse, error)
+	ListModules(context.Context, *ListModulesRequest) (*ListModulesResponse, error)
+	ReorderModule(context.Context, *ReorderModuleRequest) (*ReorderModuleResponse, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	ListOwnershipTransfers(context.Context, *ListOwnershipTransfersRequest) (*ListOwnershipTransfersResponse, error)
+	AcceptOwnershipTransfer(context.Context, *AcceptOwnershipTransferRequest) (*AcceptOwnershipTransferResponse, error)
+	DeclineOwnershipTransfer(context.Context, *DeclineOwnershipTransferRequest) (*DeclineOwnershipTransferResponse, error)
 	mustEmbedUnimplementedCoursesServiceServer()
 }
 
@@ -226,6 +616,12 @@ func (UnimplementedCoursesServiceServer) UpdateCourse(context.Context, *UpdateCo
 func (UnimplementedCoursesServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
 }
+func (UnimplementedCoursesServiceServer) ArchiveCourse(context.Context, *ArchiveCourseRequest) (*ArchiveCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) RestoreCourse(context.Context, *RestoreCourseRequest) (*RestoreCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCourse not implemented")
+}
 func (UnimplementedCoursesServiceServer) EnrollUser(context.Context, *EnrollUserRequest) (*EnrollUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnrollUser not implemented")
 }
@@ -241,6 +637,90 @@ func (UnimplementedCoursesServiceServer) IsMember(context.Context, *IsMemberRequ
 func (UnimplementedCoursesServiceServer) GetCourseStudents(context.Context, *GetCourseStudentsRequest) (*GetCourseStudentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStudents not implemented")
 }
+func (UnimplementedCoursesServiceServer) Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCoursePermissions(context.Context, *GetCoursePermissionsRequest) (*GetCoursePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursePermissions not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetRolePermissions(context.Context, *SetRolePermissionsRequest) (*SetRolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRolePermissions not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddStaff(context.Context, *AddStaffRequest) (*AddStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStaff not implemented")
+}
+func (UnimplementedCoursesServiceServer) RemoveStaff(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStaff not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaff not implemented")
+}
+func (UnimplementedCoursesServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedCoursesServiceServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedCoursesServiceServer) JoinByCode(context.Context, *JoinByCodeRequest) (*JoinByCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinByCode not implemented")
+}
+func (UnimplementedCoursesServiceServer) RequestEnrollment(context.Context, *RequestEnrollmentRequest) (*RequestEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEnrollment not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListEnrollmentRequests(context.Context, *ListEnrollmentRequestsRequest) (*ListEnrollmentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnrollmentRequests not implemented")
+}
+func (UnimplementedCoursesServiceServer) ApproveEnrollment(context.Context, *ApproveEnrollmentRequest) (*ApproveEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveEnrollment not implemented")
+}
+func (UnimplementedCoursesServiceServer) RejectEnrollment(context.Context, *RejectEnrollmentRequest) (*RejectEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectEnrollment not implemented")
+}
+func (UnimplementedCoursesServiceServer) BulkEnroll(context.Context, *BulkEnrollRequest) (*BulkEnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkEnroll not implemented")
+}
+func (UnimplementedCoursesServiceServer) ExportRoster(context.Context, *ExportRosterRequest) (*ExportRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRoster not implemented")
+}
+func (UnimplementedCoursesServiceServer) CloneCourse(context.Context, *CloneCourseRequest) (*CloneCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCloneJob(context.Context, *GetCloneJobRequest) (*GetCloneJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCloneJob not implemented")
+}
+func (UnimplementedCoursesServiceServer) SearchCourses(context.Context, *SearchCoursesRequest) (*SearchCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) CreateModule(context.Context, *CreateModuleRequest) (*CreateModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateModule not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpdateModule(context.Context, *UpdateModuleRequest) (*UpdateModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModule not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeleteModule(context.Context, *DeleteModuleRequest) (*DeleteModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModule not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListModules(context.Context, *ListModulesRequest) (*ListModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModules not implemented")
+}
+func (UnimplementedCoursesServiceServer) ReorderModule(context.Context, *ReorderModuleRequest) (*ReorderModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderModule not implemented")
+}
+func (UnimplementedCoursesServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListOwnershipTransfers(context.Context, *ListOwnershipTransfersRequest) (*ListOwnershipTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOwnershipTransfers not implemented")
+}
+func (UnimplementedCoursesServiceServer) AcceptOwnershipTransfer(context.Context, *AcceptOwnershipTransferRequest) (*AcceptOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnershipTransfer not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeclineOwnershipTransfer(context.Context, *DeclineOwnershipTransferRequest) (*DeclineOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineOwnershipTransfer not implemented")
+}
 func (UnimplementedCoursesServiceServer) mustEmbedUnimplementedCoursesServiceServer() {}
 func (UnimplementedCoursesServiceServer) testEmbeddedByValue()                        {}
 
@@ -388,49 +868,85 @@ func _CoursesService_DeleteCourse_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_EnrollUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollUserRequest)
+func _CoursesService_ArchiveCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).EnrollUser(ctx, in)
+		return srv.(CoursesServiceServer).ArchiveCourse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_EnrollUser_FullMethodName,
+		FullMethod: CoursesService_ArchiveCourse_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).EnrollUser(ctx, req.(*EnrollUserRequest))
+		return srv.(CoursesServiceServer).ArchiveCourse(ctx, req.(*ArchiveCourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_ExpelUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExpelUserRequest)
+func _CoursesService_RestoreCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).ExpelUser(ctx, in)
+		return srv.(CoursesServiceServer).RestoreCourse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_ExpelUser_FullMethodName,
+		FullMethod: CoursesService_RestoreCourse_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).ExpelUser(ctx, req.(*ExpelUserRequest))
+		return srv.(CoursesServiceServer).RestoreCourse(ctx, req.(*RestoreCourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_IsTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsTeacherRequest)
+func _CoursesService_EnrollUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).IsTeacher(ctx, in)
+		return srv.(CoursesServiceServer).EnrollUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_EnrollUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).EnrollUser(ctx, req.(*EnrollUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ExpelUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpelUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ExpelUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ExpelUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ExpelUser(ctx, req.(*ExpelUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_IsTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).IsTeacher(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -478,6 +994,510 @@ func _CoursesService_GetCourseStudents_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoursesService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_Authorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).Authorize(ctx, req.(*AuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCoursePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoursePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCoursePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCoursePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCoursePermissions(ctx, req.(*GetCoursePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SetRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SetRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SetRolePermissions(ctx, req.(*SetRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddStaff(ctx, req.(*AddStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RemoveStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RemoveStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RemoveStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RemoveStaff(ctx, req.(*RemoveStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListStaff(ctx, req.(*ListStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_JoinByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).JoinByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_JoinByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).JoinByCode(ctx, req.(*JoinByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RequestEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RequestEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RequestEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RequestEnrollment(ctx, req.(*RequestEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListEnrollmentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnrollmentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListEnrollmentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListEnrollmentRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListEnrollmentRequests(ctx, req.(*ListEnrollmentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ApproveEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ApproveEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ApproveEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ApproveEnrollment(ctx, req.(*ApproveEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RejectEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RejectEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RejectEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RejectEnrollment(ctx, req.(*RejectEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_BulkEnroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkEnrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).BulkEnroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_BulkEnroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).BulkEnroll(ctx, req.(*BulkEnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ExportRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ExportRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ExportRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ExportRoster(ctx, req.(*ExportRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_CloneCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).CloneCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_CloneCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).CloneCourse(ctx, req.(*CloneCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCloneJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloneJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCloneJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCloneJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCloneJob(ctx, req.(*GetCloneJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SearchCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SearchCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SearchCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SearchCourses(ctx, req.(*SearchCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_CreateModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).CreateModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_CreateModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).CreateModule(ctx, req.(*CreateModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_UpdateModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).UpdateModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_UpdateModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).UpdateModule(ctx, req.(*UpdateModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeleteModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeleteModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeleteModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeleteModule(ctx, req.(*DeleteModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListModules(ctx, req.(*ListModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ReorderModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ReorderModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ReorderModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ReorderModule(ctx, req.(*ReorderModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListOwnershipTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOwnershipTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListOwnershipTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListOwnershipTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListOwnershipTransfers(ctx, req.(*ListOwnershipTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AcceptOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AcceptOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AcceptOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AcceptOwnershipTransfer(ctx, req.(*AcceptOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeclineOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeclineOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeclineOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeclineOwnershipTransfer(ctx, req.(*DeclineOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CoursesService_ServiceDesc is the grpc.ServiceDesc for CoursesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -513,6 +1533,14 @@ var CoursesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCourse",
 			Handler:    _CoursesService_DeleteCourse_Handler,
 		},
+		{
+			MethodName: "ArchiveCourse",
+			Handler:    _CoursesService_ArchiveCourse_Handler,
+		},
+		{
+			MethodName: "RestoreCourse",
+			Handler:    _CoursesService_RestoreCourse_Handler,
+		},
 		{
 			MethodName: "EnrollUser",
 			Handler:    _CoursesService_EnrollUser_Handler,
@@ -533,6 +1561,118 @@ var CoursesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCourseStudents",
 			Handler:    _CoursesService_GetCourseStudents_Handler,
 		},
+		{
+			MethodName: "Authorize",
+			Handler:    _CoursesService_Authorize_Handler,
+		},
+		{
+			MethodName: "GetCoursePermissions",
+			Handler:    _CoursesService_GetCoursePermissions_Handler,
+		},
+		{
+			MethodName: "SetRolePermissions",
+			Handler:    _CoursesService_SetRolePermissions_Handler,
+		},
+		{
+			MethodName: "AddStaff",
+			Handler:    _CoursesService_AddStaff_Handler,
+		},
+		{
+			MethodName: "RemoveStaff",
+			Handler:    _CoursesService_RemoveStaff_Handler,
+		},
+		{
+			MethodName: "ListStaff",
+			Handler:    _CoursesService_ListStaff_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _CoursesService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _CoursesService_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _CoursesService_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "JoinByCode",
+			Handler:    _CoursesService_JoinByCode_Handler,
+		},
+		{
+			MethodName: "RequestEnrollment",
+			Handler:    _CoursesService_RequestEnrollment_Handler,
+		},
+		{
+			MethodName: "ListEnrollmentRequests",
+			Handler:    _CoursesService_ListEnrollmentRequests_Handler,
+		},
+		{
+			MethodName: "ApproveEnrollment",
+			Handler:    _CoursesService_ApproveEnrollment_Handler,
+		},
+		{
+			MethodName: "RejectEnrollment",
+			Handler:    _CoursesService_RejectEnrollment_Handler,
+		},
+		{
+			MethodName: "BulkEnroll",
+			Handler:    _CoursesService_BulkEnroll_Handler,
+		},
+		{
+			MethodName: "ExportRoster",
+			Handler:    _CoursesService_ExportRoster_Handler,
+		},
+		{
+			MethodName: "CloneCourse",
+			Handler:    _CoursesService_CloneCourse_Handler,
+		},
+		{
+			MethodName: "GetCloneJob",
+			Handler:    _CoursesService_GetCloneJob_Handler,
+		},
+		{
+			MethodName: "SearchCourses",
+			Handler:    _CoursesService_SearchCourses_Handler,
+		},
+		{
+			MethodName: "CreateModule",
+			Handler:    _CoursesService_CreateModule_Handler,
+		},
+		{
+			MethodName: "UpdateModule",
+			Handler:    _CoursesService_UpdateModule_Handler,
+		},
+		{
+			MethodName: "DeleteModule",
+			Handler:    _CoursesService_DeleteModule_Handler,
+		},
+		{
+			MethodName: "ListModules",
+			Handler:    _CoursesService_ListModules_Handler,
+		},
+		{
+			MethodName: "ReorderModule",
+			Handler:    _CoursesService_ReorderModule_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _CoursesService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "ListOwnershipTransfers",
+			Handler:    _CoursesService_ListOwnershipTransfers_Handler,
+		},
+		{
+			MethodName: "AcceptOwnershipTransfer",
+			Handler:    _CoursesService_AcceptOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineOwnershipTransfer",
+			Handler:    _CoursesService_DeclineOwnershipTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "Common/Proto/courses.proto",
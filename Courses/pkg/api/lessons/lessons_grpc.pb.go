@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: Common/Proto/lessons.proto
+
+package lessons
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LessonsService_CreateLesson_FullMethodName          = "/lessons.LessonsService/CreateLesson"
+	LessonsService_GetLesson_FullMethodName             = "/lessons.LessonsService/GetLesson"
+	LessonsService_GetLessons_FullMethodName            = "/lessons.LessonsService/GetLessons"
+	LessonsService_UpdateLesson_FullMethodName          = "/lessons.LessonsService/UpdateLesson"
+	LessonsService_DeleteLesson_FullMethodName          = "/lessons.LessonsService/DeleteLesson"
+	LessonsService_CloneFromCourse_FullMethodName       = "/lessons.LessonsService/CloneFromCourse"
+	LessonsService_ReorderLessons_FullMethodName        = "/lessons.LessonsService/ReorderLessons"
+	LessonsService_PreviewLesson_FullMethodName         = "/lessons.LessonsService/PreviewLesson"
+	LessonsService_ListLessonRevisions_FullMethodName   = "/lessons.LessonsService/ListLessonRevisions"
+	LessonsService_GetLessonRevision_FullMethodName     = "/lessons.LessonsService/GetLessonRevision"
+	LessonsService_RestoreLessonRevision_FullMethodName = "/lessons.LessonsService/RestoreLessonRevision"
+	LessonsService_UploadAttachment_FullMethodName      = "/lessons.LessonsService/UploadAttachment"
+	LessonsService_GetAttachment_FullMethodName         = "/lessons.LessonsService/GetAttachment"
+	LessonsService_ListAttachments_FullMethodName       = "/lessons.LessonsService/ListAttachments"
+	LessonsService_DeleteAttachment_FullMethodName      = "/lessons.LessonsService/DeleteAttachment"
+	LessonsService_DownloadAttachment_FullMethodName    = "/lessons.LessonsService/DownloadAttachment"
+)
+
+// LessonsServiceClient is the client API for LessonsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LessonsServiceClient interface {
+	CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error)
+	GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*GetLessonResponse, error)
+	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
+	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*UpdateLessonResponse, error)
+	DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error)
+	CloneFromCourse(ctx context.Context, in *CloneFromCourseRequest, opts ...grpc.CallOption) (*CloneFromCourseResponse, error)
+	ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error)
+	PreviewLesson(ctx context.Context, in *PreviewLessonRequest, opts ...grpc.CallOption) (*PreviewLessonResponse, error)
+	ListLessonRevisions(ctx context.Context, in *ListLessonRevisionsRequest, opts ...grpc.CallOption) (*ListLessonRevisionsResponse, error)
+	GetLessonRevision(ctx context.Context, in *GetLessonRevisionRequest, opts ...grpc.CallOption) (*GetLessonRevisionResponse, error)
+	RestoreLessonRevision(ctx context.Context, in *RestoreLessonRevisionRequest, opts ...grpc.CallOption) (*RestoreLessonRevisionResponse, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentResponse, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+}
+
+type lessonsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLessonsServiceClient(cc grpc.ClientConnInterface) LessonsServiceClient {
+	return &lessonsServiceClient{cc}
+}
+
+func (c *lessonsServiceClient) CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLessonResponse)
+	err := c.cc.Invoke(ctx, LessonsService_CreateLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*GetLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonResponse)
+	err := c.cc.Invoke(ctx, LessonsService_GetLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonsResponse)
+	err := c.cc.Invoke(ctx, LessonsService_GetLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*UpdateLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLessonResponse)
+	err := c.cc.Invoke(ctx, LessonsService_UpdateLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLessonResponse)
+	err := c.cc.Invoke(ctx, LessonsService_DeleteLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) CloneFromCourse(ctx context.Context, in *CloneFromCourseRequest, opts ...grpc.CallOption) (*CloneFromCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneFromCourseResponse)
+	err := c.cc.Invoke(ctx, LessonsService_CloneFromCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderLessonsResponse)
+	err := c.cc.Invoke(ctx, LessonsService_ReorderLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) PreviewLesson(ctx context.Context, in *PreviewLessonRequest, opts ...grpc.CallOption) (*PreviewLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewLessonResponse)
+	err := c.cc.Invoke(ctx, LessonsService_PreviewLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) ListLessonRevisions(ctx context.Context, in *ListLessonRevisionsRequest, opts ...grpc.CallOption) (*ListLessonRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLessonRevisionsResponse)
+	err := c.cc.Invoke(ctx, LessonsService_ListLessonRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) GetLessonRevision(ctx context.Context, in *GetLessonRevisionRequest, opts ...grpc.CallOption) (*GetLessonRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonRevisionResponse)
+	err := c.cc.Invoke(ctx, LessonsService_GetLessonRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) RestoreLessonRevision(ctx context.Context, in *RestoreLessonRevisionRequest, opts ...grpc.CallOption) (*RestoreLessonRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreLessonRevisionResponse)
+	err := c.cc.Invoke(ctx, LessonsService_RestoreLessonRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LessonsService_ServiceDesc.Streams[0], LessonsService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LessonsService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *lessonsServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentResponse)
+	err := c.cc.Invoke(ctx, LessonsService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, LessonsService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, LessonsService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonsServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LessonsService_ServiceDesc.Streams[1], LessonsService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LessonsService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+// LessonsServiceServer is the server API for LessonsService service.
+// All implementations must embed UnimplementedLessonsServiceServer
+// for forward compatibility.
+type LessonsServiceServer interface {
+	CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error)
+	GetLesson(context.Context, *GetLessonRequest) (*GetLessonResponse, error)
+	GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error)
+	UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error)
+	DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error)
+	CloneFromCourse(context.Context, *CloneFromCourseRequest) (*CloneFromCourseResponse, error)
+	ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error)
+	PreviewLesson(context.Context, *PreviewLessonRequest) (*PreviewLessonResponse, error)
+	ListLessonRevisions(context.Context, *ListLessonRevisionsRequest) (*ListLessonRevisionsResponse, error)
+	GetLessonRevision(context.Context, *GetLessonRevisionRequest) (*GetLessonRevisionResponse, error)
+	RestoreLessonRevision(context.Context, *RestoreLessonRevisionRequest) (*RestoreLessonRevisionResponse, error)
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	GetAttachment(context.Context, *GetAttachmentRequest) (*GetAttachmentResponse, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	mustEmbedUnimplementedLessonsServiceServer()
+}
+
+// UnimplementedLessonsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLessonsServiceServer struct{}
+
+func (UnimplementedLessonsServiceServer) CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLesson not implemented")
+}
+func (UnimplementedLessonsServiceServer) GetLesson(context.Context, *GetLessonRequest) (*GetLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLesson not implemented")
+}
+func (UnimplementedLessonsServiceServer) GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessons not implemented")
+}
+func (UnimplementedLessonsServiceServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
+}
+func (UnimplementedLessonsServiceServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
+}
+func (UnimplementedLessonsServiceServer) CloneFromCourse(context.Context, *CloneFromCourseRequest) (*CloneFromCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneFromCourse not implemented")
+}
+func (UnimplementedLessonsServiceServer) ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderLessons not implemented")
+}
+func (UnimplementedLessonsServiceServer) PreviewLesson(context.Context, *PreviewLessonRequest) (*PreviewLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewLesson not implemented")
+}
+func (UnimplementedLessonsServiceServer) ListLessonRevisions(context.Context, *ListLessonRevisionsRequest) (*ListLessonRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLessonRevisions not implemented")
+}
+func (UnimplementedLessonsServiceServer) GetLessonRevision(context.Context, *GetLessonRevisionRequest) (*GetLessonRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonRevision not implemented")
+}
+func (UnimplementedLessonsServiceServer) RestoreLessonRevision(context.Context, *RestoreLessonRevisionRequest) (*RestoreLessonRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLessonRevision not implemented")
+}
+func (UnimplementedLessonsServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedLessonsServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*GetAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedLessonsServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedLessonsServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedLessonsServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedLessonsServiceServer) mustEmbedUnimplementedLessonsServiceServer() {}
+func (UnimplementedLessonsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeLessonsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LessonsServiceServer will
+// result in compilation errors.
+type UnsafeLessonsServiceServer interface {
+	mustEmbedUnimplementedLessonsServiceServer()
+}
+
+func RegisterLessonsServiceServer(s grpc.ServiceRegistrar, srv LessonsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLessonsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LessonsService_ServiceDesc, srv)
+}
+
+func _LessonsService_CreateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).CreateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_CreateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).CreateLesson(ctx, req.(*CreateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_GetLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).GetLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_GetLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).GetLesson(ctx, req.(*GetLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).GetLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_GetLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).GetLessons(ctx, req.(*GetLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).UpdateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_UpdateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).UpdateLesson(ctx, req.(*UpdateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_DeleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).DeleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_DeleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).DeleteLesson(ctx, req.(*DeleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_CloneFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneFromCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).CloneFromCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_CloneFromCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).CloneFromCourse(ctx, req.(*CloneFromCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_ReorderLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).ReorderLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_ReorderLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).ReorderLessons(ctx, req.(*ReorderLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_PreviewLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).PreviewLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_PreviewLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).PreviewLesson(ctx, req.(*PreviewLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_ListLessonRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLessonRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).ListLessonRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_ListLessonRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).ListLessonRevisions(ctx, req.(*ListLessonRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_GetLessonRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).GetLessonRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_GetLessonRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).GetLessonRevision(ctx, req.(*GetLessonRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_RestoreLessonRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLessonRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).RestoreLessonRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_RestoreLessonRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).RestoreLessonRevision(ctx, req.(*RestoreLessonRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LessonsServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LessonsService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _LessonsService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonsServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LessonsService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonsServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonsService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LessonsServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LessonsService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+// LessonsService_ServiceDesc is the grpc.ServiceDesc for LessonsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LessonsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lessons.LessonsService",
+	HandlerType: (*LessonsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLesson",
+			Handler:    _LessonsService_CreateLesson_Handler,
+		},
+		{
+			MethodName: "GetLesson",
+			Handler:    _LessonsService_GetLesson_Handler,
+		},
+		{
+			MethodName: "GetLessons",
+			Handler:    _LessonsService_GetLessons_Handler,
+		},
+		{
+			MethodName: "UpdateLesson",
+			Handler:    _LessonsService_UpdateLesson_Handler,
+		},
+		{
+			MethodName: "DeleteLesson",
+			Handler:    _LessonsService_DeleteLesson_Handler,
+		},
+		{
+			MethodName: "CloneFromCourse",
+			Handler:    _LessonsService_CloneFromCourse_Handler,
+		},
+		{
+			MethodName: "ReorderLessons",
+			Handler:    _LessonsService_ReorderLessons_Handler,
+		},
+		{
+			MethodName: "PreviewLesson",
+			Handler:    _LessonsService_PreviewLesson_Handler,
+		},
+		{
+			MethodName: "ListLessonRevisions",
+			Handler:    _LessonsService_ListLessonRevisions_Handler,
+		},
+		{
+			MethodName: "GetLessonRevision",
+			Handler:    _LessonsService_GetLessonRevision_Handler,
+		},
+		{
+			MethodName: "RestoreLessonRevision",
+			Handler:    _LessonsService_RestoreLessonRevision_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _LessonsService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _LessonsService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _LessonsService_DeleteAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _LessonsService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _LessonsService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "Common/Proto/lessons.proto",
+}
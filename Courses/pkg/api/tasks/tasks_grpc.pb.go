@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: Common/Proto/tasks.proto
+
+package tasks
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TasksService_CreateTask_FullMethodName         = "/tasks.TasksService/CreateTask"
+	TasksService_GetTask_FullMethodName            = "/tasks.TasksService/GetTask"
+	TasksService_GetTasks_FullMethodName           = "/tasks.TasksService/GetTasks"
+	TasksService_GetTasksForStudent_FullMethodName = "/tasks.TasksService/GetTasksForStudent"
+	TasksService_GetStudentStatuses_FullMethodName = "/tasks.TasksService/GetStudentStatuses"
+	TasksService_UpdateTask_FullMethodName         = "/tasks.TasksService/UpdateTask"
+	TasksService_ChangeStatusTask_FullMethodName   = "/tasks.TasksService/ChangeStatusTask"
+	TasksService_DeleteTask_FullMethodName         = "/tasks.TasksService/DeleteTask"
+	TasksService_CloneFromCourse_FullMethodName    = "/tasks.TasksService/CloneFromCourse"
+)
+
+// TasksServiceClient is the client API for TasksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TasksServiceClient interface {
+	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
+	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
+	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksResponse, error)
+	GetTasksForStudent(ctx context.Context, in *GetTasksForStudentRequest, opts ...grpc.CallOption) (*GetTasksForStudentResponse, error)
+	GetStudentStatuses(ctx context.Context, in *GetStudentStatusesRequest, opts ...grpc.CallOption) (*GetStudentStatusesResponse, error)
+	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
+	ChangeStatusTask(ctx context.Context, in *ChangeStatusTaskRequest, opts ...grpc.CallOption) (*ChangeStatusTaskResponse, error)
+	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	CloneFromCourse(ctx context.Context, in *CloneFromCourseRequest, opts ...grpc.CallOption) (*CloneFromCourseResponse, error)
+}
+
+type tasksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTasksServiceClient(cc grpc.ClientConnInterface) TasksServiceClient {
+	return &tasksServiceClient{cc}
+}
+
+func (c *tasksServiceClient) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_CreateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTasksResponse)
+	err := c.cc.Invoke(ctx, TasksService_GetTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) GetTasksForStudent(ctx context.Context, in *GetTasksForStudentRequest, opts ...grpc.CallOption) (*GetTasksForStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTasksForStudentResponse)
+	err := c.cc.Invoke(ctx, TasksService_GetTasksForStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) GetStudentStatuses(ctx context.Context, in *GetStudentStatusesRequest, opts ...grpc.CallOption) (*GetStudentStatusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentStatusesResponse)
+	err := c.cc.Invoke(ctx, TasksService_GetStudentStatuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_UpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) ChangeStatusTask(ctx context.Context, in *ChangeStatusTaskRequest, opts ...grpc.CallOption) (*ChangeStatusTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeStatusTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_ChangeStatusTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_DeleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) CloneFromCourse(ctx context.Context, in *CloneFromCourseRequest, opts ...grpc.CallOption) (*CloneFromCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneFromCourseResponse)
+	err := c.cc.Invoke(ctx, TasksService_CloneFromCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TasksServiceServer is the server API for TasksService service.
+// All implementations must embed UnimplementedTasksServiceServer
+// for forward compatibility.
+type TasksServiceServer interface {
+	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
+	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
+	GetTasks(context.Context, *GetTasksRequest) (*GetTasksResponse, error)
+	GetTasksForStudent(context.Context, *GetTasksForStudentRequest) (*GetTasksForStudentResponse, error)
+	GetStudentStatuses(context.Context, *GetStudentStatusesRequest) (*GetStudentStatusesResponse, error)
+	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
+	ChangeStatusTask(context.Context, *ChangeStatusTaskRequest) (*ChangeStatusTaskResponse, error)
+	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	CloneFromCourse(context.Context, *CloneFromCourseRequest) (*CloneFromCourseResponse, error)
+	mustEmbedUnimplementedTasksServiceServer()
+}
+
+// UnimplementedTasksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTasksServiceServer struct{}
+
+func (UnimplementedTasksServiceServer) CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedTasksServiceServer) GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedTasksServiceServer) GetTasks(context.Context, *GetTasksRequest) (*GetTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTasks not implemented")
+}
+func (UnimplementedTasksServiceServer) GetTasksForStudent(context.Context, *GetTasksForStudentRequest) (*GetTasksForStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTasksForStudent not implemented")
+}
+func (UnimplementedTasksServiceServer) GetStudentStatuses(context.Context, *GetStudentStatusesRequest) (*GetStudentStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentStatuses not implemented")
+}
+func (UnimplementedTasksServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedTasksServiceServer) ChangeStatusTask(context.Context, *ChangeStatusTaskRequest) (*ChangeStatusTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusTask not implemented")
+}
+func (UnimplementedTasksServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedTasksServiceServer) CloneFromCourse(context.Context, *CloneFromCourseRequest) (*CloneFromCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneFromCourse not implemented")
+}
+func (UnimplementedTasksServiceServer) mustEmbedUnimplementedTasksServiceServer() {}
+func (UnimplementedTasksServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTasksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TasksServiceServer will
+// result in compilation errors.
+type UnsafeTasksServiceServer interface {
+	mustEmbedUnimplementedTasksServiceServer()
+}
+
+func RegisterTasksServiceServer(s grpc.ServiceRegistrar, srv TasksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTasksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TasksService_ServiceDesc, srv)
+}
+
+func _TasksService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_CreateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).CreateTask(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_GetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).GetTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_GetTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).GetTasks(ctx, req.(*GetTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_GetTasksForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTasksForStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).GetTasksForStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_GetTasksForStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).GetTasksForStudent(ctx, req.(*GetTasksForStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_GetStudentStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).GetStudentStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_GetStudentStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).GetStudentStatuses(ctx, req.(*GetStudentStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_ChangeStatusTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStatusTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).ChangeStatusTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_ChangeStatusTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).ChangeStatusTask(ctx, req.(*ChangeStatusTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_DeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_CloneFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneFromCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).CloneFromCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_CloneFromCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).CloneFromCourse(ctx, req.(*CloneFromCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TasksService_ServiceDesc is the grpc.ServiceDesc for TasksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TasksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tasks.TasksService",
+	HandlerType: (*TasksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _TasksService_CreateTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _TasksService_GetTask_Handler,
+		},
+		{
+			MethodName: "GetTasks",
+			Handler:    _TasksService_GetTasks_Handler,
+		},
+		{
+			MethodName: "GetTasksForStudent",
+			Handler:    _TasksService_GetTasksForStudent_Handler,
+		},
+		{
+			MethodName: "GetStudentStatuses",
+			Handler:    _TasksService_GetStudentStatuses_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _TasksService_UpdateTask_Handler,
+		},
+		{
+			MethodName: "ChangeStatusTask",
+			Handler:    _TasksService_ChangeStatusTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _TasksService_DeleteTask_Handler,
+		},
+		{
+			MethodName: "CloneFromCourse",
+			Handler:    _TasksService_CloneFromCourse_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "Common/Proto/tasks.proto",
+}
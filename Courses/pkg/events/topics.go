@@ -3,4 +3,13 @@ package events
 const (
 	CourseEnrolledTopic = "course.enrolled"
 	CourseExpelledTopic = "course.expelled"
+	CourseInvitedTopic  = "course.invited"
+	CourseArchivedTopic = "course.archived"
+	CourseDeletedTopic  = "course.deleted"
+	CourseRestoredTopic = "course.restored"
+	CoursePurgedTopic   = "course.purged"
+
+	CourseOwnershipTransferredTopic = "course.ownership_transferred"
+
+	CourseEnrollmentRequestUpdatedTopic = "course.enrollment_request_updated"
 )
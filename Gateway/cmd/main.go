@@ -42,12 +42,13 @@ func main() {
 	redis := redis.MustNew(config.Common.RedisURL)
 
 	server, err := srv.NewServer(ctx, config)
-	server.Redis = redis
-	server.CtxStop = stop
 	if err != nil {
 		logger.Error(ctx, "Server ran into problem: ", slog.Any("error", err))
 		stop()
+		os.Exit(1)
 	}
+	server.Redis = redis
+	server.CtxStop = stop
 
 	logger.Info(ctx, "Server running", slog.Int("port", config.Host.Port))
 	go server.Run(ctx)
@@ -6,6 +6,8 @@ import (
 	"Classroom/Gateway/pkg/config"
 	"Classroom/Gateway/pkg/logger"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"time"
@@ -131,3 +133,397 @@ func (s *AuthServiceClient) GetUserInfo(ctx context.Context, rc *redis.Client, r
 	logger.Debug(ctx, "Auth.GetUserInfo succeed")
 	return resp, nil
 }
+
+func (s *AuthServiceClient) RequestPasswordReset(ctx context.Context, req RequestPasswordResetRequest) (RequestPasswordResetResponse, error) {
+	logger.Debug(ctx, "Requesting password reset", slog.String("email", req.Email))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RequestPasswordReset(ctx, NewRequestPasswordResetRequest(req))
+	if err != nil {
+		return RequestPasswordResetResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.RequestPasswordReset succeed")
+	return NewRequestPasswordResetResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ResetPassword(ctx context.Context, req ResetPasswordRequest) (ResetPasswordResponse, error) {
+	logger.Debug(ctx, "Resetting user password")
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ResetPassword(ctx, NewResetPasswordRequest(req))
+	if err != nil {
+		return ResetPasswordResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ResetPassword succeed")
+	return NewResetPasswordResponse(resp), nil
+}
+
+func (s *AuthServiceClient) VerifyEmail(ctx context.Context, req VerifyEmailRequest) (VerifyEmailResponse, error) {
+	logger.Debug(ctx, "Verifying user email")
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.VerifyEmail(ctx, NewVerifyEmailRequest(req))
+	if err != nil {
+		return VerifyEmailResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.VerifyEmail succeed")
+	return NewVerifyEmailResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ResendVerificationEmail(ctx context.Context, req ResendVerificationEmailRequest) (ResendVerificationEmailResponse, error) {
+	logger.Debug(ctx, "Resending verification email", slog.String("email", req.Email))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ResendVerificationEmail(ctx, NewResendVerificationEmailRequest(req))
+	if err != nil {
+		return ResendVerificationEmailResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ResendVerificationEmail succeed")
+	return NewResendVerificationEmailResponse(resp), nil
+}
+
+func (s *AuthServiceClient) GetJWKS(ctx context.Context) (JWKS, error) {
+	logger.Debug(ctx, "Getting JWKS")
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.GetJWKS(ctx, &pb.GetJWKSRequest{})
+	if err != nil {
+		return JWKS{}, err
+	}
+
+	logger.Debug(ctx, "Auth.GetJWKS succeed")
+	return NewJWKS(resp), nil
+}
+
+func (s *AuthServiceClient) ListSessions(ctx context.Context, req ListSessionsRequest) (ListSessionsResponse, error) {
+	logger.Debug(ctx, "Listing user sessions", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListSessions(ctx, NewListSessionsRequest(req))
+	if err != nil {
+		return ListSessionsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ListSessions succeed")
+	return NewListSessionsResponse(resp), nil
+}
+
+func (s *AuthServiceClient) RevokeSession(ctx context.Context, req RevokeSessionRequest) (RevokeSessionResponse, error) {
+	logger.Debug(ctx, "Revoking user session", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RevokeSession(ctx, NewRevokeSessionRequest(req))
+	if err != nil {
+		return RevokeSessionResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.RevokeSession succeed")
+	return NewRevokeSessionResponse(resp), nil
+}
+
+func (s *AuthServiceClient) RevokeAllSessions(ctx context.Context, req RevokeAllSessionsRequest) (RevokeAllSessionsResponse, error) {
+	logger.Debug(ctx, "Revoking all user sessions", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RevokeAllSessions(ctx, NewRevokeAllSessionsRequest(req))
+	if err != nil {
+		return RevokeAllSessionsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.RevokeAllSessions succeed")
+	return NewRevokeAllSessionsResponse(resp), nil
+}
+
+func (s *AuthServiceClient) SetupMFA(ctx context.Context, req SetupMFARequest) (SetupMFAResponse, error) {
+	logger.Debug(ctx, "Setting up user MFA", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.SetupMFA(ctx, NewSetupMFARequest(req))
+	if err != nil {
+		return SetupMFAResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.SetupMFA succeed")
+	return NewSetupMFAResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ConfirmMFA(ctx context.Context, req ConfirmMFARequest) (ConfirmMFAResponse, error) {
+	logger.Debug(ctx, "Confirming user MFA", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ConfirmMFA(ctx, NewConfirmMFARequest(req))
+	if err != nil {
+		return ConfirmMFAResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ConfirmMFA succeed")
+	return NewConfirmMFAResponse(resp), nil
+}
+
+func (s *AuthServiceClient) DisableMFA(ctx context.Context, req DisableMFARequest) (DisableMFAResponse, error) {
+	logger.Debug(ctx, "Disabling user MFA", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.DisableMFA(ctx, NewDisableMFARequest(req))
+	if err != nil {
+		return DisableMFAResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.DisableMFA succeed")
+	return NewDisableMFAResponse(resp), nil
+}
+
+func (s *AuthServiceClient) VerifyMFA(ctx context.Context, req VerifyMFARequest) (VerifyMFAResponse, error) {
+	logger.Debug(ctx, "Verifying MFA code", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.VerifyMFA(ctx, NewVerifyMFARequest(req))
+	if err != nil {
+		return VerifyMFAResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.VerifyMFA succeed")
+	return NewVerifyMFAResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ListLockouts(ctx context.Context, req ListLockoutsRequest) (ListLockoutsResponse, error) {
+	logger.Debug(ctx, "Listing login lockouts", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListLockouts(ctx, NewListLockoutsRequest(req))
+	if err != nil {
+		return ListLockoutsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ListLockouts succeed")
+	return NewListLockoutsResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ClearLockout(ctx context.Context, req ClearLockoutRequest) (ClearLockoutResponse, error) {
+	logger.Debug(ctx, "Clearing login lockout", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ClearLockout(ctx, NewClearLockoutRequest(req))
+	if err != nil {
+		return ClearLockoutResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ClearLockout succeed")
+	return NewClearLockoutResponse(resp), nil
+}
+
+func (s *AuthServiceClient) CreateAccessToken(ctx context.Context, req CreateAccessTokenRequest) (CreateAccessTokenResponse, error) {
+	logger.Debug(ctx, "Creating personal access token", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.CreateAccessToken(ctx, NewCreateAccessTokenRequest(req))
+	if err != nil {
+		return CreateAccessTokenResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.CreateAccessToken succeed")
+	return NewCreateAccessTokenResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ListAccessTokens(ctx context.Context, req ListAccessTokensRequest) (ListAccessTokensResponse, error) {
+	logger.Debug(ctx, "Listing personal access tokens", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListAccessTokens(ctx, NewListAccessTokensRequest(req))
+	if err != nil {
+		return ListAccessTokensResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ListAccessTokens succeed")
+	return NewListAccessTokensResponse(resp), nil
+}
+
+func (s *AuthServiceClient) RevokeAccessToken(ctx context.Context, req RevokeAccessTokenRequest) (RevokeAccessTokenResponse, error) {
+	logger.Debug(ctx, "Revoking personal access token", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RevokeAccessToken(ctx, NewRevokeAccessTokenRequest(req))
+	if err != nil {
+		return RevokeAccessTokenResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.RevokeAccessToken succeed")
+	return NewRevokeAccessTokenResponse(resp), nil
+}
+
+// Успешная проверка кешируется не дольше ttl, поэтому отозванный токен
+// перестает приниматься с задержкой не больше ttl
+func (s *AuthServiceClient) IntrospectAccessToken(ctx context.Context, rc *redis.Client, ttl time.Duration, req IntrospectAccessTokenRequest) (IntrospectAccessTokenResponse, error) {
+	logger.Debug(ctx, "Introspecting personal access token")
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	// Сам токен в ключ кеша не попадает
+	sum := sha256.Sum256([]byte(req.Token))
+	key := hex.EncodeToString(sum[:])
+
+	resp, err := rds.Get[IntrospectAccessTokenResponse](rc, ctx, "Auth.IntrospectAccessToken", key)
+	if err == nil && time.Now().Before(resp.ExpiresAt) {
+		return resp, nil
+	}
+	logger.Debug(ctx, "Response was not cached", slog.Any("error", err))
+
+	pbresp, err := s.Client.IntrospectAccessToken(ctx, NewIntrospectAccessTokenRequest(req))
+	if err != nil {
+		return IntrospectAccessTokenResponse{}, err
+	}
+
+	resp = NewIntrospectAccessTokenResponse(pbresp)
+	rds.Put(rc, ctx, "Auth.IntrospectAccessToken", key, resp, min(ttl, time.Until(resp.ExpiresAt)))
+
+	logger.Debug(ctx, "Auth.IntrospectAccessToken succeed")
+	return resp, nil
+}
+
+func (s *AuthServiceClient) UpdateProfile(ctx context.Context, rc *redis.Client, req UpdateProfileRequest) (UpdateProfileResponse, error) {
+	logger.Debug(ctx, "Updating profile", slog.Any("user_id", req.UserID))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.UpdateProfile(ctx, NewUpdateProfileRequest(req))
+	if err != nil {
+		return UpdateProfileResponse{}, err
+	}
+
+	// Сбрасываем закешированную информацию о пользователе
+	rds.Delete(rc, ctx, "Auth.GetUserInfo", req.UserID)
+
+	logger.Debug(ctx, "Auth.UpdateProfile succeed")
+	return NewUpdateProfileResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ChangePassword(ctx context.Context, req ChangePasswordRequest) (ChangePasswordResponse, error) {
+	logger.Debug(ctx, "Changing password", slog.Any("user_id", req.UserID))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ChangePassword(ctx, NewChangePasswordRequest(req))
+	if err != nil {
+		return ChangePasswordResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ChangePassword succeed")
+	return NewChangePasswordResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ChangeEmail(ctx context.Context, rc *redis.Client, req ChangeEmailRequest) (ChangeEmailResponse, error) {
+	logger.Debug(ctx, "Changing email", slog.Any("user_id", req.UserID))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ChangeEmail(ctx, NewChangeEmailRequest(req))
+	if err != nil {
+		return ChangeEmailResponse{}, err
+	}
+
+	// Сбрасываем закешированную информацию о пользователе
+	rds.Delete(rc, ctx, "Auth.GetUserInfo", req.UserID)
+
+	logger.Debug(ctx, "Auth.ChangeEmail succeed")
+	return NewChangeEmailResponse(resp), nil
+}
+
+func (s *AuthServiceClient) ListUsers(ctx context.Context, req ListUsersRequest) (ListUsersResponse, error) {
+	logger.Debug(ctx, "Listing users", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListUsers(ctx, NewListUsersRequest(req))
+	if err != nil {
+		return ListUsersResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.ListUsers succeed")
+	return NewListUsersResponse(resp), nil
+}
+
+func (s *AuthServiceClient) GetUser(ctx context.Context, req GetUserRequest) (GetUserResponse, error) {
+	logger.Debug(ctx, "Getting user", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.GetUser(ctx, NewGetUserRequest(req))
+	if err != nil {
+		return GetUserResponse{}, err
+	}
+
+	logger.Debug(ctx, "Auth.GetUser succeed")
+	return NewGetUserResponse(resp), nil
+}
+
+func (s *AuthServiceClient) SetSuperuser(ctx context.Context, rc *redis.Client, req SetSuperuserRequest) (SetSuperuserResponse, error) {
+	logger.Debug(ctx, "Setting superuser", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.SetSuperuser(ctx, NewSetSuperuserRequest(req))
+	if err != nil {
+		return SetSuperuserResponse{}, err
+	}
+
+	// Сбрасываем закешированную информацию о пользователе
+	rds.Delete(rc, ctx, "Auth.GetUserInfo", req.UserID)
+
+	logger.Debug(ctx, "Auth.SetSuperuser succeed")
+	return NewSetSuperuserResponse(resp), nil
+}
+
+func (s *AuthServiceClient) DisableUser(ctx context.Context, rc *redis.Client, req DisableUserRequest) (DisableUserResponse, error) {
+	logger.Debug(ctx, "Disabling user", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.DisableUser(ctx, NewDisableUserRequest(req))
+	if err != nil {
+		return DisableUserResponse{}, err
+	}
+
+	// Сбрасываем закешированную информацию о пользователе
+	rds.Delete(rc, ctx, "Auth.GetUserInfo", req.UserID)
+
+	logger.Debug(ctx, "Auth.DisableUser succeed")
+	return NewDisableUserResponse(resp), nil
+}
+
+func (s *AuthServiceClient) DeleteUser(ctx context.Context, rc *redis.Client, req DeleteUserRequest) (DeleteUserResponse, error) {
+	logger.Debug(ctx, "Deleting user", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.DeleteUser(ctx, NewDeleteUserRequest(req))
+	if err != nil {
+		return DeleteUserResponse{}, err
+	}
+
+	// Сбрасываем закешированную информацию о пользователе
+	rds.Delete(rc, ctx, "Auth.GetUserInfo", req.UserID)
+
+	logger.Debug(ctx, "Auth.DeleteUser succeed")
+	return NewDeleteUserResponse(resp), nil
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"Classroom/Gateway/pkg/logger"
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// Минимальный интервал между запросами ключей при неизвестном kid,
+// чтобы токены с произвольным kid не приводили к запросу в Auth на каждый вызов
+const minRefreshInterval = 10 * time.Second
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeyCache хранит публичные ключи Auth в памяти и обновляет их по истечении ttl
+// или при появлении токена с новым kid после ротации ключей
+type KeyCache struct {
+	client *AuthServiceClient
+	ttl    time.Duration
+
+	mu        sync.RWMutex
+	jwks      JWKS
+	keys      map[string]any
+	fetchedAt time.Time
+}
+
+func NewKeyCache(client *AuthServiceClient, ttl time.Duration) *KeyCache {
+	return &KeyCache{
+		client: client,
+		ttl:    ttl,
+		keys:   make(map[string]any),
+	}
+}
+
+// JWKS возвращает актуальный набор публичных ключей
+func (c *KeyCache) JWKS(ctx context.Context) (JWKS, error) {
+	c.mu.RLock()
+	jwks, fresh := c.jwks, time.Since(c.fetchedAt) < c.ttl
+	c.mu.RUnlock()
+	if fresh {
+		return jwks, nil
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		return JWKS{}, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.jwks, nil
+}
+
+// Key возвращает публичный ключ по kid для проверки подписи токена
+func (c *KeyCache) Key(ctx context.Context, kid string) (any, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	age := time.Since(c.fetchedAt)
+	c.mu.RUnlock()
+
+	if ok && age < c.ttl {
+		return key, nil
+	}
+	if !ok && age < minRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		// Если Auth недоступен, продолжаем проверять токены по уже известным ключам
+		if ok {
+			logger.Error(ctx, "Failed to refresh JWKS, using cached key", slog.Any("error", err))
+			return key, nil
+		}
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+func (c *KeyCache) refresh(ctx context.Context) error {
+	jwks, err := c.client.GetJWKS(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			logger.Error(ctx, "Skipping invalid JWK", slog.String("kid", jwk.Kid), slog.Any("error", err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	c.mu.Lock()
+	c.jwks, c.keys, c.fetchedAt = jwks, keys, time.Now()
+	c.mu.Unlock()
+
+	logger.Debug(ctx, "JWKS refreshed", slog.Int("keys", len(keys)))
+	return nil
+}
+
+// PublicKey преобразует JWK в ключ, понятный golang-jwt
+func (k JWK) PublicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
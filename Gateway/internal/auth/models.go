@@ -2,6 +2,9 @@ package auth
 
 import (
 	pb "Classroom/Gateway/pkg/api/auth"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // RegisterRequest - запрос на регистрацию пользователя
@@ -46,12 +49,18 @@ type LoginRequest struct {
     Email string `json:"email" example:"user@example.com" extensions:"x-order=0"`
     // Пароль пользователя
     Password string `json:"password" example:"Qwerty123!" extensions:"x-order=1"`
+    // User-Agent клиента, заполняется из заголовков запроса
+    UserAgent string `json:"-"`
+    // IP адрес клиента, заполняется из запроса
+    IP string `json:"-"`
 } // @name AuthLoginRequest
 
 func NewLoginRequest(req LoginRequest) *pb.LoginRequest {
     return &pb.LoginRequest{
-        Email:    req.Email,
-        Password: req.Password,
+        Email:     req.Email,
+        Password:  req.Password,
+        UserAgent: req.UserAgent,
+        Ip:        req.IP,
     }
 }
 
@@ -59,15 +68,21 @@ func NewLoginRequest(req LoginRequest) *pb.LoginRequest {
 // @Description Возвращает пару access/refresh токенов для аутентификации
 type LoginResponse struct {
     // Токен для обновления access токена
-    RefreshToken string `json:"refresh_token" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    RefreshToken string `json:"refresh_token,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
     // Токен для доступа к API
-    AccessToken string `json:"access_token" example:"eyJhbG..." extensions:"x-order=1"`
+    AccessToken string `json:"access_token,omitempty" example:"eyJhbG..." extensions:"x-order=1"`
+    // Требуется подтверждение входа кодом через /auth/mfa/verify, токены доступа не выданы
+    MFARequired bool `json:"mfa_required,omitempty" example:"false" extensions:"x-order=2"`
+    // Короткоживущий токен для подтверждения входа кодом
+    MFAToken string `json:"mfa_token,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=3"`
 } // @name AuthLoginResponse
 
 func NewLoginResponse(resp *pb.LoginResponse) LoginResponse {
     return LoginResponse{
         RefreshToken: resp.GetRefreshToken(),
         AccessToken: resp.GetAccessToken(),
+        MFARequired: resp.GetMfaRequired(),
+        MFAToken: resp.GetMfaToken(),
     }
 }
 
@@ -84,16 +99,19 @@ func NewRefreshRequest(req RefreshRequest) *pb.RefreshRequest {
     }
 }
 
-// RefreshResponse - ответ с новым токеном доступа
-// @Description Возвращает новый access токен
+// RefreshResponse - ответ с новой парой токенов
+// @Description Возвращает новый access токен и новый refresh токен, переданный refresh токен становится недействительным
 type RefreshResponse struct {
     // Новый access токен
     AccessToken string `json:"access_token" example:"eyJhbG..." extensions:"x-order=0"`
+    // Новый refresh токен
+    RefreshToken string `json:"refresh_token" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
 } // @name AuthRefreshResponse
 
 func NewRefreshResponse(resp *pb.RefreshResponse) RefreshResponse {
     return RefreshResponse{
-        AccessToken: resp.GetAccessToken(),
+        AccessToken:  resp.GetAccessToken(),
+        RefreshToken: resp.GetRefreshToken(),
     }
 }
 
@@ -143,14 +161,877 @@ type GetUserInfoResponse struct {
     LastName string `json:"last_name" example:"Иванов" extensions:"x-order=3"`
     // Признак администратора
     IsSuperUser bool `json:"is_superuser" example:"false" extensions:"x-order=4"`
+    // Подтверждена ли почта
+    EmailVerified bool `json:"email_verified" example:"true" extensions:"x-order=5"`
 } // @name AuthUserInfoResponse
 
 func NewGetUserInfoResponse(resp *pb.GetUserInfoResponse) GetUserInfoResponse {
     return GetUserInfoResponse{
+        UserID:        resp.GetUserId(),
+        Email:         resp.GetEmail(),
+        FirstName:     resp.GetFirstName(),
+        LastName:      resp.GetLastName(),
+        IsSuperUser:   resp.GetIsSuperuser(),
+        EmailVerified: resp.GetEmailVerified(),
+    }
+}
+
+// RequestPasswordResetRequest - запрос на сброс пароля
+// @Description Содержит email, на который будет отправлено письмо для сброса пароля
+type RequestPasswordResetRequest struct {
+    // Email пользователя
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=0"`
+} // @name AuthRequestPasswordResetRequest
+
+func NewRequestPasswordResetRequest(req RequestPasswordResetRequest) *pb.RequestPasswordResetRequest {
+    return &pb.RequestPasswordResetRequest{
+        Email: req.Email,
+    }
+}
+
+// RequestPasswordResetResponse - подтверждение запроса на сброс пароля
+// @Description Пустой ответ, возвращается независимо от того, существует ли пользователь
+type RequestPasswordResetResponse struct{
+
+} // @name AuthRequestPasswordResetResponse
+
+func NewRequestPasswordResetResponse(resp *pb.RequestPasswordResetResponse) RequestPasswordResetResponse {
+    return RequestPasswordResetResponse{}
+}
+
+// ResetPasswordRequest - запрос на установку нового пароля
+// @Description Содержит одноразовый токен из письма и новый пароль
+type ResetPasswordRequest struct {
+    // Токен из письма
+    Token string `json:"token" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Новый пароль
+    NewPassword string `json:"new_password" example:"Qwerty123!" extensions:"x-order=1"`
+} // @name AuthResetPasswordRequest
+
+func NewResetPasswordRequest(req ResetPasswordRequest) *pb.ResetPasswordRequest {
+    return &pb.ResetPasswordRequest{
+        Token:       req.Token,
+        NewPassword: req.NewPassword,
+    }
+}
+
+// ResetPasswordResponse - подтверждение смены пароля
+// @Description Пустой ответ, все сессии пользователя завершены
+type ResetPasswordResponse struct{
+
+} // @name AuthResetPasswordResponse
+
+func NewResetPasswordResponse(resp *pb.ResetPasswordResponse) ResetPasswordResponse {
+    return ResetPasswordResponse{}
+}
+
+// VerifyEmailRequest - запрос на подтверждение почты
+// @Description Содержит одноразовый токен из письма
+type VerifyEmailRequest struct {
+    // Токен из письма
+    Token string `json:"token" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name AuthVerifyEmailRequest
+
+func NewVerifyEmailRequest(req VerifyEmailRequest) *pb.VerifyEmailRequest {
+    return &pb.VerifyEmailRequest{
+        Token: req.Token,
+    }
+}
+
+// VerifyEmailResponse - подтверждение почты
+// @Description Возвращает ID пользователя, чья почта подтверждена
+type VerifyEmailResponse struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name AuthVerifyEmailResponse
+
+func NewVerifyEmailResponse(resp *pb.VerifyEmailResponse) VerifyEmailResponse {
+    return VerifyEmailResponse{
+        UserID: resp.GetUserId(),
+    }
+}
+
+// ResendVerificationEmailRequest - запрос на повторную отправку письма
+// @Description Содержит email, на который нужно повторно отправить письмо для подтверждения
+type ResendVerificationEmailRequest struct {
+    // Email пользователя
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=0"`
+} // @name AuthResendVerificationEmailRequest
+
+func NewResendVerificationEmailRequest(req ResendVerificationEmailRequest) *pb.ResendVerificationEmailRequest {
+    return &pb.ResendVerificationEmailRequest{
+        Email: req.Email,
+    }
+}
+
+// ResendVerificationEmailResponse - подтверждение повторной отправки
+// @Description Пустой ответ, возвращается независимо от того, существует ли пользователь
+type ResendVerificationEmailResponse struct{
+
+} // @name AuthResendVerificationEmailResponse
+
+func NewResendVerificationEmailResponse(resp *pb.ResendVerificationEmailResponse) ResendVerificationEmailResponse {
+    return ResendVerificationEmailResponse{}
+}
+
+// JWK - публичный ключ для проверки access токенов
+// @Description Публичный ключ в формате JWK (RFC 7517)
+type JWK struct {
+    // Тип ключа: RSA или OKP
+    Kty string `json:"kty" example:"OKP" extensions:"x-order=0"`
+    // Идентификатор ключа из заголовка токена
+    Kid string `json:"kid" example:"2025-01" extensions:"x-order=1"`
+    // Назначение ключа
+    Use string `json:"use" example:"sig" extensions:"x-order=2"`
+    // Алгоритм подписи
+    Alg string `json:"alg" example:"EdDSA" extensions:"x-order=3"`
+    // Модуль RSA ключа
+    N string `json:"n,omitempty" extensions:"x-order=4"`
+    // Экспонента RSA ключа
+    E string `json:"e,omitempty" example:"AQAB" extensions:"x-order=5"`
+    // Кривая OKP ключа
+    Crv string `json:"crv,omitempty" example:"Ed25519" extensions:"x-order=6"`
+    // Публичный OKP ключ
+    X string `json:"x,omitempty" example:"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo" extensions:"x-order=7"`
+} // @name AuthJWK
+
+// JWKS - набор публичных ключей
+// @Description Все действующие ключи подписи, включая выведенные из ротации, пока их токены не истекли
+type JWKS struct {
+    // Массив ключей
+    Keys []JWK `json:"keys" extensions:"x-order=0"`
+} // @name AuthJWKS
+
+func NewJWKS(resp *pb.GetJWKSResponse) JWKS {
+    keys := make([]JWK, 0, len(resp.GetKeys()))
+    for _, k := range resp.GetKeys() {
+        keys = append(keys, JWK{
+            Kty: k.GetKty(),
+            Kid: k.GetKid(),
+            Use: k.GetUse(),
+            Alg: k.GetAlg(),
+            N:   k.GetN(),
+            E:   k.GetE(),
+            Crv: k.GetCrv(),
+            X:   k.GetX(),
+        })
+    }
+
+    return JWKS{
+        Keys: keys,
+    }
+}
+
+// Session - активная сессия пользователя
+// @Description Информация об устройстве и времени использования сессии
+type Session struct {
+    // ID сессии
+    SessionID string `json:"session_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // User-Agent клиента при входе
+    UserAgent string `json:"user_agent" example:"Mozilla/5.0 (X11; Linux x86_64)" extensions:"x-order=1"`
+    // IP адрес клиента при входе
+    IP string `json:"ip" example:"192.168.0.1" extensions:"x-order=2"`
+    // Дата и время входа
+    CreatedAt time.Time `json:"created_at" example:"2023-09-01T12:00:00Z" extensions:"x-order=3"`
+    // Дата и время последнего обновления токенов
+    LastUsedAt time.Time `json:"last_used_at" example:"2023-09-02T12:00:00Z" extensions:"x-order=4"`
+} // @name AuthSession
+
+// ListSessionsRequest - запрос на получение активных сессий
+// @Description ID пользователя берется из токена доступа
+type ListSessionsRequest struct {
+    UserID string `json:"-"`
+} // @name AuthListSessionsRequest
+
+func NewListSessionsRequest(req ListSessionsRequest) *pb.ListSessionsRequest {
+    return &pb.ListSessionsRequest{
+        UserId: req.UserID,
+    }
+}
+
+// ListSessionsResponse - список активных сессий
+// @Description Сессии отсортированы по времени последнего использования
+type ListSessionsResponse struct {
+    // Массив сессий
+    Sessions []Session `json:"sessions" extensions:"x-order=0"`
+} // @name AuthListSessionsResponse
+
+func NewListSessionsResponse(resp *pb.ListSessionsResponse) ListSessionsResponse {
+    sessions := make([]Session, 0, len(resp.GetSessions()))
+    for _, s := range resp.GetSessions() {
+        sessions = append(sessions, Session{
+            SessionID:  s.GetSessionId(),
+            UserAgent:  s.GetUserAgent(),
+            IP:         s.GetIp(),
+            CreatedAt:  s.GetCreatedAt().AsTime(),
+            LastUsedAt: s.GetLastUsedAt().AsTime(),
+        })
+    }
+
+    return ListSessionsResponse{
+        Sessions: sessions,
+    }
+}
+
+// RevokeSessionRequest - запрос на завершение сессии
+// @Description Содержит ID сессии, которую нужно завершить
+type RevokeSessionRequest struct {
+    UserID string `json:"-"`
+    // ID сессии
+    SessionID string `json:"session_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name AuthRevokeSessionRequest
+
+func NewRevokeSessionRequest(req RevokeSessionRequest) *pb.RevokeSessionRequest {
+    return &pb.RevokeSessionRequest{
+        UserId:    req.UserID,
+        SessionId: req.SessionID,
+    }
+}
+
+// RevokeSessionResponse - подтверждение завершения сессии
+// @Description Пустой ответ, указывающий на успешное завершение сессии
+type RevokeSessionResponse struct{
+
+} // @name AuthRevokeSessionResponse
+
+func NewRevokeSessionResponse(resp *pb.RevokeSessionResponse) RevokeSessionResponse {
+    return RevokeSessionResponse{}
+}
+
+// RevokeAllSessionsRequest - запрос на завершение всех сессий
+// @Description ID пользователя берется из токена доступа
+type RevokeAllSessionsRequest struct {
+    UserID string `json:"-"`
+} // @name AuthRevokeAllSessionsRequest
+
+func NewRevokeAllSessionsRequest(req RevokeAllSessionsRequest) *pb.RevokeAllSessionsRequest {
+    return &pb.RevokeAllSessionsRequest{
+        UserId: req.UserID,
+    }
+}
+
+// RevokeAllSessionsResponse - подтверждение завершения всех сессий
+// @Description Пустой ответ, указывающий на успешное завершение всех сессий
+type RevokeAllSessionsResponse struct{
+
+} // @name AuthRevokeAllSessionsResponse
+
+func NewRevokeAllSessionsResponse(resp *pb.RevokeAllSessionsResponse) RevokeAllSessionsResponse {
+    return RevokeAllSessionsResponse{}
+}
+
+// SetupMFARequest - запрос на подключение двухфакторной аутентификации
+// @Description ID пользователя берется из токена доступа
+type SetupMFARequest struct {
+    UserID string `json:"-"`
+} // @name AuthSetupMFARequest
+
+func NewSetupMFARequest(req SetupMFARequest) *pb.SetupMFARequest {
+    return &pb.SetupMFARequest{
+        UserId: req.UserID,
+    }
+}
+
+// SetupMFAResponse - секрет для приложения-аутентификатора
+// @Description Секрет нужно добавить в приложение и подтвердить подключение кодом из него
+type SetupMFAResponse struct {
+    // Секрет в base32 для ручного ввода
+    Secret string `json:"secret" example:"JBSWY3DPEHPK3PXP" extensions:"x-order=0"`
+    // URI для QR-кода
+    OtpauthURI string `json:"otpauth_uri" example:"otpauth://totp/Classroom:user@example.com?issuer=Classroom&secret=JBSWY3DPEHPK3PXP" extensions:"x-order=1"`
+} // @name AuthSetupMFAResponse
+
+func NewSetupMFAResponse(resp *pb.SetupMFAResponse) SetupMFAResponse {
+    return SetupMFAResponse{
+        Secret: resp.GetSecret(),
+        OtpauthURI: resp.GetOtpauthUri(),
+    }
+}
+
+// ConfirmMFARequest - запрос на подтверждение подключения двухфакторной аутентификации
+// @Description Содержит код из приложения-аутентификатора
+type ConfirmMFARequest struct {
+    UserID string `json:"-"`
+    // Код из приложения
+    Code string `json:"code" example:"123456" extensions:"x-order=0"`
+} // @name AuthConfirmMFARequest
+
+func NewConfirmMFARequest(req ConfirmMFARequest) *pb.ConfirmMFARequest {
+    return &pb.ConfirmMFARequest{
+        UserId: req.UserID,
+        Code: req.Code,
+    }
+}
+
+// ConfirmMFAResponse - коды восстановления
+// @Description Одноразовые коды для входа без приложения, показываются только один раз
+type ConfirmMFAResponse struct {
+    // Коды восстановления
+    RecoveryCodes []string `json:"recovery_codes" example:"abcde-fghij" extensions:"x-order=0"`
+} // @name AuthConfirmMFAResponse
+
+func NewConfirmMFAResponse(resp *pb.ConfirmMFAResponse) ConfirmMFAResponse {
+    return ConfirmMFAResponse{
+        RecoveryCodes: resp.GetRecoveryCodes(),
+    }
+}
+
+// DisableMFARequest - запрос на отключение двухфакторной аутентификации
+// @Description Содержит пароль и код из приложения или код восстановления
+type DisableMFARequest struct {
+    UserID string `json:"-"`
+    // Код из приложения или код восстановления
+    Code string `json:"code" example:"123456" extensions:"x-order=0"`
+    // Текущий пароль
+    Password string `json:"password" example:"password123" extensions:"x-order=1"`
+} // @name AuthDisableMFARequest
+
+func NewDisableMFARequest(req DisableMFARequest) *pb.DisableMFARequest {
+    return &pb.DisableMFARequest{
+        UserId: req.UserID,
+        Code: req.Code,
+        Password: req.Password,
+    }
+}
+
+// DisableMFAResponse - подтверждение отключения двухфакторной аутентификации
+// @Description Пустой ответ, указывающий на успешное отключение
+type DisableMFAResponse struct{
+
+} // @name AuthDisableMFAResponse
+
+func NewDisableMFAResponse(resp *pb.DisableMFAResponse) DisableMFAResponse {
+    return DisableMFAResponse{}
+}
+
+// VerifyMFARequest - второй шаг входа
+// @Description Содержит токен из ответа на вход и код из приложения или код восстановления
+type VerifyMFARequest struct {
+    // Токен из ответа /auth/login
+    MFAToken string `json:"mfa_token" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Код из приложения или код восстановления
+    Code string `json:"code" example:"123456" extensions:"x-order=1"`
+} // @name AuthVerifyMFARequest
+
+func NewVerifyMFARequest(req VerifyMFARequest) *pb.VerifyMFARequest {
+    return &pb.VerifyMFARequest{
+        MfaToken: req.MFAToken,
+        Code: req.Code,
+    }
+}
+
+// VerifyMFAResponse - ответ с токенами
+// @Description Возвращает пару access/refresh токенов для аутентификации
+type VerifyMFAResponse struct {
+    // Токен для обновления access токена
+    RefreshToken string `json:"refresh_token" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Токен для доступа к API
+    AccessToken string `json:"access_token" example:"eyJhbG..." extensions:"x-order=1"`
+} // @name AuthVerifyMFAResponse
+
+func NewVerifyMFAResponse(resp *pb.VerifyMFAResponse) VerifyMFAResponse {
+    return VerifyMFAResponse{
+        RefreshToken: resp.GetRefreshToken(),
+        AccessToken: resp.GetAccessToken(),
+    }
+}
+
+// Lockout - блокировка входа
+// @Description Почта или IP адрес, вход с которых временно заблокирован после неудачных попыток
+type Lockout struct {
+    // email, ip или mfa
+    Kind string `json:"kind" example:"email" extensions:"x-order=0"`
+    // Почта, IP адрес или ID пользователя
+    Subject string `json:"subject" example:"user@example.com" extensions:"x-order=1"`
+    // Количество неудачных попыток
+    Failures int `json:"failures" example:"10" extensions:"x-order=2"`
+    // Время последней неудачной попытки
+    LastFailureAt time.Time `json:"last_failure_at" example:"2025-01-01T12:00:00Z" extensions:"x-order=3"`
+    // Время окончания блокировки
+    LockedUntil time.Time `json:"locked_until" example:"2025-01-01T12:15:00Z" extensions:"x-order=4"`
+} // @name AuthLockout
+
+// ListLockoutsRequest - запрос на получение блокировок входа
+// @Description Пустой запрос
+type ListLockoutsRequest struct {
+
+} // @name AuthListLockoutsRequest
+
+func NewListLockoutsRequest(req ListLockoutsRequest) *pb.ListLockoutsRequest {
+    return &pb.ListLockoutsRequest{}
+}
+
+// ListLockoutsResponse - список блокировок входа
+// @Description Действующие блокировки по почте и IP адресу
+type ListLockoutsResponse struct {
+    // Массив блокировок
+    Lockouts []Lockout `json:"lockouts" extensions:"x-order=0"`
+} // @name AuthListLockoutsResponse
+
+func NewListLockoutsResponse(resp *pb.ListLockoutsResponse) ListLockoutsResponse {
+    lockouts := make([]Lockout, 0, len(resp.GetLockouts()))
+    for _, l := range resp.GetLockouts() {
+        lockouts = append(lockouts, Lockout{
+            Kind:          l.GetKind(),
+            Subject:       l.GetSubject(),
+            Failures:      int(l.GetFailures()),
+            LastFailureAt: l.GetLastFailureAt().AsTime(),
+            LockedUntil:   l.GetLockedUntil().AsTime(),
+        })
+    }
+
+    return ListLockoutsResponse{
+        Lockouts: lockouts,
+    }
+}
+
+// ClearLockoutRequest - запрос на снятие блокировки входа
+// @Description Содержит тип и значение, по которым заблокирован вход
+type ClearLockoutRequest struct {
+    // email, ip или mfa
+    Kind string `json:"kind" example:"email" extensions:"x-order=0"`
+    // Почта, IP адрес или ID пользователя
+    Subject string `json:"subject" example:"user@example.com" extensions:"x-order=1"`
+} // @name AuthClearLockoutRequest
+
+func NewClearLockoutRequest(req ClearLockoutRequest) *pb.ClearLockoutRequest {
+    return &pb.ClearLockoutRequest{
+        Kind: req.Kind,
+        Subject: req.Subject,
+    }
+}
+
+// ClearLockoutResponse - подтверждение снятия блокировки
+// @Description Пустой ответ, указывающий на успешное снятие блокировки
+type ClearLockoutResponse struct{
+
+} // @name AuthClearLockoutResponse
+
+func NewClearLockoutResponse(resp *pb.ClearLockoutResponse) ClearLockoutResponse {
+    return ClearLockoutResponse{}
+}
+
+// AccessToken - персональный токен доступа
+// @Description Токен для скриптов и интеграций с ограниченным набором разрешений
+type AccessToken struct {
+    // ID токена
+    TokenID string `json:"token_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Название токена
+    Name string `json:"name" example:"roster sync" extensions:"x-order=1"`
+    // Разрешения токена
+    Scopes []string `json:"scopes" example:"courses:read,tasks:write" extensions:"x-order=2"`
+    // Дата и время создания
+    CreatedAt time.Time `json:"created_at" example:"2025-01-01T12:00:00Z" extensions:"x-order=3"`
+    // Дата и время окончания действия
+    ExpiresAt time.Time `json:"expires_at" example:"2025-04-01T12:00:00Z" extensions:"x-order=4"`
+    // Дата и время последнего использования, отсутствует, если токен не использовался
+    LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2025-01-02T12:00:00Z" extensions:"x-order=5"`
+} // @name AuthAccessToken
+
+func NewAccessToken(t *pb.AccessToken) AccessToken {
+    token := AccessToken{
+        TokenID:   t.GetTokenId(),
+        Name:      t.GetName(),
+        Scopes:    t.GetScopes(),
+        CreatedAt: t.GetCreatedAt().AsTime(),
+        ExpiresAt: t.GetExpiresAt().AsTime(),
+    }
+    if t.GetLastUsedAt() != nil {
+        lastUsedAt := t.GetLastUsedAt().AsTime()
+        token.LastUsedAt = &lastUsedAt
+    }
+    return token
+}
+
+// CreateAccessTokenRequest - запрос на создание персонального токена доступа
+// @Description Содержит название, разрешения и срок действия токена
+type CreateAccessTokenRequest struct {
+    UserID string `json:"-"`
+    // Название токена
+    Name string `json:"name" example:"roster sync" extensions:"x-order=0"`
+    // Разрешения: profile:read, courses:read, courses:write, lessons:read, lessons:write, tasks:read, tasks:write
+    Scopes []string `json:"scopes" example:"courses:read,tasks:write" extensions:"x-order=1"`
+    // Дата и время окончания действия
+    ExpiresAt time.Time `json:"expires_at" example:"2025-04-01T12:00:00Z" extensions:"x-order=2"`
+} // @name AuthCreateAccessTokenRequest
+
+func NewCreateAccessTokenRequest(req CreateAccessTokenRequest) *pb.CreateAccessTokenRequest {
+    return &pb.CreateAccessTokenRequest{
+        UserId:    req.UserID,
+        Name:      req.Name,
+        Scopes:    req.Scopes,
+        ExpiresAt: timestamppb.New(req.ExpiresAt),
+    }
+}
+
+// CreateAccessTokenResponse - созданный персональный токен доступа
+// @Description Токен показывается только один раз, его нужно сохранить
+type CreateAccessTokenResponse struct {
+    // Токен для заголовка Authorization
+    Token string `json:"token" example:"cpat_5kq2w7xv3m9n4b8c6d1f0g2h5j7k9l3p" extensions:"x-order=0"`
+    // Информация о токене
+    AccessToken AccessToken `json:"access_token" extensions:"x-order=1"`
+} // @name AuthCreateAccessTokenResponse
+
+func NewCreateAccessTokenResponse(resp *pb.CreateAccessTokenResponse) CreateAccessTokenResponse {
+    return CreateAccessTokenResponse{
+        Token:       resp.GetToken(),
+        AccessToken: NewAccessToken(resp.GetAccessToken()),
+    }
+}
+
+// ListAccessTokensRequest - запрос на получение персональных токенов доступа
+// @Description ID пользователя берется из токена доступа
+type ListAccessTokensRequest struct {
+    UserID string `json:"-"`
+} // @name AuthListAccessTokensRequest
+
+func NewListAccessTokensRequest(req ListAccessTokensRequest) *pb.ListAccessTokensRequest {
+    return &pb.ListAccessTokensRequest{
+        UserId: req.UserID,
+    }
+}
+
+// ListAccessTokensResponse - список персональных токенов доступа
+// @Description Токены отсортированы по дате создания, сами значения токенов не возвращаются
+type ListAccessTokensResponse struct {
+    // Массив токенов
+    AccessTokens []AccessToken `json:"access_tokens" extensions:"x-order=0"`
+} // @name AuthListAccessTokensResponse
+
+func NewListAccessTokensResponse(resp *pb.ListAccessTokensResponse) ListAccessTokensResponse {
+    tokens := make([]AccessToken, 0, len(resp.GetAccessTokens()))
+    for _, t := range resp.GetAccessTokens() {
+        tokens = append(tokens, NewAccessToken(t))
+    }
+
+    return ListAccessTokensResponse{
+        AccessTokens: tokens,
+    }
+}
+
+// RevokeAccessTokenRequest - запрос на отзыв персонального токена доступа
+// @Description Содержит ID токена, который нужно отозвать
+type RevokeAccessTokenRequest struct {
+    UserID string `json:"-"`
+    // ID токена
+    TokenID string `json:"token_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name AuthRevokeAccessTokenRequest
+
+func NewRevokeAccessTokenRequest(req RevokeAccessTokenRequest) *pb.RevokeAccessTokenRequest {
+    return &pb.RevokeAccessTokenRequest{
+        UserId:  req.UserID,
+        TokenId: req.TokenID,
+    }
+}
+
+// RevokeAccessTokenResponse - подтверждение отзыва токена
+// @Description Пустой ответ, указывающий на успешный отзыв токена
+type RevokeAccessTokenResponse struct{
+
+} // @name AuthRevokeAccessTokenResponse
+
+func NewRevokeAccessTokenResponse(resp *pb.RevokeAccessTokenResponse) RevokeAccessTokenResponse {
+    return RevokeAccessTokenResponse{}
+}
+
+type IntrospectAccessTokenRequest struct {
+    Token string
+}
+
+func NewIntrospectAccessTokenRequest(req IntrospectAccessTokenRequest) *pb.IntrospectAccessTokenRequest {
+    return &pb.IntrospectAccessTokenRequest{
+        Token: req.Token,
+    }
+}
+
+type IntrospectAccessTokenResponse struct {
+    UserID      string    `json:"user_id"`
+    IsSuperUser bool      `json:"is_superuser"`
+    Scopes      []string  `json:"scopes"`
+    ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func NewIntrospectAccessTokenResponse(resp *pb.IntrospectAccessTokenResponse) IntrospectAccessTokenResponse {
+    return IntrospectAccessTokenResponse{
         UserID:      resp.GetUserId(),
-        Email:       resp.GetEmail(),
-        FirstName:   resp.GetFirstName(),
-        LastName:    resp.GetLastName(),
         IsSuperUser: resp.GetIsSuperuser(),
+        Scopes:      resp.GetScopes(),
+        ExpiresAt:   resp.GetExpiresAt().AsTime(),
+    }
+}
+
+// UpdateProfileRequest - запрос на изменение профиля
+// @Description Изменяются только переданные поля
+type UpdateProfileRequest struct {
+    UserID string `json:"-"`
+    // Имя пользователя
+    FirstName *string `json:"first_name,omitempty" example:"Иван" extensions:"x-order=0"`
+    // Фамилия пользователя
+    LastName *string `json:"last_name,omitempty" example:"Иванов" extensions:"x-order=1"`
+} // @name AuthUpdateProfileRequest
+
+func NewUpdateProfileRequest(req UpdateProfileRequest) *pb.UpdateProfileRequest {
+    return &pb.UpdateProfileRequest{
+        UserId:    req.UserID,
+        FirstName: req.FirstName,
+        LastName:  req.LastName,
+    }
+}
+
+// UpdateProfileResponse - профиль после изменения
+// @Description Актуальные данные пользователя
+type UpdateProfileResponse struct {
+    // Уникальный идентификатор
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Email адрес
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=1"`
+    // Имя
+    FirstName string `json:"first_name" example:"Иван" extensions:"x-order=2"`
+    // Фамилия
+    LastName string `json:"last_name" example:"Иванов" extensions:"x-order=3"`
+} // @name AuthUpdateProfileResponse
+
+func NewUpdateProfileResponse(resp *pb.UpdateProfileResponse) UpdateProfileResponse {
+    return UpdateProfileResponse{
+        UserID:    resp.GetUserId(),
+        Email:     resp.GetEmail(),
+        FirstName: resp.GetFirstName(),
+        LastName:  resp.GetLastName(),
+    }
+}
+
+// ChangePasswordRequest - запрос на смену пароля
+// @Description Содержит текущий и новый пароль. Все сессии, кроме сессии переданного refresh токена, завершаются
+type ChangePasswordRequest struct {
+    UserID string `json:"-"`
+    // Текущий пароль
+    CurrentPassword string `json:"current_password" example:"old-password" extensions:"x-order=0"`
+    // Новый пароль
+    NewPassword string `json:"new_password" example:"new-password" extensions:"x-order=1"`
+    // Refresh токен текущей сессии, если не передан, завершаются все сессии
+    RefreshToken string `json:"refresh_token,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+} // @name AuthChangePasswordRequest
+
+func NewChangePasswordRequest(req ChangePasswordRequest) *pb.ChangePasswordRequest {
+    return &pb.ChangePasswordRequest{
+        UserId:          req.UserID,
+        CurrentPassword: req.CurrentPassword,
+        NewPassword:     req.NewPassword,
+        RefreshToken:    req.RefreshToken,
+    }
+}
+
+// ChangePasswordResponse - подтверждение смены пароля
+// @Description Пустой ответ, указывающий на успешную смену пароля
+type ChangePasswordResponse struct{
+
+} // @name AuthChangePasswordResponse
+
+func NewChangePasswordResponse(resp *pb.ChangePasswordResponse) ChangePasswordResponse {
+    return ChangePasswordResponse{}
+}
+
+// ChangeEmailRequest - запрос на смену почты
+// @Description Содержит текущий пароль и новую почту, на которую придет письмо для подтверждения
+type ChangeEmailRequest struct {
+    UserID string `json:"-"`
+    // Текущий пароль
+    CurrentPassword string `json:"current_password" example:"password" extensions:"x-order=0"`
+    // Новая почта
+    NewEmail string `json:"new_email" example:"new@example.com" extensions:"x-order=1"`
+} // @name AuthChangeEmailRequest
+
+func NewChangeEmailRequest(req ChangeEmailRequest) *pb.ChangeEmailRequest {
+    return &pb.ChangeEmailRequest{
+        UserId:          req.UserID,
+        CurrentPassword: req.CurrentPassword,
+        NewEmail:        req.NewEmail,
+    }
+}
+
+// ChangeEmailResponse - подтверждение смены почты
+// @Description Пустой ответ, новую почту нужно подтвердить по ссылке из письма
+type ChangeEmailResponse struct{
+
+} // @name AuthChangeEmailResponse
+
+func NewChangeEmailResponse(resp *pb.ChangeEmailResponse) ChangeEmailResponse {
+    return ChangeEmailResponse{}
+}
+
+// User - пользователь для администрирования
+// @Description Данные пользователя вместе со служебными полями
+type User struct {
+    // Уникальный идентификатор
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Email адрес
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=1"`
+    // Имя
+    FirstName string `json:"first_name" example:"Иван" extensions:"x-order=2"`
+    // Фамилия
+    LastName string `json:"last_name" example:"Иванов" extensions:"x-order=3"`
+    // Признак администратора
+    IsSuperUser bool `json:"is_superuser" example:"false" extensions:"x-order=4"`
+    // Подтверждена ли почта
+    EmailVerified bool `json:"email_verified" example:"true" extensions:"x-order=5"`
+    // Дата и время отключения, отсутствует, если пользователь не отключен
+    DisabledAt *time.Time `json:"disabled_at,omitempty" example:"2025-01-01T12:00:00Z" extensions:"x-order=6"`
+} // @name AuthUser
+
+func NewUser(u *pb.User) User {
+    user := User{
+        UserID:        u.GetUserId(),
+        Email:         u.GetEmail(),
+        FirstName:     u.GetFirstName(),
+        LastName:      u.GetLastName(),
+        IsSuperUser:   u.GetIsSuperuser(),
+        EmailVerified: u.GetEmailVerified(),
+    }
+    if u.GetDisabledAt() != nil {
+        disabledAt := u.GetDisabledAt().AsTime()
+        user.DisabledAt = &disabledAt
+    }
+    return user
+}
+
+// ListUsersRequest - запрос на поиск пользователей
+// @Description Поиск по подстроке почты, имени или фамилии с пагинацией
+type ListUsersRequest struct {
+    // Подстрока почты, имени или фамилии
+    Query string `schema:"query" example:"ivanov" extensions:"x-order=0"`
+    // Номер страницы, начиная с 0
+    Index int32 `schema:"index" example:"0" extensions:"x-order=1"`
+    // Размер страницы, не больше 100
+    Limit int32 `schema:"limit" example:"20" extensions:"x-order=2"`
+} // @name AuthListUsersRequest
+
+func NewListUsersRequest(req ListUsersRequest) *pb.ListUsersRequest {
+    return &pb.ListUsersRequest{
+        Query: req.Query,
+        Index: req.Index,
+        Limit: req.Limit,
+    }
+}
+
+// ListUsersResponse - страница пользователей
+// @Description Пользователи отсортированы по почте
+type ListUsersResponse struct {
+    // Массив пользователей
+    Users []User `json:"users" extensions:"x-order=0"`
+    // Количество пользователей, подходящих под запрос
+    Total int32 `json:"total" example:"100" extensions:"x-order=1"`
+} // @name AuthListUsersResponse
+
+func NewListUsersResponse(resp *pb.ListUsersResponse) ListUsersResponse {
+    users := make([]User, 0, len(resp.GetUsers()))
+    for _, u := range resp.GetUsers() {
+        users = append(users, NewUser(u))
+    }
+
+    return ListUsersResponse{
+        Users: users,
+        Total: resp.GetTotal(),
+    }
+}
+
+// GetUserRequest - запрос на получение пользователя
+// @Description Содержит ID пользователя
+type GetUserRequest struct {
+    // ID пользователя
+    UserID string `schema:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name AuthGetUserRequest
+
+func NewGetUserRequest(req GetUserRequest) *pb.GetUserRequest {
+    return &pb.GetUserRequest{
+        UserId: req.UserID,
+    }
+}
+
+// GetUserResponse - пользователь
+// @Description Данные пользователя вместе со служебными полями
+type GetUserResponse struct {
+    // Пользователь
+    User User `json:"user" extensions:"x-order=0"`
+} // @name AuthGetUserResponse
+
+func NewGetUserResponse(resp *pb.GetUserResponse) GetUserResponse {
+    return GetUserResponse{
+        User: NewUser(resp.GetUser()),
+    }
+}
+
+// SetSuperuserRequest - запрос на изменение прав суперпользователя
+// @Description Права применяются к новым access токенам пользователя
+type SetSuperuserRequest struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Выдать или снять права
+    IsSuperUser bool `json:"is_superuser" example:"true" extensions:"x-order=1"`
+} // @name AuthSetSuperuserRequest
+
+func NewSetSuperuserRequest(req SetSuperuserRequest) *pb.SetSuperuserRequest {
+    return &pb.SetSuperuserRequest{
+        UserId:      req.UserID,
+        IsSuperuser: req.IsSuperUser,
     }
-}
\ No newline at end of file
+}
+
+// SetSuperuserResponse - подтверждение изменения прав
+// @Description Пустой ответ, указывающий на успешное изменение прав
+type SetSuperuserResponse struct{
+
+} // @name AuthSetSuperuserResponse
+
+func NewSetSuperuserResponse(resp *pb.SetSuperuserResponse) SetSuperuserResponse {
+    return SetSuperuserResponse{}
+}
+
+// DisableUserRequest - запрос на отключение пользователя
+// @Description Отключенный пользователь не может войти, его сессии завершаются
+type DisableUserRequest struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // true отключает пользователя, false снова включает
+    Disabled bool `json:"disabled" example:"true" extensions:"x-order=1"`
+} // @name AuthDisableUserRequest
+
+func NewDisableUserRequest(req DisableUserRequest) *pb.DisableUserRequest {
+    return &pb.DisableUserRequest{
+        UserId:   req.UserID,
+        Disabled: req.Disabled,
+    }
+}
+
+// DisableUserResponse - подтверждение отключения пользователя
+// @Description Пустой ответ, указывающий на успешное изменение
+type DisableUserResponse struct{
+
+} // @name AuthDisableUserResponse
+
+func NewDisableUserResponse(resp *pb.DisableUserResponse) DisableUserResponse {
+    return DisableUserResponse{}
+}
+
+// DeleteUserRequest - запрос на удаление пользователя
+// @Description Пользователя с курсами или статусами заданий удалить нельзя, его можно только отключить
+type DeleteUserRequest struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name AuthDeleteUserRequest
+
+func NewDeleteUserRequest(req DeleteUserRequest) *pb.DeleteUserRequest {
+    return &pb.DeleteUserRequest{
+        UserId: req.UserID,
+    }
+}
+
+// DeleteUserResponse - подтверждение удаления пользователя
+// @Description Пустой ответ, указывающий на успешное удаление
+type DeleteUserResponse struct{
+
+} // @name AuthDeleteUserResponse
+
+func NewDeleteUserResponse(resp *pb.DeleteUserResponse) DeleteUserResponse {
+    return DeleteUserResponse{}
+}
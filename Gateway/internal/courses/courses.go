@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -95,6 +96,20 @@ func (s *CoursesServiceClient) GetCourses(ctx context.Context, req GetCoursesReq
 	return NewGetCoursesResponse(resp), nil
 }
 
+func (s *CoursesServiceClient) SearchCourses(ctx context.Context, req SearchCoursesRequest) (SearchCoursesResponse, error) {
+	logger.Debug(ctx, "Searching courses", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.SearchCourses(ctx, NewSearchCoursesRequest(req))
+	if err != nil {
+		return SearchCoursesResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.SearchCourses succeed")
+	return NewSearchCoursesResponse(resp), nil
+}
+
 func (s *CoursesServiceClient) GetCoursesByStudent(ctx context.Context, req GetCoursesByStudentRequest) (GetCoursesResponse, error) {
 	logger.Debug(ctx, "Getting courses by student", slog.Any("request", req))
 	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
@@ -151,6 +166,34 @@ func (s *CoursesServiceClient) DeleteCourse(ctx context.Context, req DeleteCours
 	return NewDeleteCourseResponse(resp), nil
 }
 
+func (s *CoursesServiceClient) ArchiveCourse(ctx context.Context, req ArchiveCourseRequest) (ArchiveCourseResponse, error) {
+	logger.Debug(ctx, "Archiving course", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ArchiveCourse(ctx, NewArchiveCourseRequest(req))
+	if err != nil {
+		return ArchiveCourseResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ArchiveCourse succeed")
+	return NewArchiveCourseResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) RestoreCourse(ctx context.Context, req RestoreCourseRequest) (RestoreCourseResponse, error) {
+	logger.Debug(ctx, "Restoring course", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RestoreCourse(ctx, NewRestoreCourseRequest(req))
+	if err != nil {
+		return RestoreCourseResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.RestoreCourse succeed")
+	return NewRestoreCourseResponse(resp), nil
+}
+
 func (s *CoursesServiceClient) EnrollUser(ctx context.Context, req EnrollUserRequest) (EnrollUserResponse, error) {
 	logger.Debug(ctx, "Enrolling user", slog.Any("request", req))
 	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
@@ -221,7 +264,10 @@ func (s *CoursesServiceClient) IsMember(ctx context.Context, rc *redis.Client, r
 	}
 
 	resp = NewIsMemberResponse(pbresp)
-	rds.Put(rc, ctx, "Courses.IsMember", fmt.Sprintf("%s:%s", req.UserID, req.CourseID), resp, 24 * time.Hour)
+	// Отказ не кешируется, чтобы только что записанный пользователь сразу получил доступ
+	if resp.IsMember {
+		rds.Put(rc, ctx, "Courses.IsMember", fmt.Sprintf("%s:%s", req.UserID, req.CourseID), resp, 24 * time.Hour)
+	}
 
 	logger.Debug(ctx, "Courses.IsMember succeed")
 	return resp, nil
@@ -240,3 +286,396 @@ func (s *CoursesServiceClient) GetCourseStudents(ctx context.Context, req GetCou
 	logger.Debug(ctx, "Courses.GetCourseStudents succeed")
 	return NewGetCourseStudentsResponse(resp), nil
 }
+
+// Кеширует роль и права пользователя в курсе, поэтому проверка других прав того же
+// пользователя в том же курсе не требует запроса в Courses
+func (s *CoursesServiceClient) Authorize(ctx context.Context, rc *redis.Client, ttl time.Duration, req AuthorizeRequest) (AuthorizeResponse, error) {
+	logger.Debug(ctx, "Authorizing user", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	// Права участников курса хранятся в одной группе, чтобы при изменении ролей
+	// и архивации сбрасывать их для всего курса
+	resp, err := rds.GetField[AuthorizeResponse](rc, ctx, "Courses.Authorize", req.CourseID, req.UserID)
+	if err == nil {
+		logger.Debug(ctx, "Response was cached")
+		resp.Allowed = slices.Contains(resp.Permissions, req.Permission)
+		return resp, nil
+	}
+	logger.Debug(ctx, "Response was not cached", slog.Any("error", err))
+
+	pbresp, err := s.Client.Authorize(ctx, NewAuthorizeRequest(req))
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	resp = NewAuthorizeResponse(pbresp)
+	rds.PutField(rc, ctx, "Courses.Authorize", req.CourseID, req.UserID, resp, ttl)
+
+	logger.Debug(ctx, "Courses.Authorize succeed")
+	return resp, nil
+}
+
+func (s *CoursesServiceClient) GetCoursePermissions(ctx context.Context, req GetCoursePermissionsRequest) (GetCoursePermissionsResponse, error) {
+	logger.Debug(ctx, "Getting course permissions", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.GetCoursePermissions(ctx, NewGetCoursePermissionsRequest(req))
+	if err != nil {
+		return GetCoursePermissionsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.GetCoursePermissions succeed")
+	return NewGetCoursePermissionsResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) SetRolePermissions(ctx context.Context, req SetRolePermissionsRequest) (SetRolePermissionsResponse, error) {
+	logger.Debug(ctx, "Setting role permissions", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.SetRolePermissions(ctx, NewSetRolePermissionsRequest(req))
+	if err != nil {
+		return SetRolePermissionsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.SetRolePermissions succeed")
+	return NewSetRolePermissionsResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) AddStaff(ctx context.Context, req AddStaffRequest) (AddStaffResponse, error) {
+	logger.Debug(ctx, "Adding staff", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.AddStaff(ctx, NewAddStaffRequest(req))
+	if err != nil {
+		return AddStaffResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.AddStaff succeed")
+	return NewAddStaffResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) RemoveStaff(ctx context.Context, req RemoveStaffRequest) (RemoveStaffResponse, error) {
+	logger.Debug(ctx, "Removing staff", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RemoveStaff(ctx, NewRemoveStaffRequest(req))
+	if err != nil {
+		return RemoveStaffResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.RemoveStaff succeed")
+	return NewRemoveStaffResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) ListStaff(ctx context.Context, req ListStaffRequest) (ListStaffResponse, error) {
+	logger.Debug(ctx, "Listing staff", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListStaff(ctx, NewListStaffRequest(req))
+	if err != nil {
+		return ListStaffResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ListStaff succeed")
+	return NewListStaffResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) CreateInvite(ctx context.Context, req CreateInviteRequest) (CreateInviteResponse, error) {
+	logger.Debug(ctx, "Creating invite", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.CreateInvite(ctx, NewCreateInviteRequest(req))
+	if err != nil {
+		return CreateInviteResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.CreateInvite succeed")
+	return NewCreateInviteResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) ListInvites(ctx context.Context, req ListInvitesRequest) (ListInvitesResponse, error) {
+	logger.Debug(ctx, "Listing invites", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListInvites(ctx, NewListInvitesRequest(req))
+	if err != nil {
+		return ListInvitesResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ListInvites succeed")
+	return NewListInvitesResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) RevokeInvite(ctx context.Context, req RevokeInviteRequest) (RevokeInviteResponse, error) {
+	logger.Debug(ctx, "Revoking invite", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RevokeInvite(ctx, NewRevokeInviteRequest(req))
+	if err != nil {
+		return RevokeInviteResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.RevokeInvite succeed")
+	return NewRevokeInviteResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) JoinByCode(ctx context.Context, req JoinByCodeRequest) (JoinByCodeResponse, error) {
+	logger.Debug(ctx, "Joining course by code", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.JoinByCode(ctx, NewJoinByCodeRequest(req))
+	if err != nil {
+		return JoinByCodeResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.JoinByCode succeed")
+	return NewJoinByCodeResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) RequestEnrollment(ctx context.Context, req RequestEnrollmentRequest) (RequestEnrollmentResponse, error) {
+	logger.Debug(ctx, "Requesting enrollment", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RequestEnrollment(ctx, NewRequestEnrollmentRequest(req))
+	if err != nil {
+		return RequestEnrollmentResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.RequestEnrollment succeed")
+	return NewRequestEnrollmentResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) ListEnrollmentRequests(ctx context.Context, req ListEnrollmentRequestsRequest) (ListEnrollmentRequestsResponse, error) {
+	logger.Debug(ctx, "Listing enrollment requests", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListEnrollmentRequests(ctx, NewListEnrollmentRequestsRequest(req))
+	if err != nil {
+		return ListEnrollmentRequestsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ListEnrollmentRequests succeed")
+	return NewListEnrollmentRequestsResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) ApproveEnrollment(ctx context.Context, req DecideEnrollmentRequest) (DecideEnrollmentResponse, error) {
+	logger.Debug(ctx, "Approving enrollment", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ApproveEnrollment(ctx, NewApproveEnrollmentRequest(req))
+	if err != nil {
+		return DecideEnrollmentResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ApproveEnrollment succeed")
+	return NewDecideEnrollmentResponse(resp.GetRequest()), nil
+}
+
+func (s *CoursesServiceClient) RejectEnrollment(ctx context.Context, req DecideEnrollmentRequest) (DecideEnrollmentResponse, error) {
+	logger.Debug(ctx, "Rejecting enrollment", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RejectEnrollment(ctx, NewRejectEnrollmentRequest(req))
+	if err != nil {
+		return DecideEnrollmentResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.RejectEnrollment succeed")
+	return NewDecideEnrollmentResponse(resp.GetRequest()), nil
+}
+
+func (s *CoursesServiceClient) BulkEnroll(ctx context.Context, req BulkEnrollRequest) (BulkEnrollResponse, error) {
+	logger.Debug(ctx, "Bulk enrolling users", slog.String("course_id", req.CourseID), slog.Int("rows", len(req.Emails)))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.BulkEnroll(ctx, NewBulkEnrollRequest(req))
+	if err != nil {
+		return BulkEnrollResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.BulkEnroll succeed")
+	return NewBulkEnrollResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) ExportRoster(ctx context.Context, req ExportRosterRequest) (ExportRosterResponse, error) {
+	logger.Debug(ctx, "Exporting roster", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ExportRoster(ctx, NewExportRosterRequest(req))
+	if err != nil {
+		return ExportRosterResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ExportRoster succeed")
+	return NewExportRosterResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) CloneCourse(ctx context.Context, req CloneCourseRequest) (CloneCourseResponse, error) {
+	logger.Debug(ctx, "Cloning course", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.CloneCourse(ctx, NewCloneCourseRequest(req))
+	if err != nil {
+		return CloneCourseResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.CloneCourse succeed")
+	return NewCloneCourseResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) GetCloneJob(ctx context.Context, req GetCloneJobRequest) (GetCloneJobResponse, error) {
+	logger.Debug(ctx, "Getting clone job", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.GetCloneJob(ctx, NewGetCloneJobRequest(req))
+	if err != nil {
+		return GetCloneJobResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.GetCloneJob succeed")
+	return NewGetCloneJobResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) CreateModule(ctx context.Context, req CreateModuleRequest) (ModuleResponse, error) {
+	logger.Debug(ctx, "Creating module", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.CreateModule(ctx, NewCreateModuleRequest(req))
+	if err != nil {
+		return ModuleResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.CreateModule succeed")
+	return NewModuleResponse(resp.GetModule()), nil
+}
+
+func (s *CoursesServiceClient) UpdateModule(ctx context.Context, req UpdateModuleRequest) (ModuleResponse, error) {
+	logger.Debug(ctx, "Updating module", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.UpdateModule(ctx, NewUpdateModuleRequest(req))
+	if err != nil {
+		return ModuleResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.UpdateModule succeed")
+	return NewModuleResponse(resp.GetModule()), nil
+}
+
+func (s *CoursesServiceClient) DeleteModule(ctx context.Context, req DeleteModuleRequest) (ModuleResponse, error) {
+	logger.Debug(ctx, "Deleting module", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.DeleteModule(ctx, NewDeleteModuleRequest(req))
+	if err != nil {
+		return ModuleResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.DeleteModule succeed")
+	return NewModuleResponse(resp.GetModule()), nil
+}
+
+func (s *CoursesServiceClient) ListModules(ctx context.Context, req ListModulesRequest) (ListModulesResponse, error) {
+	logger.Debug(ctx, "Listing modules", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListModules(ctx, NewListModulesRequest(req))
+	if err != nil {
+		return ListModulesResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ListModules succeed")
+	return NewListModulesResponse(resp.GetModules()), nil
+}
+
+func (s *CoursesServiceClient) ReorderModule(ctx context.Context, req ReorderModuleRequest) (ListModulesResponse, error) {
+	logger.Debug(ctx, "Reordering module", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ReorderModule(ctx, NewReorderModuleRequest(req))
+	if err != nil {
+		return ListModulesResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ReorderModule succeed")
+	return NewListModulesResponse(resp.GetModules()), nil
+}
+
+func (s *CoursesServiceClient) TransferOwnership(ctx context.Context, req TransferOwnershipRequest) (OwnershipTransferResponse, error) {
+	logger.Debug(ctx, "Transferring course ownership", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.TransferOwnership(ctx, NewTransferOwnershipRequest(req))
+	if err != nil {
+		return OwnershipTransferResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.TransferOwnership succeed")
+	return NewOwnershipTransferResponse(resp.GetTransfer(), resp.GetPromoted()), nil
+}
+
+func (s *CoursesServiceClient) ListOwnershipTransfers(ctx context.Context, req ListOwnershipTransfersRequest) (ListOwnershipTransfersResponse, error) {
+	logger.Debug(ctx, "Listing ownership transfers", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListOwnershipTransfers(ctx, NewListOwnershipTransfersRequest(req))
+	if err != nil {
+		return ListOwnershipTransfersResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.ListOwnershipTransfers succeed")
+	return NewListOwnershipTransfersResponse(resp), nil
+}
+
+func (s *CoursesServiceClient) AcceptOwnershipTransfer(ctx context.Context, req DecideOwnershipTransferRequest) (OwnershipTransferResponse, error) {
+	logger.Debug(ctx, "Accepting ownership transfer", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.AcceptOwnershipTransfer(ctx, NewAcceptOwnershipTransferRequest(req))
+	if err != nil {
+		return OwnershipTransferResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.AcceptOwnershipTransfer succeed")
+	return NewOwnershipTransferResponse(resp.GetTransfer(), resp.GetPromoted()), nil
+}
+
+func (s *CoursesServiceClient) DeclineOwnershipTransfer(ctx context.Context, req DecideOwnershipTransferRequest) (OwnershipTransferResponse, error) {
+	logger.Debug(ctx, "Declining ownership transfer", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.DeclineOwnershipTransfer(ctx, NewDeclineOwnershipTransferRequest(req))
+	if err != nil {
+		return OwnershipTransferResponse{}, err
+	}
+
+	logger.Debug(ctx, "Courses.DeclineOwnershipTransfer succeed")
+	return NewOwnershipTransferResponse(resp.GetTransfer(), nil), nil
+}
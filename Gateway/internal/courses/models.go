@@ -25,6 +25,20 @@ type Course struct {
     StartTime *time.Time `json:"start_time,omitempty" example:"2023-09-01T00:00:00Z" extensions:"x-order=5"`
     // Дата окончания курса (опционально)
     EndTime *time.Time `json:"end_time,omitempty" example:"2023-12-31T23:59:59Z" extensions:"x-order=6"`
+    // Максимум записанных пользователей (опционально)
+    Capacity *int32 `json:"capacity,omitempty" example:"30" extensions:"x-order=7"`
+    // Дата архивации, архивный курс доступен только для чтения
+    ArchivedAt *time.Time `json:"archived_at,omitempty" example:"2024-01-15T00:00:00Z" extensions:"x-order=8"`
+    // Дата удаления, до окончательного удаления курс может восстановить владелец
+    DeletedAt *time.Time `json:"deleted_at,omitempty" example:"2024-01-15T00:00:00Z" extensions:"x-order=9"`
+    // Шаблон курса, на него нельзя записаться
+    IsTemplate bool `json:"is_template" example:"false" extensions:"x-order=10"`
+    // Курс опубликован в каталоге
+    Listed bool `json:"listed" example:"true" extensions:"x-order=11"`
+    // Категория курса в каталоге (опционально)
+    Category *string `json:"category,omitempty" example:"Программирование" extensions:"x-order=12"`
+    // Теги курса в нижнем регистре
+    Tags []string `json:"tags" example:"go,алгоритмы" extensions:"x-order=13"`
 } // @name Course
 
 func NewCourse(pbCourse *pb.Course) Course {
@@ -34,6 +48,14 @@ func NewCourse(pbCourse *pb.Course) Course {
 		Title:       pbCourse.GetTitle(),
 		Description: pbCourse.GetDescription(),
 		Visibility:  pbCourse.GetVisibility(),
+		Capacity:    pbCourse.Capacity,
+		IsTemplate:  pbCourse.GetIsTemplate(),
+		Listed:      pbCourse.GetListed(),
+		Category:    pbCourse.Category,
+		Tags:        pbCourse.GetTags(),
+	}
+	if course.Tags == nil {
+		course.Tags = []string{}
 	}
 
 	if pbCourse.GetStartTime() != nil {
@@ -46,6 +68,16 @@ func NewCourse(pbCourse *pb.Course) Course {
 		course.EndTime = &t
 	}
 
+	if pbCourse.GetArchivedAt() != nil {
+		t := pbCourse.GetArchivedAt().AsTime()
+		course.ArchivedAt = &t
+	}
+
+	if pbCourse.GetDeletedAt() != nil {
+		t := pbCourse.GetDeletedAt().AsTime()
+		course.DeletedAt = &t
+	}
+
 	return course
 }
 
@@ -71,6 +103,8 @@ type Enrollment struct {
     StudentID string `json:"student_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
     // Дата и время зачисления
     EnrolledAt time.Time `json:"enrolled_at" example:"2023-09-01T12:00:00Z" extensions:"x-order=2"`
+    // Роль: student или auditor
+    Role string `json:"role" example:"student" extensions:"x-order=3"`
 } // @name CourseEnrollment
 
 // CreateCourseRequest - запрос на создание курса
@@ -88,6 +122,16 @@ type CreateCourseRequest struct {
     StartTime *time.Time `json:"start_time,omitempty" example:"2023-09-01T00:00:00Z" extensions:"x-order=4"`
     // Дата окончания (опционально)
     EndTime *time.Time `json:"end_time,omitempty" example:"2023-12-31T23:59:59Z" extensions:"x-order=5"`
+    // Максимум записанных пользователей (опционально)
+    Capacity *int32 `json:"capacity,omitempty" example:"30" extensions:"x-order=6"`
+    // Создать курс как шаблон
+    IsTemplate bool `json:"is_template" example:"false" extensions:"x-order=7"`
+    // Опубликовать курс в каталоге
+    Listed bool `json:"listed" example:"false" extensions:"x-order=8"`
+    // Категория курса в каталоге (опционально)
+    Category *string `json:"category,omitempty" example:"Программирование" extensions:"x-order=9"`
+    // Теги курса, до 10 тегов длиной до 32 символов
+    Tags []string `json:"tags,omitempty" example:"go,алгоритмы" extensions:"x-order=10"`
 } // @name CreateCourseRequest
 
 func NewCreateCourseRequest(req CreateCourseRequest) *pb.CreateCourseRequest {
@@ -96,6 +140,11 @@ func NewCreateCourseRequest(req CreateCourseRequest) *pb.CreateCourseRequest {
 		Title:       req.Title,
 		Description: req.Description,
 		Visibility:  req.Visibility,
+		Capacity:    req.Capacity,
+		IsTemplate:  req.IsTemplate,
+		Listed:      req.Listed,
+		Category:    req.Category,
+		Tags:        req.Tags,
 	}
 
 	if req.StartTime != nil {
@@ -159,11 +208,14 @@ func NewGetCourseResponse(resp *pb.GetCourseResponse) GetCourseResponse {
 type GetCoursesRequest struct {
     // ID пользователя (опционально)
     UserID string `schema:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Включить архивные курсы
+    IncludeArchived bool `schema:"include_archived" example:"false" extensions:"x-order=1"`
 } // @name GetCoursesRequest
 
 func NewGetCoursesRequest(req GetCoursesRequest) *pb.GetCoursesRequest {
 	return &pb.GetCoursesRequest{
-		UserId: req.UserID,
+		UserId:          req.UserID,
+		IncludeArchived: req.IncludeArchived,
 	}
 }
 
@@ -172,11 +224,14 @@ func NewGetCoursesRequest(req GetCoursesRequest) *pb.GetCoursesRequest {
 type GetCoursesByStudentRequest struct {
     // ID студента
     StudentId string `schema:"student_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Включить архивные курсы
+    IncludeArchived bool `schema:"include_archived" example:"false" extensions:"x-order=1"`
 } // @name GetCoursesByStudentRequest
 
 func NewGetCoursesByStudentRequest(req GetCoursesByStudentRequest) *pb.GetCoursesByStudentRequest {
 	return &pb.GetCoursesByStudentRequest{
-		StudentId: req.StudentId,
+		StudentId:       req.StudentId,
+		IncludeArchived: req.IncludeArchived,
 	}
 }
 
@@ -185,11 +240,14 @@ func NewGetCoursesByStudentRequest(req GetCoursesByStudentRequest) *pb.GetCourse
 type GetCoursesByTeacherRequest struct {
     // ID преподавателя
     TeacherID string `schema:"teacher_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Включить архивные курсы
+    IncludeArchived bool `schema:"include_archived" example:"false" extensions:"x-order=1"`
 } // @name GetCoursesByTeacherRequest
 
 func NewGetCoursesByTeacherRequest(req GetCoursesByTeacherRequest) *pb.GetCoursesByTeacherRequest {
 	return &pb.GetCoursesByTeacherRequest{
-		TeacherId: req.TeacherID,
+		TeacherId:       req.TeacherID,
+		IncludeArchived: req.IncludeArchived,
 	}
 }
 
@@ -212,6 +270,68 @@ func NewGetCoursesResponse(resp *pb.GetCoursesResponse) GetCoursesResponse {
 	}
 }
 
+// SearchCoursesRequest - поиск по каталогу курсов
+// @Description Ищет опубликованные в каталоге курсы по тексту и фильтрам, пагинация курсором
+type SearchCoursesRequest struct {
+    // Текст поиска по названию и описанию, поддерживает "фразы", OR и -исключение (опционально)
+    Query string `schema:"query" example:"основы программирования" extensions:"x-order=0"`
+    // ID преподавателя курса (опционально)
+    TeacherID *string `schema:"teacher_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Тег курса (опционально)
+    Tag *string `schema:"tag" example:"go" extensions:"x-order=2"`
+    // Категория курса (опционально)
+    Category *string `schema:"category" example:"Программирование" extensions:"x-order=3"`
+    // Курс начинается не раньше (опционально)
+    StartsAfter *time.Time `schema:"starts_after" example:"2023-09-01T00:00:00Z" extensions:"x-order=4"`
+    // Курс начинается не позже (опционально)
+    StartsBefore *time.Time `schema:"starts_before" example:"2023-12-31T23:59:59Z" extensions:"x-order=5"`
+    // Размер страницы, по умолчанию 20, максимум 100
+    Limit int32 `schema:"limit" example:"20" extensions:"x-order=6"`
+    // Курсор следующей страницы из прошлого ответа (опционально)
+    Cursor string `schema:"cursor" example:"eyJjIjoiMjAyMy0wOS0wMVQwMDowMDowMFoiLCJpIjoiZDI3NyJ9" extensions:"x-order=7"`
+} // @name SearchCoursesRequest
+
+func NewSearchCoursesRequest(req SearchCoursesRequest) *pb.SearchCoursesRequest {
+	pbReq := &pb.SearchCoursesRequest{
+		Query:     req.Query,
+		TeacherId: req.TeacherID,
+		Tag:       req.Tag,
+		Category:  req.Category,
+		Limit:     req.Limit,
+		Cursor:    req.Cursor,
+	}
+
+	if req.StartsAfter != nil {
+		pbReq.StartsAfter = timestamppb.New(*req.StartsAfter)
+	}
+	if req.StartsBefore != nil {
+		pbReq.StartsBefore = timestamppb.New(*req.StartsBefore)
+	}
+
+	return pbReq
+}
+
+// SearchCoursesResponse - страница каталога
+// @Description Курсы каталога и курсор следующей страницы
+type SearchCoursesResponse struct {
+    // Найденные курсы
+    Courses []Course `json:"courses" extensions:"x-order=0"`
+    // Курсор следующей страницы, пустой на последней странице
+    NextCursor string `json:"next_cursor" example:"eyJjIjoiMjAyMy0wOS0wMVQwMDowMDowMFoiLCJpIjoiZDI3NyJ9" extensions:"x-order=1"`
+} // @name SearchCoursesResponse
+
+func NewSearchCoursesResponse(resp *pb.SearchCoursesResponse) SearchCoursesResponse {
+	courses := make([]Course, 0, len(resp.GetCourses()))
+	for _, pbCourse := range resp.GetCourses() {
+		courses = append(courses, NewCourse(pbCourse))
+	}
+
+	return SearchCoursesResponse{
+		Courses:    courses,
+		NextCursor: resp.GetNextCursor(),
+	}
+}
+
 // UpdateCourseRequest - запрос обновления курса
 // @Description Позволяет частично обновить данные курса
 type UpdateCourseRequest struct {
@@ -227,6 +347,16 @@ type UpdateCourseRequest struct {
     StartTime *time.Time `json:"start_time,omitempty" example:"2024-01-01T00:00:00Z" extensions:"x-order=4"`
     // Новое время окончания (опционально)
     EndTime *time.Time `json:"end_time,omitempty" example:"2024-06-30T23:59:59Z" extensions:"x-order=5"`
+    // Новый максимум записанных пользователей, 0 снимает ограничение (опционально)
+    Capacity *int32 `json:"capacity,omitempty" example:"40" extensions:"x-order=6"`
+    // Сделать курс шаблоном или обычным курсом (опционально)
+    IsTemplate *bool `json:"is_template,omitempty" example:"true" extensions:"x-order=7"`
+    // Опубликовать курс в каталоге или убрать из него (опционально)
+    Listed *bool `json:"listed,omitempty" example:"true" extensions:"x-order=8"`
+    // Новая категория, пустая строка убирает категорию (опционально)
+    Category *string `json:"category,omitempty" example:"Программирование" extensions:"x-order=9"`
+    // Новые теги, заменяют старые целиком, пустой список убирает теги (опционально)
+    Tags *[]string `json:"tags,omitempty" example:"go,алгоритмы" extensions:"x-order=10"`
 } // @name UpdateCourseRequest
 
 func NewUpdateCourseRequest(req UpdateCourseRequest) *pb.UpdateCourseRequest {
@@ -235,8 +365,15 @@ func NewUpdateCourseRequest(req UpdateCourseRequest) *pb.UpdateCourseRequest {
 		Title:       req.Title,
 		Description: req.Description,
 		Visibility:  req.Visibility,
+		Capacity:    req.Capacity,
+		IsTemplate:  req.IsTemplate,
+		Listed:      req.Listed,
+		Category:    req.Category,
 	}
 
+	if req.Tags != nil {
+		pbReq.Tags = &pb.Tags{Values: *req.Tags}
+	}
 	if req.StartTime != nil {
 		t := timestamppb.New(*req.StartTime)
 		pbReq.StartTime = t
@@ -290,6 +427,58 @@ func NewDeleteCourseResponse(resp *pb.DeleteCourseResponse) DeleteCourseResponse
 	}
 }
 
+// ArchiveCourseRequest - запрос архивации курса
+// @Description Переводит курс в архив, после чего он доступен только для чтения
+type ArchiveCourseRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ArchiveCourseRequest
+
+func NewArchiveCourseRequest(req ArchiveCourseRequest) *pb.ArchiveCourseRequest {
+	return &pb.ArchiveCourseRequest{
+		CourseId: req.CourseID,
+	}
+}
+
+// ArchiveCourseResponse - результат архивации
+// @Description Возвращает архивный курс
+type ArchiveCourseResponse struct {
+    Course Course `json:"course" extensions:"x-order=0"`
+} // @name ArchiveCourseResponse
+
+func NewArchiveCourseResponse(resp *pb.ArchiveCourseResponse) ArchiveCourseResponse {
+	return ArchiveCourseResponse{
+		Course: NewCourse(resp.GetCourse()),
+	}
+}
+
+// RestoreCourseRequest - запрос восстановления курса
+// @Description Восстанавливает архивный или удалённый курс. Доступно только владельцу
+type RestoreCourseRequest struct {
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name RestoreCourseRequest
+
+func NewRestoreCourseRequest(req RestoreCourseRequest) *pb.RestoreCourseRequest {
+	return &pb.RestoreCourseRequest{
+		CourseId: req.CourseID,
+		UserId:   req.UserID,
+	}
+}
+
+// RestoreCourseResponse - результат восстановления
+// @Description Возвращает восстановленный курс
+type RestoreCourseResponse struct {
+    Course Course `json:"course" extensions:"x-order=0"`
+} // @name RestoreCourseResponse
+
+func NewRestoreCourseResponse(resp *pb.RestoreCourseResponse) RestoreCourseResponse {
+	return RestoreCourseResponse{
+		Course: NewCourse(resp.GetCourse()),
+	}
+}
+
 // EnrollUserRequest - запрос зачисления студента
 // @Description Добавляет студента на курс
 type EnrollUserRequest struct {
@@ -297,12 +486,15 @@ type EnrollUserRequest struct {
     CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
     // ID студента
     UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Роль: student или auditor, по умолчанию student
+    Role string `json:"role,omitempty" example:"student" extensions:"x-order=2"`
 } // @name EnrollUserRequest
 
 func NewEnrollUserRequest(req EnrollUserRequest) *pb.EnrollUserRequest {
 	return &pb.EnrollUserRequest{
 		CourseId: req.CourseID,
 		UserId:   req.UserID,
+		Role:     req.Role,
 	}
 }
 
@@ -318,6 +510,7 @@ func NewEnrollUserResponse(resp *pb.EnrollUserResponse) EnrollUserResponse {
 			CourseID:   resp.GetEnrollment().GetCourseId(),
 			StudentID:  resp.GetEnrollment().GetStudentId(),
 			EnrolledAt: resp.GetEnrollment().GetEnrolledAt().AsTime(),
+			Role:       resp.GetEnrollment().GetRole(),
 		},
 	}
 }
@@ -342,15 +535,24 @@ func NewExpelUserRequest(req ExpelUserRequest) *pb.ExpelUserRequest {
 // @Description Подтверждение успешного отчисления
 type ExpelUserResponse struct {
     Enrollment Enrollment `json:"enrollment" extensions:"x-order=0"`
+    // Заявки из листа ожидания, записанные на освободившееся место
+    Promoted []EnrollmentRequest `json:"promoted" extensions:"x-order=1"`
 } // @name ExpelUserResponse
 
 func NewExpelUserResponse(resp *pb.ExpelUserResponse) ExpelUserResponse {
+	promoted := make([]EnrollmentRequest, 0, len(resp.GetPromoted()))
+	for _, r := range resp.GetPromoted() {
+		promoted = append(promoted, NewEnrollmentRequest(r))
+	}
+
 	return ExpelUserResponse{
 		Enrollment: Enrollment{
 			CourseID:   resp.GetEnrollment().GetCourseId(),
 			StudentID:  resp.GetEnrollment().GetStudentId(),
 			EnrolledAt: resp.GetEnrollment().GetEnrolledAt().AsTime(),
+			Role:       resp.GetEnrollment().GetRole(),
 		},
+		Promoted: promoted,
 	}
 }
 
@@ -461,3 +663,1056 @@ func NewGetCourseStudentsResponse(resp *pb.GetCourseStudentsResponse) GetCourseS
 		}(),
 	}
 }
+
+// AuthorizeRequest - проверка права пользователя в курсе
+// @Description Проверяет, есть ли у пользователя право в курсе
+type AuthorizeRequest struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Проверяемое право
+    Permission string `json:"permission" example:"lessons.write" extensions:"x-order=2"`
+} // @name AuthorizeRequest
+
+func NewAuthorizeRequest(req AuthorizeRequest) *pb.AuthorizeRequest {
+	return &pb.AuthorizeRequest{
+		UserId:     req.UserID,
+		CourseId:   req.CourseID,
+		Permission: req.Permission,
+	}
+}
+
+// AuthorizeResponse - результат проверки права
+// @Description Роль и все права пользователя в курсе
+type AuthorizeResponse struct {
+    // Есть ли у пользователя право
+    Allowed bool `json:"allowed" example:"true" extensions:"x-order=0"`
+    // Роль пользователя, пустая, если он не участник курса
+    Role string `json:"role" example:"student" extensions:"x-order=1"`
+    // Все права пользователя в курсе
+    Permissions []string `json:"permissions" example:"course.read,lessons.read" extensions:"x-order=2"`
+} // @name AuthorizeResponse
+
+func NewAuthorizeResponse(resp *pb.AuthorizeResponse) AuthorizeResponse {
+	return AuthorizeResponse{
+		Allowed:     resp.GetAllowed(),
+		Role:        resp.GetRole(),
+		Permissions: resp.GetPermissions(),
+	}
+}
+
+// RolePermissions - набор прав роли
+// @Description Права, которые есть у роли в курсе
+type RolePermissions struct {
+    // Роль: owner, co_teacher, assistant, student или auditor
+    Role string `json:"role" example:"assistant" extensions:"x-order=0"`
+    // Права роли
+    Permissions []string `json:"permissions" example:"lessons.read,tasks.grade" extensions:"x-order=1"`
+} // @name CourseRolePermissions
+
+func NewRolePermissions(rp *pb.RolePermissions) RolePermissions {
+	return RolePermissions{
+		Role:        rp.GetRole(),
+		Permissions: rp.GetPermissions(),
+	}
+}
+
+// GetCoursePermissionsRequest - запрос наборов прав курса
+// @Description Содержит ID курса
+type GetCoursePermissionsRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name GetCoursePermissionsRequest
+
+func NewGetCoursePermissionsRequest(req GetCoursePermissionsRequest) *pb.GetCoursePermissionsRequest {
+	return &pb.GetCoursePermissionsRequest{
+		CourseId: req.CourseID,
+	}
+}
+
+// GetCoursePermissionsResponse - наборы прав курса
+// @Description Наборы прав всех ролей курса, права владельца не меняются
+type GetCoursePermissionsResponse struct {
+    // Наборы прав ролей
+    Roles []RolePermissions `json:"roles" extensions:"x-order=0"`
+} // @name GetCoursePermissionsResponse
+
+func NewGetCoursePermissionsResponse(resp *pb.GetCoursePermissionsResponse) GetCoursePermissionsResponse {
+	roles := make([]RolePermissions, 0, len(resp.GetRoles()))
+	for _, rp := range resp.GetRoles() {
+		roles = append(roles, NewRolePermissions(rp))
+	}
+
+	return GetCoursePermissionsResponse{
+		Roles: roles,
+	}
+}
+
+// SetRolePermissionsRequest - запрос изменения набора прав роли
+// @Description Заменяет набор прав роли целиком
+type SetRolePermissionsRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Роль: co_teacher, assistant, student или auditor
+    Role string `json:"role" example:"assistant" extensions:"x-order=1"`
+    // Новый набор прав
+    Permissions []string `json:"permissions" example:"lessons.read,tasks.read,tasks.grade" extensions:"x-order=2"`
+} // @name SetRolePermissionsRequest
+
+func NewSetRolePermissionsRequest(req SetRolePermissionsRequest) *pb.SetRolePermissionsRequest {
+	return &pb.SetRolePermissionsRequest{
+		CourseId:    req.CourseID,
+		Role:        req.Role,
+		Permissions: req.Permissions,
+	}
+}
+
+// SetRolePermissionsResponse - измененный набор прав
+// @Description Новый набор прав роли
+type SetRolePermissionsResponse struct {
+    // Набор прав роли
+    Role RolePermissions `json:"role" extensions:"x-order=0"`
+} // @name SetRolePermissionsResponse
+
+func NewSetRolePermissionsResponse(resp *pb.SetRolePermissionsResponse) SetRolePermissionsResponse {
+	return SetRolePermissionsResponse{
+		Role: NewRolePermissions(resp.GetRole()),
+	}
+}
+
+// Staff - преподаватель курса
+// @Description Соавтор или ассистент курса
+type Staff struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Email адрес
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=2"`
+    // Имя
+    FirstName string `json:"first_name" example:"Иван" extensions:"x-order=3"`
+    // Фамилия
+    LastName string `json:"last_name" example:"Иванов" extensions:"x-order=4"`
+    // Роль: co_teacher или assistant
+    Role string `json:"role" example:"assistant" extensions:"x-order=5"`
+    // Дата и время добавления
+    AddedAt time.Time `json:"added_at" example:"2023-09-01T12:00:00Z" extensions:"x-order=6"`
+} // @name CourseStaff
+
+func NewStaff(s *pb.Staff) Staff {
+	return Staff{
+		CourseID:  s.GetCourseId(),
+		UserID:    s.GetUserId(),
+		Email:     s.GetEmail(),
+		FirstName: s.GetFirstName(),
+		LastName:  s.GetLastName(),
+		Role:      s.GetRole(),
+		AddedAt:   s.GetAddedAt().AsTime(),
+	}
+}
+
+// AddStaffRequest - запрос добавления преподавателя
+// @Description Добавляет преподавателя курса или меняет его роль
+type AddStaffRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Роль: co_teacher или assistant
+    Role string `json:"role" example:"assistant" extensions:"x-order=2"`
+} // @name AddStaffRequest
+
+func NewAddStaffRequest(req AddStaffRequest) *pb.AddStaffRequest {
+	return &pb.AddStaffRequest{
+		CourseId: req.CourseID,
+		UserId:   req.UserID,
+		Role:     req.Role,
+	}
+}
+
+// AddStaffResponse - добавленный преподаватель
+// @Description Преподаватель курса с новой ролью
+type AddStaffResponse struct {
+    Staff Staff `json:"staff" extensions:"x-order=0"`
+} // @name AddStaffResponse
+
+func NewAddStaffResponse(resp *pb.AddStaffResponse) AddStaffResponse {
+	return AddStaffResponse{
+		Staff: NewStaff(resp.GetStaff()),
+	}
+}
+
+// RemoveStaffRequest - запрос удаления преподавателя
+// @Description Убирает пользователя из преподавателей курса
+type RemoveStaffRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name RemoveStaffRequest
+
+func NewRemoveStaffRequest(req RemoveStaffRequest) *pb.RemoveStaffRequest {
+	return &pb.RemoveStaffRequest{
+		CourseId: req.CourseID,
+		UserId:   req.UserID,
+	}
+}
+
+// RemoveStaffResponse - удаленный преподаватель
+// @Description Данные удаленного преподавателя
+type RemoveStaffResponse struct {
+    Staff Staff `json:"staff" extensions:"x-order=0"`
+} // @name RemoveStaffResponse
+
+func NewRemoveStaffResponse(resp *pb.RemoveStaffResponse) RemoveStaffResponse {
+	return RemoveStaffResponse{
+		Staff: NewStaff(resp.GetStaff()),
+	}
+}
+
+// ListStaffRequest - запрос преподавателей курса
+// @Description Содержит ID курса
+type ListStaffRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ListStaffRequest
+
+func NewListStaffRequest(req ListStaffRequest) *pb.ListStaffRequest {
+	return &pb.ListStaffRequest{
+		CourseId: req.CourseID,
+	}
+}
+
+// ListStaffResponse - преподаватели курса
+// @Description Соавторы и ассистенты курса без владельца
+type ListStaffResponse struct {
+    // Массив преподавателей
+    Staff []Staff `json:"staff" extensions:"x-order=0"`
+} // @name ListStaffResponse
+
+func NewListStaffResponse(resp *pb.ListStaffResponse) ListStaffResponse {
+	staff := make([]Staff, 0, len(resp.GetStaff()))
+	for _, s := range resp.GetStaff() {
+		staff = append(staff, NewStaff(s))
+	}
+
+	return ListStaffResponse{
+		Staff: staff,
+	}
+}
+
+// Invite - код приглашения на курс
+// @Description Код, по которому пользователь сам записывается на курс
+type Invite struct {
+    // ID приглашения
+    InviteID string `json:"invite_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Код приглашения
+    Code string `json:"code" example:"K7QX2M9D" extensions:"x-order=2"`
+    // Роль при записи: student или auditor
+    Role string `json:"role" example:"student" extensions:"x-order=3"`
+    // ID создателя кода
+    CreatedBy string `json:"created_by" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=4"`
+    // Срок действия (опционально)
+    ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2023-09-08T00:00:00Z" extensions:"x-order=5"`
+    // Максимальное число использований (опционально)
+    MaxUses *int32 `json:"max_uses,omitempty" example:"30" extensions:"x-order=6"`
+    // Число использований
+    Uses int32 `json:"uses" example:"12" extensions:"x-order=7"`
+    // Дата отзыва (опционально)
+    RevokedAt *time.Time `json:"revoked_at,omitempty" example:"2023-09-05T12:00:00Z" extensions:"x-order=8"`
+    // Дата создания
+    CreatedAt time.Time `json:"created_at" example:"2023-09-01T12:00:00Z" extensions:"x-order=9"`
+} // @name CourseInvite
+
+func NewInvite(i *pb.Invite) Invite {
+	invite := Invite{
+		InviteID:  i.GetInviteId(),
+		CourseID:  i.GetCourseId(),
+		Code:      i.GetCode(),
+		Role:      i.GetRole(),
+		CreatedBy: i.GetCreatedBy(),
+		MaxUses:   i.MaxUses,
+		Uses:      i.GetUses(),
+		CreatedAt: i.GetCreatedAt().AsTime(),
+	}
+
+	if i.GetExpiresAt() != nil {
+		t := i.GetExpiresAt().AsTime()
+		invite.ExpiresAt = &t
+	}
+
+	if i.GetRevokedAt() != nil {
+		t := i.GetRevokedAt().AsTime()
+		invite.RevokedAt = &t
+	}
+
+	return invite
+}
+
+// CreateInviteRequest - запрос создания кода приглашения
+// @Description Параметры нового кода приглашения
+type CreateInviteRequest struct {
+    // ID создателя кода
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Роль при записи: student или auditor, по умолчанию student
+    Role string `json:"role,omitempty" example:"student" extensions:"x-order=2"`
+    // Срок действия (опционально)
+    ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2023-09-08T00:00:00Z" extensions:"x-order=3"`
+    // Максимальное число использований (опционально)
+    MaxUses *int32 `json:"max_uses,omitempty" example:"30" extensions:"x-order=4"`
+} // @name CreateInviteRequest
+
+func NewCreateInviteRequest(req CreateInviteRequest) *pb.CreateInviteRequest {
+	pbReq := &pb.CreateInviteRequest{
+		CourseId: req.CourseID,
+		UserId:   req.UserID,
+		Role:     req.Role,
+		MaxUses:  req.MaxUses,
+	}
+
+	if req.ExpiresAt != nil {
+		pbReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	return pbReq
+}
+
+// CreateInviteResponse - созданный код приглашения
+// @Description Возвращает созданный код приглашения
+type CreateInviteResponse struct {
+    Invite Invite `json:"invite" extensions:"x-order=0"`
+} // @name CreateInviteResponse
+
+func NewCreateInviteResponse(resp *pb.CreateInviteResponse) CreateInviteResponse {
+	return CreateInviteResponse{
+		Invite: NewInvite(resp.GetInvite()),
+	}
+}
+
+// ListInvitesRequest - запрос кодов приглашения курса
+// @Description Содержит ID курса
+type ListInvitesRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ListInvitesRequest
+
+func NewListInvitesRequest(req ListInvitesRequest) *pb.ListInvitesRequest {
+	return &pb.ListInvitesRequest{
+		CourseId: req.CourseID,
+	}
+}
+
+// ListInvitesResponse - коды приглашения курса
+// @Description Все коды курса, включая отозванные и истекшие
+type ListInvitesResponse struct {
+    // Массив кодов приглашения
+    Invites []Invite `json:"invites" extensions:"x-order=0"`
+} // @name ListInvitesResponse
+
+func NewListInvitesResponse(resp *pb.ListInvitesResponse) ListInvitesResponse {
+	invites := make([]Invite, 0, len(resp.GetInvites()))
+	for _, i := range resp.GetInvites() {
+		invites = append(invites, NewInvite(i))
+	}
+
+	return ListInvitesResponse{
+		Invites: invites,
+	}
+}
+
+// RevokeInviteRequest - запрос отзыва кода приглашения
+// @Description Отзывает код, после чего по нему нельзя записаться
+type RevokeInviteRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID приглашения
+    InviteID string `json:"invite_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name RevokeInviteRequest
+
+func NewRevokeInviteRequest(req RevokeInviteRequest) *pb.RevokeInviteRequest {
+	return &pb.RevokeInviteRequest{
+		CourseId: req.CourseID,
+		InviteId: req.InviteID,
+	}
+}
+
+// RevokeInviteResponse - отозванный код приглашения
+// @Description Данные отозванного кода
+type RevokeInviteResponse struct {
+    Invite Invite `json:"invite" extensions:"x-order=0"`
+} // @name RevokeInviteResponse
+
+func NewRevokeInviteResponse(resp *pb.RevokeInviteResponse) RevokeInviteResponse {
+	return RevokeInviteResponse{
+		Invite: NewInvite(resp.GetInvite()),
+	}
+}
+
+// JoinByCodeRequest - запрос записи на курс по коду
+// @Description Содержит код приглашения
+type JoinByCodeRequest struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // Код приглашения, регистр не важен
+    Code string `json:"code" example:"K7QX2M9D" extensions:"x-order=1"`
+} // @name JoinByCodeRequest
+
+func NewJoinByCodeRequest(req JoinByCodeRequest) *pb.JoinByCodeRequest {
+	return &pb.JoinByCodeRequest{
+		UserId: req.UserID,
+		Code:   req.Code,
+	}
+}
+
+// JoinByCodeResponse - результат записи по коду
+// @Description Данные зачисления на курс
+type JoinByCodeResponse struct {
+    Enrollment Enrollment `json:"enrollment" extensions:"x-order=0"`
+} // @name JoinByCodeResponse
+
+func NewJoinByCodeResponse(resp *pb.JoinByCodeResponse) JoinByCodeResponse {
+	return JoinByCodeResponse{
+		Enrollment: Enrollment{
+			CourseID:   resp.GetEnrollment().GetCourseId(),
+			StudentID:  resp.GetEnrollment().GetStudentId(),
+			EnrolledAt: resp.GetEnrollment().GetEnrolledAt().AsTime(),
+			Role:       resp.GetEnrollment().GetRole(),
+		},
+	}
+}
+
+// EnrollmentRequest - заявка на запись на курс
+// @Description Заявка пользователя и её состояние
+type EnrollmentRequest struct {
+    // ID заявки
+    RequestID string `json:"request_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+    // Email адрес
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=3"`
+    // Имя
+    FirstName string `json:"first_name" example:"Иван" extensions:"x-order=4"`
+    // Фамилия
+    LastName string `json:"last_name" example:"Иванов" extensions:"x-order=5"`
+    // Состояние: pending, approved, rejected или waitlisted
+    Status string `json:"status" example:"pending" extensions:"x-order=6"`
+    // ID преподавателя, принявшего решение (опционально)
+    DecidedBy *string `json:"decided_by,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=7"`
+    // Дата подачи заявки
+    CreatedAt time.Time `json:"created_at" example:"2023-09-01T12:00:00Z" extensions:"x-order=8"`
+    // Дата последнего изменения состояния
+    UpdatedAt time.Time `json:"updated_at" example:"2023-09-02T12:00:00Z" extensions:"x-order=9"`
+} // @name EnrollmentRequest
+
+func NewEnrollmentRequest(r *pb.EnrollmentRequest) EnrollmentRequest {
+	return EnrollmentRequest{
+		RequestID: r.GetRequestId(),
+		CourseID:  r.GetCourseId(),
+		UserID:    r.GetUserId(),
+		Email:     r.GetEmail(),
+		FirstName: r.GetFirstName(),
+		LastName:  r.GetLastName(),
+		Status:    r.GetStatus(),
+		DecidedBy: r.DecidedBy,
+		CreatedAt: r.GetCreatedAt().AsTime(),
+		UpdatedAt: r.GetUpdatedAt().AsTime(),
+	}
+}
+
+// RequestEnrollmentRequest - подача заявки на курс
+// @Description Содержит ID курса
+type RequestEnrollmentRequest struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name RequestEnrollmentRequest
+
+func NewRequestEnrollmentRequest(req RequestEnrollmentRequest) *pb.RequestEnrollmentRequest {
+	return &pb.RequestEnrollmentRequest{
+		CourseId: req.CourseID,
+		UserId:   req.UserID,
+	}
+}
+
+// RequestEnrollmentResponse - поданная заявка
+// @Description Заявка в состоянии pending
+type RequestEnrollmentResponse struct {
+    Request EnrollmentRequest `json:"request" extensions:"x-order=0"`
+} // @name RequestEnrollmentResponse
+
+func NewRequestEnrollmentResponse(resp *pb.RequestEnrollmentResponse) RequestEnrollmentResponse {
+	return RequestEnrollmentResponse{
+		Request: NewEnrollmentRequest(resp.GetRequest()),
+	}
+}
+
+// ListEnrollmentRequestsRequest - запрос заявок на курс
+// @Description Содержит ID курса и опциональный фильтр по состоянию
+type ListEnrollmentRequestsRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Состояние: pending, approved, rejected или waitlisted (опционально)
+    Status *string `schema:"status" example:"pending" extensions:"x-order=1"`
+} // @name ListEnrollmentRequestsRequest
+
+func NewListEnrollmentRequestsRequest(req ListEnrollmentRequestsRequest) *pb.ListEnrollmentRequestsRequest {
+	return &pb.ListEnrollmentRequestsRequest{
+		CourseId: req.CourseID,
+		Status:   req.Status,
+	}
+}
+
+// ListEnrollmentRequestsResponse - заявки на курс
+// @Description Заявки в порядке подачи
+type ListEnrollmentRequestsResponse struct {
+    // Массив заявок
+    Requests []EnrollmentRequest `json:"requests" extensions:"x-order=0"`
+} // @name ListEnrollmentRequestsResponse
+
+func NewListEnrollmentRequestsResponse(resp *pb.ListEnrollmentRequestsResponse) ListEnrollmentRequestsResponse {
+	requests := make([]EnrollmentRequest, 0, len(resp.GetRequests()))
+	for _, r := range resp.GetRequests() {
+		requests = append(requests, NewEnrollmentRequest(r))
+	}
+
+	return ListEnrollmentRequestsResponse{
+		Requests: requests,
+	}
+}
+
+// DecideEnrollmentRequest - решение по заявке
+// @Description Содержит ID курса и заявки
+type DecideEnrollmentRequest struct {
+    // ID преподавателя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // ID заявки
+    RequestID string `json:"request_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+} // @name DecideEnrollmentRequest
+
+func NewApproveEnrollmentRequest(req DecideEnrollmentRequest) *pb.ApproveEnrollmentRequest {
+	return &pb.ApproveEnrollmentRequest{
+		CourseId:  req.CourseID,
+		RequestId: req.RequestID,
+		UserId:    req.UserID,
+	}
+}
+
+func NewRejectEnrollmentRequest(req DecideEnrollmentRequest) *pb.RejectEnrollmentRequest {
+	return &pb.RejectEnrollmentRequest{
+		CourseId:  req.CourseID,
+		RequestId: req.RequestID,
+		UserId:    req.UserID,
+	}
+}
+
+// DecideEnrollmentResponse - заявка после решения
+// @Description Заявка с новым состоянием
+type DecideEnrollmentResponse struct {
+    Request EnrollmentRequest `json:"request" extensions:"x-order=0"`
+} // @name DecideEnrollmentResponse
+
+func NewDecideEnrollmentResponse(r *pb.EnrollmentRequest) DecideEnrollmentResponse {
+	return DecideEnrollmentResponse{
+		Request: NewEnrollmentRequest(r),
+	}
+}
+
+// BulkEnrollRequest - массовая запись по списку почт
+// @Description Собирается из загруженного CSV файла
+type BulkEnrollRequest struct {
+    // ID преподавателя
+    UserID string `json:"user_id" swaggerignore:"true"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Почты пользователей
+    Emails []string `json:"emails" example:"student@example.com" extensions:"x-order=1"`
+    // Роль: student или auditor, по умолчанию student
+    Role string `json:"role,omitempty" example:"student" extensions:"x-order=2"`
+    // Отправить коды приглашения на неизвестные почты
+    InviteUnknown bool `json:"invite_unknown" example:"true" extensions:"x-order=3"`
+} // @name BulkEnrollRequest
+
+func NewBulkEnrollRequest(req BulkEnrollRequest) *pb.BulkEnrollRequest {
+	return &pb.BulkEnrollRequest{
+		CourseId:      req.CourseID,
+		UserId:        req.UserID,
+		Emails:        req.Emails,
+		Role:          req.Role,
+		InviteUnknown: req.InviteUnknown,
+	}
+}
+
+// BulkEnrollResult - результат строки массовой записи
+// @Description Результат для одной почты из файла
+type BulkEnrollResult struct {
+    // Почта из файла
+    Email string `json:"email" example:"student@example.com" extensions:"x-order=0"`
+    // Результат: enrolled, already_member, invited, not_found, invalid_email, duplicate, course_full или failed
+    Status string `json:"status" example:"enrolled" extensions:"x-order=1"`
+    // ID найденного пользователя (опционально)
+    UserID *string `json:"user_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+    // Код приглашения, отправленный на почту (опционально)
+    InviteCode *string `json:"invite_code,omitempty" example:"K7QX2M9D" extensions:"x-order=3"`
+} // @name BulkEnrollResult
+
+// BulkEnrollResponse - результаты массовой записи
+// @Description Результаты в порядке строк файла
+type BulkEnrollResponse struct {
+    // Массив результатов
+    Results []BulkEnrollResult `json:"results" extensions:"x-order=0"`
+} // @name BulkEnrollResponse
+
+func NewBulkEnrollResponse(resp *pb.BulkEnrollResponse) BulkEnrollResponse {
+	results := make([]BulkEnrollResult, 0, len(resp.GetResults()))
+	for _, r := range resp.GetResults() {
+		results = append(results, BulkEnrollResult{
+			Email:      r.GetEmail(),
+			Status:     r.GetStatus(),
+			UserID:     r.UserId,
+			InviteCode: r.InviteCode,
+		})
+	}
+
+	return BulkEnrollResponse{
+		Results: results,
+	}
+}
+
+// RosterEntry - строка ведомости курса
+// @Description Студент или слушатель курса
+type RosterEntry struct {
+    // ID пользователя
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Email адрес
+    Email string `json:"email" example:"user@example.com" extensions:"x-order=1"`
+    // Имя
+    FirstName string `json:"first_name" example:"Иван" extensions:"x-order=2"`
+    // Фамилия
+    LastName string `json:"last_name" example:"Иванов" extensions:"x-order=3"`
+    // Роль: student или auditor
+    Role string `json:"role" example:"student" extensions:"x-order=4"`
+    // Дата и время зачисления
+    EnrolledAt time.Time `json:"enrolled_at" example:"2023-09-01T12:00:00Z" extensions:"x-order=5"`
+} // @name RosterEntry
+
+// ExportRosterRequest - запрос ведомости курса
+// @Description Содержит ID курса
+type ExportRosterRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ExportRosterRequest
+
+func NewExportRosterRequest(req ExportRosterRequest) *pb.ExportRosterRequest {
+	return &pb.ExportRosterRequest{
+		CourseId: req.CourseID,
+	}
+}
+
+// ExportRosterResponse - ведомость курса
+// @Description Все студенты и слушатели курса
+type ExportRosterResponse struct {
+    Entries []RosterEntry `json:"entries" extensions:"x-order=0"`
+} // @name ExportRosterResponse
+
+func NewExportRosterResponse(resp *pb.ExportRosterResponse) ExportRosterResponse {
+	entries := make([]RosterEntry, 0, len(resp.GetEntries()))
+	for _, e := range resp.GetEntries() {
+		entries = append(entries, RosterEntry{
+			UserID:     e.GetUserId(),
+			Email:      e.GetEmail(),
+			FirstName:  e.GetFirstName(),
+			LastName:   e.GetLastName(),
+			Role:       e.GetRole(),
+			EnrolledAt: e.GetEnrolledAt().AsTime(),
+		})
+	}
+
+	return ExportRosterResponse{
+		Entries: entries,
+	}
+}
+
+// CloneJob - задача копирования курса
+// @Description Состояние копирования уроков и заданий в новый курс
+type CloneJob struct {
+    // ID задачи
+    JobID string `json:"job_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID копируемого курса
+    SourceCourseID string `json:"source_course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // ID нового курса
+    TargetCourseID string `json:"target_course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+    // Состояние: pending, running, completed или failed
+    Status string `json:"status" example:"running" extensions:"x-order=3"`
+    // Сколько уроков скопировано, отсутствует, пока уроки не скопированы
+    LessonsCopied *int32 `json:"lessons_copied,omitempty" example:"12" extensions:"x-order=4"`
+    // Сколько заданий скопировано, отсутствует, пока задания не скопированы
+    TasksCopied *int32 `json:"tasks_copied,omitempty" example:"8" extensions:"x-order=5"`
+    // Причина ошибки, если копирование не удалось
+    Error *string `json:"error,omitempty" example:"failed to clone tasks" extensions:"x-order=6"`
+    // Дата создания задачи
+    CreatedAt time.Time `json:"created_at" example:"2024-01-15T00:00:00Z" extensions:"x-order=7"`
+    // Дата последнего изменения состояния
+    UpdatedAt time.Time `json:"updated_at" example:"2024-01-15T00:00:05Z" extensions:"x-order=8"`
+} // @name CloneJob
+
+func NewCloneJob(pbJob *pb.CloneJob) CloneJob {
+	return CloneJob{
+		JobID:          pbJob.GetJobId(),
+		SourceCourseID: pbJob.GetSourceCourseId(),
+		TargetCourseID: pbJob.GetTargetCourseId(),
+		Status:         pbJob.GetStatus(),
+		LessonsCopied:  pbJob.LessonsCopied,
+		TasksCopied:    pbJob.TasksCopied,
+		Error:          pbJob.Error,
+		CreatedAt:      pbJob.GetCreatedAt().AsTime(),
+		UpdatedAt:      pbJob.GetUpdatedAt().AsTime(),
+	}
+}
+
+// CloneCourseRequest - запрос копирования курса
+// @Description Копирует данные курса, уроки и задания без записей и статусов. Новый курс создаётся сразу, уроки и задания копируются фоновой задачей
+type CloneCourseRequest struct {
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID копируемого курса или шаблона
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Название нового курса, по умолчанию как у копируемого (опционально)
+    Title *string `json:"title,omitempty" example:"Основы программирования, весна" extensions:"x-order=2"`
+    // Сдвиг дат начала и окончания курса в днях
+    ShiftDays int32 `json:"shift_days" example:"182" extensions:"x-order=3"`
+    // Сохранить копию как шаблон
+    IsTemplate bool `json:"is_template" example:"false" extensions:"x-order=4"`
+} // @name CloneCourseRequest
+
+func NewCloneCourseRequest(req CloneCourseRequest) *pb.CloneCourseRequest {
+	return &pb.CloneCourseRequest{
+		CourseId:   req.CourseID,
+		UserId:     req.UserID,
+		Title:      req.Title,
+		ShiftDays:  req.ShiftDays,
+		IsTemplate: req.IsTemplate,
+	}
+}
+
+// CloneCourseResponse - результат копирования курса
+// @Description Возвращает новый курс и задачу копирования уроков и заданий
+type CloneCourseResponse struct {
+    Course Course `json:"course" extensions:"x-order=0"`
+    Job CloneJob `json:"job" extensions:"x-order=1"`
+} // @name CloneCourseResponse
+
+func NewCloneCourseResponse(resp *pb.CloneCourseResponse) CloneCourseResponse {
+	return CloneCourseResponse{
+		Course: NewCourse(resp.GetCourse()),
+		Job:    NewCloneJob(resp.GetJob()),
+	}
+}
+
+// GetCloneJobRequest - запрос состояния задачи копирования
+// @Description Задачу видит только запустивший копирование
+type GetCloneJobRequest struct {
+    UserID string `schema:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID задачи
+    JobID string `schema:"job_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name GetCloneJobRequest
+
+func NewGetCloneJobRequest(req GetCloneJobRequest) *pb.GetCloneJobRequest {
+	return &pb.GetCloneJobRequest{
+		JobId:  req.JobID,
+		UserId: req.UserID,
+	}
+}
+
+// GetCloneJobResponse - состояние задачи копирования
+// @Description Возвращает задачу копирования
+type GetCloneJobResponse struct {
+    Job CloneJob `json:"job" extensions:"x-order=0"`
+} // @name GetCloneJobResponse
+
+func NewGetCloneJobResponse(resp *pb.GetCloneJobResponse) GetCloneJobResponse {
+	return GetCloneJobResponse{
+		Job: NewCloneJob(resp.GetJob()),
+	}
+}
+
+// Module - модуль курса
+// @Description Раздел курса, объединяющий занятия и задания
+type Module struct {
+    // ID модуля
+    ModuleID string `json:"module_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Название модуля
+    Title string `json:"title" example:"Введение" extensions:"x-order=2"`
+    // Порядок модуля в курсе, начиная с 0
+    Position int32 `json:"position" example:"0" extensions:"x-order=3"`
+    // Дата создания
+    CreatedAt time.Time `json:"created_at" example:"2024-01-15T00:00:00Z" extensions:"x-order=4"`
+} // @name Module
+
+func NewModule(pbModule *pb.Module) Module {
+	return Module{
+		ModuleID:  pbModule.GetModuleId(),
+		CourseID:  pbModule.GetCourseId(),
+		Title:     pbModule.GetTitle(),
+		Position:  pbModule.GetPosition(),
+		CreatedAt: pbModule.GetCreatedAt().AsTime(),
+	}
+}
+
+func NewModules(pbModules []*pb.Module) []Module {
+	modules := make([]Module, 0, len(pbModules))
+	for _, m := range pbModules {
+		modules = append(modules, NewModule(m))
+	}
+	return modules
+}
+
+// CreateModuleRequest - запрос создания модуля
+// @Description Новый модуль добавляется в конец курса
+type CreateModuleRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Название модуля
+    Title string `json:"title" example:"Введение" extensions:"x-order=1"`
+} // @name CreateModuleRequest
+
+func NewCreateModuleRequest(req CreateModuleRequest) *pb.CreateModuleRequest {
+	return &pb.CreateModuleRequest{
+		CourseId: req.CourseID,
+		Title:    req.Title,
+	}
+}
+
+// UpdateModuleRequest - запрос переименования модуля
+// @Description Содержит ID курса, ID модуля и новое название
+type UpdateModuleRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID модуля
+    ModuleID string `json:"module_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Новое название модуля
+    Title string `json:"title" example:"Введение в курс" extensions:"x-order=2"`
+} // @name UpdateModuleRequest
+
+func NewUpdateModuleRequest(req UpdateModuleRequest) *pb.UpdateModuleRequest {
+	return &pb.UpdateModuleRequest{
+		CourseId: req.CourseID,
+		ModuleId: req.ModuleID,
+		Title:    req.Title,
+	}
+}
+
+// DeleteModuleRequest - запрос удаления модуля
+// @Description Занятия и задания модуля остаются в курсе без модуля
+type DeleteModuleRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID модуля
+    ModuleID string `json:"module_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name DeleteModuleRequest
+
+func NewDeleteModuleRequest(req DeleteModuleRequest) *pb.DeleteModuleRequest {
+	return &pb.DeleteModuleRequest{
+		CourseId: req.CourseID,
+		ModuleId: req.ModuleID,
+	}
+}
+
+// ModuleResponse - модуль курса
+// @Description Возвращает созданный, изменённый или удалённый модуль
+type ModuleResponse struct {
+    Module Module `json:"module" extensions:"x-order=0"`
+} // @name ModuleResponse
+
+func NewModuleResponse(pbModule *pb.Module) ModuleResponse {
+	return ModuleResponse{
+		Module: NewModule(pbModule),
+	}
+}
+
+// ListModulesRequest - запрос модулей курса
+// @Description Содержит ID курса
+type ListModulesRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ListModulesRequest
+
+func NewListModulesRequest(req ListModulesRequest) *pb.ListModulesRequest {
+	return &pb.ListModulesRequest{
+		CourseId: req.CourseID,
+	}
+}
+
+// ReorderModuleRequest - запрос перемещения модуля
+// @Description Ставит модуль на новую позицию, остальные модули сдвигаются
+type ReorderModuleRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID модуля
+    ModuleID string `json:"module_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Новая позиция, начиная с 0. Позиция больше числа модулей ставит модуль в конец
+    Position int32 `json:"position" example:"2" extensions:"x-order=2"`
+} // @name ReorderModuleRequest
+
+func NewReorderModuleRequest(req ReorderModuleRequest) *pb.ReorderModuleRequest {
+	return &pb.ReorderModuleRequest{
+		CourseId: req.CourseID,
+		ModuleId: req.ModuleID,
+		Position: req.Position,
+	}
+}
+
+// ListModulesResponse - модули курса
+// @Description Модули курса по порядку
+type ListModulesResponse struct {
+    Modules []Module `json:"modules" extensions:"x-order=0"`
+} // @name ListModulesResponse
+
+func NewListModulesResponse(pbModules []*pb.Module) ListModulesResponse {
+	return ListModulesResponse{
+		Modules: NewModules(pbModules),
+	}
+}
+
+// OwnershipTransfer - передача курса другому владельцу
+// @Description Передача ждёт подтверждения нового владельца, если её не выполнил суперпользователь
+type OwnershipTransfer struct {
+    // ID передачи
+    TransferID string `json:"transfer_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Название курса
+    CourseTitle string `json:"course_title" example:"Основы программирования" extensions:"x-order=2"`
+    // ID владельца на момент создания передачи
+    FromUserID string `json:"from_user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=3"`
+    // ID нового владельца
+    ToUserID string `json:"to_user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=4"`
+    // Состояние: pending, accepted, declined или cancelled
+    Status string `json:"status" example:"pending" extensions:"x-order=5"`
+    // Передача выполнена суперпользователем без подтверждения
+    Forced bool `json:"forced" example:"false" extensions:"x-order=6"`
+    // Дата создания передачи
+    CreatedAt time.Time `json:"created_at" example:"2024-01-15T00:00:00Z" extensions:"x-order=7"`
+    // Дата подтверждения, отказа или отмены
+    DecidedAt *time.Time `json:"decided_at,omitempty" example:"2024-01-16T00:00:00Z" extensions:"x-order=8"`
+} // @name OwnershipTransfer
+
+func NewOwnershipTransfer(t *pb.OwnershipTransfer) OwnershipTransfer {
+	transfer := OwnershipTransfer{
+		TransferID:  t.GetTransferId(),
+		CourseID:    t.GetCourseId(),
+		CourseTitle: t.GetCourseTitle(),
+		FromUserID:  t.GetFromUserId(),
+		ToUserID:    t.GetToUserId(),
+		Status:      t.GetStatus(),
+		Forced:      t.GetForced(),
+		CreatedAt:   t.GetCreatedAt().AsTime(),
+	}
+	if t.DecidedAt != nil {
+		decidedAt := t.GetDecidedAt().AsTime()
+		transfer.DecidedAt = &decidedAt
+	}
+	return transfer
+}
+
+// TransferOwnershipRequest - запрос передачи курса
+// @Description Содержит ID курса и нового владельца
+type TransferOwnershipRequest struct {
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // ID нового владельца
+    NewOwnerID string `json:"new_owner_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+    // Передать сразу без подтверждения, только для суперпользователя
+    Force bool `json:"force" example:"false" extensions:"x-order=3"`
+} // @name TransferOwnershipRequest
+
+func NewTransferOwnershipRequest(req TransferOwnershipRequest) *pb.TransferOwnershipRequest {
+	return &pb.TransferOwnershipRequest{
+		CourseId:   req.CourseID,
+		UserId:     req.UserID,
+		NewOwnerId: req.NewOwnerID,
+		Force:      req.Force,
+	}
+}
+
+// DecideOwnershipTransferRequest - подтверждение или отказ от передачи курса
+// @Description Содержит ID передачи
+type DecideOwnershipTransferRequest struct {
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+    // ID передачи
+    TransferID string `json:"transfer_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+} // @name DecideOwnershipTransferRequest
+
+func NewAcceptOwnershipTransferRequest(req DecideOwnershipTransferRequest) *pb.AcceptOwnershipTransferRequest {
+	return &pb.AcceptOwnershipTransferRequest{
+		TransferId: req.TransferID,
+		UserId:     req.UserID,
+	}
+}
+
+func NewDeclineOwnershipTransferRequest(req DecideOwnershipTransferRequest) *pb.DeclineOwnershipTransferRequest {
+	return &pb.DeclineOwnershipTransferRequest{
+		TransferId: req.TransferID,
+		UserId:     req.UserID,
+	}
+}
+
+// OwnershipTransferResponse - результат передачи курса
+// @Description Возвращает передачу и заявки из листа ожидания, записанные на место нового владельца
+type OwnershipTransferResponse struct {
+    Transfer OwnershipTransfer `json:"transfer" extensions:"x-order=0"`
+    // Заявки из листа ожидания, записанные на место, которое освободил новый владелец
+    Promoted []EnrollmentRequest `json:"promoted" extensions:"x-order=1"`
+} // @name OwnershipTransferResponse
+
+func NewOwnershipTransferResponse(transfer *pb.OwnershipTransfer, pbPromoted []*pb.EnrollmentRequest) OwnershipTransferResponse {
+	promoted := make([]EnrollmentRequest, 0, len(pbPromoted))
+	for _, r := range pbPromoted {
+		promoted = append(promoted, NewEnrollmentRequest(r))
+	}
+
+	return OwnershipTransferResponse{
+		Transfer: NewOwnershipTransfer(transfer),
+		Promoted: promoted,
+	}
+}
+
+// ListOwnershipTransfersRequest - запрос передач курсов пользователю
+// @Description ID пользователя берётся из токена
+type ListOwnershipTransfersRequest struct {
+    UserID string `json:"user_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0" swaggerignore:"true"`
+} // @name ListOwnershipTransfersRequest
+
+func NewListOwnershipTransfersRequest(req ListOwnershipTransfersRequest) *pb.ListOwnershipTransfersRequest {
+	return &pb.ListOwnershipTransfersRequest{
+		UserId: req.UserID,
+	}
+}
+
+// ListOwnershipTransfersResponse - передачи курсов пользователю
+// @Description Передачи, ожидающие подтверждения пользователя
+type ListOwnershipTransfersResponse struct {
+    Transfers []OwnershipTransfer `json:"transfers" extensions:"x-order=0"`
+} // @name ListOwnershipTransfersResponse
+
+func NewListOwnershipTransfersResponse(resp *pb.ListOwnershipTransfersResponse) ListOwnershipTransfersResponse {
+	transfers := make([]OwnershipTransfer, 0, len(resp.GetTransfers()))
+	for _, t := range resp.GetTransfers() {
+		transfers = append(transfers, NewOwnershipTransfer(t))
+	}
+
+	return ListOwnershipTransfersResponse{
+		Transfers: transfers,
+	}
+}
@@ -0,0 +1,92 @@
+package courses
+
+import (
+	"Classroom/Gateway/internal/lessons"
+	"Classroom/Gateway/internal/tasks"
+	"time"
+)
+
+// GetCourseOutlineRequest - запрос структуры курса
+// @Description Содержит ID курса
+type GetCourseOutlineRequest struct {
+    // ID курса
+    CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name GetCourseOutlineRequest
+
+// OutlineModule - модуль в структуре курса
+// @Description Модуль вместе с его занятиями и заданиями по порядку
+type OutlineModule struct {
+    // ID модуля
+    ModuleID string `json:"module_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Название модуля
+    Title string `json:"title" example:"Введение" extensions:"x-order=1"`
+    // Порядок модуля в курсе, начиная с 0
+    Position int32 `json:"position" example:"0" extensions:"x-order=2"`
+    // Дата создания
+    CreatedAt time.Time `json:"created_at" example:"2024-01-15T00:00:00Z" extensions:"x-order=3"`
+    // Занятия модуля
+    Lessons []lessons.Lesson `json:"lessons" extensions:"x-order=4"`
+    // Задания модуля
+    Tasks []tasks.Task `json:"tasks" extensions:"x-order=5"`
+} // @name OutlineModule
+
+// CourseOutline - структура курса
+// @Description Модули курса с занятиями и заданиями, а также занятия и задания без модуля
+type CourseOutline struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Модули по порядку
+    Modules []OutlineModule `json:"modules" extensions:"x-order=1"`
+    // Занятия без модуля
+    Lessons []lessons.Lesson `json:"lessons" extensions:"x-order=2"`
+    // Задания без модуля
+    Tasks []tasks.Task `json:"tasks" extensions:"x-order=3"`
+} // @name CourseOutline
+
+// Раскладывает занятия и задания по модулям, сохраняя их порядок. Занятия и задания
+// с модулем, которого нет в списке, попадают в конец курса без модуля
+func NewCourseOutline(courseID string, modules []Module, courseLessons []lessons.Lesson, courseTasks []tasks.Task) CourseOutline {
+	outline := CourseOutline{
+		CourseID: courseID,
+		Modules:  make([]OutlineModule, 0, len(modules)),
+		Lessons:  []lessons.Lesson{},
+		Tasks:    []tasks.Task{},
+	}
+
+	index := make(map[string]int, len(modules))
+	for i, m := range modules {
+		index[m.ModuleID] = i
+		outline.Modules = append(outline.Modules, OutlineModule{
+			ModuleID:  m.ModuleID,
+			Title:     m.Title,
+			Position:  m.Position,
+			CreatedAt: m.CreatedAt,
+			Lessons:   []lessons.Lesson{},
+			Tasks:     []tasks.Task{},
+		})
+	}
+
+	for _, lesson := range courseLessons {
+		if i, ok := moduleIndex(index, lesson.ModuleID); ok {
+			outline.Modules[i].Lessons = append(outline.Modules[i].Lessons, lesson)
+		} else {
+			outline.Lessons = append(outline.Lessons, lesson)
+		}
+	}
+	for _, task := range courseTasks {
+		if i, ok := moduleIndex(index, task.ModuleID); ok {
+			outline.Modules[i].Tasks = append(outline.Modules[i].Tasks, task)
+		} else {
+			outline.Tasks = append(outline.Tasks, task)
+		}
+	}
+	return outline
+}
+
+func moduleIndex(index map[string]int, moduleID *string) (int, bool) {
+	if moduleID == nil {
+		return 0, false
+	}
+	i, ok := index[*moduleID]
+	return i, ok
+}
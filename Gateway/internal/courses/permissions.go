@@ -0,0 +1,20 @@
+package courses
+
+// Права в курсе, которые проверяются через Courses.Authorize
+const (
+	PermissionCourseRead    = "course.read"
+	PermissionCourseUpdate  = "course.update"
+	PermissionCourseDelete  = "course.delete"
+	PermissionMembersRead   = "members.read"
+	PermissionMembersManage = "members.manage"
+	PermissionRolesManage   = "roles.manage"
+	PermissionLessonsRead   = "lessons.read"
+	PermissionLessonsDrafts = "lessons.read_drafts"
+	PermissionLessonsWrite  = "lessons.write"
+	PermissionLessonsDelete = "lessons.delete"
+	PermissionTasksRead     = "tasks.read"
+	PermissionTasksWrite    = "tasks.write"
+	PermissionTasksDelete   = "tasks.delete"
+	PermissionTasksSubmit   = "tasks.submit"
+	PermissionTasksGrade    = "tasks.grade"
+)
@@ -0,0 +1,72 @@
+package courses
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// Читает почты из CSV. Если в первой строке есть колонка email, почты берутся из неё,
+// а строка считается заголовком. Иначе почты берутся из первой колонки
+func ParseRosterCSV(r io.Reader) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	column := 0
+	var emails []string
+	for line := 0; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 0 {
+			if header := emailColumn(record); header >= 0 {
+				column = header
+				continue
+			}
+		}
+		if column >= len(record) || strings.TrimSpace(record[column]) == "" {
+			continue
+		}
+		emails = append(emails, record[column])
+	}
+	return emails, nil
+}
+
+func emailColumn(record []string) int {
+	for i, cell := range record {
+		// Excel добавляет BOM в начало UTF-8 файла
+		cell = strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff"))
+		if strings.EqualFold(cell, "email") || strings.EqualFold(cell, "e-mail") {
+			return i
+		}
+	}
+	return -1
+}
+
+// Пишет ведомость в CSV с BOM, чтобы Excel правильно показал кириллицу
+func WriteRosterCSV(w io.Writer, entries []RosterEntry) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"user_id", "email", "last_name", "first_name", "role", "enrolled_at"}); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		record := []string{e.UserID, e.Email, e.LastName, e.FirstName, e.Role, e.EnrolledAt.Format(time.RFC3339)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,135 @@
+package courses_test
+
+import (
+	"Classroom/Gateway/internal/courses"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRosterCSV(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "empty file",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:  "no header",
+			input: "ivan@example.com\npetr@example.com\n",
+			want:  []string{"ivan@example.com", "petr@example.com"},
+		},
+		{
+			name:  "no header takes first column",
+			input: "ivan@example.com,Ivan\npetr@example.com,Petr\n",
+			want:  []string{"ivan@example.com", "petr@example.com"},
+		},
+		{
+			name:  "email header",
+			input: "email\nivan@example.com\n",
+			want:  []string{"ivan@example.com"},
+		},
+		{
+			name:  "header in another column",
+			input: "last_name,first_name,Email\nIvanov,Ivan,ivan@example.com\nPetrov,Petr,petr@example.com\n",
+			want:  []string{"ivan@example.com", "petr@example.com"},
+		},
+		{
+			name:  "e-mail header with spaces",
+			input: "name, E-Mail \nIvan, ivan@example.com\n",
+			want:  []string{"ivan@example.com"},
+		},
+		{
+			name:  "header with excel bom",
+			input: "\ufeffemail,name\nivan@example.com,Ivan\n",
+			want:  []string{"ivan@example.com"},
+		},
+		{
+			name:  "header only in first row",
+			input: "ivan@example.com\nemail\n",
+			want:  []string{"ivan@example.com", "email"},
+		},
+		{
+			name:  "blank rows are skipped",
+			input: "email\n\nivan@example.com\n   \n\"\"\npetr@example.com\n\n",
+			want:  []string{"ivan@example.com", "petr@example.com"},
+		},
+		{
+			name:  "short rows are skipped",
+			input: "name,email\nIvan,ivan@example.com\nPetr\nAnna,anna@example.com\n",
+			want:  []string{"ivan@example.com", "anna@example.com"},
+		},
+		{
+			name:  "duplicates are kept for per-row results",
+			input: "ivan@example.com\nIVAN@example.com\nivan@example.com\n",
+			want:  []string{"ivan@example.com", "IVAN@example.com", "ivan@example.com"},
+		},
+		{
+			name:  "invalid emails are kept for per-row results",
+			input: "email\nnot-an-email\nivan@example.com\n@example.com\n",
+			want:  []string{"not-an-email", "ivan@example.com", "@example.com"},
+		},
+		{
+			name:  "quoted fields",
+			input: "name,email\n\"Petrov, Petr\",\"petr@example.com\"\n",
+			want:  []string{"petr@example.com"},
+		},
+		{
+			name:  "crlf line endings",
+			input: "email\r\nivan@example.com\r\npetr@example.com\r\n",
+			want:  []string{"ivan@example.com", "petr@example.com"},
+		},
+		{
+			name:    "malformed quotes",
+			input:   "email\n\"ivan@example.com\n",
+			wantErr: true,
+		},
+		{
+			name:    "bare quote",
+			input:   "email\niv\"an@example.com\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := courses.ParseRosterCSV(strings.NewReader(tc.input))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWriteRosterCSV(t *testing.T) {
+	enrolledAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	entries := []courses.RosterEntry{
+		{UserID: "1", Email: "ivan@example.com", LastName: "Иванов", FirstName: "Иван", Role: "student", EnrolledAt: enrolledAt},
+		{UserID: "2", Email: "petr@example.com", LastName: "Петров, мл.", FirstName: "Пётр", Role: "auditor", EnrolledAt: enrolledAt},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, courses.WriteRosterCSV(&buf, entries))
+
+	want := "\ufeffuser_id,email,last_name,first_name,role,enrolled_at\n" +
+		"1,ivan@example.com,Иванов,Иван,student,2025-09-01T10:00:00Z\n" +
+		"2,petr@example.com,\"Петров, мл.\",Пётр,auditor,2025-09-01T10:00:00Z\n"
+	assert.Equal(t, want, buf.String())
+
+	// Выгруженная ведомость снова читается как список почт
+	emails, err := courses.ParseRosterCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ivan@example.com", "petr@example.com"}, emails)
+}
@@ -0,0 +1,129 @@
+package lessons
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Путь скачивания по подписанной ссылке
+const DownloadPath = "/api/lessons/attachments/download"
+
+var (
+	ErrTooLarge            = errors.New("file is too large")
+	ErrUnsupportedFileType = errors.New("unsupported file type")
+)
+
+// Подпись связывает файл, пользователя и время окончания ссылки, поэтому ссылку
+// нельзя продлить или передать другому пользователю без повторной подписи
+func signDownload(secret []byte, attachmentID, userID string, expires int64) string {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s:%s:%d", attachmentID, userID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Возвращает подписанную ссылку на скачивание файла и время окончания её действия
+func SignDownloadURL(secret []byte, attachmentID, userID string, ttl time.Duration) (string, time.Time) {
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+	expires := expiresAt.Unix()
+
+	query := url.Values{}
+	query.Set("attachment_id", attachmentID)
+	query.Set("user_id", userID)
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", signDownload(secret, attachmentID, userID, expires))
+	return DownloadPath + "?" + query.Encode(), expiresAt
+}
+
+// Проверяет подпись и срок действия ссылки
+func VerifyDownload(secret []byte, req DownloadAttachmentRequest) bool {
+	if time.Now().Unix() > req.Expires {
+		return false
+	}
+	signature, err := hex.DecodeString(req.Signature)
+	if err != nil {
+		return false
+	}
+	expected, _ := hex.DecodeString(signDownload(secret, req.AttachmentID, req.UserID, req.Expires))
+	return hmac.Equal(signature, expected)
+}
+
+// DetectContentType определяет тип файла по содержимому, а не по заголовку клиента.
+// Форматы Office распознаются как zip, а markdown и csv как обычный текст, для них
+// берётся заявленный тип, если он разрешён. Возвращает reader со всем содержимым файла
+func DetectContentType(r io.Reader, fileName, declared string, allowed []string) (string, io.Reader, error) {
+	br := bufio.NewReaderSize(r, 512)
+	head, err := br.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", nil, err
+	}
+
+	sniffed := baseType(http.DetectContentType(head))
+	declared = baseType(declared)
+	if declared == "" || declared == "application/octet-stream" {
+		declared = baseType(mime.TypeByExtension(filepath.Ext(fileName)))
+	}
+
+	contentType := sniffed
+	switch {
+	case sniffed == "application/zip" && strings.HasPrefix(declared, "application/vnd.openxmlformats-officedocument."):
+		contentType = declared
+	case sniffed == "text/plain" && strings.HasPrefix(declared, "text/") && declared != "text/html":
+		contentType = declared
+	}
+
+	if !slices.Contains(allowed, contentType) {
+		return "", nil, ErrUnsupportedFileType
+	}
+	return contentType, br, nil
+}
+
+func baseType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// LimitReader возвращает ErrTooLarge, как только прочитано больше limit байт.
+// В отличие от io.LimitReader не обрезает файл молча
+func LimitReader(r io.Reader, limit int64) io.Reader {
+	return &limitedReader{r: r, left: limit}
+}
+
+type limitedReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.left -= int64(n)
+	if l.left < 0 {
+		return 0, ErrTooLarge
+	}
+	return n, err
+}
+
+// ContentDisposition формирует заголовок для скачивания, имя файла с не ASCII
+// символами кодируется по RFC 2231
+func ContentDisposition(fileName string) string {
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+	if disposition == "" {
+		return "attachment"
+	}
+	return disposition
+}
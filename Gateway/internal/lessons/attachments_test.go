@@ -0,0 +1,140 @@
+package lessons_test
+
+import (
+	"Classroom/Gateway/internal/lessons"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Разбирает подписанную ссылку в запрос скачивания
+func parseDownloadURL(t *testing.T, link string) lessons.DownloadAttachmentRequest {
+	t.Helper()
+
+	path, rawQuery, found := strings.Cut(link, "?")
+	require.True(t, found)
+	require.Equal(t, lessons.DownloadPath, path)
+
+	query, err := url.ParseQuery(rawQuery)
+	require.NoError(t, err)
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	require.NoError(t, err)
+
+	return lessons.DownloadAttachmentRequest{
+		AttachmentID: query.Get("attachment_id"),
+		UserID:       query.Get("user_id"),
+		Expires:      expires,
+		Signature:    query.Get("signature"),
+	}
+}
+
+func TestSignDownloadURL(t *testing.T) {
+	secret := []byte("secret")
+
+	before := time.Now()
+	link, expiresAt := lessons.SignDownloadURL(secret, "attachment", "user", 15*time.Minute)
+	req := parseDownloadURL(t, link)
+
+	assert.Equal(t, "attachment", req.AttachmentID)
+	assert.Equal(t, "user", req.UserID)
+	assert.Equal(t, expiresAt.Unix(), req.Expires)
+	assert.WithinDuration(t, before.Add(15*time.Minute), expiresAt, time.Second)
+	assert.NotEmpty(t, req.Signature)
+}
+
+func TestVerifyDownload(t *testing.T) {
+	secret := []byte("secret")
+
+	testCases := []struct {
+		name   string
+		ttl    time.Duration
+		secret []byte
+		tamper func(req *lessons.DownloadAttachmentRequest)
+		want   bool
+	}{
+		{
+			name:   "valid",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) {},
+			want:   true,
+		},
+		{
+			name:   "expired",
+			ttl:    -time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) {},
+			want:   false,
+		},
+		{
+			name:   "other secret",
+			ttl:    time.Minute,
+			secret: []byte("other"),
+			tamper: func(req *lessons.DownloadAttachmentRequest) {},
+			want:   false,
+		},
+		{
+			name:   "other attachment",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) { req.AttachmentID = "other" },
+			want:   false,
+		},
+		{
+			name:   "other user",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) { req.UserID = "other" },
+			want:   false,
+		},
+		{
+			name:   "extended expiry",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) { req.Expires += 3600 },
+			want:   false,
+		},
+		{
+			name:   "changed signature",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) {
+				last := "0"
+				if strings.HasSuffix(req.Signature, "0") {
+					last = "1"
+				}
+				req.Signature = req.Signature[:len(req.Signature)-1] + last
+			},
+			want: false,
+		},
+		{
+			name:   "malformed signature",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) { req.Signature = "not-hex" },
+			want:   false,
+		},
+		{
+			name:   "empty signature",
+			ttl:    time.Minute,
+			secret: secret,
+			tamper: func(req *lessons.DownloadAttachmentRequest) { req.Signature = "" },
+			want:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			link, _ := lessons.SignDownloadURL(secret, "attachment", "user", tc.ttl)
+			req := parseDownloadURL(t, link)
+			tc.tamper(&req)
+
+			assert.Equal(t, tc.want, lessons.VerifyDownload(tc.secret, req))
+		})
+	}
+}
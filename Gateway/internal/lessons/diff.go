@@ -0,0 +1,142 @@
+package lessons
+
+import (
+	"slices"
+	"strings"
+)
+
+// Изменения строки в сравнении версий
+const (
+	DiffEqual  = "equal"
+	DiffDelete = "delete"
+	DiffInsert = "insert"
+)
+
+// Предел числа изменённых строк для поиска кратчайшего сравнения. Память поиска растёт
+// квадратично от числа изменений, поэтому при большем числе изменений различающийся
+// участок целиком показывается удалённым и вставленным заново
+const maxDiffEdits = 1000
+
+func NewLessonRevisionsDiffResponse(from, to GetLessonRevisionResponse) LessonRevisionsDiffResponse {
+	lines := DiffLines(from.Revision.Content, to.Revision.Content)
+	from.Revision.Content, to.Revision.Content = "", ""
+	return LessonRevisionsDiffResponse{
+		From:  from.Revision,
+		To:    to.Revision,
+		Lines: lines,
+	}
+}
+
+// DiffLines сравнивает тексты построчно алгоритмом Майерса и возвращает кратчайший
+// набор удалённых и вставленных строк
+func DiffLines(from, to string) []DiffLine {
+	a, b := splitLines(from), splitLines(to)
+
+	// Обычно правка затрагивает несколько строк, общие начало и конец в поиске не участвуют
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]DiffLine, 0, len(a)+len(b)-prefix-suffix)
+	for _, line := range a[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Поиск идёт по диагоналям k = x - y: v[k] - самый дальний x на диагонали k после d
+// изменений. Для обратного прохода сохраняются значения v перед каждым шагом d
+func diffMiddle(a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+	for d := 0; d <= n+m && d <= maxDiffEdits; d++ {
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return diffBacktrack(a, b, trace)
+			}
+		}
+	}
+	return diffReplace(a, b)
+}
+
+// trace[d] хранит v для диагоналей от -d до d со сдвигом d
+func diffBacktrack(a, b []string, trace [][]int) []DiffLine {
+	x, y := len(a), len(b)
+	lines := make([]DiffLine, 0, x+y)
+
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && v[d+k-1] < v[d+k+1]) {
+			prevK = k + 1
+		}
+		prevX := v[d+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			lines = append(lines, DiffLine{Op: DiffEqual, Text: a[x]})
+		}
+		if x == prevX {
+			y--
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: b[y]})
+		} else {
+			x--
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: a[x]})
+		}
+	}
+	// Без изменений остаются только общие строки
+	for x > 0 {
+		x--
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: a[x]})
+	}
+
+	slices.Reverse(lines)
+	return lines
+}
+
+func diffReplace(a, b []string) []DiffLine {
+	lines := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a {
+		lines = append(lines, DiffLine{Op: DiffDelete, Text: line})
+	}
+	for _, line := range b {
+		lines = append(lines, DiffLine{Op: DiffInsert, Text: line})
+	}
+	return lines
+}
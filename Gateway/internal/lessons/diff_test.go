@@ -0,0 +1,107 @@
+package lessons_test
+
+import (
+	"Classroom/Gateway/internal/lessons"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	eq := func(text string) lessons.DiffLine { return lessons.DiffLine{Op: lessons.DiffEqual, Text: text} }
+	del := func(text string) lessons.DiffLine { return lessons.DiffLine{Op: lessons.DiffDelete, Text: text} }
+	ins := func(text string) lessons.DiffLine { return lessons.DiffLine{Op: lessons.DiffInsert, Text: text} }
+
+	testCases := []struct {
+		name string
+		from string
+		to   string
+		want []lessons.DiffLine
+	}{
+		{
+			name: "both empty",
+			from: "",
+			to:   "",
+			want: []lessons.DiffLine{},
+		},
+		{
+			name: "identical",
+			from: "a\nb\nc",
+			to:   "a\nb\nc",
+			want: []lessons.DiffLine{eq("a"), eq("b"), eq("c")},
+		},
+		{
+			name: "line endings and trailing newline are ignored",
+			from: "a\r\nb\r\n",
+			to:   "a\nb",
+			want: []lessons.DiffLine{eq("a"), eq("b")},
+		},
+		{
+			name: "from empty",
+			from: "",
+			to:   "a\nb",
+			want: []lessons.DiffLine{ins("a"), ins("b")},
+		},
+		{
+			name: "to empty",
+			from: "a\nb",
+			to:   "",
+			want: []lessons.DiffLine{del("a"), del("b")},
+		},
+		{
+			name: "insert in the middle",
+			from: "a\nc",
+			to:   "a\nb\nc",
+			want: []lessons.DiffLine{eq("a"), ins("b"), eq("c")},
+		},
+		{
+			name: "delete in the middle",
+			from: "a\nb\nc",
+			to:   "a\nc",
+			want: []lessons.DiffLine{eq("a"), del("b"), eq("c")},
+		},
+		{
+			name: "replace a line",
+			from: "a\nb\nc",
+			to:   "a\nx\nc",
+			want: []lessons.DiffLine{eq("a"), del("b"), ins("x"), eq("c")},
+		},
+		{
+			name: "shortest edit keeps common lines",
+			from: "a\nb\nc\na\nb\nb\na",
+			to:   "c\nb\na\nb\na\nc",
+			want: []lessons.DiffLine{
+				del("a"), del("b"), eq("c"), ins("b"), eq("a"), eq("b"), del("b"), eq("a"), ins("c"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := lessons.DiffLines(tc.from, tc.to)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// Если изменений больше предела поиска, различающийся участок заменяется целиком
+func TestDiffLines_TooManyEdits(t *testing.T) {
+	from := make([]string, 1500)
+	to := make([]string, 1500)
+	for i := range from {
+		from[i] = "a" + strings.Repeat("x", i%7) + string(rune('0'+i%10))
+		to[i] = "b" + strings.Repeat("y", i%5) + string(rune('0'+i%10))
+	}
+
+	got := lessons.DiffLines("same\n"+strings.Join(from, "\n"), "same\n"+strings.Join(to, "\n"))
+
+	assert.Len(t, got, 1+len(from)+len(to))
+	assert.Equal(t, lessons.DiffLine{Op: lessons.DiffEqual, Text: "same"}, got[0])
+	for i, line := range got[1 : 1+len(from)] {
+		assert.Equal(t, lessons.DiffLine{Op: lessons.DiffDelete, Text: from[i]}, line)
+	}
+	for i, line := range got[1+len(from):] {
+		assert.Equal(t, lessons.DiffLine{Op: lessons.DiffInsert, Text: to[i]}, line)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"Classroom/Gateway/pkg/config"
 	"Classroom/Gateway/pkg/logger"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"time"
 
@@ -92,6 +94,34 @@ func (s *LessonsServiceClient) GetLessons(ctx context.Context, req GetLessonsReq
 	return NewGetLessonsResponse(resp), nil
 }
 
+func (s *LessonsServiceClient) ReorderLessons(ctx context.Context, req ReorderLessonsRequest) (ReorderLessonsResponse, error) {
+	logger.Debug(ctx, "Reordering lessons", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ReorderLessons(ctx, NewReorderLessonsRequest(req))
+	if err != nil {
+		return ReorderLessonsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.ReorderLessons succeed")
+	return NewReorderLessonsResponse(resp), nil
+}
+
+func (s *LessonsServiceClient) PreviewLesson(ctx context.Context, req PreviewLessonRequest) (PreviewLessonResponse, error) {
+	logger.Debug(ctx, "Previewing lesson", slog.String("course_id", req.CourseID))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.PreviewLesson(ctx, NewPreviewLessonRequest(req))
+	if err != nil {
+		return PreviewLessonResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.PreviewLesson succeed")
+	return NewPreviewLessonResponse(resp), nil
+}
+
 func (s *LessonsServiceClient) UpdateLesson(ctx context.Context, req UpdateLessonRequest) (UpdateLessonResponse, error) {
 	logger.Debug(ctx, "Updating lesson", slog.Any("request", req))
 	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
@@ -119,3 +149,185 @@ func (s *LessonsServiceClient) DeleteLesson(ctx context.Context, req DeleteLesso
 	logger.Debug(ctx, "Lessons.DeleteLesson succeed")
 	return NewDeleteLessonResponse(resp), nil
 }
+
+func (s *LessonsServiceClient) ListLessonRevisions(ctx context.Context, req ListLessonRevisionsRequest) (ListLessonRevisionsResponse, error) {
+	logger.Debug(ctx, "Listing lesson revisions", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListLessonRevisions(ctx, NewListLessonRevisionsRequest(req))
+	if err != nil {
+		return ListLessonRevisionsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.ListLessonRevisions succeed")
+	return NewListLessonRevisionsResponse(resp), nil
+}
+
+func (s *LessonsServiceClient) GetLessonRevision(ctx context.Context, req GetLessonRevisionRequest) (GetLessonRevisionResponse, error) {
+	logger.Debug(ctx, "Getting lesson revision", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.GetLessonRevision(ctx, NewGetLessonRevisionRequest(req))
+	if err != nil {
+		return GetLessonRevisionResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.GetLessonRevision succeed")
+	return NewGetLessonRevisionResponse(resp), nil
+}
+
+func (s *LessonsServiceClient) RestoreLessonRevision(ctx context.Context, req RestoreLessonRevisionRequest) (RestoreLessonRevisionResponse, error) {
+	logger.Debug(ctx, "Restoring lesson revision", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.RestoreLessonRevision(ctx, NewRestoreLessonRevisionRequest(req))
+	if err != nil {
+		return RestoreLessonRevisionResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.RestoreLessonRevision succeed")
+	return NewRestoreLessonRevisionResponse(resp), nil
+}
+
+// Размер части файла в потоке загрузки
+const uploadChunkSize = 64 << 10
+
+// Загрузка идёт потоком без DefaultTimeout, время ограничено контекстом запроса.
+// Если чтение файла прервалось ошибкой, поток отменяется и файл не сохраняется
+func (s *LessonsServiceClient) UploadAttachment(ctx context.Context, req UploadAttachmentRequest, r io.Reader) (AttachmentResponse, error) {
+	logger.Debug(ctx, "Uploading attachment", slog.Any("request", req))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := s.Client.UploadAttachment(ctx)
+	if err != nil {
+		return AttachmentResponse{}, err
+	}
+	if err := stream.Send(NewAttachmentInfo(req)); err != nil {
+		_, err = stream.CloseAndRecv()
+		return AttachmentResponse{}, err
+	}
+
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 {
+			chunk := &pb.UploadAttachmentRequest{Payload: &pb.UploadAttachmentRequest_Chunk{Chunk: buf[:n]}}
+			if err := stream.Send(chunk); err != nil {
+				// Сервер закрыл поток, настоящая ошибка приходит из CloseAndRecv
+				_, err = stream.CloseAndRecv()
+				return AttachmentResponse{}, err
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return AttachmentResponse{}, readErr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return AttachmentResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.UploadAttachment succeed")
+	return AttachmentResponse{Attachment: NewAttachment(resp.GetAttachment())}, nil
+}
+
+func (s *LessonsServiceClient) GetAttachment(ctx context.Context, attachmentID string) (AttachmentResponse, error) {
+	logger.Debug(ctx, "Getting attachment", slog.String("attachment_id", attachmentID))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.GetAttachment(ctx, &pb.GetAttachmentRequest{AttachmentId: attachmentID})
+	if err != nil {
+		return AttachmentResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.GetAttachment succeed")
+	return AttachmentResponse{Attachment: NewAttachment(resp.GetAttachment())}, nil
+}
+
+func (s *LessonsServiceClient) ListAttachments(ctx context.Context, req ListAttachmentsRequest) (ListAttachmentsResponse, error) {
+	logger.Debug(ctx, "Listing attachments", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.ListAttachments(ctx, NewListAttachmentsRequest(req))
+	if err != nil {
+		return ListAttachmentsResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.ListAttachments succeed")
+	return NewListAttachmentsResponse(resp), nil
+}
+
+func (s *LessonsServiceClient) DeleteAttachment(ctx context.Context, req DeleteAttachmentRequest) (AttachmentResponse, error) {
+	logger.Debug(ctx, "Deleting attachment", slog.Any("request", req))
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTimeout)
+	defer cancel()
+
+	resp, err := s.Client.DeleteAttachment(ctx, NewDeleteAttachmentRequest(req))
+	if err != nil {
+		return AttachmentResponse{}, err
+	}
+
+	logger.Debug(ctx, "Lessons.DeleteAttachment succeed")
+	return AttachmentResponse{Attachment: NewAttachment(resp.GetAttachment())}, nil
+}
+
+// Первое сообщение потока читается сразу, поэтому ошибки вроде NotFound приходят
+// до того, как начнётся ответ клиенту. Содержимое читается из AttachmentReader,
+// его нужно закрыть
+func (s *LessonsServiceClient) DownloadAttachment(ctx context.Context, attachmentID string) (Attachment, *AttachmentReader, error) {
+	logger.Debug(ctx, "Downloading attachment", slog.String("attachment_id", attachmentID))
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := s.Client.DownloadAttachment(ctx, &pb.DownloadAttachmentRequest{AttachmentId: attachmentID})
+	if err != nil {
+		cancel()
+		return Attachment{}, nil, err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return Attachment{}, nil, err
+	}
+	if first.GetAttachment() == nil {
+		cancel()
+		return Attachment{}, nil, fmt.Errorf("first message of download stream has no attachment")
+	}
+
+	logger.Debug(ctx, "Lessons.DownloadAttachment succeed")
+	return NewAttachment(first.GetAttachment()), &AttachmentReader{stream: stream, cancel: cancel}, nil
+}
+
+// AttachmentReader отдаёт содержимое файла из потока скачивания как io.Reader
+type AttachmentReader struct {
+	stream pb.LessonsService_DownloadAttachmentClient
+	cancel context.CancelFunc
+	buf    []byte
+}
+
+func (r *AttachmentReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = resp.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *AttachmentReader) Close() error {
+	r.cancel()
+	return nil
+}
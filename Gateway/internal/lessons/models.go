@@ -4,6 +4,8 @@ import (
 	"time"
 
 	pb "Classroom/Gateway/pkg/api/lessons"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Lesson - информация о занятии
@@ -15,12 +17,46 @@ type Lesson struct {
     CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
     // Название занятия
     Title string `json:"title" example:"Введение в программирование" extensions:"x-order=2"`
-    // Содержание занятия
-    Description string `json:"description" example:"Базовые понятия и термины" extensions:"x-order=3"`
+    // Содержание занятия в Markdown
+    Description string `json:"description" example:"Базовые **понятия** и термины" extensions:"x-order=3"`
     // Дата создания
     CreatedAt time.Time `json:"created_at" example:"2023-01-15T10:00:00Z" extensions:"x-order=4"`
+    // ID модуля курса, пусто - занятие без модуля
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=5"`
+    // Порядок занятия в курсе
+    Position int32 `json:"position" example:"0" extensions:"x-order=6"`
+    // Состояние публикации: draft, scheduled или published. Черновики и запланированные занятия видят только преподаватели
+    Status string `json:"status" example:"published" enums:"draft,scheduled,published" extensions:"x-order=7"`
+    // Время публикации: запланированное или фактическое, пусто у черновика
+    PublishAt *time.Time `json:"publish_at,omitempty" example:"2023-01-20T10:00:00Z" extensions:"x-order=8"`
+    // Содержание в HTML, отрисованное из Markdown и очищенное от опасных тегов и ссылок. Формулы приходят как \(...\) и \[...\] в span с классом math
+    DescriptionHTML string `json:"description_html" example:"<p>Базовые <strong>понятия</strong> и термины</p>" extensions:"x-order=9"`
 } // @name Lesson
 
+func NewLesson(lesson *pb.Lesson) Lesson {
+	resp := Lesson{
+		LessonID:        lesson.GetLessonId(),
+		CourseID:        lesson.GetCourseId(),
+		Title:           lesson.GetTitle(),
+		Description:     lesson.GetContent(),
+		DescriptionHTML: lesson.GetContentHtml(),
+		CreatedAt:       lesson.GetCreatedAt().AsTime(),
+		ModuleID:        lesson.ModuleId,
+		Position:        lesson.GetPosition(),
+		Status:          lesson.GetStatus(),
+	}
+	if lesson.PublishAt != nil {
+		publishAt := lesson.GetPublishAt().AsTime()
+		resp.PublishAt = &publishAt
+	}
+	return resp
+}
+
+// Published сообщает, что занятие видно студентам
+func (l Lesson) Published() bool {
+	return l.Status == "published"
+}
+
 // CreateLessonRequest - запрос на создание занятия
 // @Description Параметры для создания нового занятия в курсе
 type CreateLessonRequest struct {
@@ -30,16 +66,35 @@ type CreateLessonRequest struct {
     Title string `json:"title" example:"Основы алгоритмов" extensions:"x-order=1"`
     // Содержание занятия
     Content string `json:"content" example:"Подробное описание занятия..." extensions:"x-order=2"`
+    // ID модуля того же курса (опционально)
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=3"`
+    // Состояние: draft (по умолчанию), scheduled или published. Уведомление студентам уходит при публикации
+    Status *string `json:"status,omitempty" example:"scheduled" enums:"draft,scheduled,published" extensions:"x-order=4"`
+    // Время публикации, обязательно для scheduled
+    PublishAt *time.Time `json:"publish_at,omitempty" example:"2023-01-20T10:00:00Z" extensions:"x-order=5"`
+    // Автор, заполняется из токена
+    UserID string `json:"-"`
 } // @name CreateLessonRequest
 
 func NewCreateLessonRequest(req CreateLessonRequest) *pb.CreateLessonRequest {
 	return &pb.CreateLessonRequest{
-		CourseId: req.CourseID,
-		Title:    req.Title,
-		Content:  req.Content,
+		CourseId:  req.CourseID,
+		Title:     req.Title,
+		Content:   req.Content,
+		ModuleId:  req.ModuleID,
+		Status:    req.Status,
+		PublishAt: timestampPtr(req.PublishAt),
+		UserId:    req.UserID,
 	}
 }
 
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
 // CreateLessonResponse - ответ после создания занятия
 // @Description Возвращает ID созданного занятия
 type CreateLessonResponse struct {
@@ -75,13 +130,7 @@ type GetLessonResponse struct {
 
 func NewGetLessonResponse(resp *pb.GetLessonResponse) GetLessonResponse {
 	return GetLessonResponse{
-		Lesson: Lesson{
-			LessonID:    resp.GetLesson().GetLessonId(),
-			CourseID:    resp.GetLesson().GetCourseId(),
-			Title:       resp.GetLesson().GetTitle(),
-			Description: resp.GetLesson().GetContent(),
-			CreatedAt:   resp.GetLesson().GetCreatedAt().AsTime(),
-		},
+		Lesson: NewLesson(resp.GetLesson()),
 	}
 }
 
@@ -90,11 +139,14 @@ func NewGetLessonResponse(resp *pb.GetLessonResponse) GetLessonResponse {
 type GetLessonsRequest struct {
     // ID курса
     CourseID string `schema:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Вернуть черновики и запланированные занятия, заполняется по роли пользователя
+    IncludeUnpublished bool `schema:"-" json:"-"`
 } // @name GetLessonsRequest
 
 func NewGetLessonsRequest(req GetLessonsRequest) *pb.GetLessonsRequest {
 	return &pb.GetLessonsRequest{
-		CourseId: req.CourseID,
+		CourseId:           req.CourseID,
+		IncludeUnpublished: req.IncludeUnpublished,
 	}
 }
 
@@ -103,23 +155,85 @@ func NewGetLessonsRequest(req GetLessonsRequest) *pb.GetLessonsRequest {
 type GetLessonsResponse struct {
     // Массив занятий
     Lessons []Lesson `json:"lessons" extensions:"x-order=0"`
+    // Версия порядка занятий, передаётся в запрос перестановки
+    Version int64 `json:"version" example:"3" extensions:"x-order=1"`
 } // @name GetLessonsResponse
 
 func NewGetLessonsResponse(resp *pb.GetLessonsResponse) GetLessonsResponse {
 	return GetLessonsResponse{
-		Lessons: func() []Lesson {
-			lessons := make([]Lesson, 0, len(resp.GetLessons()))
-			for _, lesson := range resp.GetLessons() {
-				lessons = append(lessons, Lesson{
-					LessonID:    lesson.GetLessonId(),
-					CourseID:    lesson.GetCourseId(),
-					Title:       lesson.GetTitle(),
-					Description: lesson.GetContent(),
-					CreatedAt:   lesson.GetCreatedAt().AsTime(),
-				})
-			}
-			return lessons
-		}(),
+		Lessons: newLessons(resp.GetLessons()),
+		Version: resp.GetVersion(),
+	}
+}
+
+// PreviewLessonRequest - запрос отрисовки содержания
+// @Description Markdown содержание занятия для предпросмотра в редакторе
+type PreviewLessonRequest struct {
+    // ID курса, в котором редактируется занятие
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Содержание в Markdown: блоки кода, таблицы и формулы $...$ и $$...$$
+    Content string `json:"content" example:"Формула $a^2 + b^2 = c^2$" extensions:"x-order=1"`
+} // @name PreviewLessonRequest
+
+func NewPreviewLessonRequest(req PreviewLessonRequest) *pb.PreviewLessonRequest {
+	return &pb.PreviewLessonRequest{
+		Content: req.Content,
+	}
+}
+
+// PreviewLessonResponse - отрисованное содержание
+// @Description HTML, который получит занятие при сохранении этого содержания
+type PreviewLessonResponse struct {
+    // Содержание в HTML
+    ContentHTML string `json:"content_html" example:"<p>Формула <span class=\"math math-inline\">\\(a^2 + b^2 = c^2\\)</span></p>" extensions:"x-order=0"`
+} // @name PreviewLessonResponse
+
+func NewPreviewLessonResponse(resp *pb.PreviewLessonResponse) PreviewLessonResponse {
+	return PreviewLessonResponse{
+		ContentHTML: resp.GetContentHtml(),
+	}
+}
+
+func newLessons(pbLessons []*pb.Lesson) []Lesson {
+	lessons := make([]Lesson, 0, len(pbLessons))
+	for _, lesson := range pbLessons {
+		lessons = append(lessons, NewLesson(lesson))
+	}
+	return lessons
+}
+
+// ReorderLessonsRequest - запрос перестановки занятий
+// @Description Новый порядок всех занятий курса
+type ReorderLessonsRequest struct {
+    // ID курса
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // ID всех занятий курса в новом порядке
+    LessonIDs []string `json:"lesson_ids" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // Версия порядка из списка занятий
+    Version int64 `json:"version" example:"3" extensions:"x-order=2"`
+} // @name ReorderLessonsRequest
+
+func NewReorderLessonsRequest(req ReorderLessonsRequest) *pb.ReorderLessonsRequest {
+	return &pb.ReorderLessonsRequest{
+		CourseId:  req.CourseID,
+		LessonIds: req.LessonIDs,
+		Version:   req.Version,
+	}
+}
+
+// ReorderLessonsResponse - занятия в новом порядке
+// @Description Все занятия курса по новому порядку и новая версия порядка
+type ReorderLessonsResponse struct {
+    // Массив занятий
+    Lessons []Lesson `json:"lessons" extensions:"x-order=0"`
+    // Новая версия порядка занятий
+    Version int64 `json:"version" example:"4" extensions:"x-order=1"`
+} // @name ReorderLessonsResponse
+
+func NewReorderLessonsResponse(resp *pb.ReorderLessonsResponse) ReorderLessonsResponse {
+	return ReorderLessonsResponse{
+		Lessons: newLessons(resp.GetLessons()),
+		Version: resp.GetVersion(),
 	}
 }
 
@@ -132,13 +246,25 @@ type UpdateLessonRequest struct {
     Title *string `json:"title,omitempty" example:"Обновленное название" extensions:"x-order=1"`
     // Новое содержание (опционально)
     Content *string `json:"description,omitempty" example:"Обновленное содержание" extensions:"x-order=2"`
+    // Новый модуль того же курса, пустая строка убирает занятие из модуля (опционально)
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=3"`
+    // Новое состояние: draft, scheduled или published. Опубликованное занятие нельзя вернуть в черновики (опционально)
+    Status *string `json:"status,omitempty" example:"published" enums:"draft,scheduled,published" extensions:"x-order=4"`
+    // Время публикации для scheduled, без status переносит публикацию (опционально)
+    PublishAt *time.Time `json:"publish_at,omitempty" example:"2023-01-20T10:00:00Z" extensions:"x-order=5"`
+    // Автор изменения, заполняется из токена
+    UserID string `json:"-"`
 } // @name UpdateLessonRequest
 
 func NewUpdateLessonRequest(req UpdateLessonRequest) *pb.UpdateLessonRequest {
 	return &pb.UpdateLessonRequest{
-		LessonId: req.LessonID,
-		Title:    req.Title,
-		Content:  req.Content,
+		LessonId:  req.LessonID,
+		Title:     req.Title,
+		Content:   req.Content,
+		ModuleId:  req.ModuleID,
+		Status:    req.Status,
+		PublishAt: timestampPtr(req.PublishAt),
+		UserId:    req.UserID,
 	}
 }
 
@@ -173,3 +299,285 @@ type DeleteLessonResponse struct {
 func NewDeleteLessonResponse(resp *pb.DeleteLessonResponse) DeleteLessonResponse {
 	return DeleteLessonResponse{}
 }
+
+// LessonRevision - версия занятия
+// @Description Название и содержание занятия после одного изменения
+type LessonRevision struct {
+    // ID занятия
+    LessonID string `json:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Номер версии, начиная с 1
+    Revision int32 `json:"revision" example:"3" extensions:"x-order=1"`
+    // Название занятия
+    Title string `json:"title" example:"Введение в программирование" extensions:"x-order=2"`
+    // Содержание занятия, в списке версий не заполняется
+    Content string `json:"content,omitempty" example:"Базовые понятия и термины" extensions:"x-order=3"`
+    // ID автора изменения, пусто у версий, созданных до истории изменений
+    AuthorID *string `json:"author_id,omitempty" example:"a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a" extensions:"x-order=4"`
+    // Номер восстановленной версии, если версия создана восстановлением
+    RestoredFrom *int32 `json:"restored_from,omitempty" example:"1" extensions:"x-order=5"`
+    // Время изменения
+    CreatedAt time.Time `json:"created_at" example:"2023-01-15T10:00:00Z" extensions:"x-order=6"`
+} // @name LessonRevision
+
+func NewLessonRevision(revision *pb.LessonRevision) LessonRevision {
+	return LessonRevision{
+		LessonID:     revision.GetLessonId(),
+		Revision:     revision.GetRevision(),
+		Title:        revision.GetTitle(),
+		Content:      revision.GetContent(),
+		AuthorID:     revision.AuthorId,
+		RestoredFrom: revision.RestoredFrom,
+		CreatedAt:    revision.GetCreatedAt().AsTime(),
+	}
+}
+
+// ListLessonRevisionsRequest - запрос истории изменений занятия
+// @Description Возвращает версии занятия от новых к старым
+type ListLessonRevisionsRequest struct {
+    // ID занятия
+    LessonID string `schema:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ListLessonRevisionsRequest
+
+func NewListLessonRevisionsRequest(req ListLessonRevisionsRequest) *pb.ListLessonRevisionsRequest {
+	return &pb.ListLessonRevisionsRequest{
+		LessonId: req.LessonID,
+	}
+}
+
+// ListLessonRevisionsResponse - история изменений занятия
+// @Description Версии занятия без содержания, от новых к старым
+type ListLessonRevisionsResponse struct {
+    // Версии занятия
+    Revisions []LessonRevision `json:"revisions" extensions:"x-order=0"`
+} // @name ListLessonRevisionsResponse
+
+func NewListLessonRevisionsResponse(resp *pb.ListLessonRevisionsResponse) ListLessonRevisionsResponse {
+	revisions := make([]LessonRevision, 0, len(resp.GetRevisions()))
+	for _, revision := range resp.GetRevisions() {
+		revisions = append(revisions, NewLessonRevision(revision))
+	}
+	return ListLessonRevisionsResponse{Revisions: revisions}
+}
+
+// GetLessonRevisionRequest - запрос версии занятия
+// @Description Возвращает версию занятия с содержанием
+type GetLessonRevisionRequest struct {
+    // ID занятия
+    LessonID string `schema:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Номер версии
+    Revision int32 `schema:"revision" example:"2" extensions:"x-order=1"`
+} // @name GetLessonRevisionRequest
+
+func NewGetLessonRevisionRequest(req GetLessonRevisionRequest) *pb.GetLessonRevisionRequest {
+	return &pb.GetLessonRevisionRequest{
+		LessonId: req.LessonID,
+		Revision: req.Revision,
+	}
+}
+
+// GetLessonRevisionResponse - версия занятия
+// @Description Версия занятия с содержанием
+type GetLessonRevisionResponse struct {
+    // Версия занятия
+    Revision LessonRevision `json:"revision" extensions:"x-order=0"`
+} // @name GetLessonRevisionResponse
+
+func NewGetLessonRevisionResponse(resp *pb.GetLessonRevisionResponse) GetLessonRevisionResponse {
+	return GetLessonRevisionResponse{
+		Revision: NewLessonRevision(resp.GetRevision()),
+	}
+}
+
+// RestoreLessonRevisionRequest - запрос восстановления версии
+// @Description Возвращает занятию название и содержание выбранной версии
+type RestoreLessonRevisionRequest struct {
+    // ID занятия
+    LessonID string `json:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Номер восстанавливаемой версии
+    Revision int32 `json:"revision" example:"2" extensions:"x-order=1"`
+    // Автор восстановления, заполняется из токена
+    UserID string `json:"-"`
+} // @name RestoreLessonRevisionRequest
+
+func NewRestoreLessonRevisionRequest(req RestoreLessonRevisionRequest) *pb.RestoreLessonRevisionRequest {
+	return &pb.RestoreLessonRevisionRequest{
+		LessonId: req.LessonID,
+		Revision: req.Revision,
+		UserId:   req.UserID,
+	}
+}
+
+// RestoreLessonRevisionResponse - занятие после восстановления
+// @Description Занятие с восстановленными названием и содержанием
+type RestoreLessonRevisionResponse struct {
+    // Объект занятия
+    Lesson Lesson `json:"lesson" extensions:"x-order=0"`
+} // @name RestoreLessonRevisionResponse
+
+func NewRestoreLessonRevisionResponse(resp *pb.RestoreLessonRevisionResponse) RestoreLessonRevisionResponse {
+	return RestoreLessonRevisionResponse{
+		Lesson: NewLesson(resp.GetLesson()),
+	}
+}
+
+// LessonRevisionsDiffRequest - запрос сравнения версий
+// @Description Сравнивает две версии занятия построчно
+type LessonRevisionsDiffRequest struct {
+    // ID занятия
+    LessonID string `schema:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+    // Номер старой версии
+    From int32 `schema:"from" example:"1" extensions:"x-order=1"`
+    // Номер новой версии
+    To int32 `schema:"to" example:"3" extensions:"x-order=2"`
+} // @name LessonRevisionsDiffRequest
+
+// DiffLine - строка сравнения
+// @Description Строка содержания и её изменение между версиями
+type DiffLine struct {
+    // Изменение: equal - строка есть в обеих версиях, delete - только в старой, insert - только в новой
+    Op string `json:"op" example:"insert" enums:"equal,delete,insert" extensions:"x-order=0"`
+    // Текст строки
+    Text string `json:"text" example:"Базовые понятия и термины" extensions:"x-order=1"`
+} // @name DiffLine
+
+// LessonRevisionsDiffResponse - сравнение версий
+// @Description Названия обеих версий и построчное сравнение содержания
+type LessonRevisionsDiffResponse struct {
+    // Старая версия без содержания
+    From LessonRevision `json:"from" extensions:"x-order=0"`
+    // Новая версия без содержания
+    To LessonRevision `json:"to" extensions:"x-order=1"`
+    // Строки содержания по порядку: общие для версий, удалённые из старой и вставленные в новую
+    Lines []DiffLine `json:"lines" extensions:"x-order=2"`
+} // @name LessonRevisionsDiffResponse
+
+// Attachment - файл занятия
+// @Description Информация о файле, прикреплённом к занятию
+type Attachment struct {
+    // ID файла
+    AttachmentID string `json:"attachment_id" example:"5b1d2c3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e" extensions:"x-order=0"`
+    // ID занятия
+    LessonID string `json:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=1"`
+    // ID курса занятия
+    CourseID string `json:"course_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=2"`
+    // Имя файла
+    FileName string `json:"file_name" example:"slides.pdf" extensions:"x-order=3"`
+    // MIME тип файла
+    ContentType string `json:"content_type" example:"application/pdf" extensions:"x-order=4"`
+    // Размер файла в байтах
+    Size int64 `json:"size" example:"1048576" extensions:"x-order=5"`
+    // ID пользователя, загрузившего файл
+    UploadedBy string `json:"uploaded_by" example:"a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a" extensions:"x-order=6"`
+    // Время загрузки
+    CreatedAt time.Time `json:"created_at" example:"2023-01-15T10:00:00Z" extensions:"x-order=7"`
+} // @name Attachment
+
+func NewAttachment(a *pb.Attachment) Attachment {
+	return Attachment{
+		AttachmentID: a.GetAttachmentId(),
+		LessonID:     a.GetLessonId(),
+		CourseID:     a.GetCourseId(),
+		FileName:     a.GetFileName(),
+		ContentType:  a.GetContentType(),
+		Size:         a.GetSize(),
+		UploadedBy:   a.GetUploadedBy(),
+		CreatedAt:    a.GetCreatedAt().AsTime(),
+	}
+}
+
+// UploadAttachmentRequest - параметры загружаемого файла, содержимое передаётся потоком отдельно
+type UploadAttachmentRequest struct {
+	LessonID    string
+	FileName    string
+	ContentType string
+	UserID      string
+}
+
+func NewAttachmentInfo(req UploadAttachmentRequest) *pb.UploadAttachmentRequest {
+	return &pb.UploadAttachmentRequest{
+		Payload: &pb.UploadAttachmentRequest_Info{
+			Info: &pb.AttachmentInfo{
+				LessonId:    req.LessonID,
+				FileName:    req.FileName,
+				ContentType: req.ContentType,
+				UserId:      req.UserID,
+			},
+		},
+	}
+}
+
+// AttachmentResponse - файл занятия
+// @Description Возвращает загруженный или удалённый файл
+type AttachmentResponse struct {
+    // Файл
+    Attachment Attachment `json:"attachment" extensions:"x-order=0"`
+} // @name AttachmentResponse
+
+// ListAttachmentsRequest - запрос файлов занятия
+// @Description Возвращает файлы занятия в порядке загрузки
+type ListAttachmentsRequest struct {
+    // ID занятия
+    LessonID string `schema:"lesson_id" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=0"`
+} // @name ListAttachmentsRequest
+
+func NewListAttachmentsRequest(req ListAttachmentsRequest) *pb.ListAttachmentsRequest {
+	return &pb.ListAttachmentsRequest{
+		LessonId: req.LessonID,
+	}
+}
+
+// ListAttachmentsResponse - файлы занятия
+// @Description Содержит файлы занятия
+type ListAttachmentsResponse struct {
+    // Файлы
+    Attachments []Attachment `json:"attachments" extensions:"x-order=0"`
+} // @name ListAttachmentsResponse
+
+func NewListAttachmentsResponse(resp *pb.ListAttachmentsResponse) ListAttachmentsResponse {
+	attachments := make([]Attachment, 0, len(resp.GetAttachments()))
+	for _, a := range resp.GetAttachments() {
+		attachments = append(attachments, NewAttachment(a))
+	}
+	return ListAttachmentsResponse{Attachments: attachments}
+}
+
+// DeleteAttachmentRequest - запрос удаления файла
+// @Description Удаляет файл занятия
+type DeleteAttachmentRequest struct {
+    // ID файла
+    AttachmentID string `json:"attachment_id" example:"5b1d2c3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e" extensions:"x-order=0"`
+} // @name DeleteAttachmentRequest
+
+func NewDeleteAttachmentRequest(req DeleteAttachmentRequest) *pb.DeleteAttachmentRequest {
+	return &pb.DeleteAttachmentRequest{
+		AttachmentId: req.AttachmentID,
+	}
+}
+
+// GetAttachmentURLRequest - запрос ссылки на скачивание
+// @Description Выдаёт подписанную ссылку на скачивание файла
+type GetAttachmentURLRequest struct {
+    // ID файла
+    AttachmentID string `schema:"attachment_id" example:"5b1d2c3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e" extensions:"x-order=0"`
+} // @name GetAttachmentURLRequest
+
+// AttachmentURLResponse - ссылка на скачивание
+// @Description Подписанная ссылка на скачивание файла, действует до expires_at
+type AttachmentURLResponse struct {
+    // Ссылка на скачивание относительно адреса Gateway
+    URL string `json:"url" example:"/api/lessons/attachments/download?attachment_id=5b1d2c3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e&user_id=a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a&expires=1700000000&signature=9f86d08188" extensions:"x-order=0"`
+    // Время, до которого действует ссылка
+    ExpiresAt time.Time `json:"expires_at" example:"2023-01-15T10:15:00Z" extensions:"x-order=1"`
+} // @name AttachmentURLResponse
+
+// DownloadAttachmentRequest - параметры подписанной ссылки
+type DownloadAttachmentRequest struct {
+    // ID файла
+    AttachmentID string `schema:"attachment_id"`
+    // ID пользователя, которому выдана ссылка
+    UserID string `schema:"user_id"`
+    // Время окончания действия ссылки, unix секунды
+    Expires int64 `schema:"expires"`
+    // Подпись ссылки
+    Signature string `schema:"signature"`
+}
@@ -66,6 +66,70 @@ func Delete(rc *redis.Client, ctx context.Context, method string, id string) err
     return nil
 }
 
+// Значение с общим ключом группы, например права всех участников одного курса.
+// Группу можно удалить целиком через Delete с ID группы
+type groupEntry struct {
+	ExpiresAt time.Time       `json:"expires_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+func GetField[T any](rc *redis.Client, ctx context.Context, method, group, field string) (T, error) {
+	var payload T
+
+	data, err := rc.HGet(ctx, encodeKey(method, group), field).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return payload, redis.Nil
+	}
+	if err != nil {
+		return payload, err
+	}
+
+	var entry groupEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return payload, err
+	}
+	// Время жизни ключа группы продлевается при каждой записи, поэтому срок
+	// значения проверяется отдельно
+	if time.Now().After(entry.ExpiresAt) {
+		return payload, redis.Nil
+	}
+
+	if err := json.Unmarshal(entry.Value, &payload); err != nil {
+		return payload, err
+	}
+
+	return payload, nil
+}
+
+func PutField(rc *redis.Client, ctx context.Context, method, group, field string, body any, ttl time.Duration) error {
+	value, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(groupEntry{ExpiresAt: time.Now().Add(ttl), Value: value})
+	if err != nil {
+		return err
+	}
+
+	key := encodeKey(method, group)
+	pipe := rc.TxPipeline()
+	pipe.HSet(ctx, key, field, data)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteField(rc *redis.Client, ctx context.Context, method, group, field string) error {
+	key := encodeKey(method, group)
+	if err := rc.HDel(ctx, key, field).Err(); err != nil {
+		return fmt.Errorf("failed to delete field %s of key %s: %w", field, key, err)
+	}
+	return nil
+}
+
 func encodeKey(method, id string) string {
 	return fmt.Sprintf("%s:%s", method, id)
 }
\ No newline at end of file
@@ -2,10 +2,17 @@ package server
 
 import (
 	"Classroom/Gateway/internal/auth"
+	app "Classroom/Gateway/internal/logger"
+	"Classroom/Gateway/internal/redis"
 	"Classroom/Gateway/pkg/logger"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/netip"
+	"strings"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -50,7 +57,7 @@ func (s *Server) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 
 // LoginHandler аутентифицирует пользователя
 // @Summary Вход в систему
-// @Description Возвращает токены доступа и обновления
+// @Description Возвращает токены доступа и обновления. Если у пользователя подключена двухфакторная аутентификация, возвращает mfa_token, по которому токены выдаются через /auth/mfa/verify
 // @Tags Auth
 // @Accept json
 // @Produce json
@@ -58,11 +65,15 @@ func (s *Server) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} auth.LoginResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Неверные учетные данные"
+// @Failure 403 {object} ErrorResponse "Почта не подтверждена или пользователь отключен"
+// @Failure 429 {object} ErrorResponse "Слишком много неудачных попыток, время до следующей попытки в заголовке Retry-After"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
 // @Router /auth/login [post]
 func (s *Server) LoginHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[auth.LoginRequest](r.Context())
+	body.UserAgent = r.UserAgent()
+	body.IP = s.clientIP(r)
 
 	resp, err := s.Auth.Login(r.Context(), body)
 	if err != nil {
@@ -74,6 +85,10 @@ func (s *Server) LoginHandler(w http.ResponseWriter, r *http.Request) {
 				BadRequest(w, e.Message())
 			case codes.Unauthenticated:
 				Unauthorized(w, "invalid credentials")
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
+			case codes.ResourceExhausted:
+				TooManyRequests(w, retryAfter(e), "too many login attempts")
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -88,13 +103,14 @@ func (s *Server) LoginHandler(w http.ResponseWriter, r *http.Request) {
 
 // RefreshHandler обновляет токен доступа
 // @Summary Обновление токена
-// @Description Генерирует новую пару токенов по refresh-токену
+// @Description Генерирует новую пару токенов по refresh-токену. Переданный refresh-токен становится недействительным, его повторное использование отзывает всю цепочку токенов
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param request body auth.RefreshRequest true "Refresh токен"
 // @Success 200 {object} auth.RefreshResponse
 // @Failure 401 {object} ErrorResponse "Неверный токен"
+// @Failure 403 {object} ErrorResponse "Пользователь отключен"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
 // @Router /auth/refresh [post]
@@ -109,6 +125,8 @@ func (s *Server) RefreshHandler(w http.ResponseWriter, r *http.Request) {
 			switch e.Code() {
 			case codes.Unauthenticated:
 				Unauthorized(w, "invalid refresh token")
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -192,3 +210,1074 @@ func (s *Server) GetUserInfoHandler(w http.ResponseWriter, r *http.Request) {
 
 	WriteJSON(w, resp, http.StatusOK)
 }
+
+// RequestPasswordResetHandler отправляет письмо для сброса пароля
+// @Summary Запрос сброса пароля
+// @Description Отправляет на почту одноразовую ссылку для сброса пароля. Ответ не зависит от того, существует ли пользователь
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body auth.RequestPasswordResetRequest true "Email пользователя"
+// @Success 200 {object} auth.RequestPasswordResetResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/password-reset/request [post]
+func (s *Server) RequestPasswordResetHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.RequestPasswordResetRequest](r.Context())
+
+	resp, err := s.Auth.RequestPasswordReset(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.RequestPasswordReset error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ResetPasswordHandler устанавливает новый пароль
+// @Summary Сброс пароля
+// @Description Устанавливает новый пароль по токену из письма и завершает все сессии пользователя
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body auth.ResetPasswordRequest true "Токен и новый пароль"
+// @Success 200 {object} auth.ResetPasswordResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или недействительный токен"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/password-reset/confirm [post]
+func (s *Server) ResetPasswordHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ResetPasswordRequest](r.Context())
+
+	resp, err := s.Auth.ResetPassword(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ResetPassword error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// VerifyEmailHandler подтверждает почту пользователя
+// @Summary Подтверждение почты
+// @Description Подтверждает почту по одноразовому токену из письма
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body auth.VerifyEmailRequest true "Токен из письма"
+// @Success 200 {object} auth.VerifyEmailResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или недействительный токен"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/verify-email [post]
+func (s *Server) VerifyEmailHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.VerifyEmailRequest](r.Context())
+
+	resp, err := s.Auth.VerifyEmail(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.VerifyEmail error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	err = redis.Delete(s.Redis, r.Context(), "Auth.GetUserInfo", resp.UserID)
+	logger.Debug(r.Context(), "Auth.GetUserInfo cache invalidated", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ResendVerificationEmailHandler повторно отправляет письмо для подтверждения почты
+// @Summary Повторное письмо для подтверждения почты
+// @Description Отправляет новое письмо со ссылкой для подтверждения. Ответ не зависит от того, существует ли пользователь
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body auth.ResendVerificationEmailRequest true "Email пользователя"
+// @Success 200 {object} auth.ResendVerificationEmailResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/verify-email/resend [post]
+func (s *Server) ResendVerificationEmailHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ResendVerificationEmailRequest](r.Context())
+
+	resp, err := s.Auth.ResendVerificationEmail(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ResendVerificationEmail error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// JWKSHandler возвращает публичные ключи для проверки access токенов
+// @Summary Публичные ключи
+// @Description Возвращает JWKS, по которому можно проверить подпись access токена по его kid
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} auth.JWKS
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /.well-known/jwks.json [get]
+func (s *Server) JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := app.NewLogger(r.Context(), true)
+
+	resp, err := s.Keys.JWKS(ctx)
+	if err != nil {
+		logger.Error(ctx, "Handler auth.GetJWKS error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListSessionsHandler возвращает активные сессии пользователя
+// @Summary Активные сессии
+// @Description Возвращает список устройств, на которых выполнен вход
+// @Tags Auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} auth.ListSessionsResponse
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/sessions [get]
+func (s *Server) ListSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, _ := GetClaims(r.Context())
+	body := auth.ListSessionsRequest{UserID: claims.UserID}
+
+	resp, err := s.Auth.ListSessions(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ListSessions error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RevokeSessionHandler завершает сессию пользователя
+// @Summary Завершение сессии
+// @Description Отзывает refresh токены выбранной сессии
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.RevokeSessionRequest true "Идентификатор сессии"
+// @Success 200 {object} auth.RevokeSessionResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Сессия не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/sessions/session [delete]
+func (s *Server) RevokeSessionHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.RevokeSessionRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.RevokeSession(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.RevokeSession error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "session not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RevokeAllSessionsHandler завершает все сессии пользователя
+// @Summary Завершение всех сессий
+// @Description Отзывает refresh токены на всех устройствах, включая текущее
+// @Tags Auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} auth.RevokeAllSessionsResponse
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/sessions [delete]
+func (s *Server) RevokeAllSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, _ := GetClaims(r.Context())
+	body := auth.RevokeAllSessionsRequest{UserID: claims.UserID}
+
+	resp, err := s.Auth.RevokeAllSessions(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.RevokeAllSessions error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// SetupMFAHandler начинает подключение двухфакторной аутентификации
+// @Summary Подключение двухфакторной аутентификации
+// @Description Генерирует секрет TOTP для приложения-аутентификатора. Двухфакторная аутентификация начинает действовать после подтверждения кодом
+// @Tags Auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} auth.SetupMFAResponse
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 409 {object} ErrorResponse "Двухфакторная аутентификация уже подключена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/mfa/setup [post]
+func (s *Server) SetupMFAHandler(w http.ResponseWriter, r *http.Request) {
+	claims, _ := GetClaims(r.Context())
+	body := auth.SetupMFARequest{UserID: claims.UserID}
+
+	resp, err := s.Auth.SetupMFA(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.SetupMFA error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.AlreadyExists:
+				AlreadyExists(w, "mfa already enabled")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ConfirmMFAHandler подтверждает подключение двухфакторной аутентификации
+// @Summary Подтверждение двухфакторной аутентификации
+// @Description Проверяет код из приложения и возвращает одноразовые коды восстановления, они показываются только один раз
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.ConfirmMFARequest true "Код из приложения"
+// @Success 200 {object} auth.ConfirmMFAResponse
+// @Failure 400 {object} ErrorResponse "Неверный код или подключение не начато"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 409 {object} ErrorResponse "Двухфакторная аутентификация уже подключена"
+// @Failure 429 {object} ErrorResponse "Слишком много неверных кодов, время до следующей попытки в заголовке Retry-After"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/mfa/confirm [post]
+func (s *Server) ConfirmMFAHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ConfirmMFARequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.ConfirmMFA(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ConfirmMFA error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.AlreadyExists:
+				AlreadyExists(w, "mfa already enabled")
+			case codes.ResourceExhausted:
+				TooManyRequests(w, retryAfter(e), "too many mfa attempts")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// DisableMFAHandler отключает двухфакторную аутентификацию
+// @Summary Отключение двухфакторной аутентификации
+// @Description Отключает двухфакторную аутентификацию по паролю и коду из приложения или коду восстановления
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.DisableMFARequest true "Пароль и код из приложения или код восстановления"
+// @Success 200 {object} auth.DisableMFAResponse
+// @Failure 400 {object} ErrorResponse "Неверный код или двухфакторная аутентификация не подключена"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Неверный пароль"
+// @Failure 429 {object} ErrorResponse "Слишком много неверных кодов или паролей, время до следующей попытки в заголовке Retry-After"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/mfa/disable [post]
+func (s *Server) DisableMFAHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.DisableMFARequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.DisableMFA(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.DisableMFA error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, "invalid password")
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.ResourceExhausted:
+				TooManyRequests(w, retryAfter(e), "too many mfa attempts")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// VerifyMFAHandler завершает вход с двухфакторной аутентификацией
+// @Summary Подтверждение входа кодом
+// @Description Проверяет код из приложения или код восстановления по токену из /auth/login и возвращает токены доступа и обновления
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body auth.VerifyMFARequest true "Токен входа и код"
+// @Success 200 {object} auth.VerifyMFAResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Неверный код или токен входа"
+// @Failure 403 {object} ErrorResponse "Пользователь отключен"
+// @Failure 429 {object} ErrorResponse "Слишком много неверных кодов, время до следующей попытки в заголовке Retry-After"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/mfa/verify [post]
+func (s *Server) VerifyMFAHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.VerifyMFARequest](r.Context())
+
+	resp, err := s.Auth.VerifyMFA(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.VerifyMFA error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unauthenticated:
+				Unauthorized(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
+			case codes.ResourceExhausted:
+				TooManyRequests(w, retryAfter(e), "too many mfa attempts")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListLockoutsHandler возвращает блокировки входа
+// @Summary Блокировки входа
+// @Description Возвращает почты и IP адреса, вход с которых заблокирован после неудачных попыток. Доступно только суперпользователям
+// @Tags Auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} auth.ListLockoutsResponse
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/lockouts [get]
+func (s *Server) ListLockoutsHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.Auth.ListLockouts(r.Context(), auth.ListLockoutsRequest{})
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ListLockouts error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ClearLockoutHandler снимает блокировку входа
+// @Summary Снятие блокировки входа
+// @Description Снимает блокировку и сбрасывает счетчик неудачных попыток для почты или IP адреса. Доступно только суперпользователям
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.ClearLockoutRequest true "Почта или IP адрес"
+// @Success 200 {object} auth.ClearLockoutResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 404 {object} ErrorResponse "Блокировка не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/lockouts [delete]
+func (s *Server) ClearLockoutHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ClearLockoutRequest](r.Context())
+
+	resp, err := s.Auth.ClearLockout(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ClearLockout error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "lockout not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// CreateAccessTokenHandler создает персональный токен доступа
+// @Summary Создание персонального токена доступа
+// @Description Создает токен для скриптов и интеграций. Токен действует только на маршрутах, которые разрешены его scopes, и показывается один раз
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.CreateAccessTokenRequest true "Название, разрешения и срок действия"
+// @Success 201 {object} auth.CreateAccessTokenResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Недоступно для персональных токенов"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/access-tokens [post]
+func (s *Server) CreateAccessTokenHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.CreateAccessTokenRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.CreateAccessToken(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.CreateAccessToken error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusCreated)
+}
+
+// ListAccessTokensHandler возвращает персональные токены доступа пользователя
+// @Summary Список персональных токенов доступа
+// @Description Возвращает токены пользователя без их значений
+// @Tags Auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} auth.ListAccessTokensResponse
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Недоступно для персональных токенов"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/access-tokens [get]
+func (s *Server) ListAccessTokensHandler(w http.ResponseWriter, r *http.Request) {
+	claims, _ := GetClaims(r.Context())
+	body := auth.ListAccessTokensRequest{UserID: claims.UserID}
+
+	resp, err := s.Auth.ListAccessTokens(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ListAccessTokens error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RevokeAccessTokenHandler отзывает персональный токен доступа
+// @Summary Отзыв персонального токена доступа
+// @Description Удаляет токен. Gateway может принимать его еще до access_token_cache_ttl после отзыва
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.RevokeAccessTokenRequest true "Идентификатор токена"
+// @Success 200 {object} auth.RevokeAccessTokenResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Недоступно для персональных токенов"
+// @Failure 404 {object} ErrorResponse "Токен не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/access-tokens [delete]
+func (s *Server) RevokeAccessTokenHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.RevokeAccessTokenRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.RevokeAccessToken(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.RevokeAccessToken error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "access token not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// UpdateProfileHandler изменяет имя и фамилию пользователя
+// @Summary Изменение профиля
+// @Description Изменяет только переданные поля профиля
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.UpdateProfileRequest true "Новые имя и фамилия"
+// @Success 200 {object} auth.UpdateProfileResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/profile [patch]
+func (s *Server) UpdateProfileHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.UpdateProfileRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.UpdateProfile(r.Context(), s.Redis, body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.UpdateProfile error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ChangePasswordHandler меняет пароль пользователя
+// @Summary Смена пароля
+// @Description Меняет пароль по текущему паролю. Все сессии, кроме сессии переданного refresh токена, завершаются
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.ChangePasswordRequest true "Текущий и новый пароль"
+// @Success 200 {object} auth.ChangePasswordResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Неверный текущий пароль"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/profile/password [patch]
+func (s *Server) ChangePasswordHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ChangePasswordRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.ChangePassword(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ChangePassword error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ChangeEmailHandler меняет почту пользователя
+// @Summary Смена почты
+// @Description Меняет почту по текущему паролю. Новую почту нужно подтвердить по ссылке из письма
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.ChangeEmailRequest true "Текущий пароль и новая почта"
+// @Success 200 {object} auth.ChangeEmailResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Неверный текущий пароль"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 409 {object} ErrorResponse "Почта уже занята"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /auth/profile/email [patch]
+func (s *Server) ChangeEmailHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ChangeEmailRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Auth.ChangeEmail(r.Context(), s.Redis, body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ChangeEmail error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
+			case codes.AlreadyExists:
+				AlreadyExists(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListUsersHandler возвращает пользователей
+// @Summary Список пользователей
+// @Description Поиск пользователей по подстроке почты, имени или фамилии с пагинацией. Доступно только суперпользователям
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param query query string false "Подстрока почты, имени или фамилии" example(ivanov)
+// @Param index query int false "Номер страницы, начиная с 0" example(0)
+// @Param limit query int true "Размер страницы, не больше 100" example(20)
+// @Success 200 {object} auth.ListUsersResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/users [get]
+func (s *Server) ListUsersHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.ListUsersRequest](r.Context())
+
+	resp, err := s.Auth.ListUsers(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.ListUsers error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// GetUserHandler возвращает пользователя
+// @Summary Пользователь
+// @Description Возвращает пользователя вместе со служебными полями. Доступно только суперпользователям
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query string true "Идентификатор пользователя" example(d277084b-e1f6-4670-825b-53951d20b5d3)
+// @Success 200 {object} auth.GetUserResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/users/user [get]
+func (s *Server) GetUserHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.GetUserRequest](r.Context())
+
+	resp, err := s.Auth.GetUser(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.GetUser error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// SetSuperuserHandler выдает или снимает права суперпользователя
+// @Summary Права суперпользователя
+// @Description Выдает или снимает права суперпользователя. Права применяются к новым access токенам пользователя. Снять права с себя нельзя. Доступно только суперпользователям
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.SetSuperuserRequest true "Пользователь и права"
+// @Success 200 {object} auth.SetSuperuserResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/users/user/superuser [patch]
+func (s *Server) SetSuperuserHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.SetSuperuserRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	if body.UserID == claims.UserID && !body.IsSuperUser {
+		BadRequest(w, "cannot revoke own superuser rights")
+		return
+	}
+
+	resp, err := s.Auth.SetSuperuser(r.Context(), s.Redis, body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.SetSuperuser error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// DisableUserHandler отключает или включает пользователя
+// @Summary Отключение пользователя
+// @Description Отключенный пользователь не может войти и обновить токены, его сессии завершаются. Отключить себя нельзя. Доступно только суперпользователям
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.DisableUserRequest true "Пользователь и признак отключения"
+// @Success 200 {object} auth.DisableUserResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/users/user/disable [patch]
+func (s *Server) DisableUserHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.DisableUserRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	if body.UserID == claims.UserID && body.Disabled {
+		BadRequest(w, "cannot disable own account")
+		return
+	}
+
+	resp, err := s.Auth.DisableUser(r.Context(), s.Redis, body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.DisableUser error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// DeleteUserHandler удаляет пользователя
+// @Summary Удаление пользователя
+// @Description Удаляет пользователя вместе с MFA и персональными токенами. Пользователя с курсами или статусами заданий удалить нельзя, его можно только отключить. Удалить себя нельзя. Доступно только суперпользователям
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.DeleteUserRequest true "Пользователь"
+// @Success 200 {object} auth.DeleteUserResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещен"
+// @Failure 404 {object} ErrorResponse "Пользователь не найден"
+// @Failure 409 {object} ErrorResponse "У пользователя есть курсы или статусы заданий"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /admin/users/user [delete]
+func (s *Server) DeleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[auth.DeleteUserRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	if body.UserID == claims.UserID {
+		BadRequest(w, "cannot delete own account")
+		return
+	}
+
+	resp, err := s.Auth.DeleteUser(r.Context(), s.Redis, body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler auth.DeleteUser error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "user not found")
+			case codes.FailedPrecondition:
+				AlreadyExists(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// Достает из ошибки RetryInfo, которую сервис добавляет к ResourceExhausted
+func retryAfter(st *status.Status) time.Duration {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration()
+		}
+	}
+	return 0
+}
+
+// Заголовки прокси задает клиент, поэтому им верим, только если запрос пришел от доверенного
+// прокси. В X-Forwarded-For каждый прокси дописывает адрес справа, поэтому адрес клиента -
+// самый правый, который не принадлежит доверенному прокси. Левые адреса мог подставить клиент.
+// Если заголовок испорчен, адрес клиента не определить, и используется адрес прокси
+func (s *Server) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !s.isTrustedProxy(host) {
+		return host
+	}
+
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		ips := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(ips) - 1; i >= 0; i-- {
+			ip, ok := parseIP(ips[i])
+			if !ok {
+				return host
+			}
+			if !s.isTrustedProxy(ip) {
+				return ip
+			}
+		}
+	}
+	if ip, ok := parseIP(r.Header.Get("X-Real-IP")); ok {
+		return ip
+	}
+	return host
+}
+
+// Возвращает адрес в каноничной записи или false, если это не IP адрес
+func parseIP(ip string) (string, bool) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return "", false
+	}
+	return addr.Unmap().String(), true
+}
+
+func (s *Server) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range s.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_clientIP(t *testing.T) {
+	var trustedProxies []netip.Prefix
+	for _, proxy := range []string{"10.0.0.0/8", "192.168.1.1", "::ffff:172.16.0.1"} {
+		prefix, err := parseProxy(proxy)
+		require.NoError(t, err)
+		trustedProxies = append(trustedProxies, prefix)
+	}
+	s := &Server{trustedProxies: trustedProxies}
+
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "203.0.113.7:5000",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "untrusted peer spoofs forwarded for",
+			remoteAddr: "203.0.113.7:5000",
+			forwarded:  []string{"198.51.100.1"},
+			realIP:     "198.51.100.2",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "untrusted peer spoofs trusted address",
+			remoteAddr: "203.0.113.7:5000",
+			forwarded:  []string{"10.0.0.5"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"203.0.113.7"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy chain",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"203.0.113.7, 192.168.1.1, 10.0.0.3"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy chain in several headers",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"203.0.113.7", "192.168.1.1"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "client prepends spoofed address",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"198.51.100.1, 203.0.113.7, 10.0.0.3"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "ipv4 mapped proxy address",
+			remoteAddr: "[::ffff:172.16.0.1]:5000",
+			forwarded:  []string{"::ffff:203.0.113.7"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "ipv6 client",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"2001:db8::1"},
+			want:       "2001:db8::1",
+		},
+		{
+			name:       "only trusted addresses",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"10.0.0.4, 10.0.0.3"},
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "malformed forwarded for",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"not-an-ip"},
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "malformed entry stops the chain",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"198.51.100.1, garbage, 10.0.0.3"},
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "empty entry",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"203.0.113.7,,"},
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "real ip from trusted proxy",
+			remoteAddr: "10.0.0.2:5000",
+			realIP:     "203.0.113.7",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "malformed real ip",
+			remoteAddr: "10.0.0.2:5000",
+			realIP:     "203.0.113.7:5000",
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "remote addr without port",
+			remoteAddr: "10.0.0.2",
+			forwarded:  []string{"203.0.113.7"},
+			want:       "203.0.113.7",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+			r.RemoteAddr = tc.remoteAddr
+			for _, value := range tc.forwarded {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if tc.realIP != "" {
+				r.Header.Set("X-Real-IP", tc.realIP)
+			}
+
+			assert.Equal(t, tc.want, s.clientIP(r))
+		})
+	}
+}
+
+func TestParseProxy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		proxy   string
+		want    string
+		wantErr bool
+	}{
+		{name: "subnet", proxy: "10.0.0.0/8", want: "10.0.0.0/8"},
+		{name: "subnet with host bits", proxy: "10.1.2.3/8", want: "10.0.0.0/8"},
+		{name: "address", proxy: " 192.168.1.1 ", want: "192.168.1.1/32"},
+		{name: "ipv6 address", proxy: "2001:db8::1", want: "2001:db8::1/128"},
+		{name: "ipv4 mapped address", proxy: "::ffff:172.16.0.1", want: "172.16.0.1/32"},
+		{name: "invalid address", proxy: "proxy", wantErr: true},
+		{name: "invalid subnet", proxy: "10.0.0.0/33", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prefix, err := parseProxy(tc.proxy)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, prefix.String())
+		})
+	}
+}
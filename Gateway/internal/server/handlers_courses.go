@@ -2,12 +2,15 @@ package server
 
 import (
 	"Classroom/Gateway/internal/courses"
+	"Classroom/Gateway/internal/lessons"
 	"Classroom/Gateway/internal/redis"
+	"Classroom/Gateway/internal/tasks"
+	app "Classroom/Gateway/internal/logger"
 	"Classroom/Gateway/pkg/logger"
 	"fmt"
 	"log/slog"
 	"net/http"
-	"time"
+	"strconv"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -49,15 +52,12 @@ func (s *Server) CreateCourseHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = redis.Put(s.Redis, r.Context(), "Courses.IsTeacher", fmt.Sprintf("%s:%s", resp.Course.TeacherID, resp.Course.CourseID), courses.IsTeacherResponse{IsTeacher: true}, 24 * time.Hour)
-	logger.Debug(r.Context(), "Courses.IsTeacher cached", slog.Any("error", err))
-
 	WriteJSON(w, resp, http.StatusCreated)
 }
 
 // GetCourseHandler возвращает информацию о курсе
 // @Summary Получение курса
-// @Description Возвращает детальную информацию о курсе
+// @Description Возвращает детальную информацию о курсе. Требуется право `course.read` в курсе
 // @Tags Courses
 // @Produce json
 // @Security BearerAuth
@@ -76,27 +76,7 @@ func (s *Server) GetCourseHandler(w http.ResponseWriter, r *http.Request) {
 	body.UserID = claims.UserID
 	logger.Debug(r.Context(), "debug", slog.Any("user_id", body.UserID))
 
-	isMember, err := s.IsMember(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsMember error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isMember {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseRead) {
 		return
 	}
 
@@ -128,6 +108,7 @@ func (s *Server) GetCourseHandler(w http.ResponseWriter, r *http.Request) {
 // @Tags Courses
 // @Produce json
 // @Security BearerAuth
+// @Param include_archived query bool false "Включить архивные курсы" default(false)
 // @Success 200 {object} courses.GetCoursesResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
@@ -159,12 +140,56 @@ func (s *Server) GetCoursesHandler(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, resp, http.StatusOK)
 }
 
+// SearchCoursesHandler ищет курсы в каталоге
+// @Summary Каталог курсов
+// @Description Ищет опубликованные в каталоге курсы по названию и описанию с фильтрами по преподавателю, тегу, категории и дате начала. Шаблоны, скрытые, архивные и закончившиеся курсы не возвращаются. При поиске по тексту курсы отсортированы по релевантности, иначе сначала новые. Для следующей страницы передайте next_cursor из ответа
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param query query string false "Текст поиска по названию и описанию" example("основы программирования")
+// @Param teacher_id query string false "ID преподавателя" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Param tag query string false "Тег курса" example("go")
+// @Param category query string false "Категория курса" example("Программирование")
+// @Param starts_after query string false "Курс начинается не раньше, RFC3339" example("2023-09-01T00:00:00Z")
+// @Param starts_before query string false "Курс начинается не позже, RFC3339" example("2023-12-31T23:59:59Z")
+// @Param limit query int false "Размер страницы, максимум 100" default(20)
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} courses.SearchCoursesResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/catalog [get]
+func (s *Server) SearchCoursesHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.SearchCoursesRequest](r.Context())
+
+	resp, err := s.Courses.SearchCourses(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.SearchCourses error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
 // GetCoursesByStudentHandler возвращает курсы студента
 // @Summary Получение курсов студента
 // @Description Возвращает список курсов, на которые записан студент
 // @Tags Courses
 // @Produce json
 // @Security BearerAuth
+// @Param include_archived query bool false "Включить архивные курсы" default(false)
 // @Success 200 {object} courses.GetCoursesResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
@@ -202,6 +227,7 @@ func (s *Server) GetCoursesByStudentHandler(w http.ResponseWriter, r *http.Reque
 // @Tags Courses
 // @Produce json
 // @Security BearerAuth
+// @Param include_archived query bool false "Включить архивные курсы" default(false)
 // @Success 200 {object} courses.GetCoursesResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
@@ -235,7 +261,7 @@ func (s *Server) GetCoursesByTeacherHandler(w http.ResponseWriter, r *http.Reque
 
 // UpdateCourseHandler обновляет информацию о курсе
 // @Summary Обновление курса
-// @Description Обновляет информацию о курсе
+// @Description Обновляет информацию о курсе. Архивный курс изменить нельзя. Если ограничение мест увеличено или снято, заявки из листа ожидания одобряются автоматически. Требуется право `course.update` в курсе
 // @Tags Courses
 // @Accept json
 // @Produce json
@@ -252,27 +278,7 @@ func (s *Server) GetCoursesByTeacherHandler(w http.ResponseWriter, r *http.Reque
 func (s *Server) UpdateCourseHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[courses.UpdateCourseRequest](r.Context())
 
-	isTeacher, err := s.IsTeacher(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseUpdate) {
 		return
 	}
 
@@ -282,7 +288,7 @@ func (s *Server) UpdateCourseHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
@@ -295,12 +301,18 @@ func (s *Server) UpdateCourseHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Новые места получают пользователи из листа ожидания
+	if body.Capacity != nil {
+		err = redis.Delete(s.Redis, r.Context(), "Courses.Authorize", body.CourseID)
+		logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	}
+
 	WriteJSON(w, resp, http.StatusOK)
 }
 
 // DeleteCourseHandler удаляет курс
 // @Summary Удаление курса
-// @Description Удаляет курс по идентификатору
+// @Description Помечает курс удалённым. Удалённый курс скрыт от всех, владелец может восстановить его до окончательного удаления через срок хранения. Требуется право `course.delete` в курсе
 // @Tags Courses
 // @Accept json
 // @Produce json
@@ -317,16 +329,20 @@ func (s *Server) UpdateCourseHandler(w http.ResponseWriter, r *http.Request) {
 func (s *Server) DeleteCourseHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[courses.DeleteCourseRequest](r.Context())
 
-	isTeacher, err := s.IsTeacher(r.Context(), body.CourseID)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseDelete) {
+		return
+	}
+
+	resp, err := s.Courses.DeleteCourse(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler courses.DeleteCourse error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
-				NotFound(w, e.Message())
+				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -336,18 +352,43 @@ func (s *Server) DeleteCourseHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !isTeacher {
-		Forbidden(w)
+	// Удалённый курс скрыт от всех участников
+	err = redis.Delete(s.Redis, r.Context(), "Courses.Authorize", resp.Course.CourseID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ArchiveCourseHandler переводит курс в архив
+// @Summary Архивация курса
+// @Description Переводит курс в архив. Архивный курс доступен только для чтения и не попадает в списки курсов без include_archived. Участники сразу получают права только на чтение. Требуется право `course.delete` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.ArchiveCourseRequest true "Идентификатор курса"
+// @Success 200 {object} courses.ArchiveCourseResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс уже в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещён"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/archive [post]
+func (s *Server) ArchiveCourseHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ArchiveCourseRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseDelete) {
 		return
 	}
 
-	resp, err := s.Courses.DeleteCourse(r.Context(), body)
+	resp, err := s.Courses.ArchiveCourse(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.DeleteCourse error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler courses.ArchiveCourse error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
@@ -360,41 +401,47 @@ func (s *Server) DeleteCourseHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = redis.Delete(s.Redis, r.Context(), "Courses.IsTeacher", fmt.Sprintf("%s:%s", resp.Course.TeacherID, resp.Course.CourseID))
-	logger.Debug(r.Context(), "Courses.IsTeacher uncached", slog.Any("error", err))
+	// Архивный курс доступен всем участникам только для чтения
+	err = redis.Delete(s.Redis, r.Context(), "Courses.Authorize", resp.Course.CourseID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
 
 	WriteJSON(w, resp, http.StatusOK)
 }
 
-// EnrollUserHandler записывает пользователя на курс
-// @Summary Запись на курс
-// @Description Записывает пользователя на указанный курс
+// RestoreCourseHandler восстанавливает архивный или удалённый курс
+// @Summary Восстановление курса
+// @Description Снимает с курса архивацию и удаление. Удалённый курс можно восстановить до окончательного удаления через срок хранения. Доступно только владельцу курса
 // @Tags Courses
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param request body courses.EnrollUserRequest true "Данные для записи"
-// @Success 200 {object} courses.EnrollUserResponse
-// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Param request body courses.RestoreCourseRequest true "Идентификатор курса"
+// @Success 200 {object} courses.RestoreCourseResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс не в архиве и не удалён"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
-// @Failure 403 {object} ErrorResponse "Доступ запрещён"
-// @Failure 404 {object} ErrorResponse "Курс или пользователь не найден"
+// @Failure 403 {object} ErrorResponse "Пользователь не владелец курса"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
-// @Router /courses/course/enroll [post]
-func (s *Server) EnrollUserHandler(w http.ResponseWriter, r *http.Request) {
-	body := GetBody[courses.EnrollUserRequest](r.Context())
+// @Router /courses/course/restore [post]
+func (s *Server) RestoreCourseHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.RestoreCourseRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
 
-	isTeacher, err := s.IsTeacher(r.Context(), body.CourseID)
+	// Права удалённого курса через Authorize не проверить, владельца проверяет Courses
+	resp, err := s.Courses.RestoreCourse(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler courses.RestoreCourse error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
 			case codes.NotFound:
-				NotFound(w, e.Message())
+				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -404,8 +451,33 @@ func (s *Server) EnrollUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !isTeacher {
-		Forbidden(w)
+	// Участники снова получают права на изменение
+	err = redis.Delete(s.Redis, r.Context(), "Courses.Authorize", resp.Course.CourseID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// EnrollUserHandler записывает пользователя на курс
+// @Summary Запись на курс
+// @Description Записывает пользователя на указанный курс с учётом ограничения мест. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.EnrollUserRequest true "Данные для записи"
+// @Success 200 {object} courses.EnrollUserResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные, нет свободных мест, курс в архиве или является шаблоном"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Доступ запрещён"
+// @Failure 404 {object} ErrorResponse "Курс или пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/enroll [post]
+func (s *Server) EnrollUserHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.EnrollUserRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
 		return
 	}
 	
@@ -415,7 +487,7 @@ func (s *Server) EnrollUserHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
@@ -428,15 +500,15 @@ func (s *Server) EnrollUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = redis.Put(s.Redis, r.Context(), "Courses.IsMember", fmt.Sprintf("%s:%s", body.UserID, body.CourseID), courses.IsMemberResponse{IsMember: true}, 24 * time.Hour)
-	logger.Debug(r.Context(), "Courses.IsMember cached", slog.Any("error", err))
+	err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", body.CourseID, body.UserID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
 
 	WriteJSON(w, resp, http.StatusOK)
 }
 
 // ExpelUserHandler отчисляет пользователя с курса
 // @Summary Отчисление с курса
-// @Description Отчисляет пользователя с указанного курса
+// @Description Отчисляет пользователя с указанного курса. Требуется право `members.manage` в курсе
 // @Tags Courses
 // @Accept json
 // @Produce json
@@ -453,27 +525,7 @@ func (s *Server) EnrollUserHandler(w http.ResponseWriter, r *http.Request) {
 func (s *Server) ExpelUserHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[courses.ExpelUserRequest](r.Context())
 
-	isTeacher, err := s.IsTeacher(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
 		return
 	}
 
@@ -492,19 +544,27 @@ func (s *Server) ExpelUserHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		} else {
 			InternalError(w)
-			return
 		}
+		return
 	}
 
+	err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", body.CourseID, body.UserID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	// По членству в курсе выдаются и проверяются ссылки на файлы занятий
 	err = redis.Delete(s.Redis, r.Context(), "Courses.IsMember", fmt.Sprintf("%s:%s", body.UserID, body.CourseID))
-	logger.Debug(r.Context(), "Course.IsMember uncached", slog.Any("error", err))
+	logger.Debug(r.Context(), "Courses.IsMember uncached", slog.Any("error", err))
+	// На освободившееся место записаны пользователи из листа ожидания
+	for _, promoted := range resp.Promoted {
+		err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", promoted.CourseID, promoted.UserID)
+		logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	}
 
 	WriteJSON(w, resp, http.StatusOK)
 }
 
 // GetCourseStudentsHandler возвращает студентов курса
 // @Summary Получение студентов курса
-// @Description Возвращает список студентов, записанных на курс, с поддержкой пагинации
+// @Description Возвращает список студентов, записанных на курс, с поддержкой пагинации. Требуется право `members.read` в курсе
 // @Tags Courses
 // @Produce json
 // @Security BearerAuth
@@ -522,16 +582,20 @@ func (s *Server) ExpelUserHandler(w http.ResponseWriter, r *http.Request) {
 func (s *Server) GetCourseStudentsHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[courses.GetCourseStudentsRequest](r.Context())
 
-	isTeacher, err := s.IsMember(r.Context(), body.CourseID)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersRead) {
+		return
+	}
+
+	resp, err := s.Courses.GetCourseStudents(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsMember error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler courses.GetCourseStudents error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
-				NotFound(w, e.Message())
+				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -541,29 +605,1317 @@ func (s *Server) GetCourseStudentsHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if !isTeacher {
-		Forbidden(w)
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// GetCoursePermissionsHandler возвращает наборы прав ролей курса
+// @Summary Получение прав ролей курса
+// @Description Возвращает наборы прав всех ролей курса. Права владельца не меняются. Требуется право `roles.manage` в курсе
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a")
+// @Success 200 {object} courses.GetCoursePermissionsResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/permissions [get]
+func (s *Server) GetCoursePermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.GetCoursePermissionsRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionRolesManage) {
 		return
 	}
 
-	resp, err := s.Courses.GetCourseStudents(r.Context(), body)
+	resp, err := s.Courses.GetCoursePermissions(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.GetCourseStudents error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler courses.GetCoursePermissions error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// SetRolePermissionsHandler изменяет набор прав роли курса
+// @Summary Изменение прав роли курса
+// @Description Заменяет набор прав роли целиком. Права владельца не меняются. Новые права действуют сразу для всех участников курса. Требуется право `roles.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.SetRolePermissionsRequest true "Роль и новый набор прав"
+// @Success 200 {object} courses.SetRolePermissionsResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/permissions [put]
+func (s *Server) SetRolePermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.SetRolePermissionsRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionRolesManage) {
+		return
+	}
+
+	resp, err := s.Courses.SetRolePermissions(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.SetRolePermissions error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	// Права роли изменились у всех её участников, поэтому сбрасываем кеш всего курса
+	err = redis.Delete(s.Redis, r.Context(), "Courses.Authorize", body.CourseID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListStaffHandler возвращает преподавателей курса
+// @Summary Получение преподавателей курса
+// @Description Возвращает соавторов и ассистентов курса, владелец в список не входит. Требуется право `members.read` в курсе
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a")
+// @Success 200 {object} courses.ListStaffResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/staff [get]
+func (s *Server) ListStaffHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ListStaffRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersRead) {
+		return
+	}
+
+	resp, err := s.Courses.ListStaff(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ListStaff error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
 		} else {
 			InternalError(w)
-			return
 		}
+		return
 	}
 
 	WriteJSON(w, resp, http.StatusOK)
 }
+
+// AddStaffHandler добавляет преподавателя курса
+// @Summary Добавление преподавателя курса
+// @Description Добавляет соавтора или ассистента курса, либо меняет роль уже добавленного. Ассистент по умолчанию может проверять задания, но не может удалять курс и материалы. Требуется право `roles.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.AddStaffRequest true "Пользователь и роль"
+// @Success 200 {object} courses.AddStaffResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или пользователь - владелец курса"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс или пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/staff [post]
+func (s *Server) AddStaffHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.AddStaffRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionRolesManage) {
+		return
+	}
+
+	resp, err := s.Courses.AddStaff(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.AddStaff error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	// Роль пользователя в курсе изменилась
+	err = redis.Delete(s.Redis, r.Context(), "Courses.IsTeacher", fmt.Sprintf("%s:%s", body.UserID, body.CourseID))
+	logger.Debug(r.Context(), "Courses.IsTeacher uncached", slog.Any("error", err))
+	err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", body.CourseID, body.UserID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RemoveStaffHandler удаляет преподавателя курса
+// @Summary Удаление преподавателя курса
+// @Description Убирает пользователя из преподавателей курса. Требуется право `roles.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.RemoveStaffRequest true "Пользователь"
+// @Success 200 {object} courses.RemoveStaffResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Преподаватель не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/staff [delete]
+func (s *Server) RemoveStaffHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.RemoveStaffRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionRolesManage) {
+		return
+	}
+
+	resp, err := s.Courses.RemoveStaff(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.RemoveStaff error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	// Роль пользователя в курсе изменилась
+	err = redis.Delete(s.Redis, r.Context(), "Courses.IsTeacher", fmt.Sprintf("%s:%s", body.UserID, body.CourseID))
+	logger.Debug(r.Context(), "Courses.IsTeacher uncached", slog.Any("error", err))
+	err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", body.CourseID, body.UserID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// CreateInviteHandler создает код приглашения на курс
+// @Summary Создание кода приглашения
+// @Description Создает код, по которому пользователи сами записываются на курс через `POST /api/courses/join`. Можно ограничить срок действия и число использований. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.CreateInviteRequest true "Параметры кода"
+// @Success 200 {object} courses.CreateInviteResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/invites [post]
+func (s *Server) CreateInviteHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.CreateInviteRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	resp, err := s.Courses.CreateInvite(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.CreateInvite error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListInvitesHandler возвращает коды приглашения курса
+// @Summary Получение кодов приглашения
+// @Description Возвращает все коды приглашения курса, включая отозванные и истекшие. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a")
+// @Success 200 {object} courses.ListInvitesResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/invites [get]
+func (s *Server) ListInvitesHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ListInvitesRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	resp, err := s.Courses.ListInvites(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ListInvites error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RevokeInviteHandler отзывает код приглашения
+// @Summary Отзыв кода приглашения
+// @Description Отзывает код приглашения, уже записанные по нему пользователи остаются на курсе. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.RevokeInviteRequest true "Код приглашения"
+// @Success 200 {object} courses.RevokeInviteResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Код не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/invites [delete]
+func (s *Server) RevokeInviteHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.RevokeInviteRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	resp, err := s.Courses.RevokeInvite(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.RevokeInvite error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// JoinByCodeHandler записывает текущего пользователя на курс по коду
+// @Summary Запись на курс по коду приглашения
+// @Description Записывает текущего пользователя на курс с ролью из кода приглашения. Права в курсе не требуются
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.JoinByCodeRequest true "Код приглашения"
+// @Success 200 {object} courses.JoinByCodeResponse
+// @Failure 400 {object} ErrorResponse "Некорректный, отозванный, истекший или исчерпанный код, курс в архиве или является шаблоном"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Код или курс не найден"
+// @Failure 409 {object} ErrorResponse "Пользователь уже участник курса"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/join [post]
+func (s *Server) JoinByCodeHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.JoinByCodeRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Courses.JoinByCode(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.JoinByCode error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.AlreadyExists:
+				AlreadyExists(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	// Пользователь стал участником курса
+	err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", resp.Enrollment.CourseID, body.UserID)
+	logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RequestEnrollmentHandler подает заявку на запись на курс
+// @Summary Заявка на запись на курс
+// @Description Подает заявку текущего пользователя на запись на видимый курс. Заявку рассматривает преподаватель, владелец курса получает письмо. Права в курсе не требуются
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.RequestEnrollmentRequest true "Курс"
+// @Success 200 {object} courses.RequestEnrollmentResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 409 {object} ErrorResponse "Пользователь уже участник курса или заявка уже подана"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/requests [post]
+func (s *Server) RequestEnrollmentHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.RequestEnrollmentRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Courses.RequestEnrollment(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.RequestEnrollment error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.AlreadyExists:
+				AlreadyExists(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListEnrollmentRequestsHandler возвращает заявки на курс
+// @Summary Получение заявок на курс
+// @Description Возвращает заявки на запись в порядке подачи, опционально только в указанном состоянии. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a")
+// @Param status query string false "Состояние заявки" Enums(pending, approved, rejected, waitlisted)
+// @Success 200 {object} courses.ListEnrollmentRequestsResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/requests [get]
+func (s *Server) ListEnrollmentRequestsHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ListEnrollmentRequestsRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	resp, err := s.Courses.ListEnrollmentRequests(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ListEnrollmentRequests error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ApproveEnrollmentHandler одобряет заявку на курс
+// @Summary Одобрение заявки на курс
+// @Description Записывает автора заявки на курс. Если мест нет, заявка переходит в лист ожидания и будет одобрена автоматически, когда место освободится. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.DecideEnrollmentRequest true "Заявка"
+// @Success 200 {object} courses.DecideEnrollmentResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или заявка уже закрыта"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Заявка не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/requests/approve [post]
+func (s *Server) ApproveEnrollmentHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.DecideEnrollmentRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	resp, err := s.Courses.ApproveEnrollment(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ApproveEnrollment error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	if resp.Request.Status == "approved" {
+		err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", resp.Request.CourseID, resp.Request.UserID)
+		logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// RejectEnrollmentHandler отклоняет заявку на курс
+// @Summary Отклонение заявки на курс
+// @Description Отклоняет заявку в состоянии pending или waitlisted, автор заявки получает письмо. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.DecideEnrollmentRequest true "Заявка"
+// @Success 200 {object} courses.DecideEnrollmentResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или заявка уже закрыта"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Заявка не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/requests/reject [post]
+func (s *Server) RejectEnrollmentHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.DecideEnrollmentRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	resp, err := s.Courses.RejectEnrollment(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.RejectEnrollment error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// Максимальный размер CSV файла для массовой записи
+const maxRosterUploadSize = 1 << 20
+
+// BulkEnrollHandler записывает на курс пользователей из CSV файла
+// @Summary Массовая запись из CSV
+// @Description Записывает на курс пользователей по почтам из CSV файла и возвращает результат для каждой строки. Если в первой строке есть колонка `email`, почты берутся из неё, иначе из первой колонки. На неизвестные почты можно отправить одноразовые коды приглашения. Требуется право `members.manage` в курсе
+// @Tags Courses
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param course_id formData string true "ID курса"
+// @Param role formData string false "Роль: student или auditor" Enums(student, auditor)
+// @Param invite_unknown formData bool false "Отправить коды приглашения на неизвестные почты"
+// @Param file formData file true "CSV файл с почтами, до 1 МБ"
+// @Success 200 {object} courses.BulkEnrollResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или файл"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/enroll/bulk [post]
+func (s *Server) BulkEnrollHandler(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(app.NewLogger(r.Context(), true))
+	claims, _ := GetClaims(r.Context())
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRosterUploadSize)
+	if err := r.ParseMultipartForm(maxRosterUploadSize); err != nil {
+		logger.Debug(r.Context(), "Failed to parse multipart form", slog.Any("error", err))
+		BadRequest(w, "invalid form or file is too large")
+		return
+	}
+
+	body := courses.BulkEnrollRequest{
+		UserID:   claims.UserID,
+		CourseID: r.FormValue("course_id"),
+		Role:     r.FormValue("role"),
+	}
+	if v := r.FormValue("invite_unknown"); v != "" {
+		inviteUnknown, err := strconv.ParseBool(v)
+		if err != nil {
+			BadRequest(w, "invalid invite_unknown")
+			return
+		}
+		body.InviteUnknown = inviteUnknown
+	}
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersManage) {
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		BadRequest(w, "file is required")
+		return
+	}
+	defer file.Close()
+
+	body.Emails, err = courses.ParseRosterCSV(file)
+	if err != nil {
+		logger.Debug(r.Context(), "Failed to parse CSV", slog.Any("error", err))
+		BadRequest(w, "invalid CSV file")
+		return
+	}
+
+	resp, err := s.Courses.BulkEnroll(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.BulkEnroll error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	for _, result := range resp.Results {
+		if result.Status != "enrolled" || result.UserID == nil {
+			continue
+		}
+		err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", body.CourseID, *result.UserID)
+		logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ExportRosterHandler выгружает ведомость курса в CSV
+// @Summary Выгрузка ведомости курса
+// @Description Возвращает всех студентов и слушателей курса одним CSV файлом без пагинации. Требуется право `members.read` в курсе
+// @Tags Courses
+// @Produce text/csv
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a")
+// @Success 200 {file} file "CSV файл: user_id, email, last_name, first_name, role, enrolled_at"
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/students/export [get]
+func (s *Server) ExportRosterHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ExportRosterRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionMembersRead) {
+		return
+	}
+
+	resp, err := s.Courses.ExportRoster(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ExportRoster error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"roster-%s.csv\"", body.CourseID))
+	w.WriteHeader(http.StatusOK)
+	if err := courses.WriteRosterCSV(w, resp.Entries); err != nil {
+		logger.Error(r.Context(), "Failed to write roster CSV", slog.Any("error", err))
+	}
+}
+
+// CloneCourseHandler копирует курс
+// @Summary Копирование курса
+// @Description Создаёт новый курс с данными, наборами прав ролей, уроками и заданиями исходного курса или шаблона. Записи на курс, заявки, коды приглашения и статусы заданий не копируются. Даты начала и окончания сдвигаются на shift_days дней. Курс создаётся сразу, а уроки и задания копируются фоновой задачей, её состояние возвращает /courses/clone-jobs. Если копирование не удалось, новый курс удаляется. Копировать можно и архивный курс. Требуется право `course.read` в курсе, копировать могут только преподаватели курса
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.CloneCourseRequest true "Параметры копирования"
+// @Success 201 {object} courses.CloneCourseResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Пользователь не преподаватель курса"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/clone [post]
+func (s *Server) CloneCourseHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.CloneCourseRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseRead) {
+		return
+	}
+
+	resp, err := s.Courses.CloneCourse(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.CloneCourse error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
+			case codes.NotFound:
+				NotFound(w)
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusCreated)
+}
+
+// GetCloneJobHandler возвращает состояние копирования курса
+// @Summary Состояние копирования курса
+// @Description Возвращает состояние задачи копирования уроков и заданий: pending, running, completed или failed, и число уже скопированных уроков и заданий. Задачу видит только запустивший копирование
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param job_id query string true "ID задачи" example("a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a")
+// @Success 200 {object} courses.GetCloneJobResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Задача не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/clone-jobs [get]
+func (s *Server) GetCloneJobHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.GetCloneJobRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Courses.GetCloneJob(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.GetCloneJob error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// CreateModuleHandler создаёт модуль курса
+// @Summary Создание модуля
+// @Description Добавляет модуль в конец курса. Архивный курс менять нельзя. Требуется право `course.update` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.CreateModuleRequest true "Данные модуля"
+// @Success 201 {object} courses.ModuleResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/modules [post]
+func (s *Server) CreateModuleHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.CreateModuleRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseUpdate) {
+		return
+	}
+
+	resp, err := s.Courses.CreateModule(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.CreateModule error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusCreated)
+}
+
+// UpdateModuleHandler переименовывает модуль курса
+// @Summary Переименование модуля
+// @Description Меняет название модуля. Архивный курс менять нельзя. Требуется право `course.update` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.UpdateModuleRequest true "Новое название модуля"
+// @Success 200 {object} courses.ModuleResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс или модуль не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/modules [patch]
+func (s *Server) UpdateModuleHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.UpdateModuleRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseUpdate) {
+		return
+	}
+
+	resp, err := s.Courses.UpdateModule(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.UpdateModule error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// DeleteModuleHandler удаляет модуль курса
+// @Summary Удаление модуля
+// @Description Удаляет модуль, следующие модули сдвигаются. Занятия и задания модуля остаются в курсе без модуля. Архивный курс менять нельзя. Требуется право `course.update` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.DeleteModuleRequest true "ID курса и модуля"
+// @Success 200 {object} courses.ModuleResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс или модуль не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/modules [delete]
+func (s *Server) DeleteModuleHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.DeleteModuleRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseUpdate) {
+		return
+	}
+
+	resp, err := s.Courses.DeleteModule(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.DeleteModule error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListModulesHandler возвращает модули курса
+// @Summary Модули курса
+// @Description Возвращает модули курса по порядку. Требуется право `course.read` в курсе
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Success 200 {object} courses.ListModulesResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/modules [get]
+func (s *Server) ListModulesHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ListModulesRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseRead) {
+		return
+	}
+
+	resp, err := s.Courses.ListModules(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ListModules error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ReorderModuleHandler перемещает модуль курса
+// @Summary Перемещение модуля
+// @Description Ставит модуль на новую позицию, остальные модули сдвигаются. Позиция больше числа модулей ставит модуль в конец. Возвращает все модули курса по новому порядку. Архивный курс менять нельзя. Требуется право `course.update` в курсе
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.ReorderModuleRequest true "Модуль и новая позиция"
+// @Success 200 {object} courses.ListModulesResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс или модуль не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/modules/reorder [put]
+func (s *Server) ReorderModuleHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.ReorderModuleRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionCourseUpdate) {
+		return
+	}
+
+	resp, err := s.Courses.ReorderModule(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ReorderModule error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// GetCourseOutlineHandler возвращает структуру курса
+// @Summary Структура курса
+// @Description Возвращает модули курса по порядку, в каждом модуле его занятия и задания по порядку, а также занятия и задания без модуля. Черновики и запланированные занятия видны только с правом `lessons.read_drafts`. Требуются права `course.read`, `lessons.read` и `tasks.read` в курсе
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Param course_id query string true "ID курса" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Success 200 {object} courses.CourseOutline
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/outline [get]
+func (s *Server) GetCourseOutlineHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.GetCourseOutlineRequest](r.Context())
+
+	for _, permission := range []string{courses.PermissionCourseRead, courses.PermissionLessonsRead, courses.PermissionTasksRead} {
+		if !s.Authorize(w, r, body.CourseID, permission) {
+			return
+		}
+	}
+
+	modules, err := s.Courses.ListModules(r.Context(), courses.ListModulesRequest{CourseID: body.CourseID})
+	if err != nil {
+		outlineError(w, r, "courses.ListModules", err)
+		return
+	}
+	readDrafts, ok := s.hasPermission(w, r, body.CourseID, courses.PermissionLessonsDrafts)
+	if !ok {
+		return
+	}
+	courseLessons, err := s.Lessons.GetLessons(r.Context(), lessons.GetLessonsRequest{CourseID: body.CourseID, IncludeUnpublished: readDrafts})
+	if err != nil {
+		outlineError(w, r, "lessons.GetLessons", err)
+		return
+	}
+	courseTasks, err := s.Tasks.GetTasks(r.Context(), tasks.GetTasksRequest{CourseID: body.CourseID})
+	if err != nil {
+		outlineError(w, r, "tasks.GetTasks", err)
+		return
+	}
+
+	WriteJSON(w, courses.NewCourseOutline(body.CourseID, modules.Modules, courseLessons.Lessons, courseTasks.Tasks), http.StatusOK)
+}
+
+func outlineError(w http.ResponseWriter, r *http.Request, call string, err error) {
+	logger.Error(r.Context(), "Handler "+call+" error", slog.Any("error", err))
+
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.InvalidArgument:
+			BadRequest(w, e.Message())
+		case codes.Unavailable:
+			ServiceUnavailable(w)
+		}
+	} else {
+		InternalError(w)
+	}
+}
+
+// TransferOwnershipHandler передаёт курс другому владельцу
+// @Summary Передача курса
+// @Description Создаёт передачу курса, которую новый владелец подтверждает через /courses/transfers/accept. Новая передача отменяет прежнюю неподтверждённую. Передать курс может только его владелец. Суперпользователь может передать любой курс сразу без подтверждения, указав force. После смены владельца прежний владелец становится соавтором курса, а новый владелец перестаёт быть студентом или преподавателем курса
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.TransferOwnershipRequest true "Курс и новый владелец"
+// @Success 200 {object} courses.OwnershipTransferResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или пользователь уже владелец"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Пользователь не владелец курса или force без прав суперпользователя"
+// @Failure 404 {object} ErrorResponse "Курс или пользователь не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/course/transfer [post]
+func (s *Server) TransferOwnershipHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.TransferOwnershipRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	if body.Force && !claims.IsSuperUser {
+		Forbidden(w, "only superusers can force ownership transfer")
+		return
+	}
+
+	resp, err := s.Courses.TransferOwnership(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.TransferOwnership error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.PermissionDenied:
+				Forbidden(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	if resp.Transfer.Status == "accepted" {
+		s.uncacheOwnershipTransfer(r, resp)
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListOwnershipTransfersHandler возвращает передачи курсов пользователю
+// @Summary Передачи курсов пользователю
+// @Description Возвращает передачи курсов, которые ждут подтверждения текущего пользователя
+// @Tags Courses
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} courses.ListOwnershipTransfersResponse
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/transfers [get]
+func (s *Server) ListOwnershipTransfersHandler(w http.ResponseWriter, r *http.Request) {
+	claims, _ := GetClaims(r.Context())
+	body := courses.ListOwnershipTransfersRequest{UserID: claims.UserID}
+
+	resp, err := s.Courses.ListOwnershipTransfers(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.ListOwnershipTransfers error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// AcceptOwnershipTransferHandler подтверждает передачу курса
+// @Summary Подтверждение передачи курса
+// @Description Делает текущего пользователя владельцем курса. Подтвердить передачу может только новый владелец. Прежний владелец становится соавтором курса
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.DecideOwnershipTransferRequest true "ID передачи"
+// @Success 200 {object} courses.OwnershipTransferResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или передача уже закрыта"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Передача не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/transfers/accept [post]
+func (s *Server) AcceptOwnershipTransferHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.DecideOwnershipTransferRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Courses.AcceptOwnershipTransfer(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.AcceptOwnershipTransfer error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	s.uncacheOwnershipTransfer(r, resp)
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// DeclineOwnershipTransferHandler отклоняет передачу курса
+// @Summary Отказ от передачи курса
+// @Description Отклоняет передачу курса текущему пользователю, владелец курса не меняется
+// @Tags Courses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body courses.DecideOwnershipTransferRequest true "ID передачи"
+// @Success 200 {object} courses.OwnershipTransferResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или передача уже закрыта"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 404 {object} ErrorResponse "Передача не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /courses/transfers/decline [post]
+func (s *Server) DeclineOwnershipTransferHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[courses.DecideOwnershipTransferRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	resp, err := s.Courses.DeclineOwnershipTransfer(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.DeclineOwnershipTransfer error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// Роли прежнего и нового владельца в курсе изменились, а на место нового владельца,
+// если он был записан на курс, могли записаться пользователи из листа ожидания
+func (s *Server) uncacheOwnershipTransfer(r *http.Request, resp courses.OwnershipTransferResponse) {
+	for _, userID := range []string{resp.Transfer.FromUserID, resp.Transfer.ToUserID} {
+		key := fmt.Sprintf("%s:%s", userID, resp.Transfer.CourseID)
+		err := redis.Delete(s.Redis, r.Context(), "Courses.IsTeacher", key)
+		logger.Debug(r.Context(), "Courses.IsTeacher uncached", slog.Any("error", err))
+		err = redis.Delete(s.Redis, r.Context(), "Courses.IsMember", key)
+		logger.Debug(r.Context(), "Courses.IsMember uncached", slog.Any("error", err))
+		err = redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", resp.Transfer.CourseID, userID)
+		logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	}
+	for _, promoted := range resp.Promoted {
+		err := redis.DeleteField(s.Redis, r.Context(), "Courses.Authorize", promoted.CourseID, promoted.UserID)
+		logger.Debug(r.Context(), "Courses.Authorize uncached", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Запросы, которые отклоняются до проверки прав в курсе
+func TestServer_BulkEnrollHandler_RejectsForm(t *testing.T) {
+	testCases := []struct {
+		name   string
+		fields map[string]string
+		file   string
+		want   string
+	}{
+		{
+			name:   "file is too large",
+			fields: map[string]string{"course_id": "a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a"},
+			file:   strings.Repeat("ivan@example.com\n", maxRosterUploadSize/len("ivan@example.com\n")+1),
+			want:   "file is too large",
+		},
+		{
+			name: "invalid invite_unknown",
+			fields: map[string]string{
+				"course_id":      "a3d8e9b0-5c1f-4e9d-8c1a-2b3c4d5e6f7a",
+				"invite_unknown": "maybe",
+			},
+			file: "ivan@example.com\n",
+			want: "invalid invite_unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			for name, value := range tc.fields {
+				require.NoError(t, form.WriteField(name, value))
+			}
+			file, err := form.CreateFormFile("file", "roster.csv")
+			require.NoError(t, err)
+			_, err = file.Write([]byte(tc.file))
+			require.NoError(t, err)
+			require.NoError(t, form.Close())
+
+			r := httptest.NewRequest(http.MethodPost, "/api/courses/course/enroll/bulk", &body)
+			r.Header.Set("Content-Type", form.FormDataContentType())
+			w := httptest.NewRecorder()
+
+			s := &Server{}
+			s.BulkEnrollHandler(w, r)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tc.want)
+		})
+	}
+}
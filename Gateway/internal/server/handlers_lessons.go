@@ -1,11 +1,18 @@
 package server
 
 import (
+	"Classroom/Gateway/internal/courses"
 	"Classroom/Gateway/internal/lessons"
+	app "Classroom/Gateway/internal/logger"
 	"Classroom/Gateway/internal/redis"
 	"Classroom/Gateway/pkg/logger"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"strconv"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,7 +20,7 @@ import (
 
 // CreateLessonHandler создает новый урок
 // @Summary Создание урока
-// @Description Создает новый урок в курсе
+// @Description Создает новый урок в курсе. По умолчанию урок создаётся черновиком и виден только преподавателям, студенты получают уведомление, когда урок опубликован сразу или по расписанию. Требуется право `lessons.write` в курсе
 // @Tags Lessons
 // @Accept json
 // @Produce json
@@ -28,28 +35,10 @@ import (
 // @Router /lessons/create [post]
 func (s *Server) CreateLessonHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[lessons.CreateLessonRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
 
-	isTeacher, err := s.IsTeacher(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionLessonsWrite) {
 		return
 	}
 
@@ -59,7 +48,7 @@ func (s *Server) CreateLessonHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.Unavailable:
 				ServiceUnavailable(w)
@@ -75,7 +64,7 @@ func (s *Server) CreateLessonHandler(w http.ResponseWriter, r *http.Request) {
 
 // GetLessonHandler возвращает информацию об уроке
 // @Summary Получение урока
-// @Description Возвращает детальную информацию об уроке
+// @Description Возвращает детальную информацию об уроке. Черновики и запланированные уроки видны только с правом `lessons.read_drafts`. Требуется право `lessons.read` в курсе
 // @Tags Lessons
 // @Accept json
 // @Produce json
@@ -93,17 +82,15 @@ func (s *Server) GetLessonHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[lessons.GetLessonRequest](r.Context())
 
 	resp, err := s.Lessons.GetLesson(r.Context(), body)
-
-	isMember, err := s.IsTeacher(r.Context(), resp.Lesson.CourseID)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsMember error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler lessons.GetLesson error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
-				NotFound(w, e.Message())
+				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -113,8 +100,10 @@ func (s *Server) GetLessonHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !isMember {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp.Lesson.CourseID, courses.PermissionLessonsRead) {
+		return
+	}
+	if !s.requireVisible(w, r, resp.Lesson) {
 		return
 	}
 
@@ -123,7 +112,7 @@ func (s *Server) GetLessonHandler(w http.ResponseWriter, r *http.Request) {
 
 // GetLessonsHandler возвращает список уроков
 // @Summary Получение списка уроков
-// @Description Возвращает список уроков с возможностью фильтрации по курсу
+// @Description Возвращает список уроков с возможностью фильтрации по курсу. Черновики и запланированные уроки возвращаются только пользователям с правом `lessons.read_drafts`. Требуется право `lessons.read` в курсе
 // @Tags Lessons
 // @Produce json
 // @Security BearerAuth
@@ -131,6 +120,7 @@ func (s *Server) GetLessonHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} lessons.GetLessonsResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Курс не найден"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -138,16 +128,71 @@ func (s *Server) GetLessonHandler(w http.ResponseWriter, r *http.Request) {
 func (s *Server) GetLessonsHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[lessons.GetLessonsRequest](r.Context())
 
-	isMember, err := s.IsMember(r.Context(), body.CourseID)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionLessonsRead) {
+		return
+	}
+	readDrafts, ok := s.hasPermission(w, r, body.CourseID, courses.PermissionLessonsDrafts)
+	if !ok {
+		return
+	}
+	body.IncludeUnpublished = readDrafts
+
+	resp, err := s.Lessons.GetLessons(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsMember error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler lessons.GetLessons error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ReorderLessonsHandler меняет порядок уроков курса
+// @Summary Перестановка уроков
+// @Description Расставляет уроки курса в порядке списка. Список должен содержать все уроки курса по одному разу. Версия берётся из списка уроков: если порядок успел измениться, возвращается 409 и список нужно загрузить заново. Архивный курс менять нельзя. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body lessons.ReorderLessonsRequest true "Новый порядок уроков"
+// @Success 200 {object} lessons.ReorderLessonsResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Курс не найден"
+// @Failure 409 {object} ErrorResponse "Порядок уроков изменился"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/reorder [put]
+func (s *Server) ReorderLessonsHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.ReorderLessonsRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionLessonsWrite) {
+		return
+	}
+
+	resp, err := s.Lessons.ReorderLessons(r.Context(), body)
+	if err != nil {
+		logger.Error(r.Context(), "Handler lessons.ReorderLessons error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w, e.Message())
+			case codes.Aborted:
+				AlreadyExists(w, e.Message())
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -157,14 +202,34 @@ func (s *Server) GetLessonsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !isMember {
-		Forbidden(w)
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// PreviewLessonHandler отрисовывает содержание урока без сохранения
+// @Summary Предпросмотр урока
+// @Description Отрисовывает Markdown содержание так же, как при сохранении урока: блоки кода, таблицы и формулы, HTML очищается по списку разрешённых тегов. Ничего не сохраняет. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body lessons.PreviewLessonRequest true "Курс и содержание урока"
+// @Success 200 {object} lessons.PreviewLessonResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/preview [post]
+func (s *Server) PreviewLessonHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.PreviewLessonRequest](r.Context())
+
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionLessonsWrite) {
 		return
 	}
 
-	resp, err := s.Lessons.GetLessons(r.Context(), body)
+	resp, err := s.Lessons.PreviewLesson(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler lessons.GetLessons error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler lessons.PreviewLesson error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
@@ -184,7 +249,7 @@ func (s *Server) GetLessonsHandler(w http.ResponseWriter, r *http.Request) {
 
 // UpdateLessonHandler обновляет информацию об уроке
 // @Summary Обновление урока
-// @Description Обновляет информацию об уроке
+// @Description Обновляет информацию об уроке. Через status и publish_at урок публикуется сразу, планируется или возвращается в черновики, опубликованный урок вернуть в черновики нельзя. Требуется право `lessons.write` в курсе
 // @Tags Lessons
 // @Accept json
 // @Produce json
@@ -193,12 +258,16 @@ func (s *Server) GetLessonsHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} lessons.UpdateLessonResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Урок не найден"
+// @Failure 409 {object} ErrorResponse "Урок опубликован одновременно другим запросом"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
 // @Router /lessons/lesson/update [put]
 func (s *Server) UpdateLessonHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[lessons.UpdateLessonRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
 
 	body1 := lessons.GetLessonRequest{
 		LessonID: body.LessonID,
@@ -222,27 +291,7 @@ func (s *Server) UpdateLessonHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isTeacher, err := s.IsTeacher(r.Context(), resp1.Lesson.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp1.Lesson.CourseID, courses.PermissionLessonsWrite) {
 		return
 	}
 
@@ -252,10 +301,12 @@ func (s *Server) UpdateLessonHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
+			case codes.Aborted:
+				AlreadyExists(w, e.Message())
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -273,7 +324,7 @@ func (s *Server) UpdateLessonHandler(w http.ResponseWriter, r *http.Request) {
 
 // DeleteLessonHandler удаляет урок
 // @Summary Удаление урока
-// @Description Удаляет урок по идентификатору
+// @Description Удаляет урок по идентификатору. Требуется право `lessons.delete` в курсе
 // @Tags Lessons
 // @Accept json
 // @Produce json
@@ -282,6 +333,7 @@ func (s *Server) UpdateLessonHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} lessons.DeleteLessonResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Урок не найден"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -311,16 +363,20 @@ func (s *Server) DeleteLessonHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isTeacher, err := s.IsTeacher(r.Context(), resp1.Lesson.CourseID)
+	if !s.Authorize(w, r, resp1.Lesson.CourseID, courses.PermissionLessonsDelete) {
+		return
+	}
+
+	resp, err := s.Lessons.DeleteLesson(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler lessons.DeleteLesson error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
-				NotFound(w, e.Message())
+				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -330,32 +386,496 @@ func (s *Server) DeleteLessonHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !isTeacher {
-		Forbidden(w)
+	err = redis.Delete(s.Redis, r.Context(), "Lessons.GetLesson", body.LessonID)
+	logger.Debug(r.Context(), "Lessons.GetLesson uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// ListLessonRevisionsHandler возвращает историю изменений урока
+// @Summary История изменений урока
+// @Description Возвращает версии урока от новых к старым без содержания. Каждое создание, изменение и восстановление урока сохраняет новую версию с автором и временем изменения. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Produce json
+// @Security BearerAuth
+// @Param lesson_id query string true "ID урока" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Success 200 {object} lessons.ListLessonRevisionsResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Урок не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/revisions [get]
+func (s *Server) ListLessonRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.ListLessonRevisionsRequest](r.Context())
+
+	if !s.authorizeLesson(w, r, body.LessonID, courses.PermissionLessonsWrite) {
 		return
 	}
 
-	resp, err := s.Lessons.DeleteLesson(r.Context(), body)
+	resp, err := s.Lessons.ListLessonRevisions(r.Context(), body)
 	if err != nil {
-		logger.Error(r.Context(), "Handler lessons.DeleteLesson error", slog.Any("error", err))
+		revisionError(w, r, "lessons.ListLessonRevisions", err)
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// GetLessonRevisionHandler возвращает версию урока
+// @Summary Получение версии урока
+// @Description Возвращает название и содержание урока в выбранной версии. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Produce json
+// @Security BearerAuth
+// @Param lesson_id query string true "ID урока" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Param revision query int true "Номер версии" example(2)
+// @Success 200 {object} lessons.GetLessonRevisionResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Урок или версия не найдены"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/revisions/revision [get]
+func (s *Server) GetLessonRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.GetLessonRevisionRequest](r.Context())
+
+	if !s.authorizeLesson(w, r, body.LessonID, courses.PermissionLessonsWrite) {
+		return
+	}
+
+	resp, err := s.Lessons.GetLessonRevision(r.Context(), body)
+	if err != nil {
+		revisionError(w, r, "lessons.GetLessonRevision", err)
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// LessonRevisionsDiffHandler сравнивает две версии урока
+// @Summary Сравнение версий урока
+// @Description Сравнивает содержание двух версий урока построчно. Строки возвращаются по порядку: общие для версий, удалённые из версии from и вставленные в версии to. Версии можно передать в любом порядке, from старше to не обязательно. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Produce json
+// @Security BearerAuth
+// @Param lesson_id query string true "ID урока" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Param from query int true "Номер старой версии" example(1)
+// @Param to query int true "Номер новой версии" example(3)
+// @Success 200 {object} lessons.LessonRevisionsDiffResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Урок или версия не найдены"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/revisions/diff [get]
+func (s *Server) LessonRevisionsDiffHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.LessonRevisionsDiffRequest](r.Context())
+
+	if !s.authorizeLesson(w, r, body.LessonID, courses.PermissionLessonsWrite) {
+		return
+	}
+
+	from, err := s.Lessons.GetLessonRevision(r.Context(), lessons.GetLessonRevisionRequest{LessonID: body.LessonID, Revision: body.From})
+	if err != nil {
+		revisionError(w, r, "lessons.GetLessonRevision", err)
+		return
+	}
+	to, err := s.Lessons.GetLessonRevision(r.Context(), lessons.GetLessonRevisionRequest{LessonID: body.LessonID, Revision: body.To})
+	if err != nil {
+		revisionError(w, r, "lessons.GetLessonRevision", err)
+		return
+	}
+
+	WriteJSON(w, lessons.NewLessonRevisionsDiffResponse(from, to), http.StatusOK)
+}
+
+// RestoreLessonRevisionHandler восстанавливает версию урока
+// @Summary Восстановление версии урока
+// @Description Возвращает уроку название и содержание выбранной версии. Восстановление сохраняется новой версией, поэтому история не теряется. Урок архивного курса восстановить нельзя. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body lessons.RestoreLessonRevisionRequest true "Урок и номер версии"
+// @Success 200 {object} lessons.RestoreLessonRevisionResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Урок или версия не найдены"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/revisions/restore [post]
+func (s *Server) RestoreLessonRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.RestoreLessonRevisionRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+	body.UserID = claims.UserID
+
+	if !s.authorizeLesson(w, r, body.LessonID, courses.PermissionLessonsWrite) {
+		return
+	}
+
+	resp, err := s.Lessons.RestoreLessonRevision(r.Context(), body)
+	if err != nil {
+		revisionError(w, r, "lessons.RestoreLessonRevision", err)
+		return
+	}
+
+	err = redis.Delete(s.Redis, r.Context(), "Lessons.GetLesson", body.LessonID)
+	logger.Debug(r.Context(), "Lessons.GetLesson uncached", slog.Any("error", err))
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// Проверяет право в курсе урока. false, если ответ уже записан
+func (s *Server) authorizeLesson(w http.ResponseWriter, r *http.Request, lessonID string, permission string) bool {
+	lesson, err := s.Lessons.GetLesson(r.Context(), lessons.GetLessonRequest{LessonID: lessonID})
+	if err != nil {
+		revisionError(w, r, "lessons.GetLesson", err)
+		return false
+	}
+	return s.Authorize(w, r, lesson.Lesson.CourseID, permission)
+}
+
+func revisionError(w http.ResponseWriter, r *http.Request, call string, err error) {
+	logger.Error(r.Context(), "Handler "+call+" error", slog.Any("error", err))
+
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			BadRequest(w, e.Message())
+		case codes.NotFound:
+			NotFound(w, e.Message())
+		case codes.Unavailable:
+			ServiceUnavailable(w)
+		}
+	} else {
+		InternalError(w)
+	}
+}
+
+// Запас на заголовки multipart формы сверх размера файла
+const multipartOverhead = 64 << 10
+
+// UploadAttachmentHandler загружает файл к занятию
+// @Summary Загрузка файла к занятию
+// @Description Загружает файл к занятию потоком, файл не буферизуется в Gateway целиком. Тип файла определяется по содержимому и должен быть в списке attachments.allowed_types. Размер файла ограничен attachments.max_size. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param lesson_id query string true "ID занятия" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Param file formData file true "Файл"
+// @Success 201 {object} lessons.AttachmentResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Занятие не найдено"
+// @Failure 413 {object} ErrorResponse "Файл слишком большой"
+// @Failure 415 {object} ErrorResponse "Тип файла не разрешён"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/attachments [post]
+func (s *Server) UploadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(app.NewLogger(r.Context(), true))
+	claims, _ := GetClaims(r.Context())
+
+	lesson, err := s.Lessons.GetLesson(r.Context(), lessons.GetLessonRequest{LessonID: r.URL.Query().Get("lesson_id")})
+	if err != nil {
+		attachmentError(w, r, "lessons.GetLesson", err)
+		return
+	}
+
+	if !s.Authorize(w, r, lesson.Lesson.CourseID, courses.PermissionLessonsWrite) {
+		return
+	}
+
+	maxSize := s.Config.Attachments.MaxSize
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		BadRequest(w, "multipart form expected")
+		return
+	}
+	part, err := nextFilePart(reader)
+	if err != nil {
+		logger.Debug(r.Context(), "Failed to read multipart form", slog.Any("error", err))
+		BadRequest(w, "file is required")
+		return
+	}
+	defer part.Close()
+	if part.FileName() == "" {
+		BadRequest(w, "file name is required")
+		return
+	}
+
+	contentType, file, err := lessons.DetectContentType(part, part.FileName(), part.Header.Get("Content-Type"), s.Config.Attachments.AllowedTypes)
+	if errors.Is(err, lessons.ErrUnsupportedFileType) {
+		UnsupportedMediaType(w, "file type is not allowed")
+		return
+	}
+	if err != nil {
+		logger.Debug(r.Context(), "Failed to read file", slog.Any("error", err))
+		BadRequest(w, "failed to read file")
+		return
+	}
+
+	req := lessons.UploadAttachmentRequest{
+		LessonID:    lesson.Lesson.LessonID,
+		FileName:    part.FileName(),
+		ContentType: contentType,
+		UserID:      claims.UserID,
+	}
+	resp, err := s.Lessons.UploadAttachment(r.Context(), req, lessons.LimitReader(file, maxSize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.Is(err, lessons.ErrTooLarge) || errors.As(err, &maxBytesErr) || status.Code(err) == codes.ResourceExhausted {
+		RequestEntityTooLarge(w, fmt.Sprintf("file must not exceed %d bytes", maxSize))
+		return
+	}
+	if err != nil {
+		attachmentError(w, r, "lessons.UploadAttachment", err)
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusCreated)
+}
+
+// ListAttachmentsHandler возвращает файлы занятия
+// @Summary Получение файлов занятия
+// @Description Возвращает файлы занятия в порядке загрузки. Ссылку на скачивание выдаёт /lessons/attachments/url. Требуется право `lessons.read` в курсе
+// @Tags Lessons
+// @Produce json
+// @Security BearerAuth
+// @Param lesson_id query string true "ID занятия" example("d277084b-e1f6-4670-825b-53951d20b5d3")
+// @Success 200 {object} lessons.ListAttachmentsResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Занятие не найдено"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/attachments [get]
+func (s *Server) ListAttachmentsHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.ListAttachmentsRequest](r.Context())
+
+	lesson, err := s.Lessons.GetLesson(r.Context(), lessons.GetLessonRequest{LessonID: body.LessonID})
+	if err != nil {
+		attachmentError(w, r, "lessons.GetLesson", err)
+		return
+	}
+
+	if !s.Authorize(w, r, lesson.Lesson.CourseID, courses.PermissionLessonsRead) {
+		return
+	}
+	if !s.requireVisible(w, r, lesson.Lesson) {
+		return
+	}
+
+	resp, err := s.Lessons.ListAttachments(r.Context(), body)
+	if err != nil {
+		attachmentError(w, r, "lessons.ListAttachments", err)
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// DeleteAttachmentHandler удаляет файл занятия
+// @Summary Удаление файла занятия
+// @Description Удаляет файл занятия из хранилища. Требуется право `lessons.write` в курсе
+// @Tags Lessons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body lessons.DeleteAttachmentRequest true "ID файла"
+// @Success 200 {object} lessons.AttachmentResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные или курс в архиве"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
+// @Failure 404 {object} ErrorResponse "Файл не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/attachments [delete]
+func (s *Server) DeleteAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.DeleteAttachmentRequest](r.Context())
+
+	attachment, err := s.Lessons.GetAttachment(r.Context(), body.AttachmentID)
+	if err != nil {
+		attachmentError(w, r, "lessons.GetAttachment", err)
+		return
+	}
+
+	if !s.Authorize(w, r, attachment.Attachment.CourseID, courses.PermissionLessonsWrite) {
+		return
+	}
+
+	resp, err := s.Lessons.DeleteAttachment(r.Context(), body)
+	if err != nil {
+		attachmentError(w, r, "lessons.DeleteAttachment", err)
+		return
+	}
+
+	WriteJSON(w, resp, http.StatusOK)
+}
+
+// GetAttachmentURLHandler выдаёт ссылку на скачивание файла
+// @Summary Ссылка на скачивание файла
+// @Description Выдаёт подписанную ссылку на скачивание файла, которая действует attachments.url_ttl и только для этого пользователя. Ссылку можно открыть без заголовка Authorization. Выдаётся только участникам курса занятия
+// @Tags Lessons
+// @Produce json
+// @Security BearerAuth
+// @Param attachment_id query string true "ID файла" example("5b1d2c3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+// @Success 200 {object} lessons.AttachmentURLResponse
+// @Failure 400 {object} ErrorResponse "Некорректные данные"
+// @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Пользователь не участник курса"
+// @Failure 404 {object} ErrorResponse "Файл не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/attachments/url [get]
+func (s *Server) GetAttachmentURLHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.GetAttachmentURLRequest](r.Context())
+	claims, _ := GetClaims(r.Context())
+
+	attachment, err := s.Lessons.GetAttachment(r.Context(), body.AttachmentID)
+	if err != nil {
+		attachmentError(w, r, "lessons.GetAttachment", err)
+		return
+	}
+
+	if !s.requireMember(w, r, claims.UserID, attachment.Attachment.CourseID) {
+		return
+	}
+	lesson, err := s.Lessons.GetLesson(r.Context(), lessons.GetLessonRequest{LessonID: attachment.Attachment.LessonID})
+	if err != nil {
+		attachmentError(w, r, "lessons.GetLesson", err)
+		return
+	}
+	if !s.requireVisible(w, r, lesson.Lesson) {
+		return
+	}
+
+	url, expiresAt := lessons.SignDownloadURL(s.attachmentSecret, attachment.Attachment.AttachmentID, claims.UserID, s.Config.Attachments.URLTTL)
+	WriteJSON(w, lessons.AttachmentURLResponse{URL: url, ExpiresAt: expiresAt}, http.StatusOK)
+}
+
+// DownloadAttachmentHandler отдаёт файл по подписанной ссылке
+// @Summary Скачивание файла
+// @Description Отдаёт содержимое файла потоком по ссылке из /lessons/attachments/url. Авторизация не нужна, но подпись должна быть верной и не истёкшей, а пользователь, которому выдана ссылка, всё ещё должен быть участником курса
+// @Tags Lessons
+// @Produce octet-stream
+// @Param attachment_id query string true "ID файла"
+// @Param user_id query string true "ID пользователя, которому выдана ссылка"
+// @Param expires query int true "Время окончания действия ссылки, unix секунды"
+// @Param signature query string true "Подпись ссылки"
+// @Success 200 {file} file "Содержимое файла"
+// @Failure 403 {object} ErrorResponse "Подпись неверна или истекла, или пользователь не участник курса"
+// @Failure 404 {object} ErrorResponse "Файл не найден"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Failure 503 {object} ErrorResponse "Сервис недоступен"
+// @Router /lessons/attachments/download [get]
+func (s *Server) DownloadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	body := GetBody[lessons.DownloadAttachmentRequest](r.Context())
+
+	if !lessons.VerifyDownload(s.attachmentSecret, body) {
+		Forbidden(w, "invalid or expired signature")
+		return
+	}
+
+	attachment, file, err := s.Lessons.DownloadAttachment(r.Context(), body.AttachmentID)
+	if err != nil {
+		attachmentError(w, r, "lessons.DownloadAttachment", err)
+		return
+	}
+	defer file.Close()
+
+	if !s.requireMember(w, r, body.UserID, attachment.CourseID) {
+		return
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", lessons.ContentDisposition(attachment.FileName))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, file); err != nil {
+		logger.Error(r.Context(), "Failed to stream attachment", slog.Any("error", err))
+	}
+}
+
+// Черновики и запланированные занятия видят только пользователи с правом lessons.read_drafts,
+// для остальных их нет
+func (s *Server) requireVisible(w http.ResponseWriter, r *http.Request, lesson lessons.Lesson) bool {
+	if lesson.Published() {
+		return true
+	}
+	readDrafts, ok := s.hasPermission(w, r, lesson.CourseID, courses.PermissionLessonsDrafts)
+	if !ok {
+		return false
+	}
+	if !readDrafts {
+		NotFound(w)
+		return false
+	}
+	return true
+}
+
+// Скачивать файлы занятия могут только участники курса: студенты, слушатели и преподаватели
+func (s *Server) requireMember(w http.ResponseWriter, r *http.Request, userID, courseID string) bool {
+	resp, err := s.Courses.IsMember(r.Context(), s.Redis, courses.IsMemberRequest{UserID: userID, CourseID: courseID})
+	if err != nil {
+		logger.Error(r.Context(), "Handler courses.IsMember error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
 		} else {
 			InternalError(w)
 		}
-		return
+		return false
 	}
 
-	err = redis.Delete(s.Redis, r.Context(), "Lessons.GetLesson", body.LessonID)
-	logger.Debug(r.Context(), "Lessons.GetLesson uncached", slog.Any("error", err))
+	if !resp.IsMember {
+		Forbidden(w, "user is not a member of the course")
+		return false
+	}
+	return true
+}
 
-	WriteJSON(w, resp, http.StatusOK)
+// Ищет в форме часть с файлом, остальные поля пропускаются
+func nextFilePart(reader *multipart.Reader) (*multipart.Part, error) {
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == "file" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
+
+func attachmentError(w http.ResponseWriter, r *http.Request, call string, err error) {
+	logger.Error(r.Context(), "Handler "+call+" error", slog.Any("error", err))
+
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			BadRequest(w, e.Message())
+		case codes.NotFound:
+			NotFound(w, e.Message())
+		case codes.Unavailable:
+			ServiceUnavailable(w)
+		}
+	} else {
+		InternalError(w)
+	}
 }
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"Classroom/Gateway/internal/courses"
 	"Classroom/Gateway/internal/tasks"
 	"Classroom/Gateway/pkg/logger"
 	"log/slog"
@@ -12,7 +13,7 @@ import (
 
 // CreateTaskHandler создает новую задачу
 // @Summary Создание задачи
-// @Description Создает новую задачу в курсе
+// @Description Создает новую задачу в курсе. Требуется право `tasks.write` в курсе
 // @Tags Tasks
 // @Accept json
 // @Produce json
@@ -28,27 +29,7 @@ import (
 func (s *Server) CreateTaskHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[tasks.CreateTaskRequest](r.Context())
 
-	isTeacher, err := s.IsTeacher(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionTasksWrite) {
 		return
 	}
 
@@ -58,7 +39,7 @@ func (s *Server) CreateTaskHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.Unavailable:
 				ServiceUnavailable(w)
@@ -74,7 +55,7 @@ func (s *Server) CreateTaskHandler(w http.ResponseWriter, r *http.Request) {
 
 // GetTaskHandler возвращает информацию о задаче
 // @Summary Получение задачи
-// @Description Возвращает детальную информацию о задаче в рамках курса
+// @Description Возвращает детальную информацию о задаче в рамках курса. Требуется право `tasks.read` в курсе
 // @Tags Tasks
 // @Produce json
 // @Security BearerAuth
@@ -91,17 +72,15 @@ func (s *Server) GetTaskHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[tasks.GetTaskRequest](r.Context())
 
 	resp, err := s.Tasks.GetTask(r.Context(), body)
-
-	isMember, err := s.IsMember(r.Context(), resp.Task.CourseID)
 	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsMember error", slog.Any("error", err))
+		logger.Error(r.Context(), "Handler tasks.GetTask error", slog.Any("error", err))
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
 			case codes.InvalidArgument:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
-				NotFound(w, e.Message())
+				NotFound(w)
 			case codes.Unavailable:
 				ServiceUnavailable(w)
 			}
@@ -111,8 +90,7 @@ func (s *Server) GetTaskHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !isMember {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp.Task.CourseID, courses.PermissionTasksRead) {
 		return
 	}
 
@@ -121,7 +99,7 @@ func (s *Server) GetTaskHandler(w http.ResponseWriter, r *http.Request) {
 
 // GetStudentStatuses возвращает статусы студентов для задачи
 // @Summary Получение статусов студентов
-// @Description Возвращает статусы выполнения задачи для студентов
+// @Description Возвращает статусы выполнения задачи для студентов. Требуется право `tasks.grade` в курсе
 // @Tags Tasks
 // @Produce json
 // @Security BearerAuth
@@ -159,27 +137,7 @@ func (s *Server) GetStudentStatuses(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isTeacher, err := s.IsTeacher(r.Context(), resp1.Task.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp1.Task.CourseID, courses.PermissionTasksGrade) {
 		return
 	}
 
@@ -207,7 +165,7 @@ func (s *Server) GetStudentStatuses(w http.ResponseWriter, r *http.Request) {
 
 // GetTasksHandler возвращает задачи для учителя
 // @Summary Получение списка задач для учителя
-// @Description Возвращает список задач курса для учителя
+// @Description Возвращает список задач курса для учителя. Требуется право `tasks.read` в курсе
 // @Tags Tasks
 // @Accept json
 // @Produce json
@@ -216,6 +174,7 @@ func (s *Server) GetStudentStatuses(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} tasks.GetTasksResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Курс не найден"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -223,27 +182,7 @@ func (s *Server) GetStudentStatuses(w http.ResponseWriter, r *http.Request) {
 func (s *Server) GetTasksForTeacherHandler(w http.ResponseWriter, r *http.Request) {
 	body := GetBody[tasks.GetTasksRequest](r.Context())
 
-	isTeacher, err := s.IsMember(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionTasksRead) {
 		return
 	}
 
@@ -271,7 +210,7 @@ func (s *Server) GetTasksForTeacherHandler(w http.ResponseWriter, r *http.Reques
 
 // GetTasksForStudentHandler возвращает задачи для студента
 // @Summary Получение задач студента
-// @Description Возвращает список задач для конкретного студента
+// @Description Возвращает список задач для конкретного студента. Требуется право `tasks.submit` в курсе
 // @Tags Tasks
 // @Accept json
 // @Produce json
@@ -280,6 +219,7 @@ func (s *Server) GetTasksForTeacherHandler(w http.ResponseWriter, r *http.Reques
 // @Success 200 {object} tasks.GetTasksForStudentResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Студент не найден"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -289,27 +229,7 @@ func (s *Server) GetTasksForStudentHandler(w http.ResponseWriter, r *http.Reques
 	claims, _ := GetClaims(r.Context())
 	body.StudentID = claims.UserID
 
-	isStudent, err := s.IsStudent(r.Context(), body.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsStudent error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isStudent {
-		Forbidden(w)
+	if !s.Authorize(w, r, body.CourseID, courses.PermissionTasksSubmit) {
 		return
 	}
 
@@ -337,7 +257,7 @@ func (s *Server) GetTasksForStudentHandler(w http.ResponseWriter, r *http.Reques
 
 // UpdateTaskHandler обновляет информацию о задаче
 // @Summary Обновление задачи
-// @Description Обновляет информацию о задаче
+// @Description Обновляет информацию о задаче. Требуется право `tasks.write` в курсе
 // @Tags Tasks
 // @Accept json
 // @Produce json
@@ -346,6 +266,7 @@ func (s *Server) GetTasksForStudentHandler(w http.ResponseWriter, r *http.Reques
 // @Success 200 {object} tasks.UpdateTaskResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Задача не найдена"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -375,27 +296,7 @@ func (s *Server) UpdateTaskHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isTeacher, err := s.IsTeacher(r.Context(), resp1.Task.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp1.Task.CourseID, courses.PermissionTasksWrite) {
 		return
 	}
 
@@ -405,7 +306,7 @@ func (s *Server) UpdateTaskHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
@@ -423,7 +324,7 @@ func (s *Server) UpdateTaskHandler(w http.ResponseWriter, r *http.Request) {
 
 // ChangeStatusTaskHandler изменяет статус задачи
 // @Summary Изменение статуса задачи
-// @Description Обновляет статус выполнения задачи студентом
+// @Description Обновляет статус выполнения задачи студентом. Требуется право `tasks.submit` в курсе
 // @Tags Tasks
 // @Accept json
 // @Produce json
@@ -432,6 +333,7 @@ func (s *Server) UpdateTaskHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} tasks.ChangeStatusTaskResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Задача не найдена"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -461,27 +363,7 @@ func (s *Server) ChangeStatusTaskHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	isStudent, err := s.IsStudent(r.Context(), resp1.Task.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsStudent error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isStudent {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp1.Task.CourseID, courses.PermissionTasksSubmit) {
 		return
 	}
 
@@ -491,7 +373,7 @@ func (s *Server) ChangeStatusTaskHandler(w http.ResponseWriter, r *http.Request)
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
@@ -509,7 +391,7 @@ func (s *Server) ChangeStatusTaskHandler(w http.ResponseWriter, r *http.Request)
 
 // DeleteTaskHandler удаляет задачу
 // @Summary Удаление задачи
-// @Description Удаляет задачу по идентификатору
+// @Description Удаляет задачу по идентификатору. Требуется право `tasks.delete` в курсе
 // @Tags Tasks
 // @Accept json
 // @Produce json
@@ -518,6 +400,7 @@ func (s *Server) ChangeStatusTaskHandler(w http.ResponseWriter, r *http.Request)
 // @Success 200 {object} tasks.DeleteTaskResponse
 // @Failure 400 {object} ErrorResponse "Некорректные данные"
 // @Failure 401 {object} ErrorResponse "Требуется авторизация"
+// @Failure 403 {object} ErrorResponse "Нет права в курсе"
 // @Failure 404 {object} ErrorResponse "Задача не найдена"
 // @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
 // @Failure 503 {object} ErrorResponse "Сервис недоступен"
@@ -547,27 +430,7 @@ func (s *Server) DeleteTaskHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isTeacher, err := s.IsTeacher(r.Context(), resp1.Task.CourseID)
-	if err != nil {
-		logger.Error(r.Context(), "Handler courses.IsTeacher error", slog.Any("error", err))
-
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				BadRequest(w, e.Message())
-			case codes.NotFound:
-				NotFound(w, e.Message())
-			case codes.Unavailable:
-				ServiceUnavailable(w)
-			}
-		} else {
-			InternalError(w)
-		}
-		return
-	}
-
-	if !isTeacher {
-		Forbidden(w)
+	if !s.Authorize(w, r, resp1.Task.CourseID, courses.PermissionTasksDelete) {
 		return
 	}
 
@@ -577,7 +440,7 @@ func (s *Server) DeleteTaskHandler(w http.ResponseWriter, r *http.Request) {
 
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() {
-			case codes.InvalidArgument:
+			case codes.InvalidArgument, codes.FailedPrecondition:
 				BadRequest(w, e.Message())
 			case codes.NotFound:
 				NotFound(w)
@@ -1,8 +1,11 @@
 package server
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // ErrorResponse стандартизированный формат для возврата ошибок API
@@ -85,3 +88,33 @@ func ServiceUnavailable(w http.ResponseWriter, msgs ...string) {
 		http.StatusServiceUnavailable,
 	)
 }
+
+func TooManyRequests(w http.ResponseWriter, retryAfter time.Duration, msgs ...string) {
+	message := mergeMsgs("Too many requests", msgs)
+	if retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+	WriteJSON(
+		w,
+		ErrorResponse{Code: http.StatusTooManyRequests, Message: message},
+		http.StatusTooManyRequests,
+	)
+}
+
+func RequestEntityTooLarge(w http.ResponseWriter, msgs ...string) {
+	message := mergeMsgs("Request entity too large", msgs)
+	WriteJSON(
+		w,
+		ErrorResponse{Code: http.StatusRequestEntityTooLarge, Message: message},
+		http.StatusRequestEntityTooLarge,
+	)
+}
+
+func UnsupportedMediaType(w http.ResponseWriter, msgs ...string) {
+	message := mergeMsgs("Unsupported media type", msgs)
+	WriteJSON(
+		w,
+		ErrorResponse{Code: http.StatusUnsupportedMediaType, Message: message},
+		http.StatusUnsupportedMediaType,
+	)
+}
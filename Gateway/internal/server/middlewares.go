@@ -1,17 +1,20 @@
 package server
 
 import (
+	"Classroom/Gateway/internal/auth"
 	"Classroom/Gateway/internal/courses"
 	app "Classroom/Gateway/internal/logger"
 	"Classroom/Gateway/pkg/logger"
-	"context"
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func JSONHandlerWrapper[T any](handler http.HandlerFunc) http.HandlerFunc {
@@ -49,12 +52,21 @@ func QueryHandlerWrapper[T any](handler http.HandlerFunc)  http.HandlerFunc {
 }
 
 type AuthClaims struct {
-	UserID      string `json:"user_id"`
-	IsSuperUser bool   `json:"is_superuser"`
+	UserID      string   `json:"user_id"`
+	IsSuperUser bool     `json:"is_superuser"`
+	Scopes      []string `json:"scopes,omitempty"`
+	// Запрос авторизован персональным токеном доступа, а не JWT
+	PersonalToken bool `json:"-"`
 	jwt.RegisteredClaims
 }
 
-func (s *Server) IsAuthenticated(next http.HandlerFunc) http.HandlerFunc {
+// Префикс персональных токенов доступа, совпадает с префиксом в Auth
+const accessTokenPrefix = "cpat_"
+
+// IsAuthenticated принимает JWT и персональные токены доступа. Персональный токен
+// пропускается только на маршруты, которые объявили scopes, и только если у токена
+// есть все эти scopes. Для JWT scopes не проверяются
+func (s *Server) IsAuthenticated(next http.HandlerFunc, scopes ...string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := app.NewLogger(r.Context(), true)
 
@@ -72,13 +84,21 @@ func (s *Server) IsAuthenticated(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
+		if strings.HasPrefix(parts[1], accessTokenPrefix) {
+			s.authenticateAccessToken(w, r.WithContext(ctx), parts[1], next, scopes)
+			return
+		}
+
+		// Токены подписаны асимметричным ключом Auth, публичный ключ ищем в JWKS по kid
 		var claims AuthClaims
-		authJWTSecret := s.Config.Common.AuthJWTSecret
 		token, err := jwt.ParseWithClaims(parts[1], &claims, func(token *jwt.Token) (any, error) {
-			return []byte(authJWTSecret), nil
-		})
+			kid, _ := token.Header["kid"].(string)
+			return s.Keys.Key(ctx, kid)
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 
 		if err != nil || !token.Valid {
+			logger.Debug(ctx, "Invalid access token", slog.Any("error", err))
+
 			Unauthorized(w, "invalid access token")
 			return
 		}
@@ -88,69 +108,92 @@ func (s *Server) IsAuthenticated(next http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
-func (s *Server) IsStudent(ctx context.Context, courseID string) (bool, error) {
-	claims, ok := GetClaims(ctx)
+func (s *Server) authenticateAccessToken(w http.ResponseWriter, r *http.Request, token string, next http.HandlerFunc, scopes []string) {
+	ctx := r.Context()
 
-	logger.Debug(ctx, "claims", slog.Any("status", ok))
-	req := courses.IsMemberRequest{
-		UserID: claims.UserID,
-		CourseID: courseID,
+	if len(scopes) == 0 {
+		Forbidden(w, "route is not available for personal access tokens")
+		return
 	}
 
-	resp, err := s.Courses.IsMember(ctx, s.Redis, req)
+	req := auth.IntrospectAccessTokenRequest{Token: token}
+	resp, err := s.Auth.IntrospectAccessToken(ctx, s.Redis, s.Config.Common.AccessTokenCacheTTL, req)
 	if err != nil {
-		return false, err
-	}
+		logger.Debug(ctx, "Invalid personal access token", slog.Any("error", err))
 
-	return resp.IsMember, nil
-}
-
-func (s *Server) IsTeacher(ctx context.Context, courseID string) (bool, error) {
-	claims, ok := GetClaims(ctx)
+		if e, ok := status.FromError(err); ok && e.Code() == codes.Unavailable {
+			ServiceUnavailable(w)
+		} else {
+			Unauthorized(w, "invalid access token")
+		}
+		return
+	}
 
-	logger.Debug(ctx, "claims", slog.Any("status", ok))
-	req := courses.IsTeacherRequest{
-		UserID: claims.UserID,
-		CourseID: courseID,
+	for _, scope := range scopes {
+		if !slices.Contains(resp.Scopes, scope) {
+			Forbidden(w, "missing scope "+scope)
+			return
+		}
 	}
 
-	resp, err := s.Courses.IsTeacher(ctx, s.Redis, req)
-	if err != nil {
-		return false, err
+	claims := AuthClaims{
+		UserID:        resp.UserID,
+		IsSuperUser:   resp.IsSuperUser,
+		Scopes:        resp.Scopes,
+		PersonalToken: true,
 	}
-	
-	return resp.IsTeacher, nil
-}
 
-func (s *Server) IsMember(ctx context.Context, courseID string) (bool, error) {
-	claims, ok := GetClaims(ctx)
+	logger.Debug(ctx, "claims", slog.Any("claims", claims))
+	next.ServeHTTP(w, r.WithContext(WithClaims(ctx, claims)))
+}
 
-	logger.Debug(ctx, "claims", slog.Any("status", ok))
-	req1 := courses.IsMemberRequest{
-		UserID: claims.UserID,
-		CourseID: courseID,
+// Проверяет право пользователя в курсе. Если права нет или проверить его не удалось,
+// сам отвечает ошибкой и возвращает false
+func (s *Server) Authorize(w http.ResponseWriter, r *http.Request, courseID string, permission string) bool {
+	allowed, ok := s.hasPermission(w, r, courseID, permission)
+	if !ok {
+		return false
 	}
-
-	resp1, err := s.Courses.IsMember(ctx, s.Redis, req1)
-	if err != nil {
-		return false, err
+	if !allowed {
+		Forbidden(w, "missing permission "+permission)
+		return false
 	}
+	return true
+}
 
-	if resp1.IsMember {
-		return resp1.IsMember, nil
-	}
+// Проверяет право, но не отвечает 403, если его нет. ok == false, если ответ уже записан
+func (s *Server) hasPermission(w http.ResponseWriter, r *http.Request, courseID string, permission string) (allowed bool, ok bool) {
+	claims, ok := GetClaims(r.Context())
 
-	req2 := courses.IsTeacherRequest{
-		UserID: claims.UserID,
-		CourseID: courseID,
+	logger.Debug(r.Context(), "claims", slog.Any("status", ok))
+	req := courses.AuthorizeRequest{
+		UserID:     claims.UserID,
+		CourseID:   courseID,
+		Permission: permission,
 	}
 
-	resp2, err := s.Courses.IsTeacher(ctx, s.Redis, req2)
+	resp, err := s.Courses.Authorize(r.Context(), s.Redis, s.Config.Common.PermissionsCacheTTL, req)
 	if err != nil {
-		return false, err
+		logger.Error(r.Context(), "Handler courses.Authorize error", slog.Any("error", err))
+
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				BadRequest(w, e.Message())
+			case codes.NotFound:
+				NotFound(w, "course not found")
+			case codes.Unavailable:
+				ServiceUnavailable(w)
+			default:
+				InternalError(w)
+			}
+		} else {
+			InternalError(w)
+		}
+		return false, false
 	}
 
-	return resp2.IsTeacher, nil
+	return resp.Allowed, true
 }
 
 func (s *Server) IsSuperUser(next http.HandlerFunc) http.HandlerFunc {
@@ -10,9 +10,12 @@ import (
 	"Classroom/Gateway/pkg/config"
 	"Classroom/Gateway/pkg/logger"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
+	"strings"
 
 	"github.com/redis/go-redis/v9"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -24,9 +27,15 @@ type Server struct {
 	Server  *http.Server
 	Redis   *redis.Client
 	Auth    *auth.AuthServiceClient
+	Keys    *auth.KeyCache
 	Courses *courses.CoursesServiceClient
 	Lessons *lessons.LessonsServiceClient
 	Tasks   *tasks.TasksServiceClient
+
+	// Ключ подписи ссылок на скачивание файлов занятий
+	attachmentSecret []byte
+	// Подсети прокси, которым доверяем адрес клиента из заголовков
+	trustedProxies []netip.Prefix
 }
 
 func enableCORS(next http.Handler) http.Handler {
@@ -81,46 +90,117 @@ func (s *Server) RegisterMux(mux *http.ServeMux) {
 	// Auth handlers
 	if s.Config.Auth.Enabled {
 
+		mux.HandleFunc("GET /api/.well-known/jwks.json", s.JWKSHandler)
 		mux.HandleFunc("POST /api/auth/register", JSONHandlerWrapper[auth.RegisterRequest](s.RegisterHandler))
 		mux.HandleFunc("POST /api/auth/login", JSONHandlerWrapper[auth.LoginRequest](s.LoginHandler))
 		mux.HandleFunc("POST /api/auth/refresh", JSONHandlerWrapper[auth.RefreshRequest](s.RefreshHandler))
 		mux.HandleFunc("POST /api/auth/logout", s.IsAuthenticated(JSONHandlerWrapper[auth.LogoutRequest](s.LogoutHandler)))
-		mux.HandleFunc("GET /api/auth/user-info", s.IsAuthenticated(QueryHandlerWrapper[auth.GetUserInfoRequest](s.GetUserInfoHandler)))
+		mux.HandleFunc("GET /api/auth/user-info", s.IsAuthenticated(QueryHandlerWrapper[auth.GetUserInfoRequest](s.GetUserInfoHandler), "profile:read"))
+		mux.HandleFunc("POST /api/auth/password-reset/request", JSONHandlerWrapper[auth.RequestPasswordResetRequest](s.RequestPasswordResetHandler))
+		mux.HandleFunc("POST /api/auth/password-reset/confirm", JSONHandlerWrapper[auth.ResetPasswordRequest](s.ResetPasswordHandler))
+		mux.HandleFunc("POST /api/auth/verify-email", JSONHandlerWrapper[auth.VerifyEmailRequest](s.VerifyEmailHandler))
+		mux.HandleFunc("POST /api/auth/verify-email/resend", JSONHandlerWrapper[auth.ResendVerificationEmailRequest](s.ResendVerificationEmailHandler))
+		mux.HandleFunc("GET /api/auth/sessions", s.IsAuthenticated(s.ListSessionsHandler))
+		mux.HandleFunc("DELETE /api/auth/sessions", s.IsAuthenticated(s.RevokeAllSessionsHandler))
+		mux.HandleFunc("DELETE /api/auth/sessions/session", s.IsAuthenticated(JSONHandlerWrapper[auth.RevokeSessionRequest](s.RevokeSessionHandler)))
+		mux.HandleFunc("POST /api/auth/mfa/setup", s.IsAuthenticated(s.SetupMFAHandler))
+		mux.HandleFunc("POST /api/auth/mfa/confirm", s.IsAuthenticated(JSONHandlerWrapper[auth.ConfirmMFARequest](s.ConfirmMFAHandler)))
+		mux.HandleFunc("POST /api/auth/mfa/disable", s.IsAuthenticated(JSONHandlerWrapper[auth.DisableMFARequest](s.DisableMFAHandler)))
+		mux.HandleFunc("POST /api/auth/mfa/verify", JSONHandlerWrapper[auth.VerifyMFARequest](s.VerifyMFAHandler))
+		mux.HandleFunc("PATCH /api/auth/profile", s.IsAuthenticated(JSONHandlerWrapper[auth.UpdateProfileRequest](s.UpdateProfileHandler)))
+		mux.HandleFunc("PATCH /api/auth/profile/password", s.IsAuthenticated(JSONHandlerWrapper[auth.ChangePasswordRequest](s.ChangePasswordHandler)))
+		mux.HandleFunc("PATCH /api/auth/profile/email", s.IsAuthenticated(JSONHandlerWrapper[auth.ChangeEmailRequest](s.ChangeEmailHandler)))
+		mux.HandleFunc("GET /api/auth/access-tokens", s.IsAuthenticated(s.ListAccessTokensHandler))
+		mux.HandleFunc("POST /api/auth/access-tokens", s.IsAuthenticated(JSONHandlerWrapper[auth.CreateAccessTokenRequest](s.CreateAccessTokenHandler)))
+		mux.HandleFunc("DELETE /api/auth/access-tokens", s.IsAuthenticated(JSONHandlerWrapper[auth.RevokeAccessTokenRequest](s.RevokeAccessTokenHandler)))
+		mux.HandleFunc("GET /api/admin/lockouts", s.IsSuperUser(s.ListLockoutsHandler))
+		mux.HandleFunc("DELETE /api/admin/lockouts", s.IsSuperUser(JSONHandlerWrapper[auth.ClearLockoutRequest](s.ClearLockoutHandler)))
+		mux.HandleFunc("GET /api/admin/users", s.IsSuperUser(QueryHandlerWrapper[auth.ListUsersRequest](s.ListUsersHandler)))
+		mux.HandleFunc("GET /api/admin/users/user", s.IsSuperUser(QueryHandlerWrapper[auth.GetUserRequest](s.GetUserHandler)))
+		mux.HandleFunc("PATCH /api/admin/users/user/superuser", s.IsSuperUser(JSONHandlerWrapper[auth.SetSuperuserRequest](s.SetSuperuserHandler)))
+		mux.HandleFunc("PATCH /api/admin/users/user/disable", s.IsSuperUser(JSONHandlerWrapper[auth.DisableUserRequest](s.DisableUserHandler)))
+		mux.HandleFunc("DELETE /api/admin/users/user", s.IsSuperUser(JSONHandlerWrapper[auth.DeleteUserRequest](s.DeleteUserHandler)))
 	}
 
 	// Courses handlers
 	if s.Config.Auth.Enabled && s.Config.Courses.Enabled {
-		mux.HandleFunc("POST /api/courses/create", s.IsAuthenticated(JSONHandlerWrapper[courses.CreateCourseRequest](s.CreateCourseHandler)))
-		mux.HandleFunc("GET /api/courses/course", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCourseRequest](s.GetCourseHandler)))
-		mux.HandleFunc("GET /api/courses/courses", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursesRequest](s.GetCoursesHandler)))
-		mux.HandleFunc("GET /api/courses/student-courses", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursesByStudentRequest](s.GetCoursesByStudentHandler)))
-		mux.HandleFunc("GET /api/courses/teacher-courses", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursesByTeacherRequest](s.GetCoursesByTeacherHandler)))
-		mux.HandleFunc("PUT /api/courses/course/update", s.IsAuthenticated(JSONHandlerWrapper[courses.UpdateCourseRequest](s.UpdateCourseHandler)))
-		mux.HandleFunc("DELETE /api/courses/course/delete", s.IsAuthenticated(JSONHandlerWrapper[courses.DeleteCourseRequest](s.DeleteCourseHandler)))
-		mux.HandleFunc("POST /api/courses/course/enroll", s.IsAuthenticated(JSONHandlerWrapper[courses.EnrollUserRequest](s.EnrollUserHandler)))
-		mux.HandleFunc("POST /api/courses/course/expel", s.IsAuthenticated(JSONHandlerWrapper[courses.ExpelUserRequest](s.ExpelUserHandler)))
-		mux.HandleFunc("GET /api/courses/course/students", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCourseStudentsRequest](s.GetCourseStudentsHandler)))
+		mux.HandleFunc("POST /api/courses/create", s.IsAuthenticated(JSONHandlerWrapper[courses.CreateCourseRequest](s.CreateCourseHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/course", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCourseRequest](s.GetCourseHandler), "courses:read"))
+		mux.HandleFunc("GET /api/courses/courses", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursesRequest](s.GetCoursesHandler), "courses:read"))
+		mux.HandleFunc("GET /api/courses/catalog", s.IsAuthenticated(QueryHandlerWrapper[courses.SearchCoursesRequest](s.SearchCoursesHandler), "courses:read"))
+		mux.HandleFunc("GET /api/courses/student-courses", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursesByStudentRequest](s.GetCoursesByStudentHandler), "courses:read"))
+		mux.HandleFunc("GET /api/courses/teacher-courses", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursesByTeacherRequest](s.GetCoursesByTeacherHandler), "courses:read"))
+		mux.HandleFunc("PUT /api/courses/course/update", s.IsAuthenticated(JSONHandlerWrapper[courses.UpdateCourseRequest](s.UpdateCourseHandler), "courses:write"))
+		mux.HandleFunc("DELETE /api/courses/course/delete", s.IsAuthenticated(JSONHandlerWrapper[courses.DeleteCourseRequest](s.DeleteCourseHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/archive", s.IsAuthenticated(JSONHandlerWrapper[courses.ArchiveCourseRequest](s.ArchiveCourseHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/restore", s.IsAuthenticated(JSONHandlerWrapper[courses.RestoreCourseRequest](s.RestoreCourseHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/clone", s.IsAuthenticated(JSONHandlerWrapper[courses.CloneCourseRequest](s.CloneCourseHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/clone-jobs", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCloneJobRequest](s.GetCloneJobHandler), "courses:read"))
+		mux.HandleFunc("POST /api/courses/course/enroll", s.IsAuthenticated(JSONHandlerWrapper[courses.EnrollUserRequest](s.EnrollUserHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/enroll/bulk", s.IsAuthenticated(s.BulkEnrollHandler, "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/expel", s.IsAuthenticated(JSONHandlerWrapper[courses.ExpelUserRequest](s.ExpelUserHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/course/students", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCourseStudentsRequest](s.GetCourseStudentsHandler), "courses:read"))
+		mux.HandleFunc("GET /api/courses/course/students/export", s.IsAuthenticated(QueryHandlerWrapper[courses.ExportRosterRequest](s.ExportRosterHandler), "courses:read"))
+		mux.HandleFunc("GET /api/courses/course/permissions", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCoursePermissionsRequest](s.GetCoursePermissionsHandler), "courses:read"))
+		mux.HandleFunc("PUT /api/courses/course/permissions", s.IsAuthenticated(JSONHandlerWrapper[courses.SetRolePermissionsRequest](s.SetRolePermissionsHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/course/staff", s.IsAuthenticated(QueryHandlerWrapper[courses.ListStaffRequest](s.ListStaffHandler), "courses:read"))
+		mux.HandleFunc("POST /api/courses/course/staff", s.IsAuthenticated(JSONHandlerWrapper[courses.AddStaffRequest](s.AddStaffHandler), "courses:write"))
+		mux.HandleFunc("DELETE /api/courses/course/staff", s.IsAuthenticated(JSONHandlerWrapper[courses.RemoveStaffRequest](s.RemoveStaffHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/course/invites", s.IsAuthenticated(QueryHandlerWrapper[courses.ListInvitesRequest](s.ListInvitesHandler), "courses:read"))
+		mux.HandleFunc("POST /api/courses/course/invites", s.IsAuthenticated(JSONHandlerWrapper[courses.CreateInviteRequest](s.CreateInviteHandler), "courses:write"))
+		mux.HandleFunc("DELETE /api/courses/course/invites", s.IsAuthenticated(JSONHandlerWrapper[courses.RevokeInviteRequest](s.RevokeInviteHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/join", s.IsAuthenticated(JSONHandlerWrapper[courses.JoinByCodeRequest](s.JoinByCodeHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/course/requests", s.IsAuthenticated(QueryHandlerWrapper[courses.ListEnrollmentRequestsRequest](s.ListEnrollmentRequestsHandler), "courses:read"))
+		mux.HandleFunc("POST /api/courses/course/requests", s.IsAuthenticated(JSONHandlerWrapper[courses.RequestEnrollmentRequest](s.RequestEnrollmentHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/requests/approve", s.IsAuthenticated(JSONHandlerWrapper[courses.DecideEnrollmentRequest](s.ApproveEnrollmentHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/requests/reject", s.IsAuthenticated(JSONHandlerWrapper[courses.DecideEnrollmentRequest](s.RejectEnrollmentHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/course/transfer", s.IsAuthenticated(JSONHandlerWrapper[courses.TransferOwnershipRequest](s.TransferOwnershipHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/transfers", s.IsAuthenticated(s.ListOwnershipTransfersHandler, "courses:read"))
+		mux.HandleFunc("POST /api/courses/transfers/accept", s.IsAuthenticated(JSONHandlerWrapper[courses.DecideOwnershipTransferRequest](s.AcceptOwnershipTransferHandler), "courses:write"))
+		mux.HandleFunc("POST /api/courses/transfers/decline", s.IsAuthenticated(JSONHandlerWrapper[courses.DecideOwnershipTransferRequest](s.DeclineOwnershipTransferHandler), "courses:write"))
+		mux.HandleFunc("GET /api/courses/modules", s.IsAuthenticated(QueryHandlerWrapper[courses.ListModulesRequest](s.ListModulesHandler), "courses:read"))
+		mux.HandleFunc("POST /api/courses/modules", s.IsAuthenticated(JSONHandlerWrapper[courses.CreateModuleRequest](s.CreateModuleHandler), "courses:write"))
+		mux.HandleFunc("PATCH /api/courses/modules", s.IsAuthenticated(JSONHandlerWrapper[courses.UpdateModuleRequest](s.UpdateModuleHandler), "courses:write"))
+		mux.HandleFunc("DELETE /api/courses/modules", s.IsAuthenticated(JSONHandlerWrapper[courses.DeleteModuleRequest](s.DeleteModuleHandler), "courses:write"))
+		mux.HandleFunc("PUT /api/courses/modules/reorder", s.IsAuthenticated(JSONHandlerWrapper[courses.ReorderModuleRequest](s.ReorderModuleHandler), "courses:write"))
+	}
+
+	// Структура курса собирается из Courses, Lessons и Tasks
+	if s.Config.Auth.Enabled && s.Config.Courses.Enabled && s.Config.Lessons.Enabled && s.Config.Tasks.Enabled {
+		mux.HandleFunc("GET /api/courses/outline", s.IsAuthenticated(QueryHandlerWrapper[courses.GetCourseOutlineRequest](s.GetCourseOutlineHandler), "courses:read", "lessons:read", "tasks:read"))
 	}
 
 	// Lessons handlers
 	if s.Config.Auth.Enabled && s.Config.Courses.Enabled && s.Config.Lessons.Enabled {
-		mux.HandleFunc("POST /api/lessons/create", s.IsAuthenticated(JSONHandlerWrapper[lessons.CreateLessonRequest](s.CreateLessonHandler)))
-		mux.HandleFunc("GET /api/lessons/lesson", s.IsAuthenticated(QueryHandlerWrapper[lessons.GetLessonRequest](s.GetLessonHandler)))
-		mux.HandleFunc("GET /api/lessons/lessons", s.IsAuthenticated(QueryHandlerWrapper[lessons.GetLessonsRequest](s.GetLessonsHandler)))
-		mux.HandleFunc("PUT /api/lessons/lesson/update", s.IsAuthenticated(JSONHandlerWrapper[lessons.UpdateLessonRequest](s.UpdateLessonHandler)))
-		mux.HandleFunc("DELETE /api/lessons/lesson/delete", s.IsAuthenticated(JSONHandlerWrapper[lessons.DeleteLessonRequest](s.DeleteLessonHandler)))
+		mux.HandleFunc("POST /api/lessons/create", s.IsAuthenticated(JSONHandlerWrapper[lessons.CreateLessonRequest](s.CreateLessonHandler), "lessons:write"))
+		mux.HandleFunc("GET /api/lessons/lesson", s.IsAuthenticated(QueryHandlerWrapper[lessons.GetLessonRequest](s.GetLessonHandler), "lessons:read"))
+		mux.HandleFunc("GET /api/lessons/lessons", s.IsAuthenticated(QueryHandlerWrapper[lessons.GetLessonsRequest](s.GetLessonsHandler), "lessons:read"))
+		mux.HandleFunc("PUT /api/lessons/reorder", s.IsAuthenticated(JSONHandlerWrapper[lessons.ReorderLessonsRequest](s.ReorderLessonsHandler), "lessons:write"))
+		mux.HandleFunc("POST /api/lessons/preview", s.IsAuthenticated(JSONHandlerWrapper[lessons.PreviewLessonRequest](s.PreviewLessonHandler), "lessons:write"))
+		mux.HandleFunc("PUT /api/lessons/lesson/update", s.IsAuthenticated(JSONHandlerWrapper[lessons.UpdateLessonRequest](s.UpdateLessonHandler), "lessons:write"))
+		mux.HandleFunc("DELETE /api/lessons/lesson/delete", s.IsAuthenticated(JSONHandlerWrapper[lessons.DeleteLessonRequest](s.DeleteLessonHandler), "lessons:write"))
+		mux.HandleFunc("GET /api/lessons/revisions", s.IsAuthenticated(QueryHandlerWrapper[lessons.ListLessonRevisionsRequest](s.ListLessonRevisionsHandler), "lessons:write"))
+		mux.HandleFunc("GET /api/lessons/revisions/revision", s.IsAuthenticated(QueryHandlerWrapper[lessons.GetLessonRevisionRequest](s.GetLessonRevisionHandler), "lessons:write"))
+		mux.HandleFunc("GET /api/lessons/revisions/diff", s.IsAuthenticated(QueryHandlerWrapper[lessons.LessonRevisionsDiffRequest](s.LessonRevisionsDiffHandler), "lessons:write"))
+		mux.HandleFunc("POST /api/lessons/revisions/restore", s.IsAuthenticated(JSONHandlerWrapper[lessons.RestoreLessonRevisionRequest](s.RestoreLessonRevisionHandler), "lessons:write"))
+		mux.HandleFunc("POST /api/lessons/attachments", s.IsAuthenticated(s.UploadAttachmentHandler, "lessons:write"))
+		mux.HandleFunc("GET /api/lessons/attachments", s.IsAuthenticated(QueryHandlerWrapper[lessons.ListAttachmentsRequest](s.ListAttachmentsHandler), "lessons:read"))
+		mux.HandleFunc("DELETE /api/lessons/attachments", s.IsAuthenticated(JSONHandlerWrapper[lessons.DeleteAttachmentRequest](s.DeleteAttachmentHandler), "lessons:write"))
+		mux.HandleFunc("GET /api/lessons/attachments/url", s.IsAuthenticated(QueryHandlerWrapper[lessons.GetAttachmentURLRequest](s.GetAttachmentURLHandler), "lessons:read"))
+		// Ссылка на скачивание подписана, заголовок Authorization не нужен
+		mux.HandleFunc("GET "+lessons.DownloadPath, QueryHandlerWrapper[lessons.DownloadAttachmentRequest](s.DownloadAttachmentHandler))
 	}
 
 	// Tasks handlers
 	if s.Config.Auth.Enabled && s.Config.Courses.Enabled && s.Config.Tasks.Enabled {
-		mux.HandleFunc("POST /api/tasks/create", s.IsAuthenticated(JSONHandlerWrapper[tasks.CreateTaskRequest](s.CreateTaskHandler)))
-		mux.HandleFunc("GET /api/tasks/task", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetTaskRequest](s.GetTaskHandler)))
-		mux.HandleFunc("GET /api/tasks/student-tasks", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetTasksForStudentRequest](s.GetTasksForStudentHandler)))
-		mux.HandleFunc("GET /api/tasks/teacher-tasks", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetTasksRequest](s.GetTasksForTeacherHandler)))
-		mux.HandleFunc("GET /api/tasks/student-statuses", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetStudentStatusesRequest](s.GetStudentStatuses)))
-		mux.HandleFunc("PUT /api/tasks/task/update", s.IsAuthenticated(JSONHandlerWrapper[tasks.UpdateTaskRequest](s.UpdateTaskHandler)))
-		mux.HandleFunc("DELETE /api/tasks/task/delete", s.IsAuthenticated(JSONHandlerWrapper[tasks.DeleteTaskRequest](s.DeleteTaskHandler)))
-		mux.HandleFunc("PATCH /api/tasks/task/changestatus", s.IsAuthenticated(JSONHandlerWrapper[tasks.ChangeStatusTaskRequest](s.ChangeStatusTaskHandler)))
+		mux.HandleFunc("POST /api/tasks/create", s.IsAuthenticated(JSONHandlerWrapper[tasks.CreateTaskRequest](s.CreateTaskHandler), "tasks:write"))
+		mux.HandleFunc("GET /api/tasks/task", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetTaskRequest](s.GetTaskHandler), "tasks:read"))
+		mux.HandleFunc("GET /api/tasks/student-tasks", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetTasksForStudentRequest](s.GetTasksForStudentHandler), "tasks:read"))
+		mux.HandleFunc("GET /api/tasks/teacher-tasks", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetTasksRequest](s.GetTasksForTeacherHandler), "tasks:read"))
+		mux.HandleFunc("GET /api/tasks/student-statuses", s.IsAuthenticated(QueryHandlerWrapper[tasks.GetStudentStatusesRequest](s.GetStudentStatuses), "tasks:read"))
+		mux.HandleFunc("PUT /api/tasks/task/update", s.IsAuthenticated(JSONHandlerWrapper[tasks.UpdateTaskRequest](s.UpdateTaskHandler), "tasks:write"))
+		mux.HandleFunc("DELETE /api/tasks/task/delete", s.IsAuthenticated(JSONHandlerWrapper[tasks.DeleteTaskRequest](s.DeleteTaskHandler), "tasks:write"))
+		mux.HandleFunc("PATCH /api/tasks/task/changestatus", s.IsAuthenticated(JSONHandlerWrapper[tasks.ChangeStatusTaskRequest](s.ChangeStatusTaskHandler), "tasks:write"))
 	}
 }
 
@@ -128,6 +208,20 @@ func NewServer(ctx context.Context, cfg *config.Config) (*Server, error) {
 	var server Server
 
 	server.Config = cfg
+	// Ключ должен быть общим для всех экземпляров Gateway и не меняться между
+	// перезапусками, иначе выданные ссылки на скачивание перестанут приниматься
+	server.attachmentSecret = []byte(cfg.Attachments.URLSecret)
+	if cfg.Lessons.Enabled && len(server.attachmentSecret) == 0 {
+		return nil, errors.New("attachment url secret is required: set ATTACHMENTS_URL_SECRET")
+	}
+
+	for _, proxy := range cfg.Common.TrustedProxies {
+		prefix, err := parseProxy(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		server.trustedProxies = append(server.trustedProxies, prefix)
+	}
 
 	mux := http.NewServeMux()
 	server.RegisterMux(mux)
@@ -140,16 +234,32 @@ func NewServer(ctx context.Context, cfg *config.Config) (*Server, error) {
 	return &server, nil
 }
 
+// Прокси задается подсетью или отдельным адресом
+func parseProxy(proxy string) (netip.Prefix, error) {
+	proxy = strings.TrimSpace(proxy)
+	if strings.Contains(proxy, "/") {
+		prefix, err := netip.ParsePrefix(proxy)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(proxy)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
 func (s *Server) Run(ctx context.Context) {
 	if s.Config.Auth.Enabled {
-		auth, err := auth.NewAuthServiceClient(ctx, s.Config)
+		authClient, err := auth.NewAuthServiceClient(ctx, s.Config)
 		if err != nil {
 			logger.Error(ctx, "Auth service failed with error", slog.Any("error", err))
 			s.CtxStop()
 			return
 		}
-		defer auth.Conn.Close()
-		s.Auth = auth
+		defer authClient.Conn.Close()
+		s.Auth = authClient
+		s.Keys = auth.NewKeyCache(authClient, s.Config.Common.JWKSCacheTTL)
 	}
 
 	if s.Config.Auth.Enabled && s.Config.Courses.Enabled {
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"Classroom/Gateway/pkg/config"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServer_AttachmentSecret(t *testing.T) {
+	testCases := []struct {
+		name           string
+		lessonsEnabled bool
+		secret         string
+		wantErr        bool
+	}{
+		{
+			name:           "secret set",
+			lessonsEnabled: true,
+			secret:         "secret",
+		},
+		{
+			name:           "secret missing",
+			lessonsEnabled: true,
+			wantErr:        true,
+		},
+		{
+			name: "lessons disabled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.DefaultConfig
+			cfg.Lessons.Enabled = tc.lessonsEnabled
+			cfg.Attachments.URLSecret = tc.secret
+
+			server, err := NewServer(context.Background(), &cfg)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, server)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte(tc.secret), server.attachmentSecret)
+		})
+	}
+}
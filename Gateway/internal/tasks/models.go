@@ -19,6 +19,10 @@ type Task struct {
     Description string `json:"description" example:"Решить задачи по алгоритмам" extensions:"x-order=3"`
     // Дата создания
     CreatedAt time.Time `json:"created_at" example:"2023-01-15T10:00:00Z" extensions:"x-order=4"`
+    // ID модуля курса, пусто - задание без модуля
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=5"`
+    // Порядок задания в курсе
+    Position int32 `json:"position" example:"0" extensions:"x-order=6"`
 } // @name Task
 
 // StudentTask - информация о задании для студента
@@ -36,6 +40,10 @@ type StudentTask struct {
     Completed bool `json:"completed" example:"false" extensions:"x-order=4"`
     // Дата создания
     CreatedAt time.Time `json:"created_at" example:"2023-01-15T10:00:00Z" extensions:"x-order=5"`
+    // ID модуля курса, пусто - задание без модуля
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=6"`
+    // Порядок задания в курсе
+    Position int32 `json:"position" example:"0" extensions:"x-order=7"`
 } // @name StudentTask
 
 // TaskStatus - статус выполнения задания
@@ -58,6 +66,8 @@ type CreateTaskRequest struct {
     Title string `json:"title" example:"Лабораторная работа 1" extensions:"x-order=1"`
     // Описание задания
     Description string `json:"description" example:"Реализовать алгоритм сортировки" extensions:"x-order=2"`
+    // ID модуля того же курса (опционально)
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=3"`
 } // @name CreateTaskRequest
 
 func NewCreateTaskRequest(req CreateTaskRequest) *pb.CreateTaskRequest {
@@ -65,6 +75,7 @@ func NewCreateTaskRequest(req CreateTaskRequest) *pb.CreateTaskRequest {
 		CourseId:    req.CourseID,
 		Title:       req.Title,
 		Description: req.Description,
+		ModuleId:    req.ModuleID,
 	}
 }
 
@@ -108,6 +119,9 @@ func NewGetTaskResponse(resp *pb.GetTaskResponse) GetTaskResponse {
 			CourseID: resp.Task.GetCourseId(),
 			Title: resp.Task.GetTitle(),
 			Description: resp.Task.GetContent(),
+			CreatedAt: resp.Task.GetCreatedAt().AsTime(),
+			ModuleID: resp.Task.ModuleId,
+			Position: resp.Task.GetPosition(),
 		},
 	}
 }
@@ -179,6 +193,8 @@ func NewGetTasksResponse(resp *pb.GetTasksResponse) GetTasksResponse {
 					Title:       task.GetTitle(),
 					Description: task.GetContent(),
 					CreatedAt:   task.GetCreatedAt().AsTime(),
+					ModuleID:    task.ModuleId,
+					Position:    task.GetPosition(),
 				})
 			}
 			return tasks
@@ -221,6 +237,8 @@ func NewGetTasksForStudentResponse(resp *pb.GetTasksForStudentResponse) GetTasks
 					Description: task.GetContent(),
 					Completed:   task.GetCompleted(),
 					CreatedAt:   task.GetCreatedAt().AsTime(),
+					ModuleID:    task.ModuleId,
+					Position:    task.GetPosition(),
 				})
 			}
 			return tasks
@@ -237,13 +255,16 @@ type UpdateTaskRequest struct {
     Title *string `json:"title,omitempty" example:"Обновленное название" extensions:"x-order=1"`
     // Новое описание (опционально)
     Content *string `json:"description,omitempty" example:"Новые требования" extensions:"x-order=2"`
+    // Новый модуль того же курса, пустая строка убирает задание из модуля (опционально)
+    ModuleID *string `json:"module_id,omitempty" example:"d277084b-e1f6-4670-825b-53951d20b5d3" extensions:"x-order=3"`
 } // @name UpdateTaskRequest
 
 func NewUpdateTaskRequest(req UpdateTaskRequest) *pb.UpdateTaskRequest {
 	return &pb.UpdateTaskRequest{
-		TaskId:  req.TaskID,
-		Title:   req.Title,
-		Content: req.Content,
+		TaskId:   req.TaskID,
+		Title:    req.Title,
+		Content:  req.Content,
+		ModuleId: req.ModuleID,
 	}
 }
 
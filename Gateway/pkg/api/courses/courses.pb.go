@@ -24,14 +24,21 @@ const (
 
 type Course struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`          // ID курса
-	TeacherId     string                 `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`       // ID Владельца
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                // Название курса
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                    // Описание курса
-	Visibility    bool                   `protobuf:"varint,5,opt,name=visibility,proto3" json:"visibility,omitempty"`                     // Видимость курса пользователю
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // Дата открытия доступа к курсу
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // Дата закрытия доступа к курсу
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // Дата создания курса
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`              // ID курса
+	TeacherId     string                 `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`           // ID Владельца
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                    // Название курса
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                        // Описание курса
+	Visibility    bool                   `protobuf:"varint,5,opt,name=visibility,proto3" json:"visibility,omitempty"`                         // Видимость курса пользователю
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`     // Дата открытия доступа к курсу
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`           // Дата закрытия доступа к курсу
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // Дата создания курса
+	Capacity      *int32                 `protobuf:"varint,9,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`                       // Максимум записанных пользователей
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"` // Дата архивации курса
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`    // Дата удаления курса
+	IsTemplate    bool                   `protobuf:"varint,12,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`      // Шаблон курса, на него нельзя записаться
+	Listed        bool                   `protobuf:"varint,13,opt,name=listed,proto3" json:"listed,omitempty"`                                // Курс показывается в каталоге
+	Category      *string                `protobuf:"bytes,14,opt,name=category,proto3,oneof" json:"category,omitempty"`                       // Категория курса
+	Tags          []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                     // Теги курса
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,6 +129,99 @@ func (x *Course) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Course) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *Course) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Course) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Course) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *Course) GetListed() bool {
+	if x != nil {
+		return x.Listed
+	}
+	return false
+}
+
+func (x *Course) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *Course) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Tags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tags) Reset() {
+	*x = Tags{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tags) ProtoMessage() {}
+
+func (x *Tags) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tags.ProtoReflect.Descriptor instead.
+func (*Tags) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Tags) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type Student struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -134,7 +234,7 @@ type Student struct {
 
 func (x *Student) Reset() {
 	*x = Student{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[1]
+	mi := &file_Common_Proto_courses_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -146,7 +246,7 @@ func (x *Student) String() string {
 func (*Student) ProtoMessage() {}
 
 func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[1]
+	mi := &file_Common_Proto_courses_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,7 +259,7 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Student.ProtoReflect.Descriptor instead.
 func (*Student) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{1}
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Student) GetUserId() string {
@@ -195,13 +295,14 @@ type Enrollment struct {
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	EnrolledAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // Роль: student или auditor
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Enrollment) Reset() {
 	*x = Enrollment{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[2]
+	mi := &file_Common_Proto_courses_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +314,7 @@ func (x *Enrollment) String() string {
 func (*Enrollment) ProtoMessage() {}
 
 func (x *Enrollment) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[2]
+	mi := &file_Common_Proto_courses_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +327,7 @@ func (x *Enrollment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
 func (*Enrollment) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{2}
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Enrollment) GetCourseId() string {
@@ -250,33 +351,41 @@ func (x *Enrollment) GetEnrolledAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateCourseRequest struct {
+func (x *Enrollment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Staff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    bool                   `protobuf:"varint,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`                      // Роль: co_teacher или assistant
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"` // Дата добавления
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCourseRequest) Reset() {
-	*x = CreateCourseRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[3]
+func (x *Staff) Reset() {
+	*x = Staff{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseRequest) String() string {
+func (x *Staff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCourseRequest) ProtoMessage() {}
+func (*Staff) ProtoMessage() {}
 
-func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[3]
+func (x *Staff) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,75 +396,4030 @@ func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
-func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Staff.ProtoReflect.Descriptor instead.
+func (*Staff) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateCourseRequest) GetUserId() string {
+func (x *Staff) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Staff) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateCourseRequest) GetTitle() string {
+func (x *Staff) GetEmail() string {
 	if x != nil {
-		return x.Title
+		return x.Email
 	}
 	return ""
 }
 
-func (x *CreateCourseRequest) GetDescription() string {
+func (x *Staff) GetFirstName() string {
 	if x != nil {
-		return x.Description
+		return x.FirstName
 	}
 	return ""
 }
 
-func (x *CreateCourseRequest) GetVisibility() bool {
+func (x *Staff) GetLastName() string {
 	if x != nil {
-		return x.Visibility
+		return x.LastName
 	}
-	return false
+	return ""
 }
 
-func (x *CreateCourseRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *Staff) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Staff) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                                  // Код приглашения
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                  // Роль при записи: student или auditor
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`       // ID создателя кода
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // Срок действия кода
+	MaxUses       *int32                 `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`      // Максимальное число использований
+	Uses          int32                  `protobuf:"varint,8,opt,name=uses,proto3" json:"uses,omitempty"`                                 // Число использований
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"` // Дата отзыва кода
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // Дата создания кода
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Invite) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *Invite) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,5,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,6,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                              // pending, approved, rejected или waitlisted
+	DecidedBy     *string                `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3,oneof" json:"decided_by,omitempty"` // ID преподавателя, принявшего решение
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentRequest) ProtoMessage() {}
+
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EnrollmentRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetDecidedBy() string {
+	if x != nil && x.DecidedBy != nil {
+		return *x.DecidedBy
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EnrollmentRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RosterEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`                               // student или auditor
+	EnrolledAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"` // Дата зачисления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterEntry) Reset() {
+	*x = RosterEntry{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterEntry) ProtoMessage() {}
+
+func (x *RosterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterEntry.ProtoReflect.Descriptor instead.
+func (*RosterEntry) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RosterEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetEnrolledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrolledAt
+	}
+	return nil
+}
+
+type BulkEnrollResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`                                   // Почта из запроса
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                 // enrolled, already_member, invited, not_found, invalid_email, duplicate, course_full или failed
+	UserId        *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`             // ID найденного пользователя
+	InviteCode    *string                `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3,oneof" json:"invite_code,omitempty"` // Код приглашения, отправленный на почту
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkEnrollResult) Reset() {
+	*x = BulkEnrollResult{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEnrollResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEnrollResult) ProtoMessage() {}
+
+func (x *BulkEnrollResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEnrollResult.ProtoReflect.Descriptor instead.
+func (*BulkEnrollResult) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BulkEnrollResult) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BulkEnrollResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkEnrollResult) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *BulkEnrollResult) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
+	}
+	return ""
+}
+
+type CloneJob struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobId          string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	SourceCourseId string                 `protobuf:"bytes,2,opt,name=source_course_id,json=sourceCourseId,proto3" json:"source_course_id,omitempty"`   // ID копируемого курса
+	TargetCourseId string                 `protobuf:"bytes,3,opt,name=target_course_id,json=targetCourseId,proto3" json:"target_course_id,omitempty"`   // ID нового курса
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                             // ID запустившего копирование
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                           // pending, running, completed или failed
+	LessonsCopied  *int32                 `protobuf:"varint,6,opt,name=lessons_copied,json=lessonsCopied,proto3,oneof" json:"lessons_copied,omitempty"` // Сколько уроков скопировано, пусто - уроки ещё не копировались
+	TasksCopied    *int32                 `protobuf:"varint,7,opt,name=tasks_copied,json=tasksCopied,proto3,oneof" json:"tasks_copied,omitempty"`       // Сколько заданий скопировано, пусто - задания ещё не копировались
+	Error          *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`                                       // Причина ошибки для failed
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloneJob) Reset() {
+	*x = CloneJob{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneJob) ProtoMessage() {}
+
+func (x *CloneJob) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneJob.ProtoReflect.Descriptor instead.
+func (*CloneJob) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloneJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CloneJob) GetSourceCourseId() string {
+	if x != nil {
+		return x.SourceCourseId
+	}
+	return ""
+}
+
+func (x *CloneJob) GetTargetCourseId() string {
+	if x != nil {
+		return x.TargetCourseId
+	}
+	return ""
+}
+
+func (x *CloneJob) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CloneJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CloneJob) GetLessonsCopied() int32 {
+	if x != nil && x.LessonsCopied != nil {
+		return *x.LessonsCopied
+	}
+	return 0
+}
+
+func (x *CloneJob) GetTasksCopied() int32 {
+	if x != nil && x.TasksCopied != nil {
+		return *x.TasksCopied
+	}
+	return 0
+}
+
+func (x *CloneJob) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *CloneJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CloneJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Module struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModuleId      string                 `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"` // Позиция в курсе, начиная с 0
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Module) GetModuleId() string {
+	if x != nil {
+		return x.ModuleId
+	}
+	return ""
+}
+
+func (x *Module) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Module) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Module) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Module) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OwnershipTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseTitle   string                 `protobuf:"bytes,3,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,4,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"` // ID владельца на момент создания передачи
+	ToUserId      string                 `protobuf:"bytes,5,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`       // ID нового владельца
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                             // pending, accepted, declined или cancelled
+	Forced        bool                   `protobuf:"varint,7,opt,name=forced,proto3" json:"forced,omitempty"`                            // Передача выполнена суперпользователем без подтверждения
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=decided_at,json=decidedAt,proto3,oneof" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipTransfer) Reset() {
+	*x = OwnershipTransfer{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransfer) ProtoMessage() {}
+
+func (x *OwnershipTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipTransfer.ProtoReflect.Descriptor instead.
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OwnershipTransfer) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *OwnershipTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OwnershipTransfer) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+type RolePermissions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`               // Роль в курсе
+	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"` // Права роли
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolePermissions) Reset() {
+	*x = RolePermissions{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolePermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolePermissions) ProtoMessage() {}
+
+func (x *RolePermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolePermissions.ProtoReflect.Descriptor instead.
+func (*RolePermissions) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RolePermissions) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RolePermissions) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type CreateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility    bool                   `protobuf:"varint,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Capacity      *int32                 `protobuf:"varint,7,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	IsTemplate    bool                   `protobuf:"varint,8,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`
+	Listed        bool                   `protobuf:"varint,9,opt,name=listed,proto3" json:"listed,omitempty"`
+	Category      *string                `protobuf:"bytes,10,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseRequest) Reset() {
+	*x = CreateCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseRequest) ProtoMessage() {}
+
+func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateCourseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetVisibility() bool {
+	if x != nil {
+		return x.Visibility
+	}
+	return false
+}
+
+func (x *CreateCourseRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateCourseRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateCourseRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateCourseRequest) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *CreateCourseRequest) GetListed() bool {
+	if x != nil {
+		return x.Listed
+	}
+	return false
+}
+
+func (x *CreateCourseRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseResponse) Reset() {
+	*x = CreateCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseResponse) ProtoMessage() {}
+
+func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseResponse.ProtoReflect.Descriptor instead.
+func (*CreateCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type GetCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseRequest) Reset() {
+	*x = GetCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseRequest) ProtoMessage() {}
+
+func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseResponse) Reset() {
+	*x = GetCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseResponse) ProtoMessage() {}
+
+func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type GetCoursesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Включить архивные курсы
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCoursesRequest) Reset() {
+	*x = GetCoursesRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesRequest) ProtoMessage() {}
+
+func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCoursesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCoursesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetCoursesByStudentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StudentId       string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCoursesByStudentRequest) Reset() {
+	*x = GetCoursesByStudentRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesByStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesByStudentRequest) ProtoMessage() {}
+
+func (x *GetCoursesByStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesByStudentRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesByStudentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCoursesByStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetCoursesByStudentRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetCoursesByTeacherRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId       string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCoursesByTeacherRequest) Reset() {
+	*x = GetCoursesByTeacherRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesByTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesByTeacherRequest) ProtoMessage() {}
+
+func (x *GetCoursesByTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesByTeacherRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesByTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCoursesByTeacherRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetCoursesByTeacherRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursesResponse) Reset() {
+	*x = GetCoursesResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesResponse) ProtoMessage() {}
+
+func (x *GetCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type UpdateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Visibility    *bool                  `protobuf:"varint,5,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Capacity      *int32                 `protobuf:"varint,8,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"` // 0 снимает ограничение мест
+	IsTemplate    *bool                  `protobuf:"varint,9,opt,name=is_template,json=isTemplate,proto3,oneof" json:"is_template,omitempty"`
+	Listed        *bool                  `protobuf:"varint,10,opt,name=listed,proto3,oneof" json:"listed,omitempty"`
+	Category      *string                `protobuf:"bytes,11,opt,name=category,proto3,oneof" json:"category,omitempty"` // Пустая строка убирает категорию
+	Tags          *Tags                  `protobuf:"bytes,12,opt,name=tags,proto3" json:"tags,omitempty"`               // Заменяет теги целиком, пусто - теги не меняются
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseRequest) Reset() {
+	*x = UpdateCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseRequest) ProtoMessage() {}
+
+func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetVisibility() bool {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateCourseRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UpdateCourseRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateCourseRequest) GetIsTemplate() bool {
+	if x != nil && x.IsTemplate != nil {
+		return *x.IsTemplate
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetListed() bool {
+	if x != nil && x.Listed != nil {
+		return *x.Listed
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetTags() *Tags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseResponse) Reset() {
+	*x = UpdateCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseResponse) ProtoMessage() {}
+
+func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type DeleteCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseRequest) Reset() {
+	*x = DeleteCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseRequest) ProtoMessage() {}
+
+func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type DeleteCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseResponse) Reset() {
+	*x = DeleteCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseResponse) ProtoMessage() {}
+
+func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type ArchiveCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCourseRequest) Reset() {
+	*x = ArchiveCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCourseRequest) ProtoMessage() {}
+
+func (x *ArchiveCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCourseRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ArchiveCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type ArchiveCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCourseResponse) Reset() {
+	*x = ArchiveCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCourseResponse) ProtoMessage() {}
+
+func (x *ArchiveCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCourseResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ArchiveCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type RestoreCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Восстановить курс может только владелец
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCourseRequest) Reset() {
+	*x = RestoreCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCourseRequest) ProtoMessage() {}
+
+func (x *RestoreCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCourseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RestoreCourseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCourseResponse) Reset() {
+	*x = RestoreCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCourseResponse) ProtoMessage() {}
+
+func (x *RestoreCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCourseResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type EnrollUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // student или auditor, по умолчанию student
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollUserRequest) Reset() {
+	*x = EnrollUserRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollUserRequest) ProtoMessage() {}
+
+func (x *EnrollUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollUserRequest.ProtoReflect.Descriptor instead.
+func (*EnrollUserRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EnrollUserRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *EnrollUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnrollUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type EnrollUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollUserResponse) Reset() {
+	*x = EnrollUserResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollUserResponse) ProtoMessage() {}
+
+func (x *EnrollUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollUserResponse.ProtoReflect.Descriptor instead.
+func (*EnrollUserResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EnrollUserResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type ExpelUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpelUserRequest) Reset() {
+	*x = ExpelUserRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpelUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpelUserRequest) ProtoMessage() {}
+
+func (x *ExpelUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpelUserRequest.ProtoReflect.Descriptor instead.
+func (*ExpelUserRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExpelUserRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ExpelUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExpelUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	Promoted      []*EnrollmentRequest   `protobuf:"bytes,2,rep,name=promoted,proto3" json:"promoted,omitempty"` // Заявки, переведенные из листа ожидания на освободившиеся места
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpelUserResponse) Reset() {
+	*x = ExpelUserResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpelUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpelUserResponse) ProtoMessage() {}
+
+func (x *ExpelUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpelUserResponse.ProtoReflect.Descriptor instead.
+func (*ExpelUserResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExpelUserResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+func (x *ExpelUserResponse) GetPromoted() []*EnrollmentRequest {
+	if x != nil {
+		return x.Promoted
+	}
+	return nil
+}
+
+type IsTeacherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsTeacherRequest) Reset() {
+	*x = IsTeacherRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsTeacherRequest) ProtoMessage() {}
+
+func (x *IsTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsTeacherRequest.ProtoReflect.Descriptor instead.
+func (*IsTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *IsTeacherRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IsTeacherRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type IsTeacherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsTeacher     bool                   `protobuf:"varint,1,opt,name=is_teacher,json=isTeacher,proto3" json:"is_teacher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsTeacherResponse) Reset() {
+	*x = IsTeacherResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsTeacherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsTeacherResponse) ProtoMessage() {}
+
+func (x *IsTeacherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsTeacherResponse.ProtoReflect.Descriptor instead.
+func (*IsTeacherResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *IsTeacherResponse) GetIsTeacher() bool {
+	if x != nil {
+		return x.IsTeacher
+	}
+	return false
+}
+
+type IsMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMemberRequest) Reset() {
+	*x = IsMemberRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMemberRequest) ProtoMessage() {}
+
+func (x *IsMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMemberRequest.ProtoReflect.Descriptor instead.
+func (*IsMemberRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IsMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IsMemberRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type IsMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMember      bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMemberResponse) Reset() {
+	*x = IsMemberResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMemberResponse) ProtoMessage() {}
+
+func (x *IsMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMemberResponse.ProtoReflect.Descriptor instead.
+func (*IsMemberResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IsMemberResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type GetCourseStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStudentsRequest) Reset() {
+	*x = GetCourseStudentsRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStudentsRequest) ProtoMessage() {}
+
+func (x *GetCourseStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStudentsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCourseStudentsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseStudentsRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetCourseStudentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCourseStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Students      []*Student             `protobuf:"bytes,3,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStudentsResponse) Reset() {
+	*x = GetCourseStudentsResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStudentsResponse) ProtoMessage() {}
+
+func (x *GetCourseStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStudentsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCourseStudentsResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetCourseStudentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCourseStudentsResponse) GetStudents() []*Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type AuthorizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"` // Проверяемое право
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeRequest) Reset() {
+	*x = AuthorizeRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeRequest) ProtoMessage() {}
+
+func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AuthorizeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorizeRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AuthorizeRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type AuthorizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`        // Есть ли у пользователя право
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`               // Роль пользователя, пустая, если он не участник курса
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"` // Все права пользователя в курсе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeResponse) Reset() {
+	*x = AuthorizeResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResponse) ProtoMessage() {}
+
+func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AuthorizeResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AuthorizeResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthorizeResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type GetCoursePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursePermissionsRequest) Reset() {
+	*x = GetCoursePermissionsRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursePermissionsRequest) ProtoMessage() {}
+
+func (x *GetCoursePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCoursePermissionsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type GetCoursePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*RolePermissions     `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursePermissionsResponse) Reset() {
+	*x = GetCoursePermissionsResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursePermissionsResponse) ProtoMessage() {}
+
+func (x *GetCoursePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCoursePermissionsResponse) GetRoles() []*RolePermissions {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetRolePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRolePermissionsRequest) Reset() {
+	*x = SetRolePermissionsRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRolePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRolePermissionsRequest) ProtoMessage() {}
+
+func (x *SetRolePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRolePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetRolePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetRolePermissionsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SetRolePermissionsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetRolePermissionsRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type SetRolePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *RolePermissions       `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRolePermissionsResponse) Reset() {
+	*x = SetRolePermissionsResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRolePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRolePermissionsResponse) ProtoMessage() {}
+
+func (x *SetRolePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRolePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*SetRolePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetRolePermissionsResponse) GetRole() *RolePermissions {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type AddStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // co_teacher или assistant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStaffRequest) Reset() {
+	*x = AddStaffRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffRequest) ProtoMessage() {}
+
+func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffRequest.ProtoReflect.Descriptor instead.
+func (*AddStaffRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddStaffRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AddStaffRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddStaffRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *Staff                 `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStaffResponse) Reset() {
+	*x = AddStaffResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffResponse) ProtoMessage() {}
+
+func (x *AddStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffResponse.ProtoReflect.Descriptor instead.
+func (*AddStaffResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddStaffResponse) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type RemoveStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffRequest) Reset() {
+	*x = RemoveStaffRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffRequest) ProtoMessage() {}
+
+func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStaffRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RemoveStaffRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RemoveStaffRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *Staff                 `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffResponse) Reset() {
+	*x = RemoveStaffResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffResponse) ProtoMessage() {}
+
+func (x *RemoveStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStaffResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveStaffResponse) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type ListStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffRequest) Reset() {
+	*x = ListStaffRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffRequest) ProtoMessage() {}
+
+func (x *ListStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListStaffRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type ListStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         []*Staff               `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffResponse) Reset() {
+	*x = ListStaffResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffResponse) ProtoMessage() {}
+
+func (x *ListStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListStaffResponse) GetStaff() []*Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID создателя кода
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                   // student или auditor, по умолчанию student
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	MaxUses       *int32                 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateInviteRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListInvitesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	InviteId      string                 `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RevokeInviteRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RevokeInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RevokeInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type JoinByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByCodeRequest) Reset() {
+	*x = JoinByCodeRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByCodeRequest) ProtoMessage() {}
+
+func (x *JoinByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByCodeRequest.ProtoReflect.Descriptor instead.
+func (*JoinByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *JoinByCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinByCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinByCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByCodeResponse) Reset() {
+	*x = JoinByCodeResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByCodeResponse) ProtoMessage() {}
+
+func (x *JoinByCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByCodeResponse.ProtoReflect.Descriptor instead.
+func (*JoinByCodeResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *JoinByCodeResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type RequestEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEnrollmentRequest) Reset() {
+	*x = RequestEnrollmentRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEnrollmentRequest) ProtoMessage() {}
+
+func (x *RequestEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*RequestEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RequestEnrollmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RequestEnrollmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *EnrollmentRequest     `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEnrollmentResponse) Reset() {
+	*x = RequestEnrollmentResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEnrollmentResponse) ProtoMessage() {}
+
+func (x *RequestEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*RequestEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RequestEnrollmentResponse) GetRequest() *EnrollmentRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListEnrollmentRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"` // Фильтр по состоянию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnrollmentRequestsRequest) Reset() {
+	*x = ListEnrollmentRequestsRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnrollmentRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnrollmentRequestsRequest) ProtoMessage() {}
+
+func (x *ListEnrollmentRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnrollmentRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnrollmentRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListEnrollmentRequestsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ListEnrollmentRequestsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type ListEnrollmentRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*EnrollmentRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnrollmentRequestsResponse) Reset() {
+	*x = ListEnrollmentRequestsResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnrollmentRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnrollmentRequestsResponse) ProtoMessage() {}
+
+func (x *ListEnrollmentRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnrollmentRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListEnrollmentRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListEnrollmentRequestsResponse) GetRequests() []*EnrollmentRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ApproveEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID преподавателя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEnrollmentRequest) Reset() {
+	*x = ApproveEnrollmentRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEnrollmentRequest) ProtoMessage() {}
+
+func (x *ApproveEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ApproveEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApproveEnrollmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ApproveEnrollmentRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ApproveEnrollmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApproveEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *EnrollmentRequest     `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEnrollmentResponse) Reset() {
+	*x = ApproveEnrollmentResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEnrollmentResponse) ProtoMessage() {}
+
+func (x *ApproveEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*ApproveEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ApproveEnrollmentResponse) GetRequest() *EnrollmentRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RejectEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID преподавателя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEnrollmentRequest) Reset() {
+	*x = RejectEnrollmentRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEnrollmentRequest) ProtoMessage() {}
+
+func (x *RejectEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*RejectEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RejectEnrollmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RejectEnrollmentRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RejectEnrollmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RejectEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *EnrollmentRequest     `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEnrollmentResponse) Reset() {
+	*x = RejectEnrollmentResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEnrollmentResponse) ProtoMessage() {}
+
+func (x *RejectEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*RejectEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RejectEnrollmentResponse) GetRequest() *EnrollmentRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type BulkEnrollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID преподавателя
+	Emails        []string               `protobuf:"bytes,3,rep,name=emails,proto3" json:"emails,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                         // student или auditor, по умолчанию student
+	InviteUnknown bool                   `protobuf:"varint,5,opt,name=invite_unknown,json=inviteUnknown,proto3" json:"invite_unknown,omitempty"` // Отправить коды приглашения на неизвестные почты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkEnrollRequest) Reset() {
+	*x = BulkEnrollRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEnrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEnrollRequest) ProtoMessage() {}
+
+func (x *BulkEnrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEnrollRequest.ProtoReflect.Descriptor instead.
+func (*BulkEnrollRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BulkEnrollRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *BulkEnrollRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkEnrollRequest) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *BulkEnrollRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BulkEnrollRequest) GetInviteUnknown() bool {
+	if x != nil {
+		return x.InviteUnknown
+	}
+	return false
+}
+
+type BulkEnrollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkEnrollResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Результаты в порядке почт из запроса
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkEnrollResponse) Reset() {
+	*x = BulkEnrollResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEnrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEnrollResponse) ProtoMessage() {}
+
+func (x *BulkEnrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEnrollResponse.ProtoReflect.Descriptor instead.
+func (*BulkEnrollResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *BulkEnrollResponse) GetResults() []*BulkEnrollResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRosterRequest) Reset() {
+	*x = ExportRosterRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRosterRequest) ProtoMessage() {}
+
+func (x *ExportRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRosterRequest.ProtoReflect.Descriptor instead.
+func (*ExportRosterRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExportRosterRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type ExportRosterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*RosterEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRosterResponse) Reset() {
+	*x = ExportRosterResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRosterResponse) ProtoMessage() {}
+
+func (x *ExportRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRosterResponse.ProtoReflect.Descriptor instead.
+func (*ExportRosterResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExportRosterResponse) GetEntries() []*RosterEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type CloneCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`        // ID копируемого курса или шаблона
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // Владелец нового курса
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`                        // Название нового курса, по умолчанию как у копируемого
+	ShiftDays     int32                  `protobuf:"varint,4,opt,name=shift_days,json=shiftDays,proto3" json:"shift_days,omitempty"`    // Сдвиг дат начала и окончания курса в днях
+	IsTemplate    bool                   `protobuf:"varint,5,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"` // Сохранить копию как шаблон
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneCourseRequest) Reset() {
+	*x = CloneCourseRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneCourseRequest) ProtoMessage() {}
+
+func (x *CloneCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneCourseRequest.ProtoReflect.Descriptor instead.
+func (*CloneCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CloneCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CloneCourseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CloneCourseRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *CloneCourseRequest) GetShiftDays() int32 {
+	if x != nil {
+		return x.ShiftDays
+	}
+	return 0
+}
+
+func (x *CloneCourseRequest) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+type CloneCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // Новый курс, уроки и задания копируются после ответа
+	Job           *CloneJob              `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneCourseResponse) Reset() {
+	*x = CloneCourseResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneCourseResponse) ProtoMessage() {}
+
+func (x *CloneCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneCourseResponse.ProtoReflect.Descriptor instead.
+func (*CloneCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CloneCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *CloneCourseResponse) GetJob() *CloneJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetCloneJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Задачу видит только запустивший копирование
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloneJobRequest) Reset() {
+	*x = GetCloneJobRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloneJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloneJobRequest) ProtoMessage() {}
+
+func (x *GetCloneJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloneJobRequest.ProtoReflect.Descriptor instead.
+func (*GetCloneJobRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetCloneJobRequest) GetJobId() string {
 	if x != nil {
-		return x.StartTime
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateCourseRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *GetCloneJobRequest) GetUserId() string {
 	if x != nil {
-		return x.EndTime
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type CreateCourseResponse struct {
+type GetCloneJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Job           *CloneJob              `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCourseResponse) Reset() {
-	*x = CreateCourseResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[4]
+func (x *GetCloneJobResponse) Reset() {
+	*x = GetCloneJobResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseResponse) String() string {
+func (x *GetCloneJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCourseResponse) ProtoMessage() {}
+func (*GetCloneJobResponse) ProtoMessage() {}
 
-func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[4]
+func (x *GetCloneJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,41 +4430,47 @@ func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCourseResponse.ProtoReflect.Descriptor instead.
-func (*CreateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetCloneJobResponse.ProtoReflect.Descriptor instead.
+func (*GetCloneJobResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateCourseResponse) GetCourse() *Course {
+func (x *GetCloneJobResponse) GetJob() *CloneJob {
 	if x != nil {
-		return x.Course
+		return x.Job
 	}
 	return nil
 }
 
-type GetCourseRequest struct {
+type SearchCoursesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                // Поисковый запрос по названию и описанию
+	TeacherId     *string                `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"` // Курсы преподавателя
+	Tag           *string                `protobuf:"bytes,3,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	Category      *string                `protobuf:"bytes,4,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	StartsAfter   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_after,json=startsAfter,proto3,oneof" json:"starts_after,omitempty"`    // Курсы, начинающиеся не раньше даты
+	StartsBefore  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_before,json=startsBefore,proto3,oneof" json:"starts_before,omitempty"` // Курсы, начинающиеся не позже даты
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                        // По умолчанию 20, максимум 100
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`                                       // next_cursor предыдущей страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseRequest) Reset() {
-	*x = GetCourseRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[5]
+func (x *SearchCoursesRequest) Reset() {
+	*x = SearchCoursesRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseRequest) String() string {
+func (x *SearchCoursesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseRequest) ProtoMessage() {}
+func (*SearchCoursesRequest) ProtoMessage() {}
 
-func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[5]
+func (x *SearchCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,47 +4481,90 @@ func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SearchCoursesRequest.ProtoReflect.Descriptor instead.
+func (*SearchCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetCourseRequest) GetCourseId() string {
+func (x *SearchCoursesRequest) GetQuery() string {
 	if x != nil {
-		return x.CourseId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *GetCourseRequest) GetUserId() string {
+func (x *SearchCoursesRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *SearchCoursesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *SearchCoursesRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *SearchCoursesRequest) GetStartsAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.StartsAfter
+	}
+	return nil
+}
+
+func (x *SearchCoursesRequest) GetStartsBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsBefore
+	}
+	return nil
+}
+
+func (x *SearchCoursesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchCoursesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
 
-type GetCourseResponse struct {
+type SearchCoursesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Пустой, если страниц больше нет
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseResponse) Reset() {
-	*x = GetCourseResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[6]
+func (x *SearchCoursesResponse) Reset() {
+	*x = SearchCoursesResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseResponse) String() string {
+func (x *SearchCoursesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseResponse) ProtoMessage() {}
+func (*SearchCoursesResponse) ProtoMessage() {}
 
-func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[6]
+func (x *SearchCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,40 +4575,48 @@ func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SearchCoursesResponse.ProtoReflect.Descriptor instead.
+func (*SearchCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetCourseResponse) GetCourse() *Course {
+func (x *SearchCoursesResponse) GetCourses() []*Course {
 	if x != nil {
-		return x.Course
+		return x.Courses
 	}
 	return nil
 }
 
-type GetCoursesRequest struct {
+func (x *SearchCoursesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreateModuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCoursesRequest) Reset() {
-	*x = GetCoursesRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[7]
+func (x *CreateModuleRequest) Reset() {
+	*x = CreateModuleRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCoursesRequest) String() string {
+func (x *CreateModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCoursesRequest) ProtoMessage() {}
+func (*CreateModuleRequest) ProtoMessage() {}
 
-func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[7]
+func (x *CreateModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,40 +4627,47 @@ func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCoursesRequest.ProtoReflect.Descriptor instead.
-func (*GetCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateModuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateModuleRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetCoursesRequest) GetUserId() string {
+func (x *CreateModuleRequest) GetCourseId() string {
 	if x != nil {
-		return x.UserId
+		return x.CourseId
 	}
 	return ""
 }
 
-type GetCoursesByStudentRequest struct {
+func (x *CreateModuleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreateModuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Module        *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCoursesByStudentRequest) Reset() {
-	*x = GetCoursesByStudentRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[8]
+func (x *CreateModuleResponse) Reset() {
+	*x = CreateModuleResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCoursesByStudentRequest) String() string {
+func (x *CreateModuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCoursesByStudentRequest) ProtoMessage() {}
+func (*CreateModuleResponse) ProtoMessage() {}
 
-func (x *GetCoursesByStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[8]
+func (x *CreateModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,40 +4678,42 @@ func (x *GetCoursesByStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCoursesByStudentRequest.ProtoReflect.Descriptor instead.
-func (*GetCoursesByStudentRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateModuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateModuleResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetCoursesByStudentRequest) GetStudentId() string {
+func (x *CreateModuleResponse) GetModule() *Module {
 	if x != nil {
-		return x.StudentId
+		return x.Module
 	}
-	return ""
+	return nil
 }
 
-type GetCoursesByTeacherRequest struct {
+type UpdateModuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ModuleId      string                 `protobuf:"bytes,2,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCoursesByTeacherRequest) Reset() {
-	*x = GetCoursesByTeacherRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[9]
+func (x *UpdateModuleRequest) Reset() {
+	*x = UpdateModuleRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCoursesByTeacherRequest) String() string {
+func (x *UpdateModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCoursesByTeacherRequest) ProtoMessage() {}
+func (*UpdateModuleRequest) ProtoMessage() {}
 
-func (x *GetCoursesByTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[9]
+func (x *UpdateModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,40 +4724,54 @@ func (x *GetCoursesByTeacherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCoursesByTeacherRequest.ProtoReflect.Descriptor instead.
-func (*GetCoursesByTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateModuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModuleRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetCoursesByTeacherRequest) GetTeacherId() string {
+func (x *UpdateModuleRequest) GetCourseId() string {
 	if x != nil {
-		return x.TeacherId
+		return x.CourseId
 	}
 	return ""
 }
 
-type GetCoursesResponse struct {
+func (x *UpdateModuleRequest) GetModuleId() string {
+	if x != nil {
+		return x.ModuleId
+	}
+	return ""
+}
+
+func (x *UpdateModuleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type UpdateModuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Module        *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCoursesResponse) Reset() {
-	*x = GetCoursesResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[10]
+func (x *UpdateModuleResponse) Reset() {
+	*x = UpdateModuleResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCoursesResponse) String() string {
+func (x *UpdateModuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCoursesResponse) ProtoMessage() {}
+func (*UpdateModuleResponse) ProtoMessage() {}
 
-func (x *GetCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[10]
+func (x *UpdateModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,45 +4782,41 @@ func (x *GetCoursesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCoursesResponse.ProtoReflect.Descriptor instead.
-func (*GetCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateModuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateModuleResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetCoursesResponse) GetCourses() []*Course {
+func (x *UpdateModuleResponse) GetModule() *Module {
 	if x != nil {
-		return x.Courses
+		return x.Module
 	}
 	return nil
 }
 
-type UpdateCourseRequest struct {
+type DeleteModuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Visibility    *bool                  `protobuf:"varint,5,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	ModuleId      string                 `protobuf:"bytes,2,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseRequest) Reset() {
-	*x = UpdateCourseRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[11]
+func (x *DeleteModuleRequest) Reset() {
+	*x = DeleteModuleRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseRequest) String() string {
+func (x *DeleteModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseRequest) ProtoMessage() {}
+func (*DeleteModuleRequest) ProtoMessage() {}
 
-func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[11]
+func (x *DeleteModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,75 +4827,47 @@ func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteModuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModuleRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *UpdateCourseRequest) GetCourseId() string {
+func (x *DeleteModuleRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *UpdateCourseRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdateCourseRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateCourseRequest) GetVisibility() bool {
-	if x != nil && x.Visibility != nil {
-		return *x.Visibility
-	}
-	return false
-}
-
-func (x *UpdateCourseRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *UpdateCourseRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *DeleteModuleRequest) GetModuleId() string {
 	if x != nil {
-		return x.EndTime
+		return x.ModuleId
 	}
-	return nil
+	return ""
 }
 
-type UpdateCourseResponse struct {
+type DeleteModuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Module        *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseResponse) Reset() {
-	*x = UpdateCourseResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[12]
+func (x *DeleteModuleResponse) Reset() {
+	*x = DeleteModuleResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseResponse) String() string {
+func (x *DeleteModuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseResponse) ProtoMessage() {}
+func (*DeleteModuleResponse) ProtoMessage() {}
 
-func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[12]
+func (x *DeleteModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,40 +4878,40 @@ func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteModuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteModuleResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *UpdateCourseResponse) GetCourse() *Course {
+func (x *DeleteModuleResponse) GetModule() *Module {
 	if x != nil {
-		return x.Course
+		return x.Module
 	}
 	return nil
 }
 
-type DeleteCourseRequest struct {
+type ListModulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCourseRequest) Reset() {
-	*x = DeleteCourseRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[13]
+func (x *ListModulesRequest) Reset() {
+	*x = ListModulesRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCourseRequest) String() string {
+func (x *ListModulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCourseRequest) ProtoMessage() {}
+func (*ListModulesRequest) ProtoMessage() {}
 
-func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[13]
+func (x *ListModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,40 +4922,40 @@ func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListModulesRequest.ProtoReflect.Descriptor instead.
+func (*ListModulesRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DeleteCourseRequest) GetCourseId() string {
+func (x *ListModulesRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-type DeleteCourseResponse struct {
+type ListModulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Modules       []*Module              `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCourseResponse) Reset() {
-	*x = DeleteCourseResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[14]
+func (x *ListModulesResponse) Reset() {
+	*x = ListModulesResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCourseResponse) String() string {
+func (x *ListModulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCourseResponse) ProtoMessage() {}
+func (*ListModulesResponse) ProtoMessage() {}
 
-func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[14]
+func (x *ListModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,41 +4966,42 @@ func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListModulesResponse.ProtoReflect.Descriptor instead.
+func (*ListModulesResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DeleteCourseResponse) GetCourse() *Course {
+func (x *ListModulesResponse) GetModules() []*Module {
 	if x != nil {
-		return x.Course
+		return x.Modules
 	}
 	return nil
 }
 
-type EnrollUserRequest struct {
+type ReorderModuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ModuleId      string                 `protobuf:"bytes,2,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // Новая позиция, больше последней - модуль ставится в конец
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnrollUserRequest) Reset() {
-	*x = EnrollUserRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[15]
+func (x *ReorderModuleRequest) Reset() {
+	*x = ReorderModuleRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnrollUserRequest) String() string {
+func (x *ReorderModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollUserRequest) ProtoMessage() {}
+func (*ReorderModuleRequest) ProtoMessage() {}
 
-func (x *EnrollUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[15]
+func (x *ReorderModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,47 +5012,54 @@ func (x *EnrollUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollUserRequest.ProtoReflect.Descriptor instead.
-func (*EnrollUserRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ReorderModuleRequest.ProtoReflect.Descriptor instead.
+func (*ReorderModuleRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *EnrollUserRequest) GetCourseId() string {
+func (x *ReorderModuleRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *EnrollUserRequest) GetUserId() string {
+func (x *ReorderModuleRequest) GetModuleId() string {
 	if x != nil {
-		return x.UserId
+		return x.ModuleId
 	}
 	return ""
 }
 
-type EnrollUserResponse struct {
+func (x *ReorderModuleRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ReorderModuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	Modules       []*Module              `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"` // Все модули курса в новом порядке
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnrollUserResponse) Reset() {
-	*x = EnrollUserResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[16]
+func (x *ReorderModuleResponse) Reset() {
+	*x = ReorderModuleResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnrollUserResponse) String() string {
+func (x *ReorderModuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollUserResponse) ProtoMessage() {}
+func (*ReorderModuleResponse) ProtoMessage() {}
 
-func (x *EnrollUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[16]
+func (x *ReorderModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,41 +5070,43 @@ func (x *EnrollUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollUserResponse.ProtoReflect.Descriptor instead.
-func (*EnrollUserResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ReorderModuleResponse.ProtoReflect.Descriptor instead.
+func (*ReorderModuleResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *EnrollUserResponse) GetEnrollment() *Enrollment {
+func (x *ReorderModuleResponse) GetModules() []*Module {
 	if x != nil {
-		return x.Enrollment
+		return x.Modules
 	}
 	return nil
 }
 
-type ExpelUserRequest struct {
+type TransferOwnershipRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID владельца курса или суперпользователя
+	NewOwnerId    string                 `protobuf:"bytes,3,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	Force         bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"` // Передать сразу без подтверждения, только для суперпользователя
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpelUserRequest) Reset() {
-	*x = ExpelUserRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[17]
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpelUserRequest) String() string {
+func (x *TransferOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpelUserRequest) ProtoMessage() {}
+func (*TransferOwnershipRequest) ProtoMessage() {}
 
-func (x *ExpelUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[17]
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,47 +5117,62 @@ func (x *ExpelUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpelUserRequest.ProtoReflect.Descriptor instead.
-func (*ExpelUserRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ExpelUserRequest) GetCourseId() string {
+func (x *TransferOwnershipRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *ExpelUserRequest) GetUserId() string {
+func (x *TransferOwnershipRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type ExpelUserResponse struct {
+func (x *TransferOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type TransferOwnershipResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Promoted      []*EnrollmentRequest   `protobuf:"bytes,2,rep,name=promoted,proto3" json:"promoted,omitempty"` // Заявки, переведенные из листа ожидания, если новый владелец был записан на курс
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpelUserResponse) Reset() {
-	*x = ExpelUserResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[18]
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpelUserResponse) String() string {
+func (x *TransferOwnershipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpelUserResponse) ProtoMessage() {}
+func (*TransferOwnershipResponse) ProtoMessage() {}
 
-func (x *ExpelUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[18]
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,41 +5183,47 @@ func (x *ExpelUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpelUserResponse.ProtoReflect.Descriptor instead.
-func (*ExpelUserResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ExpelUserResponse) GetEnrollment() *Enrollment {
+func (x *TransferOwnershipResponse) GetTransfer() *OwnershipTransfer {
 	if x != nil {
-		return x.Enrollment
+		return x.Transfer
 	}
 	return nil
 }
 
-type IsTeacherRequest struct {
+func (x *TransferOwnershipResponse) GetPromoted() []*EnrollmentRequest {
+	if x != nil {
+		return x.Promoted
+	}
+	return nil
+}
+
+type ListOwnershipTransfersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID получателя
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsTeacherRequest) Reset() {
-	*x = IsTeacherRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[19]
+func (x *ListOwnershipTransfersRequest) Reset() {
+	*x = ListOwnershipTransfersRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsTeacherRequest) String() string {
+func (x *ListOwnershipTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsTeacherRequest) ProtoMessage() {}
+func (*ListOwnershipTransfersRequest) ProtoMessage() {}
 
-func (x *IsTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[19]
+func (x *ListOwnershipTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,47 +5234,40 @@ func (x *IsTeacherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsTeacherRequest.ProtoReflect.Descriptor instead.
-func (*IsTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListOwnershipTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListOwnershipTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *IsTeacherRequest) GetUserId() string {
+func (x *ListOwnershipTransfersRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *IsTeacherRequest) GetCourseId() string {
-	if x != nil {
-		return x.CourseId
-	}
-	return ""
-}
-
-type IsTeacherResponse struct {
+type ListOwnershipTransfersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsTeacher     bool                   `protobuf:"varint,1,opt,name=is_teacher,json=isTeacher,proto3" json:"is_teacher,omitempty"`
+	Transfers     []*OwnershipTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsTeacherResponse) Reset() {
-	*x = IsTeacherResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[20]
+func (x *ListOwnershipTransfersResponse) Reset() {
+	*x = ListOwnershipTransfersResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsTeacherResponse) String() string {
+func (x *ListOwnershipTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsTeacherResponse) ProtoMessage() {}
+func (*ListOwnershipTransfersResponse) ProtoMessage() {}
 
-func (x *IsTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[20]
+func (x *ListOwnershipTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,41 +5278,41 @@ func (x *IsTeacherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsTeacherResponse.ProtoReflect.Descriptor instead.
-func (*IsTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListOwnershipTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListOwnershipTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *IsTeacherResponse) GetIsTeacher() bool {
+func (x *ListOwnershipTransfersResponse) GetTransfers() []*OwnershipTransfer {
 	if x != nil {
-		return x.IsTeacher
+		return x.Transfers
 	}
-	return false
+	return nil
 }
 
-type IsMemberRequest struct {
+type AcceptOwnershipTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID нового владельца
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsMemberRequest) Reset() {
-	*x = IsMemberRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[21]
+func (x *AcceptOwnershipTransferRequest) Reset() {
+	*x = AcceptOwnershipTransferRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsMemberRequest) String() string {
+func (x *AcceptOwnershipTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsMemberRequest) ProtoMessage() {}
+func (*AcceptOwnershipTransferRequest) ProtoMessage() {}
 
-func (x *IsMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[21]
+func (x *AcceptOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,47 +5323,48 @@ func (x *IsMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsMemberRequest.ProtoReflect.Descriptor instead.
-func (*IsMemberRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AcceptOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *IsMemberRequest) GetUserId() string {
+func (x *AcceptOwnershipTransferRequest) GetTransferId() string {
 	if x != nil {
-		return x.UserId
+		return x.TransferId
 	}
 	return ""
 }
 
-func (x *IsMemberRequest) GetCourseId() string {
+func (x *AcceptOwnershipTransferRequest) GetUserId() string {
 	if x != nil {
-		return x.CourseId
+		return x.UserId
 	}
 	return ""
 }
 
-type IsMemberResponse struct {
+type AcceptOwnershipTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsMember      bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Promoted      []*EnrollmentRequest   `protobuf:"bytes,2,rep,name=promoted,proto3" json:"promoted,omitempty"` // Заявки, переведенные из листа ожидания, если новый владелец был записан на курс
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsMemberResponse) Reset() {
-	*x = IsMemberResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[22]
+func (x *AcceptOwnershipTransferResponse) Reset() {
+	*x = AcceptOwnershipTransferResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsMemberResponse) String() string {
+func (x *AcceptOwnershipTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsMemberResponse) ProtoMessage() {}
+func (*AcceptOwnershipTransferResponse) ProtoMessage() {}
 
-func (x *IsMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[22]
+func (x *AcceptOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,42 +5375,48 @@ func (x *IsMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsMemberResponse.ProtoReflect.Descriptor instead.
-func (*IsMemberResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AcceptOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *IsMemberResponse) GetIsMember() bool {
+func (x *AcceptOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
 	if x != nil {
-		return x.IsMember
+		return x.Transfer
 	}
-	return false
+	return nil
 }
 
-type GetCourseStudentsRequest struct {
+func (x *AcceptOwnershipTransferResponse) GetPromoted() []*EnrollmentRequest {
+	if x != nil {
+		return x.Promoted
+	}
+	return nil
+}
+
+type DeclineOwnershipTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID нового владельца
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseStudentsRequest) Reset() {
-	*x = GetCourseStudentsRequest{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[23]
+func (x *DeclineOwnershipTransferRequest) Reset() {
+	*x = DeclineOwnershipTransferRequest{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseStudentsRequest) String() string {
+func (x *DeclineOwnershipTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseStudentsRequest) ProtoMessage() {}
+func (*DeclineOwnershipTransferRequest) ProtoMessage() {}
 
-func (x *GetCourseStudentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[23]
+func (x *DeclineOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,56 +5427,47 @@ func (x *GetCourseStudentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseStudentsRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseStudentsRequest) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DeclineOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*DeclineOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetCourseStudentsRequest) GetCourseId() string {
+func (x *DeclineOwnershipTransferRequest) GetTransferId() string {
 	if x != nil {
-		return x.CourseId
+		return x.TransferId
 	}
 	return ""
 }
 
-func (x *GetCourseStudentsRequest) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *GetCourseStudentsRequest) GetLimit() int32 {
+func (x *DeclineOwnershipTransferRequest) GetUserId() string {
 	if x != nil {
-		return x.Limit
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type GetCourseStudentsResponse struct {
+type DeclineOwnershipTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Students      []*Student             `protobuf:"bytes,3,rep,name=students,proto3" json:"students,omitempty"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseStudentsResponse) Reset() {
-	*x = GetCourseStudentsResponse{}
-	mi := &file_Common_Proto_courses_proto_msgTypes[24]
+func (x *DeclineOwnershipTransferResponse) Reset() {
+	*x = DeclineOwnershipTransferResponse{}
+	mi := &file_Common_Proto_courses_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseStudentsResponse) String() string {
+func (x *DeclineOwnershipTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseStudentsResponse) ProtoMessage() {}
+func (*DeclineOwnershipTransferResponse) ProtoMessage() {}
 
-func (x *GetCourseStudentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Common_Proto_courses_proto_msgTypes[24]
+func (x *DeclineOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_courses_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,28 +5478,14 @@ func (x *GetCourseStudentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseStudentsResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseStudentsResponse) Descriptor() ([]byte, []int) {
-	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GetCourseStudentsResponse) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *GetCourseStudentsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use DeclineOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*DeclineOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_courses_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetCourseStudentsResponse) GetStudents() []*Student {
+func (x *DeclineOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
 	if x != nil {
-		return x.Students
+		return x.Transfer
 	}
 	return nil
 }
@@ -1374,7 +5494,7 @@ var File_Common_Proto_courses_proto protoreflect.FileDescriptor
 
 const file_Common_Proto_courses_proto_rawDesc = "" +
 	"\n" +
-	"\x1aCommon/Proto/courses.proto\x12\acourses\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x02\n" +
+	"\x1aCommon/Proto/courses.proto\x12\acourses\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x05\n" +
 	"\x06Course\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
@@ -1388,22 +5508,147 @@ const file_Common_Proto_courses_proto_rawDesc = "" +
 	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
 	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\bcapacity\x18\t \x01(\x05H\x02R\bcapacity\x88\x01\x01\x12@\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x03R\n" +
+	"archivedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tdeletedAt\x88\x01\x01\x12\x1f\n" +
+	"\vis_template\x18\f \x01(\bR\n" +
+	"isTemplate\x12\x16\n" +
+	"\x06listed\x18\r \x01(\bR\x06listed\x12\x1f\n" +
+	"\bcategory\x18\x0e \x01(\tH\x05R\bcategory\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tagsB\r\n" +
 	"\v_start_timeB\v\n" +
-	"\t_end_time\"t\n" +
+	"\t_end_timeB\v\n" +
+	"\t_capacityB\x0e\n" +
+	"\f_archived_atB\r\n" +
+	"\v_deleted_atB\v\n" +
+	"\t_category\"\x1e\n" +
+	"\x04Tags\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"t\n" +
 	"\aStudent\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
-	"\tlast_name\x18\x04 \x01(\tR\blastName\"\x85\x01\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\"\x99\x01\n" +
 	"\n" +
 	"Enrollment\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x02 \x01(\tR\tstudentId\x12;\n" +
 	"\venrolled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"enrolledAt\"\x9e\x02\n" +
+	"enrolledAt\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\xda\x01\n" +
+	"\x05Staff\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x125\n" +
+	"\badded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\xa3\x03\n" +
+	"\x06Invite\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12\x1e\n" +
+	"\bmax_uses\x18\a \x01(\x05H\x01R\amaxUses\x88\x01\x01\x12\x12\n" +
+	"\x04uses\x18\b \x01(\x05R\x04uses\x12>\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\trevokedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_expires_atB\v\n" +
+	"\t_max_usesB\r\n" +
+	"\v_revoked_at\"\xfb\x02\n" +
+	"\x11EnrollmentRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x05 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x06 \x01(\tR\blastName\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\"\n" +
+	"\n" +
+	"decided_by\x18\b \x01(\tH\x00R\tdecidedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_decided_by\"\xc9\x01\n" +
+	"\vRosterEntry\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12;\n" +
+	"\venrolled_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"enrolledAt\"\xa0\x01\n" +
+	"\x10BulkEnrollResult\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x00R\x06userId\x88\x01\x01\x12$\n" +
+	"\vinvite_code\x18\x04 \x01(\tH\x01R\n" +
+	"inviteCode\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x0e\n" +
+	"\f_invite_code\"\xb9\x03\n" +
+	"\bCloneJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12(\n" +
+	"\x10source_course_id\x18\x02 \x01(\tR\x0esourceCourseId\x12(\n" +
+	"\x10target_course_id\x18\x03 \x01(\tR\x0etargetCourseId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12*\n" +
+	"\x0elessons_copied\x18\x06 \x01(\x05H\x00R\rlessonsCopied\x88\x01\x01\x12&\n" +
+	"\ftasks_copied\x18\a \x01(\x05H\x01R\vtasksCopied\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x02R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_lessons_copiedB\x0f\n" +
+	"\r_tasks_copiedB\b\n" +
+	"\x06_error\"\xaf\x01\n" +
+	"\x06Module\x12\x1b\n" +
+	"\tmodule_id\x18\x01 \x01(\tR\bmoduleId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xee\x02\n" +
+	"\x11OwnershipTransfer\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12!\n" +
+	"\fcourse_title\x18\x03 \x01(\tR\vcourseTitle\x12 \n" +
+	"\ffrom_user_id\x18\x04 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x05 \x01(\tR\btoUserId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x16\n" +
+	"\x06forced\x18\a \x01(\bR\x06forced\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"decided_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdecidedAt\x88\x01\x01B\r\n" +
+	"\v_decided_at\"G\n" +
+	"\x0fRolePermissions\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\"\xc7\x03\n" +
 	"\x13CreateCourseRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -1413,26 +5658,38 @@ const file_Common_Proto_courses_proto_rawDesc = "" +
 	"visibility\x12>\n" +
 	"\n" +
 	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
-	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01B\r\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\a \x01(\x05H\x02R\bcapacity\x88\x01\x01\x12\x1f\n" +
+	"\vis_template\x18\b \x01(\bR\n" +
+	"isTemplate\x12\x16\n" +
+	"\x06listed\x18\t \x01(\bR\x06listed\x12\x1f\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tH\x03R\bcategory\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tagsB\r\n" +
 	"\v_start_timeB\v\n" +
-	"\t_end_time\"?\n" +
+	"\t_end_timeB\v\n" +
+	"\t_capacityB\v\n" +
+	"\t_category\"?\n" +
 	"\x14CreateCourseResponse\x12'\n" +
 	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"H\n" +
 	"\x10GetCourseRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"<\n" +
 	"\x11GetCourseResponse\x12'\n" +
-	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\",\n" +
+	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"W\n" +
 	"\x11GetCoursesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"f\n" +
 	"\x1aGetCoursesByStudentRequest\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\";\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"f\n" +
 	"\x1aGetCoursesByTeacherRequest\x12\x1d\n" +
 	"\n" +
-	"teacher_id\x18\x01 \x01(\tR\tteacherId\"?\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"?\n" +
 	"\x12GetCoursesResponse\x12)\n" +
-	"\acourses\x18\x01 \x03(\v2\x0f.courses.CourseR\acourses\"\xda\x02\n" +
+	"\acourses\x18\x01 \x03(\v2\x0f.courses.CourseR\acourses\"\xb7\x04\n" +
 	"\x13UpdateCourseRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x19\n" +
 	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
@@ -1442,32 +5699,54 @@ const file_Common_Proto_courses_proto_rawDesc = "" +
 	"visibility\x88\x01\x01\x12>\n" +
 	"\n" +
 	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tstartTime\x88\x01\x01\x12:\n" +
-	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\aendTime\x88\x01\x01B\b\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\aendTime\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\b \x01(\x05H\x05R\bcapacity\x88\x01\x01\x12$\n" +
+	"\vis_template\x18\t \x01(\bH\x06R\n" +
+	"isTemplate\x88\x01\x01\x12\x1b\n" +
+	"\x06listed\x18\n" +
+	" \x01(\bH\aR\x06listed\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\v \x01(\tH\bR\bcategory\x88\x01\x01\x12!\n" +
+	"\x04tags\x18\f \x01(\v2\r.courses.TagsR\x04tagsB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\r\n" +
 	"\v_visibilityB\r\n" +
 	"\v_start_timeB\v\n" +
-	"\t_end_time\"?\n" +
+	"\t_end_timeB\v\n" +
+	"\t_capacityB\x0e\n" +
+	"\f_is_templateB\t\n" +
+	"\a_listedB\v\n" +
+	"\t_category\"?\n" +
 	"\x14UpdateCourseResponse\x12'\n" +
 	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"2\n" +
 	"\x13DeleteCourseRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"?\n" +
 	"\x14DeleteCourseResponse\x12'\n" +
-	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"I\n" +
+	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"3\n" +
+	"\x14ArchiveCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"@\n" +
+	"\x15ArchiveCourseResponse\x12'\n" +
+	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"L\n" +
+	"\x14RestoreCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
+	"\x15RestoreCourseResponse\x12'\n" +
+	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\"]\n" +
 	"\x11EnrollUserRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"I\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"I\n" +
 	"\x12EnrollUserResponse\x123\n" +
 	"\n" +
 	"enrollment\x18\x01 \x01(\v2\x13.courses.EnrollmentR\n" +
 	"enrollment\"H\n" +
 	"\x10ExpelUserRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x80\x01\n" +
 	"\x11ExpelUserResponse\x123\n" +
 	"\n" +
 	"enrollment\x18\x01 \x01(\v2\x13.courses.EnrollmentR\n" +
-	"enrollment\"H\n" +
+	"enrollment\x126\n" +
+	"\bpromoted\x18\x02 \x03(\v2\x1a.courses.EnrollmentRequestR\bpromoted\"H\n" +
 	"\x10IsTeacherRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"2\n" +
@@ -1486,7 +5765,194 @@ const file_Common_Proto_courses_proto_rawDesc = "" +
 	"\x19GetCourseStudentsResponse\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12,\n" +
-	"\bstudents\x18\x03 \x03(\v2\x10.courses.StudentR\bstudents2\xa0\a\n" +
+	"\bstudents\x18\x03 \x03(\v2\x10.courses.StudentR\bstudents\"h\n" +
+	"\x10AuthorizeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\"c\n" +
+	"\x11AuthorizeResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\":\n" +
+	"\x1bGetCoursePermissionsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"N\n" +
+	"\x1cGetCoursePermissionsResponse\x12.\n" +
+	"\x05roles\x18\x01 \x03(\v2\x18.courses.RolePermissionsR\x05roles\"n\n" +
+	"\x19SetRolePermissionsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"J\n" +
+	"\x1aSetRolePermissionsResponse\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.courses.RolePermissionsR\x04role\"[\n" +
+	"\x0fAddStaffRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"8\n" +
+	"\x10AddStaffResponse\x12$\n" +
+	"\x05staff\x18\x01 \x01(\v2\x0e.courses.StaffR\x05staff\"J\n" +
+	"\x12RemoveStaffRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\";\n" +
+	"\x13RemoveStaffResponse\x12$\n" +
+	"\x05staff\x18\x01 \x01(\v2\x0e.courses.StaffR\x05staff\"/\n" +
+	"\x10ListStaffRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"9\n" +
+	"\x11ListStaffResponse\x12$\n" +
+	"\x05staff\x18\x01 \x03(\v2\x0e.courses.StaffR\x05staff\"\xdb\x01\n" +
+	"\x13CreateInviteRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12\x1e\n" +
+	"\bmax_uses\x18\x05 \x01(\x05H\x01R\amaxUses\x88\x01\x01B\r\n" +
+	"\v_expires_atB\v\n" +
+	"\t_max_uses\"?\n" +
+	"\x14CreateInviteResponse\x12'\n" +
+	"\x06invite\x18\x01 \x01(\v2\x0f.courses.InviteR\x06invite\"1\n" +
+	"\x12ListInvitesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"@\n" +
+	"\x13ListInvitesResponse\x12)\n" +
+	"\ainvites\x18\x01 \x03(\v2\x0f.courses.InviteR\ainvites\"O\n" +
+	"\x13RevokeInviteRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tinvite_id\x18\x02 \x01(\tR\binviteId\"?\n" +
+	"\x14RevokeInviteResponse\x12'\n" +
+	"\x06invite\x18\x01 \x01(\v2\x0f.courses.InviteR\x06invite\"@\n" +
+	"\x11JoinByCodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"I\n" +
+	"\x12JoinByCodeResponse\x123\n" +
+	"\n" +
+	"enrollment\x18\x01 \x01(\v2\x13.courses.EnrollmentR\n" +
+	"enrollment\"P\n" +
+	"\x18RequestEnrollmentRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"Q\n" +
+	"\x19RequestEnrollmentResponse\x124\n" +
+	"\arequest\x18\x01 \x01(\v2\x1a.courses.EnrollmentRequestR\arequest\"d\n" +
+	"\x1dListEnrollmentRequestsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"X\n" +
+	"\x1eListEnrollmentRequestsResponse\x126\n" +
+	"\brequests\x18\x01 \x03(\v2\x1a.courses.EnrollmentRequestR\brequests\"o\n" +
+	"\x18ApproveEnrollmentRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"Q\n" +
+	"\x19ApproveEnrollmentResponse\x124\n" +
+	"\arequest\x18\x01 \x01(\v2\x1a.courses.EnrollmentRequestR\arequest\"n\n" +
+	"\x17RejectEnrollmentRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"P\n" +
+	"\x18RejectEnrollmentResponse\x124\n" +
+	"\arequest\x18\x01 \x01(\v2\x1a.courses.EnrollmentRequestR\arequest\"\x9c\x01\n" +
+	"\x11BulkEnrollRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06emails\x18\x03 \x03(\tR\x06emails\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12%\n" +
+	"\x0einvite_unknown\x18\x05 \x01(\bR\rinviteUnknown\"I\n" +
+	"\x12BulkEnrollResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.courses.BulkEnrollResultR\aresults\"2\n" +
+	"\x13ExportRosterRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"F\n" +
+	"\x14ExportRosterResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.courses.RosterEntryR\aentries\"\xaf\x01\n" +
+	"\x12CloneCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"shift_days\x18\x04 \x01(\x05R\tshiftDays\x12\x1f\n" +
+	"\vis_template\x18\x05 \x01(\bR\n" +
+	"isTemplateB\b\n" +
+	"\x06_title\"c\n" +
+	"\x13CloneCourseResponse\x12'\n" +
+	"\x06course\x18\x01 \x01(\v2\x0f.courses.CourseR\x06course\x12#\n" +
+	"\x03job\x18\x02 \x01(\v2\x11.courses.CloneJobR\x03job\"D\n" +
+	"\x12GetCloneJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\":\n" +
+	"\x13GetCloneJobResponse\x12#\n" +
+	"\x03job\x18\x01 \x01(\v2\x11.courses.CloneJobR\x03job\"\x87\x03\n" +
+	"\x14SearchCoursesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\"\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tH\x00R\tteacherId\x88\x01\x01\x12\x15\n" +
+	"\x03tag\x18\x03 \x01(\tH\x01R\x03tag\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\tH\x02R\bcategory\x88\x01\x01\x12B\n" +
+	"\fstarts_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\vstartsAfter\x88\x01\x01\x12D\n" +
+	"\rstarts_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\fstartsBefore\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursorB\r\n" +
+	"\v_teacher_idB\x06\n" +
+	"\x04_tagB\v\n" +
+	"\t_categoryB\x0f\n" +
+	"\r_starts_afterB\x10\n" +
+	"\x0e_starts_before\"c\n" +
+	"\x15SearchCoursesResponse\x12)\n" +
+	"\acourses\x18\x01 \x03(\v2\x0f.courses.CourseR\acourses\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"H\n" +
+	"\x13CreateModuleRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"?\n" +
+	"\x14CreateModuleResponse\x12'\n" +
+	"\x06module\x18\x01 \x01(\v2\x0f.courses.ModuleR\x06module\"e\n" +
+	"\x13UpdateModuleRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tmodule_id\x18\x02 \x01(\tR\bmoduleId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\"?\n" +
+	"\x14UpdateModuleResponse\x12'\n" +
+	"\x06module\x18\x01 \x01(\v2\x0f.courses.ModuleR\x06module\"O\n" +
+	"\x13DeleteModuleRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tmodule_id\x18\x02 \x01(\tR\bmoduleId\"?\n" +
+	"\x14DeleteModuleResponse\x12'\n" +
+	"\x06module\x18\x01 \x01(\v2\x0f.courses.ModuleR\x06module\"1\n" +
+	"\x12ListModulesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"@\n" +
+	"\x13ListModulesResponse\x12)\n" +
+	"\amodules\x18\x01 \x03(\v2\x0f.courses.ModuleR\amodules\"l\n" +
+	"\x14ReorderModuleRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tmodule_id\x18\x02 \x01(\tR\bmoduleId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"B\n" +
+	"\x15ReorderModuleResponse\x12)\n" +
+	"\amodules\x18\x01 \x03(\v2\x0f.courses.ModuleR\amodules\"\x88\x01\n" +
+	"\x18TransferOwnershipRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12 \n" +
+	"\fnew_owner_id\x18\x03 \x01(\tR\n" +
+	"newOwnerId\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"\x8b\x01\n" +
+	"\x19TransferOwnershipResponse\x126\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1a.courses.OwnershipTransferR\btransfer\x126\n" +
+	"\bpromoted\x18\x02 \x03(\v2\x1a.courses.EnrollmentRequestR\bpromoted\"8\n" +
+	"\x1dListOwnershipTransfersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"Z\n" +
+	"\x1eListOwnershipTransfersResponse\x128\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1a.courses.OwnershipTransferR\ttransfers\"Z\n" +
+	"\x1eAcceptOwnershipTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x91\x01\n" +
+	"\x1fAcceptOwnershipTransferResponse\x126\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1a.courses.OwnershipTransferR\btransfer\x126\n" +
+	"\bpromoted\x18\x02 \x03(\v2\x1a.courses.EnrollmentRequestR\bpromoted\"[\n" +
+	"\x1fDeclineOwnershipTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"Z\n" +
+	" DeclineOwnershipTransferResponse\x126\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1a.courses.OwnershipTransferR\btransfer2\xdd\x1a\n" +
 	"\x0eCoursesService\x12K\n" +
 	"\fCreateCourse\x12\x1c.courses.CreateCourseRequest\x1a\x1d.courses.CreateCourseResponse\x12B\n" +
 	"\tGetCourse\x12\x19.courses.GetCourseRequest\x1a\x1a.courses.GetCourseResponse\x12E\n" +
@@ -1495,13 +5961,45 @@ const file_Common_Proto_courses_proto_rawDesc = "" +
 	"\x13GetCoursesByStudent\x12#.courses.GetCoursesByStudentRequest\x1a\x1b.courses.GetCoursesResponse\x12W\n" +
 	"\x13GetCoursesByTeacher\x12#.courses.GetCoursesByTeacherRequest\x1a\x1b.courses.GetCoursesResponse\x12K\n" +
 	"\fUpdateCourse\x12\x1c.courses.UpdateCourseRequest\x1a\x1d.courses.UpdateCourseResponse\x12K\n" +
-	"\fDeleteCourse\x12\x1c.courses.DeleteCourseRequest\x1a\x1d.courses.DeleteCourseResponse\x12E\n" +
+	"\fDeleteCourse\x12\x1c.courses.DeleteCourseRequest\x1a\x1d.courses.DeleteCourseResponse\x12N\n" +
+	"\rArchiveCourse\x12\x1d.courses.ArchiveCourseRequest\x1a\x1e.courses.ArchiveCourseResponse\x12N\n" +
+	"\rRestoreCourse\x12\x1d.courses.RestoreCourseRequest\x1a\x1e.courses.RestoreCourseResponse\x12E\n" +
 	"\n" +
 	"EnrollUser\x12\x1a.courses.EnrollUserRequest\x1a\x1b.courses.EnrollUserResponse\x12B\n" +
 	"\tExpelUser\x12\x19.courses.ExpelUserRequest\x1a\x1a.courses.ExpelUserResponse\x12B\n" +
 	"\tIsTeacher\x12\x19.courses.IsTeacherRequest\x1a\x1a.courses.IsTeacherResponse\x12?\n" +
 	"\bIsMember\x12\x18.courses.IsMemberRequest\x1a\x19.courses.IsMemberResponse\x12Z\n" +
-	"\x11GetCourseStudents\x12!.courses.GetCourseStudentsRequest\x1a\".courses.GetCourseStudentsResponseB\rZ\vapi/coursesb\x06proto3"
+	"\x11GetCourseStudents\x12!.courses.GetCourseStudentsRequest\x1a\".courses.GetCourseStudentsResponse\x12B\n" +
+	"\tAuthorize\x12\x19.courses.AuthorizeRequest\x1a\x1a.courses.AuthorizeResponse\x12c\n" +
+	"\x14GetCoursePermissions\x12$.courses.GetCoursePermissionsRequest\x1a%.courses.GetCoursePermissionsResponse\x12]\n" +
+	"\x12SetRolePermissions\x12\".courses.SetRolePermissionsRequest\x1a#.courses.SetRolePermissionsResponse\x12?\n" +
+	"\bAddStaff\x12\x18.courses.AddStaffRequest\x1a\x19.courses.AddStaffResponse\x12H\n" +
+	"\vRemoveStaff\x12\x1b.courses.RemoveStaffRequest\x1a\x1c.courses.RemoveStaffResponse\x12B\n" +
+	"\tListStaff\x12\x19.courses.ListStaffRequest\x1a\x1a.courses.ListStaffResponse\x12K\n" +
+	"\fCreateInvite\x12\x1c.courses.CreateInviteRequest\x1a\x1d.courses.CreateInviteResponse\x12H\n" +
+	"\vListInvites\x12\x1b.courses.ListInvitesRequest\x1a\x1c.courses.ListInvitesResponse\x12K\n" +
+	"\fRevokeInvite\x12\x1c.courses.RevokeInviteRequest\x1a\x1d.courses.RevokeInviteResponse\x12E\n" +
+	"\n" +
+	"JoinByCode\x12\x1a.courses.JoinByCodeRequest\x1a\x1b.courses.JoinByCodeResponse\x12Z\n" +
+	"\x11RequestEnrollment\x12!.courses.RequestEnrollmentRequest\x1a\".courses.RequestEnrollmentResponse\x12i\n" +
+	"\x16ListEnrollmentRequests\x12&.courses.ListEnrollmentRequestsRequest\x1a'.courses.ListEnrollmentRequestsResponse\x12Z\n" +
+	"\x11ApproveEnrollment\x12!.courses.ApproveEnrollmentRequest\x1a\".courses.ApproveEnrollmentResponse\x12W\n" +
+	"\x10RejectEnrollment\x12 .courses.RejectEnrollmentRequest\x1a!.courses.RejectEnrollmentResponse\x12E\n" +
+	"\n" +
+	"BulkEnroll\x12\x1a.courses.BulkEnrollRequest\x1a\x1b.courses.BulkEnrollResponse\x12K\n" +
+	"\fExportRoster\x12\x1c.courses.ExportRosterRequest\x1a\x1d.courses.ExportRosterResponse\x12H\n" +
+	"\vCloneCourse\x12\x1b.courses.CloneCourseRequest\x1a\x1c.courses.CloneCourseResponse\x12H\n" +
+	"\vGetCloneJob\x12\x1b.courses.GetCloneJobRequest\x1a\x1c.courses.GetCloneJobResponse\x12N\n" +
+	"\rSearchCourses\x12\x1d.courses.SearchCoursesRequest\x1a\x1e.courses.SearchCoursesResponse\x12K\n" +
+	"\fCreateModule\x12\x1c.courses.CreateModuleRequest\x1a\x1d.courses.CreateModuleResponse\x12K\n" +
+	"\fUpdateModule\x12\x1c.courses.UpdateModuleRequest\x1a\x1d.courses.UpdateModuleResponse\x12K\n" +
+	"\fDeleteModule\x12\x1c.courses.DeleteModuleRequest\x1a\x1d.courses.DeleteModuleResponse\x12H\n" +
+	"\vListModules\x12\x1b.courses.ListModulesRequest\x1a\x1c.courses.ListModulesResponse\x12N\n" +
+	"\rReorderModule\x12\x1d.courses.ReorderModuleRequest\x1a\x1e.courses.ReorderModuleResponse\x12Z\n" +
+	"\x11TransferOwnership\x12!.courses.TransferOwnershipRequest\x1a\".courses.TransferOwnershipResponse\x12i\n" +
+	"\x16ListOwnershipTransfers\x12&.courses.ListOwnershipTransfersRequest\x1a'.courses.ListOwnershipTransfersResponse\x12l\n" +
+	"\x17AcceptOwnershipTransfer\x12'.courses.AcceptOwnershipTransferRequest\x1a(.courses.AcceptOwnershipTransferResponse\x12o\n" +
+	"\x18DeclineOwnershipTransfer\x12(.courses.DeclineOwnershipTransferRequest\x1a).courses.DeclineOwnershipTransferResponseB\rZ\vapi/coursesb\x06proto3"
 
 var (
 	file_Common_Proto_courses_proto_rawDescOnce sync.Once
@@ -1515,81 +6013,262 @@ func file_Common_Proto_courses_proto_rawDescGZIP() []byte {
 	return file_Common_Proto_courses_proto_rawDescData
 }
 
-var file_Common_Proto_courses_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_Common_Proto_courses_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
 var file_Common_Proto_courses_proto_goTypes = []any{
-	(*Course)(nil),                     // 0: courses.Course
-	(*Student)(nil),                    // 1: courses.Student
-	(*Enrollment)(nil),                 // 2: courses.Enrollment
-	(*CreateCourseRequest)(nil),        // 3: courses.CreateCourseRequest
-	(*CreateCourseResponse)(nil),       // 4: courses.CreateCourseResponse
-	(*GetCourseRequest)(nil),           // 5: courses.GetCourseRequest
-	(*GetCourseResponse)(nil),          // 6: courses.GetCourseResponse
-	(*GetCoursesRequest)(nil),          // 7: courses.GetCoursesRequest
-	(*GetCoursesByStudentRequest)(nil), // 8: courses.GetCoursesByStudentRequest
-	(*GetCoursesByTeacherRequest)(nil), // 9: courses.GetCoursesByTeacherRequest
-	(*GetCoursesResponse)(nil),         // 10: courses.GetCoursesResponse
-	(*UpdateCourseRequest)(nil),        // 11: courses.UpdateCourseRequest
-	(*UpdateCourseResponse)(nil),       // 12: courses.UpdateCourseResponse
-	(*DeleteCourseRequest)(nil),        // 13: courses.DeleteCourseRequest
-	(*DeleteCourseResponse)(nil),       // 14: courses.DeleteCourseResponse
-	(*EnrollUserRequest)(nil),          // 15: courses.EnrollUserRequest
-	(*EnrollUserResponse)(nil),         // 16: courses.EnrollUserResponse
-	(*ExpelUserRequest)(nil),           // 17: courses.ExpelUserRequest
-	(*ExpelUserResponse)(nil),          // 18: courses.ExpelUserResponse
-	(*IsTeacherRequest)(nil),           // 19: courses.IsTeacherRequest
-	(*IsTeacherResponse)(nil),          // 20: courses.IsTeacherResponse
-	(*IsMemberRequest)(nil),            // 21: courses.IsMemberRequest
-	(*IsMemberResponse)(nil),           // 22: courses.IsMemberResponse
-	(*GetCourseStudentsRequest)(nil),   // 23: courses.GetCourseStudentsRequest
-	(*GetCourseStudentsResponse)(nil),  // 24: courses.GetCourseStudentsResponse
-	(*timestamppb.Timestamp)(nil),      // 25: google.protobuf.Timestamp
+	(*Course)(nil),                           // 0: courses.Course
+	(*Tags)(nil),                             // 1: courses.Tags
+	(*Student)(nil),                          // 2: courses.Student
+	(*Enrollment)(nil),                       // 3: courses.Enrollment
+	(*Staff)(nil),                            // 4: courses.Staff
+	(*Invite)(nil),                           // 5: courses.Invite
+	(*EnrollmentRequest)(nil),                // 6: courses.EnrollmentRequest
+	(*RosterEntry)(nil),                      // 7: courses.RosterEntry
+	(*BulkEnrollResult)(nil),                 // 8: courses.BulkEnrollResult
+	(*CloneJob)(nil),                         // 9: courses.CloneJob
+	(*Module)(nil),                           // 10: courses.Module
+	(*OwnershipTransfer)(nil),                // 11: courses.OwnershipTransfer
+	(*RolePermissions)(nil),                  // 12: courses.RolePermissions
+	(*CreateCourseRequest)(nil),              // 13: courses.CreateCourseRequest
+	(*CreateCourseResponse)(nil),             // 14: courses.CreateCourseResponse
+	(*GetCourseRequest)(nil),                 // 15: courses.GetCourseRequest
+	(*GetCourseResponse)(nil),                // 16: courses.GetCourseResponse
+	(*GetCoursesRequest)(nil),                // 17: courses.GetCoursesRequest
+	(*GetCoursesByStudentRequest)(nil),       // 18: courses.GetCoursesByStudentRequest
+	(*GetCoursesByTeacherRequest)(nil),       // 19: courses.GetCoursesByTeacherRequest
+	(*GetCoursesResponse)(nil),               // 20: courses.GetCoursesResponse
+	(*UpdateCourseRequest)(nil),              // 21: courses.UpdateCourseRequest
+	(*UpdateCourseResponse)(nil),             // 22: courses.UpdateCourseResponse
+	(*DeleteCourseRequest)(nil),              // 23: courses.DeleteCourseRequest
+	(*DeleteCourseResponse)(nil),             // 24: courses.DeleteCourseResponse
+	(*ArchiveCourseRequest)(nil),             // 25: courses.ArchiveCourseRequest
+	(*ArchiveCourseResponse)(nil),            // 26: courses.ArchiveCourseResponse
+	(*RestoreCourseRequest)(nil),             // 27: courses.RestoreCourseRequest
+	(*RestoreCourseResponse)(nil),            // 28: courses.RestoreCourseResponse
+	(*EnrollUserRequest)(nil),                // 29: courses.EnrollUserRequest
+	(*EnrollUserResponse)(nil),               // 30: courses.EnrollUserResponse
+	(*ExpelUserRequest)(nil),                 // 31: courses.ExpelUserRequest
+	(*ExpelUserResponse)(nil),                // 32: courses.ExpelUserResponse
+	(*IsTeacherRequest)(nil),                 // 33: courses.IsTeacherRequest
+	(*IsTeacherResponse)(nil),                // 34: courses.IsTeacherResponse
+	(*IsMemberRequest)(nil),                  // 35: courses.IsMemberRequest
+	(*IsMemberResponse)(nil),                 // 36: courses.IsMemberResponse
+	(*GetCourseStudentsRequest)(nil),         // 37: courses.GetCourseStudentsRequest
+	(*GetCourseStudentsResponse)(nil),        // 38: courses.GetCourseStudentsResponse
+	(*AuthorizeRequest)(nil),                 // 39: courses.AuthorizeRequest
+	(*AuthorizeResponse)(nil),                // 40: courses.AuthorizeResponse
+	(*GetCoursePermissionsRequest)(nil),      // 41: courses.GetCoursePermissionsRequest
+	(*GetCoursePermissionsResponse)(nil),     // 42: courses.GetCoursePermissionsResponse
+	(*SetRolePermissionsRequest)(nil),        // 43: courses.SetRolePermissionsRequest
+	(*SetRolePermissionsResponse)(nil),       // 44: courses.SetRolePermissionsResponse
+	(*AddStaffRequest)(nil),                  // 45: courses.AddStaffRequest
+	(*AddStaffResponse)(nil),                 // 46: courses.AddStaffResponse
+	(*RemoveStaffRequest)(nil),               // 47: courses.RemoveStaffRequest
+	(*RemoveStaffResponse)(nil),              // 48: courses.RemoveStaffResponse
+	(*ListStaffRequest)(nil),                 // 49: courses.ListStaffRequest
+	(*ListStaffResponse)(nil),                // 50: courses.ListStaffResponse
+	(*CreateInviteRequest)(nil),              // 51: courses.CreateInviteRequest
+	(*CreateInviteResponse)(nil),             // 52: courses.CreateInviteResponse
+	(*ListInvitesRequest)(nil),               // 53: courses.ListInvitesRequest
+	(*ListInvitesResponse)(nil),              // 54: courses.ListInvitesResponse
+	(*RevokeInviteRequest)(nil),              // 55: courses.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),             // 56: courses.RevokeInviteResponse
+	(*JoinByCodeRequest)(nil),                // 57: courses.JoinByCodeRequest
+	(*JoinByCodeResponse)(nil),               // 58: courses.JoinByCodeResponse
+	(*RequestEnrollmentRequest)(nil),         // 59: courses.RequestEnrollmentRequest
+	(*RequestEnrollmentResponse)(nil),        // 60: courses.RequestEnrollmentResponse
+	(*ListEnrollmentRequestsRequest)(nil),    // 61: courses.ListEnrollmentRequestsRequest
+	(*ListEnrollmentRequestsResponse)(nil),   // 62: courses.ListEnrollmentRequestsResponse
+	(*ApproveEnrollmentRequest)(nil),         // 63: courses.ApproveEnrollmentRequest
+	(*ApproveEnrollmentResponse)(nil),        // 64: courses.ApproveEnrollmentResponse
+	(*RejectEnrollmentRequest)(nil),          // 65: courses.RejectEnrollmentRequest
+	(*RejectEnrollmentResponse)(nil),         // 66: courses.RejectEnrollmentResponse
+	(*BulkEnrollRequest)(nil),                // 67: courses.BulkEnrollRequest
+	(*BulkEnrollResponse)(nil),               // 68: courses.BulkEnrollResponse
+	(*ExportRosterRequest)(nil),              // 69: courses.ExportRosterRequest
+	(*ExportRosterResponse)(nil),             // 70: courses.ExportRosterResponse
+	(*CloneCourseRequest)(nil),               // 71: courses.CloneCourseRequest
+	(*CloneCourseResponse)(nil),              // 72: courses.CloneCourseResponse
+	(*GetCloneJobRequest)(nil),               // 73: courses.GetCloneJobRequest
+	(*GetCloneJobResponse)(nil),              // 74: courses.GetCloneJobResponse
+	(*SearchCoursesRequest)(nil),             // 75: courses.SearchCoursesRequest
+	(*SearchCoursesResponse)(nil),            // 76: courses.SearchCoursesResponse
+	(*CreateModuleRequest)(nil),              // 77: courses.CreateModuleRequest
+	(*CreateModuleResponse)(nil),             // 78: courses.CreateModuleResponse
+	(*UpdateModuleRequest)(nil),              // 79: courses.UpdateModuleRequest
+	(*UpdateModuleResponse)(nil),             // 80: courses.UpdateModuleResponse
+	(*DeleteModuleRequest)(nil),              // 81: courses.DeleteModuleRequest
+	(*DeleteModuleResponse)(nil),             // 82: courses.DeleteModuleResponse
+	(*ListModulesRequest)(nil),               // 83: courses.ListModulesRequest
+	(*ListModulesResponse)(nil),              // 84: courses.ListModulesResponse
+	(*ReorderModuleRequest)(nil),             // 85: courses.ReorderModuleRequest
+	(*ReorderModuleResponse)(nil),            // 86: courses.ReorderModuleResponse
+	(*TransferOwnershipRequest)(nil),         // 87: courses.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),        // 88: courses.TransferOwnershipResponse
+	(*ListOwnershipTransfersRequest)(nil),    // 89: courses.ListOwnershipTransfersRequest
+	(*ListOwnershipTransfersResponse)(nil),   // 90: courses.ListOwnershipTransfersResponse
+	(*AcceptOwnershipTransferRequest)(nil),   // 91: courses.AcceptOwnershipTransferRequest
+	(*AcceptOwnershipTransferResponse)(nil),  // 92: courses.AcceptOwnershipTransferResponse
+	(*DeclineOwnershipTransferRequest)(nil),  // 93: courses.DeclineOwnershipTransferRequest
+	(*DeclineOwnershipTransferResponse)(nil), // 94: courses.DeclineOwnershipTransferResponse
+	(*timestamppb.Timestamp)(nil),            // 95: google.protobuf.Timestamp
 }
 var file_Common_Proto_courses_proto_depIdxs = []int32{
-	25, // 0: courses.Course.start_time:type_name -> google.protobuf.Timestamp
-	25, // 1: courses.Course.end_time:type_name -> google.protobuf.Timestamp
-	25, // 2: courses.Course.created_at:type_name -> google.protobuf.Timestamp
-	25, // 3: courses.Enrollment.enrolled_at:type_name -> google.protobuf.Timestamp
-	25, // 4: courses.CreateCourseRequest.start_time:type_name -> google.protobuf.Timestamp
-	25, // 5: courses.CreateCourseRequest.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 6: courses.CreateCourseResponse.course:type_name -> courses.Course
-	0,  // 7: courses.GetCourseResponse.course:type_name -> courses.Course
-	0,  // 8: courses.GetCoursesResponse.courses:type_name -> courses.Course
-	25, // 9: courses.UpdateCourseRequest.start_time:type_name -> google.protobuf.Timestamp
-	25, // 10: courses.UpdateCourseRequest.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 11: courses.UpdateCourseResponse.course:type_name -> courses.Course
-	0,  // 12: courses.DeleteCourseResponse.course:type_name -> courses.Course
-	2,  // 13: courses.EnrollUserResponse.enrollment:type_name -> courses.Enrollment
-	2,  // 14: courses.ExpelUserResponse.enrollment:type_name -> courses.Enrollment
-	1,  // 15: courses.GetCourseStudentsResponse.students:type_name -> courses.Student
-	3,  // 16: courses.CoursesService.CreateCourse:input_type -> courses.CreateCourseRequest
-	5,  // 17: courses.CoursesService.GetCourse:input_type -> courses.GetCourseRequest
-	7,  // 18: courses.CoursesService.GetCourses:input_type -> courses.GetCoursesRequest
-	8,  // 19: courses.CoursesService.GetCoursesByStudent:input_type -> courses.GetCoursesByStudentRequest
-	9,  // 20: courses.CoursesService.GetCoursesByTeacher:input_type -> courses.GetCoursesByTeacherRequest
-	11, // 21: courses.CoursesService.UpdateCourse:input_type -> courses.UpdateCourseRequest
-	13, // 22: courses.CoursesService.DeleteCourse:input_type -> courses.DeleteCourseRequest
-	15, // 23: courses.CoursesService.EnrollUser:input_type -> courses.EnrollUserRequest
-	17, // 24: courses.CoursesService.ExpelUser:input_type -> courses.ExpelUserRequest
-	19, // 25: courses.CoursesService.IsTeacher:input_type -> courses.IsTeacherRequest
-	21, // 26: courses.CoursesService.IsMember:input_type -> courses.IsMemberRequest
-	23, // 27: courses.CoursesService.GetCourseStudents:input_type -> courses.GetCourseStudentsRequest
-	4,  // 28: courses.CoursesService.CreateCourse:output_type -> courses.CreateCourseResponse
-	6,  // 29: courses.CoursesService.GetCourse:output_type -> courses.GetCourseResponse
-	10, // 30: courses.CoursesService.GetCourses:output_type -> courses.GetCoursesResponse
-	10, // 31: courses.CoursesService.GetCoursesByStudent:output_type -> courses.GetCoursesResponse
-	10, // 32: courses.CoursesService.GetCoursesByTeacher:output_type -> courses.GetCoursesResponse
-	12, // 33: courses.CoursesService.UpdateCourse:output_type -> courses.UpdateCourseResponse
-	14, // 34: courses.CoursesService.DeleteCourse:output_type -> courses.DeleteCourseResponse
-	16, // 35: courses.CoursesService.EnrollUser:output_type -> courses.EnrollUserResponse
-	18, // 36: courses.CoursesService.ExpelUser:output_type -> courses.ExpelUserResponse
-	20, // 37: courses.CoursesService.IsTeacher:output_type -> courses.IsTeacherResponse
-	22, // 38: courses.CoursesService.IsMember:output_type -> courses.IsMemberResponse
-	24, // 39: courses.CoursesService.GetCourseStudents:output_type -> courses.GetCourseStudentsResponse
-	28, // [28:40] is the sub-list for method output_type
-	16, // [16:28] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	95,  // 0: courses.Course.start_time:type_name -> google.protobuf.Timestamp
+	95,  // 1: courses.Course.end_time:type_name -> google.protobuf.Timestamp
+	95,  // 2: courses.Course.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 3: courses.Course.archived_at:type_name -> google.protobuf.Timestamp
+	95,  // 4: courses.Course.deleted_at:type_name -> google.protobuf.Timestamp
+	95,  // 5: courses.Enrollment.enrolled_at:type_name -> google.protobuf.Timestamp
+	95,  // 6: courses.Staff.added_at:type_name -> google.protobuf.Timestamp
+	95,  // 7: courses.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	95,  // 8: courses.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	95,  // 9: courses.Invite.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 10: courses.EnrollmentRequest.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 11: courses.EnrollmentRequest.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 12: courses.RosterEntry.enrolled_at:type_name -> google.protobuf.Timestamp
+	95,  // 13: courses.CloneJob.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 14: courses.CloneJob.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 15: courses.Module.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 16: courses.OwnershipTransfer.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 17: courses.OwnershipTransfer.decided_at:type_name -> google.protobuf.Timestamp
+	95,  // 18: courses.CreateCourseRequest.start_time:type_name -> google.protobuf.Timestamp
+	95,  // 19: courses.CreateCourseRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 20: courses.CreateCourseResponse.course:type_name -> courses.Course
+	0,   // 21: courses.GetCourseResponse.course:type_name -> courses.Course
+	0,   // 22: courses.GetCoursesResponse.courses:type_name -> courses.Course
+	95,  // 23: courses.UpdateCourseRequest.start_time:type_name -> google.protobuf.Timestamp
+	95,  // 24: courses.UpdateCourseRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,   // 25: courses.UpdateCourseRequest.tags:type_name -> courses.Tags
+	0,   // 26: courses.UpdateCourseResponse.course:type_name -> courses.Course
+	0,   // 27: courses.DeleteCourseResponse.course:type_name -> courses.Course
+	0,   // 28: courses.ArchiveCourseResponse.course:type_name -> courses.Course
+	0,   // 29: courses.RestoreCourseResponse.course:type_name -> courses.Course
+	3,   // 30: courses.EnrollUserResponse.enrollment:type_name -> courses.Enrollment
+	3,   // 31: courses.ExpelUserResponse.enrollment:type_name -> courses.Enrollment
+	6,   // 32: courses.ExpelUserResponse.promoted:type_name -> courses.EnrollmentRequest
+	2,   // 33: courses.GetCourseStudentsResponse.students:type_name -> courses.Student
+	12,  // 34: courses.GetCoursePermissionsResponse.roles:type_name -> courses.RolePermissions
+	12,  // 35: courses.SetRolePermissionsResponse.role:type_name -> courses.RolePermissions
+	4,   // 36: courses.AddStaffResponse.staff:type_name -> courses.Staff
+	4,   // 37: courses.RemoveStaffResponse.staff:type_name -> courses.Staff
+	4,   // 38: courses.ListStaffResponse.staff:type_name -> courses.Staff
+	95,  // 39: courses.CreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	5,   // 40: courses.CreateInviteResponse.invite:type_name -> courses.Invite
+	5,   // 41: courses.ListInvitesResponse.invites:type_name -> courses.Invite
+	5,   // 42: courses.RevokeInviteResponse.invite:type_name -> courses.Invite
+	3,   // 43: courses.JoinByCodeResponse.enrollment:type_name -> courses.Enrollment
+	6,   // 44: courses.RequestEnrollmentResponse.request:type_name -> courses.EnrollmentRequest
+	6,   // 45: courses.ListEnrollmentRequestsResponse.requests:type_name -> courses.EnrollmentRequest
+	6,   // 46: courses.ApproveEnrollmentResponse.request:type_name -> courses.EnrollmentRequest
+	6,   // 47: courses.RejectEnrollmentResponse.request:type_name -> courses.EnrollmentRequest
+	8,   // 48: courses.BulkEnrollResponse.results:type_name -> courses.BulkEnrollResult
+	7,   // 49: courses.ExportRosterResponse.entries:type_name -> courses.RosterEntry
+	0,   // 50: courses.CloneCourseResponse.course:type_name -> courses.Course
+	9,   // 51: courses.CloneCourseResponse.job:type_name -> courses.CloneJob
+	9,   // 52: courses.GetCloneJobResponse.job:type_name -> courses.CloneJob
+	95,  // 53: courses.SearchCoursesRequest.starts_after:type_name -> google.protobuf.Timestamp
+	95,  // 54: courses.SearchCoursesRequest.starts_before:type_name -> google.protobuf.Timestamp
+	0,   // 55: courses.SearchCoursesResponse.courses:type_name -> courses.Course
+	10,  // 56: courses.CreateModuleResponse.module:type_name -> courses.Module
+	10,  // 57: courses.UpdateModuleResponse.module:type_name -> courses.Module
+	10,  // 58: courses.DeleteModuleResponse.module:type_name -> courses.Module
+	10,  // 59: courses.ListModulesResponse.modules:type_name -> courses.Module
+	10,  // 60: courses.ReorderModuleResponse.modules:type_name -> courses.Module
+	11,  // 61: courses.TransferOwnershipResponse.transfer:type_name -> courses.OwnershipTransfer
+	6,   // 62: courses.TransferOwnershipResponse.promoted:type_name -> courses.EnrollmentRequest
+	11,  // 63: courses.ListOwnershipTransfersResponse.transfers:type_name -> courses.OwnershipTransfer
+	11,  // 64: courses.AcceptOwnershipTransferResponse.transfer:type_name -> courses.OwnershipTransfer
+	6,   // 65: courses.AcceptOwnershipTransferResponse.promoted:type_name -> courses.EnrollmentRequest
+	11,  // 66: courses.DeclineOwnershipTransferResponse.transfer:type_name -> courses.OwnershipTransfer
+	13,  // 67: courses.CoursesService.CreateCourse:input_type -> courses.CreateCourseRequest
+	15,  // 68: courses.CoursesService.GetCourse:input_type -> courses.GetCourseRequest
+	17,  // 69: courses.CoursesService.GetCourses:input_type -> courses.GetCoursesRequest
+	18,  // 70: courses.CoursesService.GetCoursesByStudent:input_type -> courses.GetCoursesByStudentRequest
+	19,  // 71: courses.CoursesService.GetCoursesByTeacher:input_type -> courses.GetCoursesByTeacherRequest
+	21,  // 72: courses.CoursesService.UpdateCourse:input_type -> courses.UpdateCourseRequest
+	23,  // 73: courses.CoursesService.DeleteCourse:input_type -> courses.DeleteCourseRequest
+	25,  // 74: courses.CoursesService.ArchiveCourse:input_type -> courses.ArchiveCourseRequest
+	27,  // 75: courses.CoursesService.RestoreCourse:input_type -> courses.RestoreCourseRequest
+	29,  // 76: courses.CoursesService.EnrollUser:input_type -> courses.EnrollUserRequest
+	31,  // 77: courses.CoursesService.ExpelUser:input_type -> courses.ExpelUserRequest
+	33,  // 78: courses.CoursesService.IsTeacher:input_type -> courses.IsTeacherRequest
+	35,  // 79: courses.CoursesService.IsMember:input_type -> courses.IsMemberRequest
+	37,  // 80: courses.CoursesService.GetCourseStudents:input_type -> courses.GetCourseStudentsRequest
+	39,  // 81: courses.CoursesService.Authorize:input_type -> courses.AuthorizeRequest
+	41,  // 82: courses.CoursesService.GetCoursePermissions:input_type -> courses.GetCoursePermissionsRequest
+	43,  // 83: courses.CoursesService.SetRolePermissions:input_type -> courses.SetRolePermissionsRequest
+	45,  // 84: courses.CoursesService.AddStaff:input_type -> courses.AddStaffRequest
+	47,  // 85: courses.CoursesService.RemoveStaff:input_type -> courses.RemoveStaffRequest
+	49,  // 86: courses.CoursesService.ListStaff:input_type -> courses.ListStaffRequest
+	51,  // 87: courses.CoursesService.CreateInvite:input_type -> courses.CreateInviteRequest
+	53,  // 88: courses.CoursesService.ListInvites:input_type -> courses.ListInvitesRequest
+	55,  // 89: courses.CoursesService.RevokeInvite:input_type -> courses.RevokeInviteRequest
+	57,  // 90: courses.CoursesService.JoinByCode:input_type -> courses.JoinByCodeRequest
+	59,  // 91: courses.CoursesService.RequestEnrollment:input_type -> courses.RequestEnrollmentRequest
+	61,  // 92: courses.CoursesService.ListEnrollmentRequests:input_type -> courses.ListEnrollmentRequestsRequest
+	63,  // 93: courses.CoursesService.ApproveEnrollment:input_type -> courses.ApproveEnrollmentRequest
+	65,  // 94: courses.CoursesService.RejectEnrollment:input_type -> courses.RejectEnrollmentRequest
+	67,  // 95: courses.CoursesService.BulkEnroll:input_type -> courses.BulkEnrollRequest
+	69,  // 96: courses.CoursesService.ExportRoster:input_type -> courses.ExportRosterRequest
+	71,  // 97: courses.CoursesService.CloneCourse:input_type -> courses.CloneCourseRequest
+	73,  // 98: courses.CoursesService.GetCloneJob:input_type -> courses.GetCloneJobRequest
+	75,  // 99: courses.CoursesService.SearchCourses:input_type -> courses.SearchCoursesRequest
+	77,  // 100: courses.CoursesService.CreateModule:input_type -> courses.CreateModuleRequest
+	79,  // 101: courses.CoursesService.UpdateModule:input_type -> courses.UpdateModuleRequest
+	81,  // 102: courses.CoursesService.DeleteModule:input_type -> courses.DeleteModuleRequest
+	83,  // 103: courses.CoursesService.ListModules:input_type -> courses.ListModulesRequest
+	85,  // 104: courses.CoursesService.ReorderModule:input_type -> courses.ReorderModuleRequest
+	87,  // 105: courses.CoursesService.TransferOwnership:input_type -> courses.TransferOwnershipRequest
+	89,  // 106: courses.CoursesService.ListOwnershipTransfers:input_type -> courses.ListOwnershipTransfersRequest
+	91,  // 107: courses.CoursesService.AcceptOwnershipTransfer:input_type -> courses.AcceptOwnershipTransferRequest
+	93,  // 108: courses.CoursesService.DeclineOwnershipTransfer:input_type -> courses.DeclineOwnershipTransferRequest
+	14,  // 109: courses.CoursesService.CreateCourse:output_type -> courses.CreateCourseResponse
+	16,  // 110: courses.CoursesService.GetCourse:output_type -> courses.GetCourseResponse
+	20,  // 111: courses.CoursesService.GetCourses:output_type -> courses.GetCoursesResponse
+	20,  // 112: courses.CoursesService.GetCoursesByStudent:output_type -> courses.GetCoursesResponse
+	20,  // 113: courses.CoursesService.GetCoursesByTeacher:output_type -> courses.GetCoursesResponse
+	22,  // 114: courses.CoursesService.UpdateCourse:output_type -> courses.UpdateCourseResponse
+	24,  // 115: courses.CoursesService.DeleteCourse:output_type -> courses.DeleteCourseResponse
+	26,  // 116: courses.CoursesService.ArchiveCourse:output_type -> courses.ArchiveCourseResponse
+	28,  // 117: courses.CoursesService.RestoreCourse:output_type -> courses.RestoreCourseResponse
+	30,  // 118: courses.CoursesService.EnrollUser:output_type -> courses.EnrollUserResponse
+	32,  // 119: courses.CoursesService.ExpelUser:output_type -> courses.ExpelUserResponse
+	34,  // 120: courses.CoursesService.IsTeacher:output_type -> courses.IsTeacherResponse
+	36,  // 121: courses.CoursesService.IsMember:output_type -> courses.IsMemberResponse
+	38,  // 122: courses.CoursesService.GetCourseStudents:output_type -> courses.GetCourseStudentsResponse
+	40,  // 123: courses.CoursesService.Authorize:output_type -> courses.AuthorizeResponse
+	42,  // 124: courses.CoursesService.GetCoursePermissions:output_type -> courses.GetCoursePermissionsResponse
+	44,  // 125: courses.CoursesService.SetRolePermissions:output_type -> courses.SetRolePermissionsResponse
+	46,  // 126: courses.CoursesService.AddStaff:output_type -> courses.AddStaffResponse
+	48,  // 127: courses.CoursesService.RemoveStaff:output_type -> courses.RemoveStaffResponse
+	50,  // 128: courses.CoursesService.ListStaff:output_type -> courses.ListStaffResponse
+	52,  // 129: courses.CoursesService.CreateInvite:output_type -> courses.CreateInviteResponse
+	54,  // 130: courses.CoursesService.ListInvites:output_type -> courses.ListInvitesResponse
+	56,  // 131: courses.CoursesService.RevokeInvite:output_type -> courses.RevokeInviteResponse
+	58,  // 132: courses.CoursesService.JoinByCode:output_type -> courses.JoinByCodeResponse
+	60,  // 133: courses.CoursesService.RequestEnrollment:output_type -> courses.RequestEnrollmentResponse
+	62,  // 134: courses.CoursesService.ListEnrollmentRequests:output_type -> courses.ListEnrollmentRequestsResponse
+	64,  // 135: courses.CoursesService.ApproveEnrollment:output_type -> courses.ApproveEnrollmentResponse
+	66,  // 136: courses.CoursesService.RejectEnrollment:output_type -> courses.RejectEnrollmentResponse
+	68,  // 137: courses.CoursesService.BulkEnroll:output_type -> courses.BulkEnrollResponse
+	70,  // 138: courses.CoursesService.ExportRoster:output_type -> courses.ExportRosterResponse
+	72,  // 139: courses.CoursesService.CloneCourse:output_type -> courses.CloneCourseResponse
+	74,  // 140: courses.CoursesService.GetCloneJob:output_type -> courses.GetCloneJobResponse
+	76,  // 141: courses.CoursesService.SearchCourses:output_type -> courses.SearchCoursesResponse
+	78,  // 142: courses.CoursesService.CreateModule:output_type -> courses.CreateModuleResponse
+	80,  // 143: courses.CoursesService.UpdateModule:output_type -> courses.UpdateModuleResponse
+	82,  // 144: courses.CoursesService.DeleteModule:output_type -> courses.DeleteModuleResponse
+	84,  // 145: courses.CoursesService.ListModules:output_type -> courses.ListModulesResponse
+	86,  // 146: courses.CoursesService.ReorderModule:output_type -> courses.ReorderModuleResponse
+	88,  // 147: courses.CoursesService.TransferOwnership:output_type -> courses.TransferOwnershipResponse
+	90,  // 148: courses.CoursesService.ListOwnershipTransfers:output_type -> courses.ListOwnershipTransfersResponse
+	92,  // 149: courses.CoursesService.AcceptOwnershipTransfer:output_type -> courses.AcceptOwnershipTransferResponse
+	94,  // 150: courses.CoursesService.DeclineOwnershipTransfer:output_type -> courses.DeclineOwnershipTransferResponse
+	109, // [109:151] is the sub-list for method output_type
+	67,  // [67:109] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_Common_Proto_courses_proto_init() }
@@ -1598,15 +6277,24 @@ func file_Common_Proto_courses_proto_init() {
 		return
 	}
 	file_Common_Proto_courses_proto_msgTypes[0].OneofWrappers = []any{}
-	file_Common_Proto_courses_proto_msgTypes[3].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[5].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[6].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[8].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[9].OneofWrappers = []any{}
 	file_Common_Proto_courses_proto_msgTypes[11].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[13].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[21].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[51].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[61].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[71].OneofWrappers = []any{}
+	file_Common_Proto_courses_proto_msgTypes[75].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_Common_Proto_courses_proto_rawDesc), len(file_Common_Proto_courses_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
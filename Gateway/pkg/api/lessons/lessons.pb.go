@@ -24,11 +24,16 @@ const (
 
 type Lesson struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`    // ID урока
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                          // Название урока
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                      // Описание урока
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Время создания урока
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`           // ID урока
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`           // ID курса
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                 // Название урока
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                             // Описание урока
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // Время создания урока
+	ModuleId      *string                `protobuf:"bytes,6,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"`     // ID модуля курса, пусто - урок без модуля
+	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`                          // Порядок урока в курсе
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                               // draft, scheduled или published
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`  // Время публикации: запланированное или фактическое
+	ContentHtml   string                 `protobuf:"bytes,10,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"` // Содержание в HTML, отрисованное из Markdown и очищенное
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,11 +103,50 @@ func (x *Lesson) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Lesson) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
+func (x *Lesson) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Lesson) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Lesson) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Lesson) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
 type CreateLessonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ModuleId      *string                `protobuf:"bytes,4,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"`    // Модуль того же курса, урок добавляется в конец курса
+	Status        *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`                        // draft (по умолчанию), scheduled или published
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"` // Время публикации, обязательно для scheduled
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // Автор первой ревизии урока
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,6 +202,34 @@ func (x *CreateLessonRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreateLessonRequest) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
+func (x *CreateLessonRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *CreateLessonRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreateLessonRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type CreateLessonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
@@ -291,10 +363,11 @@ func (x *GetLessonResponse) GetLesson() *Lesson {
 }
 
 type GetLessonsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CourseId           string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	IncludeUnpublished bool                   `protobuf:"varint,2,opt,name=include_unpublished,json=includeUnpublished,proto3" json:"include_unpublished,omitempty"` // Вернуть также черновики и запланированные уроки
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetLessonsRequest) Reset() {
@@ -334,9 +407,17 @@ func (x *GetLessonsRequest) GetCourseId() string {
 	return ""
 }
 
+func (x *GetLessonsRequest) GetIncludeUnpublished() bool {
+	if x != nil {
+		return x.IncludeUnpublished
+	}
+	return false
+}
+
 type GetLessonsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lessons       []*Lesson              `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Версия порядка уроков курса, передаётся в ReorderLessons
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -378,11 +459,22 @@ func (x *GetLessonsResponse) GetLessons() []*Lesson {
 	return nil
 }
 
+func (x *GetLessonsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateLessonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
 	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	Content       *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	ModuleId      *string                `protobuf:"bytes,4,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"`    // Перенос в модуль того же курса, пустая строка убирает урок из модуля
+	Status        *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`                        // draft, scheduled или published, опубликованный урок нельзя вернуть в черновики
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"` // Новое время публикации запланированного урока
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // Автор ревизии с изменениями
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -438,6 +530,34 @@ func (x *UpdateLessonRequest) GetContent() string {
 	return ""
 }
 
+func (x *UpdateLessonRequest) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
+func (x *UpdateLessonRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateLessonRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *UpdateLessonRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type UpdateLessonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lesson        *Lesson                `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
@@ -482,27 +602,34 @@ func (x *UpdateLessonResponse) GetLesson() *Lesson {
 	return nil
 }
 
-type DeleteLessonRequest struct {
+// Снимок названия и содержания урока после изменения
+type LessonRevision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // Номер ревизии, начиная с 1
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                                      // В ListLessonRevisions не заполняется
+	AuthorId      *string                `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`              // Пусто у ревизий, созданных до ведения истории
+	RestoredFrom  *int32                 `protobuf:"varint,6,opt,name=restored_from,json=restoredFrom,proto3,oneof" json:"restored_from,omitempty"` // Номер ревизии, из которой урок восстановлен
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLessonRequest) Reset() {
-	*x = DeleteLessonRequest{}
+func (x *LessonRevision) Reset() {
+	*x = LessonRevision{}
 	mi := &file_Common_Proto_lessons_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLessonRequest) String() string {
+func (x *LessonRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLessonRequest) ProtoMessage() {}
+func (*LessonRevision) ProtoMessage() {}
 
-func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
+func (x *LessonRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_Common_Proto_lessons_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,39 +641,81 @@ func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLessonRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLessonRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LessonRevision.ProtoReflect.Descriptor instead.
+func (*LessonRevision) Descriptor() ([]byte, []int) {
 	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteLessonRequest) GetLessonId() string {
+func (x *LessonRevision) GetLessonId() string {
 	if x != nil {
 		return x.LessonId
 	}
 	return ""
 }
 
-type DeleteLessonResponse struct {
+func (x *LessonRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *LessonRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LessonRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LessonRevision) GetAuthorId() string {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return ""
+}
+
+func (x *LessonRevision) GetRestoredFrom() int32 {
+	if x != nil && x.RestoredFrom != nil {
+		return *x.RestoredFrom
+	}
+	return 0
+}
+
+func (x *LessonRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListLessonRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLessonResponse) Reset() {
-	*x = DeleteLessonResponse{}
+func (x *ListLessonRevisionsRequest) Reset() {
+	*x = ListLessonRevisionsRequest{}
 	mi := &file_Common_Proto_lessons_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLessonResponse) String() string {
+func (x *ListLessonRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLessonResponse) ProtoMessage() {}
+func (*ListLessonRevisionsRequest) ProtoMessage() {}
 
-func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
+func (x *ListLessonRevisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Common_Proto_lessons_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -558,127 +727,1659 @@ func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLessonResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLessonResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLessonRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListLessonRevisionsRequest) Descriptor() ([]byte, []int) {
 	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteLessonResponse) GetSuccess() bool {
+func (x *ListLessonRevisionsRequest) GetLessonId() string {
 	if x != nil {
-		return x.Success
+		return x.LessonId
 	}
-	return false
+	return ""
 }
 
-var File_Common_Proto_lessons_proto protoreflect.FileDescriptor
+type ListLessonRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*LessonRevision      `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // От новых к старым
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_Common_Proto_lessons_proto_rawDesc = "" +
-	"\n" +
-	"\x1aCommon/Proto/lessons.proto\x12\alessons\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x01\n" +
-	"\x06Lesson\x12\x1b\n" +
-	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1b\n" +
-	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"b\n" +
-	"\x13CreateLessonRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"3\n" +
-	"\x14CreateLessonResponse\x12\x1b\n" +
-	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"/\n" +
-	"\x10GetLessonRequest\x12\x1b\n" +
-	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"<\n" +
-	"\x11GetLessonResponse\x12'\n" +
-	"\x06lesson\x18\x01 \x01(\v2\x0f.lessons.LessonR\x06lesson\"0\n" +
-	"\x11GetLessonsRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"?\n" +
-	"\x12GetLessonsResponse\x12)\n" +
-	"\alessons\x18\x01 \x03(\v2\x0f.lessons.LessonR\alessons\"\x82\x01\n" +
-	"\x13UpdateLessonRequest\x12\x1b\n" +
-	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
-	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01B\b\n" +
-	"\x06_titleB\n" +
-	"\n" +
-	"\b_content\"?\n" +
-	"\x14UpdateLessonResponse\x12'\n" +
-	"\x06lesson\x18\x01 \x01(\v2\x0f.lessons.LessonR\x06lesson\"2\n" +
-	"\x13DeleteLessonRequest\x12\x1b\n" +
-	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"0\n" +
-	"\x14DeleteLessonResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x82\x03\n" +
-	"\x0eLessonsService\x12K\n" +
-	"\fCreateLesson\x12\x1c.lessons.CreateLessonRequest\x1a\x1d.lessons.CreateLessonResponse\x12B\n" +
-	"\tGetLesson\x12\x19.lessons.GetLessonRequest\x1a\x1a.lessons.GetLessonResponse\x12E\n" +
-	"\n" +
-	"GetLessons\x12\x1a.lessons.GetLessonsRequest\x1a\x1b.lessons.GetLessonsResponse\x12K\n" +
-	"\fUpdateLesson\x12\x1c.lessons.UpdateLessonRequest\x1a\x1d.lessons.UpdateLessonResponse\x12K\n" +
-	"\fDeleteLesson\x12\x1c.lessons.DeleteLessonRequest\x1a\x1d.lessons.DeleteLessonResponseB\rZ\vapi/lessonsb\x06proto3"
+func (x *ListLessonRevisionsResponse) Reset() {
+	*x = ListLessonRevisionsResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_Common_Proto_lessons_proto_rawDescOnce sync.Once
-	file_Common_Proto_lessons_proto_rawDescData []byte
-)
+func (x *ListLessonRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_Common_Proto_lessons_proto_rawDescGZIP() []byte {
-	file_Common_Proto_lessons_proto_rawDescOnce.Do(func() {
-		file_Common_Proto_lessons_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_Common_Proto_lessons_proto_rawDesc), len(file_Common_Proto_lessons_proto_rawDesc)))
-	})
-	return file_Common_Proto_lessons_proto_rawDescData
+func (*ListLessonRevisionsResponse) ProtoMessage() {}
+
+func (x *ListLessonRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_Common_Proto_lessons_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_Common_Proto_lessons_proto_goTypes = []any{
-	(*Lesson)(nil),                // 0: lessons.Lesson
-	(*CreateLessonRequest)(nil),   // 1: lessons.CreateLessonRequest
-	(*CreateLessonResponse)(nil),  // 2: lessons.CreateLessonResponse
-	(*GetLessonRequest)(nil),      // 3: lessons.GetLessonRequest
-	(*GetLessonResponse)(nil),     // 4: lessons.GetLessonResponse
-	(*GetLessonsRequest)(nil),     // 5: lessons.GetLessonsRequest
-	(*GetLessonsResponse)(nil),    // 6: lessons.GetLessonsResponse
-	(*UpdateLessonRequest)(nil),   // 7: lessons.UpdateLessonRequest
-	(*UpdateLessonResponse)(nil),  // 8: lessons.UpdateLessonResponse
-	(*DeleteLessonRequest)(nil),   // 9: lessons.DeleteLessonRequest
-	(*DeleteLessonResponse)(nil),  // 10: lessons.DeleteLessonResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+// Deprecated: Use ListLessonRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListLessonRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{11}
 }
-var file_Common_Proto_lessons_proto_depIdxs = []int32{
-	11, // 0: lessons.Lesson.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: lessons.GetLessonResponse.lesson:type_name -> lessons.Lesson
-	0,  // 2: lessons.GetLessonsResponse.lessons:type_name -> lessons.Lesson
-	0,  // 3: lessons.UpdateLessonResponse.lesson:type_name -> lessons.Lesson
-	1,  // 4: lessons.LessonsService.CreateLesson:input_type -> lessons.CreateLessonRequest
-	3,  // 5: lessons.LessonsService.GetLesson:input_type -> lessons.GetLessonRequest
-	5,  // 6: lessons.LessonsService.GetLessons:input_type -> lessons.GetLessonsRequest
-	7,  // 7: lessons.LessonsService.UpdateLesson:input_type -> lessons.UpdateLessonRequest
-	9,  // 8: lessons.LessonsService.DeleteLesson:input_type -> lessons.DeleteLessonRequest
-	2,  // 9: lessons.LessonsService.CreateLesson:output_type -> lessons.CreateLessonResponse
-	4,  // 10: lessons.LessonsService.GetLesson:output_type -> lessons.GetLessonResponse
-	6,  // 11: lessons.LessonsService.GetLessons:output_type -> lessons.GetLessonsResponse
-	8,  // 12: lessons.LessonsService.UpdateLesson:output_type -> lessons.UpdateLessonResponse
-	10, // 13: lessons.LessonsService.DeleteLesson:output_type -> lessons.DeleteLessonResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+func (x *ListLessonRevisionsResponse) GetRevisions() []*LessonRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
 }
 
-func init() { file_Common_Proto_lessons_proto_init() }
-func file_Common_Proto_lessons_proto_init() {
-	if File_Common_Proto_lessons_proto != nil {
-		return
+type GetLessonRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonRevisionRequest) Reset() {
+	*x = GetLessonRevisionRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonRevisionRequest) ProtoMessage() {}
+
+func (x *GetLessonRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLessonRevisionRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *GetLessonRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetLessonRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *LessonRevision        `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonRevisionResponse) Reset() {
+	*x = GetLessonRevisionResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonRevisionResponse) ProtoMessage() {}
+
+func (x *GetLessonRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetLessonRevisionResponse) GetRevision() *LessonRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type RestoreLessonRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Автор новой ревизии
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreLessonRevisionRequest) Reset() {
+	*x = RestoreLessonRevisionRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreLessonRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreLessonRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreLessonRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreLessonRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreLessonRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreLessonRevisionRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *RestoreLessonRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RestoreLessonRevisionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreLessonRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lesson        *Lesson                `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreLessonRevisionResponse) Reset() {
+	*x = RestoreLessonRevisionResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreLessonRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreLessonRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreLessonRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreLessonRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreLessonRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreLessonRevisionResponse) GetLesson() *Lesson {
+	if x != nil {
+		return x.Lesson
+	}
+	return nil
+}
+
+type DeleteLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLessonRequest) Reset() {
+	*x = DeleteLessonRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonRequest) ProtoMessage() {}
+
+func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLessonRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLessonRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteLessonRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+type DeleteLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLessonResponse) Reset() {
+	*x = DeleteLessonResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonResponse) ProtoMessage() {}
+
+func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLessonResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLessonResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteLessonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CloneFromCourseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceCourseId string                 `protobuf:"bytes,1,opt,name=source_course_id,json=sourceCourseId,proto3" json:"source_course_id,omitempty"` // ID курса, из которого копируются уроков
+	TargetCourseId string                 `protobuf:"bytes,2,opt,name=target_course_id,json=targetCourseId,proto3" json:"target_course_id,omitempty"` // ID курса, в который копируются уроков
+	ShiftDays      int32                  `protobuf:"varint,3,opt,name=shift_days,json=shiftDays,proto3" json:"shift_days,omitempty"`                 // На сколько дней сдвигается время публикации запланированных уроков
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloneFromCourseRequest) Reset() {
+	*x = CloneFromCourseRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneFromCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneFromCourseRequest) ProtoMessage() {}
+
+func (x *CloneFromCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneFromCourseRequest.ProtoReflect.Descriptor instead.
+func (*CloneFromCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloneFromCourseRequest) GetSourceCourseId() string {
+	if x != nil {
+		return x.SourceCourseId
+	}
+	return ""
+}
+
+func (x *CloneFromCourseRequest) GetTargetCourseId() string {
+	if x != nil {
+		return x.TargetCourseId
+	}
+	return ""
+}
+
+func (x *CloneFromCourseRequest) GetShiftDays() int32 {
+	if x != nil {
+		return x.ShiftDays
+	}
+	return 0
+}
+
+type CloneFromCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Copied        int32                  `protobuf:"varint,1,opt,name=copied,proto3" json:"copied,omitempty"` // Количество скопированных уроков
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneFromCourseResponse) Reset() {
+	*x = CloneFromCourseResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneFromCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneFromCourseResponse) ProtoMessage() {}
+
+func (x *CloneFromCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneFromCourseResponse.ProtoReflect.Descriptor instead.
+func (*CloneFromCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CloneFromCourseResponse) GetCopied() int32 {
+	if x != nil {
+		return x.Copied
+	}
+	return 0
+}
+
+type ReorderLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	LessonIds     []string               `protobuf:"bytes,2,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"` // Все уроки курса в новом порядке
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                     // Версия порядка из GetLessons, при несовпадении порядок не меняется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLessonsRequest) Reset() {
+	*x = ReorderLessonsRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLessonsRequest) ProtoMessage() {}
+
+func (x *ReorderLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReorderLessonsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ReorderLessonsRequest) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
+}
+
+func (x *ReorderLessonsRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ReorderLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*Lesson              `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`  // Уроки курса в новом порядке
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Новая версия порядка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLessonsResponse) Reset() {
+	*x = ReorderLessonsResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLessonsResponse) ProtoMessage() {}
+
+func (x *ReorderLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReorderLessonsResponse) GetLessons() []*Lesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+func (x *ReorderLessonsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PreviewLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // Содержание урока в Markdown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewLessonRequest) Reset() {
+	*x = PreviewLessonRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewLessonRequest) ProtoMessage() {}
+
+func (x *PreviewLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewLessonRequest.ProtoReflect.Descriptor instead.
+func (*PreviewLessonRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PreviewLessonRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type PreviewLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentHtml   string                 `protobuf:"bytes,1,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"` // Содержание в HTML, так же как его сохранит урок
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewLessonResponse) Reset() {
+	*x = PreviewLessonResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewLessonResponse) ProtoMessage() {}
+
+func (x *PreviewLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewLessonResponse.ProtoReflect.Descriptor instead.
+func (*PreviewLessonResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PreviewLessonResponse) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"` // ID файла
+	LessonId      string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`             // ID урока
+	CourseId      string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`             // ID курса урока
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`             // Имя файла
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`    // MIME тип файла
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`                                    // Размер файла в байтах
+	UploadedBy    string                 `protobuf:"bytes,7,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`       // ID пользователя, загрузившего файл
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // Время загрузки
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Attachment) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *Attachment) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *Attachment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AttachmentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Кто загружает файл
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentInfo) Reset() {
+	*x = AttachmentInfo{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentInfo) ProtoMessage() {}
+
+func (x *AttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AttachmentInfo) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Первое сообщение потока - информация о файле, дальше содержимое частями
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type GetAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentResponse) Reset() {
+	*x = GetAttachmentResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentResponse) ProtoMessage() {}
+
+func (x *GetAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAttachmentsRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// Первое сообщение потока - информация о файле, дальше содержимое частями
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_Common_Proto_lessons_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_lessons_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_lessons_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+var File_Common_Proto_lessons_proto protoreflect.FileDescriptor
+
+const file_Common_Proto_lessons_proto_rawDesc = "" +
+	"\n" +
+	"\x1aCommon/Proto/lessons.proto\x12\alessons\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x03\n" +
+	"\x06Lesson\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12 \n" +
+	"\tmodule_id\x18\x06 \x01(\tH\x00R\bmoduleId\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12>\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tpublishAt\x88\x01\x01\x12!\n" +
+	"\fcontent_html\x18\n" +
+	" \x01(\tR\vcontentHtmlB\f\n" +
+	"\n" +
+	"_module_idB\r\n" +
+	"\v_publish_at\"\xa2\x02\n" +
+	"\x13CreateLessonRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12 \n" +
+	"\tmodule_id\x18\x04 \x01(\tH\x00R\bmoduleId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x01R\x06status\x88\x01\x01\x12>\n" +
+	"\n" +
+	"publish_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tpublishAt\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userIdB\f\n" +
+	"\n" +
+	"_module_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_publish_at\"3\n" +
+	"\x14CreateLessonResponse\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"/\n" +
+	"\x10GetLessonRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"<\n" +
+	"\x11GetLessonResponse\x12'\n" +
+	"\x06lesson\x18\x01 \x01(\v2\x0f.lessons.LessonR\x06lesson\"a\n" +
+	"\x11GetLessonsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12/\n" +
+	"\x13include_unpublished\x18\x02 \x01(\bR\x12includeUnpublished\"Y\n" +
+	"\x12GetLessonsResponse\x12)\n" +
+	"\alessons\x18\x01 \x03(\v2\x0f.lessons.LessonR\alessons\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\xc2\x02\n" +
+	"\x13UpdateLessonRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01\x12 \n" +
+	"\tmodule_id\x18\x04 \x01(\tH\x02R\bmoduleId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x03R\x06status\x88\x01\x01\x12>\n" +
+	"\n" +
+	"publish_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tpublishAt\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userIdB\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_contentB\f\n" +
+	"\n" +
+	"_module_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_publish_at\"?\n" +
+	"\x14UpdateLessonResponse\x12'\n" +
+	"\x06lesson\x18\x01 \x01(\v2\x0f.lessons.LessonR\x06lesson\"\xa0\x02\n" +
+	"\x0eLessonRevision\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12 \n" +
+	"\tauthor_id\x18\x05 \x01(\tH\x00R\bauthorId\x88\x01\x01\x12(\n" +
+	"\rrestored_from\x18\x06 \x01(\x05H\x01R\frestoredFrom\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\f\n" +
+	"\n" +
+	"_author_idB\x10\n" +
+	"\x0e_restored_from\"9\n" +
+	"\x1aListLessonRevisionsRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"T\n" +
+	"\x1bListLessonRevisionsResponse\x125\n" +
+	"\trevisions\x18\x01 \x03(\v2\x17.lessons.LessonRevisionR\trevisions\"S\n" +
+	"\x18GetLessonRevisionRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"P\n" +
+	"\x19GetLessonRevisionResponse\x123\n" +
+	"\brevision\x18\x01 \x01(\v2\x17.lessons.LessonRevisionR\brevision\"p\n" +
+	"\x1cRestoreLessonRevisionRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"H\n" +
+	"\x1dRestoreLessonRevisionResponse\x12'\n" +
+	"\x06lesson\x18\x01 \x01(\v2\x0f.lessons.LessonR\x06lesson\"2\n" +
+	"\x13DeleteLessonRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"0\n" +
+	"\x14DeleteLessonResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8b\x01\n" +
+	"\x16CloneFromCourseRequest\x12(\n" +
+	"\x10source_course_id\x18\x01 \x01(\tR\x0esourceCourseId\x12(\n" +
+	"\x10target_course_id\x18\x02 \x01(\tR\x0etargetCourseId\x12\x1d\n" +
+	"\n" +
+	"shift_days\x18\x03 \x01(\x05R\tshiftDays\"1\n" +
+	"\x17CloneFromCourseResponse\x12\x16\n" +
+	"\x06copied\x18\x01 \x01(\x05R\x06copied\"m\n" +
+	"\x15ReorderLessonsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"lesson_ids\x18\x02 \x03(\tR\tlessonIds\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"]\n" +
+	"\x16ReorderLessonsResponse\x12)\n" +
+	"\alessons\x18\x01 \x03(\v2\x0f.lessons.LessonR\alessons\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"0\n" +
+	"\x14PreviewLessonRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\":\n" +
+	"\x15PreviewLessonResponse\x12!\n" +
+	"\fcontent_html\x18\x01 \x01(\tR\vcontentHtml\"\x9b\x02\n" +
+	"\n" +
+	"Attachment\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x1f\n" +
+	"\vuploaded_by\x18\a \x01(\tR\n" +
+	"uploadedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x86\x01\n" +
+	"\x0eAttachmentInfo\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"k\n" +
+	"\x17UploadAttachmentRequest\x12-\n" +
+	"\x04info\x18\x01 \x01(\v2\x17.lessons.AttachmentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"O\n" +
+	"\x18UploadAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.lessons.AttachmentR\n" +
+	"attachment\";\n" +
+	"\x14GetAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"L\n" +
+	"\x15GetAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.lessons.AttachmentR\n" +
+	"attachment\"5\n" +
+	"\x16ListAttachmentsRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"P\n" +
+	"\x17ListAttachmentsResponse\x125\n" +
+	"\vattachments\x18\x01 \x03(\v2\x13.lessons.AttachmentR\vattachments\">\n" +
+	"\x17DeleteAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"O\n" +
+	"\x18DeleteAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.lessons.AttachmentR\n" +
+	"attachment\"@\n" +
+	"\x19DownloadAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"v\n" +
+	"\x1aDownloadAttachmentResponse\x125\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.lessons.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload2\xdc\n" +
+	"\n" +
+	"\x0eLessonsService\x12K\n" +
+	"\fCreateLesson\x12\x1c.lessons.CreateLessonRequest\x1a\x1d.lessons.CreateLessonResponse\x12B\n" +
+	"\tGetLesson\x12\x19.lessons.GetLessonRequest\x1a\x1a.lessons.GetLessonResponse\x12E\n" +
+	"\n" +
+	"GetLessons\x12\x1a.lessons.GetLessonsRequest\x1a\x1b.lessons.GetLessonsResponse\x12K\n" +
+	"\fUpdateLesson\x12\x1c.lessons.UpdateLessonRequest\x1a\x1d.lessons.UpdateLessonResponse\x12K\n" +
+	"\fDeleteLesson\x12\x1c.lessons.DeleteLessonRequest\x1a\x1d.lessons.DeleteLessonResponse\x12T\n" +
+	"\x0fCloneFromCourse\x12\x1f.lessons.CloneFromCourseRequest\x1a .lessons.CloneFromCourseResponse\x12Q\n" +
+	"\x0eReorderLessons\x12\x1e.lessons.ReorderLessonsRequest\x1a\x1f.lessons.ReorderLessonsResponse\x12N\n" +
+	"\rPreviewLesson\x12\x1d.lessons.PreviewLessonRequest\x1a\x1e.lessons.PreviewLessonResponse\x12`\n" +
+	"\x13ListLessonRevisions\x12#.lessons.ListLessonRevisionsRequest\x1a$.lessons.ListLessonRevisionsResponse\x12Z\n" +
+	"\x11GetLessonRevision\x12!.lessons.GetLessonRevisionRequest\x1a\".lessons.GetLessonRevisionResponse\x12f\n" +
+	"\x15RestoreLessonRevision\x12%.lessons.RestoreLessonRevisionRequest\x1a&.lessons.RestoreLessonRevisionResponse\x12Y\n" +
+	"\x10UploadAttachment\x12 .lessons.UploadAttachmentRequest\x1a!.lessons.UploadAttachmentResponse(\x01\x12N\n" +
+	"\rGetAttachment\x12\x1d.lessons.GetAttachmentRequest\x1a\x1e.lessons.GetAttachmentResponse\x12T\n" +
+	"\x0fListAttachments\x12\x1f.lessons.ListAttachmentsRequest\x1a .lessons.ListAttachmentsResponse\x12W\n" +
+	"\x10DeleteAttachment\x12 .lessons.DeleteAttachmentRequest\x1a!.lessons.DeleteAttachmentResponse\x12_\n" +
+	"\x12DownloadAttachment\x12\".lessons.DownloadAttachmentRequest\x1a#.lessons.DownloadAttachmentResponse0\x01B\rZ\vapi/lessonsb\x06proto3"
+
+var (
+	file_Common_Proto_lessons_proto_rawDescOnce sync.Once
+	file_Common_Proto_lessons_proto_rawDescData []byte
+)
+
+func file_Common_Proto_lessons_proto_rawDescGZIP() []byte {
+	file_Common_Proto_lessons_proto_rawDescOnce.Do(func() {
+		file_Common_Proto_lessons_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_Common_Proto_lessons_proto_rawDesc), len(file_Common_Proto_lessons_proto_rawDesc)))
+	})
+	return file_Common_Proto_lessons_proto_rawDescData
+}
+
+var file_Common_Proto_lessons_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_Common_Proto_lessons_proto_goTypes = []any{
+	(*Lesson)(nil),                        // 0: lessons.Lesson
+	(*CreateLessonRequest)(nil),           // 1: lessons.CreateLessonRequest
+	(*CreateLessonResponse)(nil),          // 2: lessons.CreateLessonResponse
+	(*GetLessonRequest)(nil),              // 3: lessons.GetLessonRequest
+	(*GetLessonResponse)(nil),             // 4: lessons.GetLessonResponse
+	(*GetLessonsRequest)(nil),             // 5: lessons.GetLessonsRequest
+	(*GetLessonsResponse)(nil),            // 6: lessons.GetLessonsResponse
+	(*UpdateLessonRequest)(nil),           // 7: lessons.UpdateLessonRequest
+	(*UpdateLessonResponse)(nil),          // 8: lessons.UpdateLessonResponse
+	(*LessonRevision)(nil),                // 9: lessons.LessonRevision
+	(*ListLessonRevisionsRequest)(nil),    // 10: lessons.ListLessonRevisionsRequest
+	(*ListLessonRevisionsResponse)(nil),   // 11: lessons.ListLessonRevisionsResponse
+	(*GetLessonRevisionRequest)(nil),      // 12: lessons.GetLessonRevisionRequest
+	(*GetLessonRevisionResponse)(nil),     // 13: lessons.GetLessonRevisionResponse
+	(*RestoreLessonRevisionRequest)(nil),  // 14: lessons.RestoreLessonRevisionRequest
+	(*RestoreLessonRevisionResponse)(nil), // 15: lessons.RestoreLessonRevisionResponse
+	(*DeleteLessonRequest)(nil),           // 16: lessons.DeleteLessonRequest
+	(*DeleteLessonResponse)(nil),          // 17: lessons.DeleteLessonResponse
+	(*CloneFromCourseRequest)(nil),        // 18: lessons.CloneFromCourseRequest
+	(*CloneFromCourseResponse)(nil),       // 19: lessons.CloneFromCourseResponse
+	(*ReorderLessonsRequest)(nil),         // 20: lessons.ReorderLessonsRequest
+	(*ReorderLessonsResponse)(nil),        // 21: lessons.ReorderLessonsResponse
+	(*PreviewLessonRequest)(nil),          // 22: lessons.PreviewLessonRequest
+	(*PreviewLessonResponse)(nil),         // 23: lessons.PreviewLessonResponse
+	(*Attachment)(nil),                    // 24: lessons.Attachment
+	(*AttachmentInfo)(nil),                // 25: lessons.AttachmentInfo
+	(*UploadAttachmentRequest)(nil),       // 26: lessons.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),      // 27: lessons.UploadAttachmentResponse
+	(*GetAttachmentRequest)(nil),          // 28: lessons.GetAttachmentRequest
+	(*GetAttachmentResponse)(nil),         // 29: lessons.GetAttachmentResponse
+	(*ListAttachmentsRequest)(nil),        // 30: lessons.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),       // 31: lessons.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),       // 32: lessons.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),      // 33: lessons.DeleteAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),     // 34: lessons.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),    // 35: lessons.DownloadAttachmentResponse
+	(*timestamppb.Timestamp)(nil),         // 36: google.protobuf.Timestamp
+}
+var file_Common_Proto_lessons_proto_depIdxs = []int32{
+	36, // 0: lessons.Lesson.created_at:type_name -> google.protobuf.Timestamp
+	36, // 1: lessons.Lesson.publish_at:type_name -> google.protobuf.Timestamp
+	36, // 2: lessons.CreateLessonRequest.publish_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: lessons.GetLessonResponse.lesson:type_name -> lessons.Lesson
+	0,  // 4: lessons.GetLessonsResponse.lessons:type_name -> lessons.Lesson
+	36, // 5: lessons.UpdateLessonRequest.publish_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: lessons.UpdateLessonResponse.lesson:type_name -> lessons.Lesson
+	36, // 7: lessons.LessonRevision.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 8: lessons.ListLessonRevisionsResponse.revisions:type_name -> lessons.LessonRevision
+	9,  // 9: lessons.GetLessonRevisionResponse.revision:type_name -> lessons.LessonRevision
+	0,  // 10: lessons.RestoreLessonRevisionResponse.lesson:type_name -> lessons.Lesson
+	0,  // 11: lessons.ReorderLessonsResponse.lessons:type_name -> lessons.Lesson
+	36, // 12: lessons.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	25, // 13: lessons.UploadAttachmentRequest.info:type_name -> lessons.AttachmentInfo
+	24, // 14: lessons.UploadAttachmentResponse.attachment:type_name -> lessons.Attachment
+	24, // 15: lessons.GetAttachmentResponse.attachment:type_name -> lessons.Attachment
+	24, // 16: lessons.ListAttachmentsResponse.attachments:type_name -> lessons.Attachment
+	24, // 17: lessons.DeleteAttachmentResponse.attachment:type_name -> lessons.Attachment
+	24, // 18: lessons.DownloadAttachmentResponse.attachment:type_name -> lessons.Attachment
+	1,  // 19: lessons.LessonsService.CreateLesson:input_type -> lessons.CreateLessonRequest
+	3,  // 20: lessons.LessonsService.GetLesson:input_type -> lessons.GetLessonRequest
+	5,  // 21: lessons.LessonsService.GetLessons:input_type -> lessons.GetLessonsRequest
+	7,  // 22: lessons.LessonsService.UpdateLesson:input_type -> lessons.UpdateLessonRequest
+	16, // 23: lessons.LessonsService.DeleteLesson:input_type -> lessons.DeleteLessonRequest
+	18, // 24: lessons.LessonsService.CloneFromCourse:input_type -> lessons.CloneFromCourseRequest
+	20, // 25: lessons.LessonsService.ReorderLessons:input_type -> lessons.ReorderLessonsRequest
+	22, // 26: lessons.LessonsService.PreviewLesson:input_type -> lessons.PreviewLessonRequest
+	10, // 27: lessons.LessonsService.ListLessonRevisions:input_type -> lessons.ListLessonRevisionsRequest
+	12, // 28: lessons.LessonsService.GetLessonRevision:input_type -> lessons.GetLessonRevisionRequest
+	14, // 29: lessons.LessonsService.RestoreLessonRevision:input_type -> lessons.RestoreLessonRevisionRequest
+	26, // 30: lessons.LessonsService.UploadAttachment:input_type -> lessons.UploadAttachmentRequest
+	28, // 31: lessons.LessonsService.GetAttachment:input_type -> lessons.GetAttachmentRequest
+	30, // 32: lessons.LessonsService.ListAttachments:input_type -> lessons.ListAttachmentsRequest
+	32, // 33: lessons.LessonsService.DeleteAttachment:input_type -> lessons.DeleteAttachmentRequest
+	34, // 34: lessons.LessonsService.DownloadAttachment:input_type -> lessons.DownloadAttachmentRequest
+	2,  // 35: lessons.LessonsService.CreateLesson:output_type -> lessons.CreateLessonResponse
+	4,  // 36: lessons.LessonsService.GetLesson:output_type -> lessons.GetLessonResponse
+	6,  // 37: lessons.LessonsService.GetLessons:output_type -> lessons.GetLessonsResponse
+	8,  // 38: lessons.LessonsService.UpdateLesson:output_type -> lessons.UpdateLessonResponse
+	17, // 39: lessons.LessonsService.DeleteLesson:output_type -> lessons.DeleteLessonResponse
+	19, // 40: lessons.LessonsService.CloneFromCourse:output_type -> lessons.CloneFromCourseResponse
+	21, // 41: lessons.LessonsService.ReorderLessons:output_type -> lessons.ReorderLessonsResponse
+	23, // 42: lessons.LessonsService.PreviewLesson:output_type -> lessons.PreviewLessonResponse
+	11, // 43: lessons.LessonsService.ListLessonRevisions:output_type -> lessons.ListLessonRevisionsResponse
+	13, // 44: lessons.LessonsService.GetLessonRevision:output_type -> lessons.GetLessonRevisionResponse
+	15, // 45: lessons.LessonsService.RestoreLessonRevision:output_type -> lessons.RestoreLessonRevisionResponse
+	27, // 46: lessons.LessonsService.UploadAttachment:output_type -> lessons.UploadAttachmentResponse
+	29, // 47: lessons.LessonsService.GetAttachment:output_type -> lessons.GetAttachmentResponse
+	31, // 48: lessons.LessonsService.ListAttachments:output_type -> lessons.ListAttachmentsResponse
+	33, // 49: lessons.LessonsService.DeleteAttachment:output_type -> lessons.DeleteAttachmentResponse
+	35, // 50: lessons.LessonsService.DownloadAttachment:output_type -> lessons.DownloadAttachmentResponse
+	35, // [35:51] is the sub-list for method output_type
+	19, // [19:35] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_Common_Proto_lessons_proto_init() }
+func file_Common_Proto_lessons_proto_init() {
+	if File_Common_Proto_lessons_proto != nil {
+		return
+	}
+	file_Common_Proto_lessons_proto_msgTypes[0].OneofWrappers = []any{}
+	file_Common_Proto_lessons_proto_msgTypes[1].OneofWrappers = []any{}
+	file_Common_Proto_lessons_proto_msgTypes[7].OneofWrappers = []any{}
+	file_Common_Proto_lessons_proto_msgTypes[9].OneofWrappers = []any{}
+	file_Common_Proto_lessons_proto_msgTypes[26].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_Common_Proto_lessons_proto_msgTypes[35].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
 	}
-	file_Common_Proto_lessons_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_Common_Proto_lessons_proto_rawDesc), len(file_Common_Proto_lessons_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,11 +24,13 @@ const (
 
 type Task struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // ID задания
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                          // Название задания
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                      // Содержание задания
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Дата создания задания
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`             // ID задания
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`       // ID курса
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                             // Название задания
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                         // Содержание задания
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // Дата создания задания
+	ModuleId      *string                `protobuf:"bytes,6,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"` // ID модуля курса, пусто - задание без модуля
+	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`                      // Порядок задания в курсе
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,14 +100,30 @@ func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Task) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
+func (x *Task) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type StudentTask struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // ID задания
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                          // Название задания
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                      // Содержание задания
-	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`                 // Выполнено ли задание
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Дата создания задания
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`             // ID задания
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`       // ID курса
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                             // Название задания
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                         // Содержание задания
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`                    // Выполнено ли задание
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // Дата создания задания
+	ModuleId      *string                `protobuf:"bytes,7,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"` // ID модуля курса, пусто - задание без модуля
+	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`                      // Порядок задания в курсе
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +200,20 @@ func (x *StudentTask) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *StudentTask) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
+func (x *StudentTask) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type TaskStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // ID задания
@@ -247,6 +279,7 @@ type CreateTaskRequest struct {
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ModuleId      *string                `protobuf:"bytes,4,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"` // Модуль того же курса, задание добавляется в конец курса
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -302,6 +335,13 @@ func (x *CreateTaskRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
 type CreateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -527,6 +567,7 @@ type UpdateTaskRequest struct {
 	Title         *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	Content       *string                `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
 	TaskId        string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ModuleId      *string                `protobuf:"bytes,4,opt,name=module_id,json=moduleId,proto3,oneof" json:"module_id,omitempty"` // Перенос в модуль того же курса, пустая строка убирает задание из модуля
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -582,6 +623,13 @@ func (x *UpdateTaskRequest) GetTaskId() string {
 	return ""
 }
 
+func (x *UpdateTaskRequest) GetModuleId() string {
+	if x != nil && x.ModuleId != nil {
+		return *x.ModuleId
+	}
+	return ""
+}
+
 type UpdateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
@@ -994,18 +1042,118 @@ func (x *GetStudentStatusesResponse) GetStatuses() []*TaskStatus {
 	return nil
 }
 
+type CloneFromCourseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceCourseId string                 `protobuf:"bytes,1,opt,name=source_course_id,json=sourceCourseId,proto3" json:"source_course_id,omitempty"` // ID курса, из которого копируются заданий
+	TargetCourseId string                 `protobuf:"bytes,2,opt,name=target_course_id,json=targetCourseId,proto3" json:"target_course_id,omitempty"` // ID курса, в который копируются заданий
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloneFromCourseRequest) Reset() {
+	*x = CloneFromCourseRequest{}
+	mi := &file_Common_Proto_tasks_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneFromCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneFromCourseRequest) ProtoMessage() {}
+
+func (x *CloneFromCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_tasks_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneFromCourseRequest.ProtoReflect.Descriptor instead.
+func (*CloneFromCourseRequest) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_tasks_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CloneFromCourseRequest) GetSourceCourseId() string {
+	if x != nil {
+		return x.SourceCourseId
+	}
+	return ""
+}
+
+func (x *CloneFromCourseRequest) GetTargetCourseId() string {
+	if x != nil {
+		return x.TargetCourseId
+	}
+	return ""
+}
+
+type CloneFromCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Copied        int32                  `protobuf:"varint,1,opt,name=copied,proto3" json:"copied,omitempty"` // Количество скопированных заданий
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneFromCourseResponse) Reset() {
+	*x = CloneFromCourseResponse{}
+	mi := &file_Common_Proto_tasks_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneFromCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneFromCourseResponse) ProtoMessage() {}
+
+func (x *CloneFromCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Common_Proto_tasks_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneFromCourseResponse.ProtoReflect.Descriptor instead.
+func (*CloneFromCourseResponse) Descriptor() ([]byte, []int) {
+	return file_Common_Proto_tasks_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CloneFromCourseResponse) GetCopied() int32 {
+	if x != nil {
+		return x.Copied
+	}
+	return 0
+}
+
 var File_Common_Proto_tasks_proto protoreflect.FileDescriptor
 
 const file_Common_Proto_tasks_proto_rawDesc = "" +
 	"\n" +
-	"\x18Common/Proto/tasks.proto\x12\x05tasks\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n" +
+	"\x18Common/Proto/tasks.proto\x12\x05tasks\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf3\x01\n" +
 	"\x04Task\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcc\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12 \n" +
+	"\tmodule_id\x18\x06 \x01(\tH\x00R\bmoduleId\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bpositionB\f\n" +
+	"\n" +
+	"_module_id\"\x98\x02\n" +
 	"\vStudentTask\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x14\n" +
@@ -1013,17 +1161,24 @@ const file_Common_Proto_tasks_proto_rawDesc = "" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1c\n" +
 	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"b\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12 \n" +
+	"\tmodule_id\x18\a \x01(\tH\x00R\bmoduleId\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bpositionB\f\n" +
+	"\n" +
+	"_module_id\"b\n" +
 	"\n" +
 	"TaskStatus\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1c\n" +
-	"\tcompleted\x18\x03 \x01(\bR\tcompleted\"h\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\"\x98\x01\n" +
 	"\x11CreateTaskRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"-\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\tmodule_id\x18\x04 \x01(\tH\x00R\bmoduleId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_module_id\"-\n" +
 	"\x12CreateTaskResponse\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\")\n" +
 	"\x0eGetTaskRequest\x12\x17\n" +
@@ -1033,14 +1188,17 @@ const file_Common_Proto_tasks_proto_rawDesc = "" +
 	"\x0fGetTasksRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"5\n" +
 	"\x10GetTasksResponse\x12!\n" +
-	"\x05tasks\x18\x01 \x03(\v2\v.tasks.TaskR\x05tasks\"|\n" +
+	"\x05tasks\x18\x01 \x03(\v2\v.tasks.TaskR\x05tasks\"\xac\x01\n" +
 	"\x11UpdateTaskRequest\x12\x19\n" +
 	"\x05title\x18\x01 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
 	"\acontent\x18\x02 \x01(\tH\x01R\acontent\x88\x01\x01\x12\x17\n" +
-	"\atask_id\x18\x03 \x01(\tR\x06taskIdB\b\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\x12 \n" +
+	"\tmodule_id\x18\x04 \x01(\tH\x02R\bmoduleId\x88\x01\x01B\b\n" +
 	"\x06_titleB\n" +
 	"\n" +
-	"\b_content\"5\n" +
+	"\b_contentB\f\n" +
+	"\n" +
+	"_module_id\"5\n" +
 	"\x12UpdateTaskResponse\x12\x1f\n" +
 	"\x04task\x18\x01 \x01(\v2\v.tasks.TaskR\x04task\"Q\n" +
 	"\x17ChangeStatusTaskRequest\x12\x17\n" +
@@ -1063,7 +1221,12 @@ const file_Common_Proto_tasks_proto_rawDesc = "" +
 	"\x19GetStudentStatusesRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\"K\n" +
 	"\x1aGetStudentStatusesResponse\x12-\n" +
-	"\bstatuses\x18\x01 \x03(\v2\x11.tasks.TaskStatusR\bstatuses2\xd9\x04\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x11.tasks.TaskStatusR\bstatuses\"l\n" +
+	"\x16CloneFromCourseRequest\x12(\n" +
+	"\x10source_course_id\x18\x01 \x01(\tR\x0esourceCourseId\x12(\n" +
+	"\x10target_course_id\x18\x02 \x01(\tR\x0etargetCourseId\"1\n" +
+	"\x17CloneFromCourseResponse\x12\x16\n" +
+	"\x06copied\x18\x01 \x01(\x05R\x06copied2\xab\x05\n" +
 	"\fTasksService\x12A\n" +
 	"\n" +
 	"CreateTask\x12\x18.tasks.CreateTaskRequest\x1a\x19.tasks.CreateTaskResponse\x128\n" +
@@ -1075,7 +1238,8 @@ const file_Common_Proto_tasks_proto_rawDesc = "" +
 	"UpdateTask\x12\x18.tasks.UpdateTaskRequest\x1a\x19.tasks.UpdateTaskResponse\x12S\n" +
 	"\x10ChangeStatusTask\x12\x1e.tasks.ChangeStatusTaskRequest\x1a\x1f.tasks.ChangeStatusTaskResponse\x12A\n" +
 	"\n" +
-	"DeleteTask\x12\x18.tasks.DeleteTaskRequest\x1a\x19.tasks.DeleteTaskResponseB\vZ\tapi/tasksb\x06proto3"
+	"DeleteTask\x12\x18.tasks.DeleteTaskRequest\x1a\x19.tasks.DeleteTaskResponse\x12P\n" +
+	"\x0fCloneFromCourse\x12\x1d.tasks.CloneFromCourseRequest\x1a\x1e.tasks.CloneFromCourseResponseB\vZ\tapi/tasksb\x06proto3"
 
 var (
 	file_Common_Proto_tasks_proto_rawDescOnce sync.Once
@@ -1089,7 +1253,7 @@ func file_Common_Proto_tasks_proto_rawDescGZIP() []byte {
 	return file_Common_Proto_tasks_proto_rawDescData
 }
 
-var file_Common_Proto_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_Common_Proto_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_Common_Proto_tasks_proto_goTypes = []any{
 	(*Task)(nil),                       // 0: tasks.Task
 	(*StudentTask)(nil),                // 1: tasks.StudentTask
@@ -1110,11 +1274,13 @@ var file_Common_Proto_tasks_proto_goTypes = []any{
 	(*GetTasksForStudentResponse)(nil), // 16: tasks.GetTasksForStudentResponse
 	(*GetStudentStatusesRequest)(nil),  // 17: tasks.GetStudentStatusesRequest
 	(*GetStudentStatusesResponse)(nil), // 18: tasks.GetStudentStatusesResponse
-	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+	(*CloneFromCourseRequest)(nil),     // 19: tasks.CloneFromCourseRequest
+	(*CloneFromCourseResponse)(nil),    // 20: tasks.CloneFromCourseResponse
+	(*timestamppb.Timestamp)(nil),      // 21: google.protobuf.Timestamp
 }
 var file_Common_Proto_tasks_proto_depIdxs = []int32{
-	19, // 0: tasks.Task.created_at:type_name -> google.protobuf.Timestamp
-	19, // 1: tasks.StudentTask.created_at:type_name -> google.protobuf.Timestamp
+	21, // 0: tasks.Task.created_at:type_name -> google.protobuf.Timestamp
+	21, // 1: tasks.StudentTask.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: tasks.GetTaskResponse.task:type_name -> tasks.Task
 	0,  // 3: tasks.GetTasksResponse.tasks:type_name -> tasks.Task
 	0,  // 4: tasks.UpdateTaskResponse.task:type_name -> tasks.Task
@@ -1128,16 +1294,18 @@ var file_Common_Proto_tasks_proto_depIdxs = []int32{
 	9,  // 12: tasks.TasksService.UpdateTask:input_type -> tasks.UpdateTaskRequest
 	11, // 13: tasks.TasksService.ChangeStatusTask:input_type -> tasks.ChangeStatusTaskRequest
 	13, // 14: tasks.TasksService.DeleteTask:input_type -> tasks.DeleteTaskRequest
-	4,  // 15: tasks.TasksService.CreateTask:output_type -> tasks.CreateTaskResponse
-	6,  // 16: tasks.TasksService.GetTask:output_type -> tasks.GetTaskResponse
-	8,  // 17: tasks.TasksService.GetTasks:output_type -> tasks.GetTasksResponse
-	16, // 18: tasks.TasksService.GetTasksForStudent:output_type -> tasks.GetTasksForStudentResponse
-	18, // 19: tasks.TasksService.GetStudentStatuses:output_type -> tasks.GetStudentStatusesResponse
-	10, // 20: tasks.TasksService.UpdateTask:output_type -> tasks.UpdateTaskResponse
-	12, // 21: tasks.TasksService.ChangeStatusTask:output_type -> tasks.ChangeStatusTaskResponse
-	14, // 22: tasks.TasksService.DeleteTask:output_type -> tasks.DeleteTaskResponse
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
+	19, // 15: tasks.TasksService.CloneFromCourse:input_type -> tasks.CloneFromCourseRequest
+	4,  // 16: tasks.TasksService.CreateTask:output_type -> tasks.CreateTaskResponse
+	6,  // 17: tasks.TasksService.GetTask:output_type -> tasks.GetTaskResponse
+	8,  // 18: tasks.TasksService.GetTasks:output_type -> tasks.GetTasksResponse
+	16, // 19: tasks.TasksService.GetTasksForStudent:output_type -> tasks.GetTasksForStudentResponse
+	18, // 20: tasks.TasksService.GetStudentStatuses:output_type -> tasks.GetStudentStatusesResponse
+	10, // 21: tasks.TasksService.UpdateTask:output_type -> tasks.UpdateTaskResponse
+	12, // 22: tasks.TasksService.ChangeStatusTask:output_type -> tasks.ChangeStatusTaskResponse
+	14, // 23: tasks.TasksService.DeleteTask:output_type -> tasks.DeleteTaskResponse
+	20, // 24: tasks.TasksService.CloneFromCourse:output_type -> tasks.CloneFromCourseResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -1148,6 +1316,9 @@ func file_Common_Proto_tasks_proto_init() {
 	if File_Common_Proto_tasks_proto != nil {
 		return
 	}
+	file_Common_Proto_tasks_proto_msgTypes[0].OneofWrappers = []any{}
+	file_Common_Proto_tasks_proto_msgTypes[1].OneofWrappers = []any{}
+	file_Common_Proto_tasks_proto_msgTypes[3].OneofWrappers = []any{}
 	file_Common_Proto_tasks_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1155,7 +1326,7 @@ func file_Common_Proto_tasks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_Common_Proto_tasks_proto_rawDesc), len(file_Common_Proto_tasks_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
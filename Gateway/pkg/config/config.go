@@ -37,20 +37,53 @@ type Config struct {
 	} `mapstructure:"tasks"`
 
 	Common struct {
-		Timeout       time.Duration `mapstructure:"timeout"`
-		MaxRetries    int           `mapstructure:"max_retries"`
-		AuthJWTSecret string        `mapstructure:"auth_jwt_secret"`
-		RedisURL      string        `mapstructure:"redis_url"`
+		Timeout             time.Duration `mapstructure:"timeout"`
+		MaxRetries          int           `mapstructure:"max_retries"`
+		JWKSCacheTTL        time.Duration `mapstructure:"jwks_cache_ttl"`
+		AccessTokenCacheTTL time.Duration `mapstructure:"access_token_cache_ttl"`
+		PermissionsCacheTTL time.Duration `mapstructure:"permissions_cache_ttl"`
+		RedisURL            string        `mapstructure:"redis_url"`
+		// Адреса и подсети прокси, которым доверяем заголовки X-Forwarded-For и X-Real-IP
+		TrustedProxies []string `mapstructure:"trusted_proxies"`
 	} `mapstructure:"common"`
+
+	// Файлы уроков
+	Attachments struct {
+		MaxSize      int64         `mapstructure:"max_size"`      // Максимальный размер файла в байтах
+		AllowedTypes []string      `mapstructure:"allowed_types"` // Разрешённые MIME типы файлов
+		URLTTL       time.Duration `mapstructure:"url_ttl"`       // Время жизни подписанной ссылки на скачивание
+		URLSecret    string        `mapstructure:"url_secret"`    // Ключ подписи ссылок на скачивание
+	} `mapstructure:"attachments"`
 }
 
 const (
-	DefaultGatewayPort int           = 8080
-	DefaultGatewayAddr string        = "0.0.0.0"
-	DefaultTimeout     time.Duration = 10 * time.Second
-	MaxRetries         int           = 5
+	DefaultGatewayPort         int           = 8080
+	DefaultGatewayAddr         string        = "0.0.0.0"
+	DefaultTimeout             time.Duration = 10 * time.Second
+	DefaultJWKSCacheTTL        time.Duration = 5 * time.Minute
+	DefaultAccessTokenCacheTTL time.Duration = 30 * time.Second
+	DefaultPermissionsCacheTTL time.Duration = 5 * time.Minute
+	MaxRetries                 int           = 5
+	DefaultAttachmentMaxSize   int64         = 50 << 20
+	DefaultAttachmentURLTTL    time.Duration = 15 * time.Minute
 )
 
+// Документы, презентации, таблицы, картинки и архивы
+var DefaultAttachmentAllowedTypes = []string{
+	"application/pdf",
+	"application/zip",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"text/plain",
+	"text/csv",
+	"text/markdown",
+}
+
 var (
 	DefaultConfig Config = func() Config {
 		var Config Config
@@ -69,6 +102,12 @@ func MustReadConfig() *Config {
 	v.SetDefault("gateway.address", DefaultGatewayAddr)
 	v.SetDefault("common.timeout", DefaultTimeout)
 	v.SetDefault("common.max_retries", MaxRetries)
+	v.SetDefault("common.jwks_cache_ttl", DefaultJWKSCacheTTL)
+	v.SetDefault("common.access_token_cache_ttl", DefaultAccessTokenCacheTTL)
+	v.SetDefault("common.permissions_cache_ttl", DefaultPermissionsCacheTTL)
+	v.SetDefault("attachments.max_size", DefaultAttachmentMaxSize)
+	v.SetDefault("attachments.allowed_types", DefaultAttachmentAllowedTypes)
+	v.SetDefault("attachments.url_ttl", DefaultAttachmentURLTTL)
 
 	var AppConfig Config
 
@@ -87,12 +126,18 @@ func MustReadConfig() *Config {
 	v.AutomaticEnv()
 
 	envToMapstructure := map[string]string{
-		"GATEWAY_PORT":    "gateway.port",
-		"GATEWAY_ADDRESS": "gateway.address",
-		"TIMEOUT":         "common.timeout",
-		"MAX_RETRIES":     "common.max_retries",
-		"AUTH_JWT_SECRET": "common.auth_jwt_secret",
-		"REDIS_URL":       "common.redis_url",
+		"GATEWAY_PORT":           "gateway.port",
+		"GATEWAY_ADDRESS":        "gateway.address",
+		"TIMEOUT":                "common.timeout",
+		"MAX_RETRIES":            "common.max_retries",
+		"JWKS_CACHE_TTL":         "common.jwks_cache_ttl",
+		"ACCESS_TOKEN_CACHE_TTL": "common.access_token_cache_ttl",
+		"PERMISSIONS_CACHE_TTL":  "common.permissions_cache_ttl",
+		"REDIS_URL":              "common.redis_url",
+		"TRUSTED_PROXIES":        "common.trusted_proxies",
+		"ATTACHMENTS_MAX_SIZE":   "attachments.max_size",
+		"ATTACHMENTS_URL_TTL":    "attachments.url_ttl",
+		"ATTACHMENTS_URL_SECRET": "attachments.url_secret",
 	}
 
 	serviceList := []string{
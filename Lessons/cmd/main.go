@@ -2,10 +2,13 @@ package main
 
 import (
 	"Classroom/Lessons/internal/config"
+	"Classroom/Lessons/internal/consumer"
 	"Classroom/Lessons/internal/controller"
 	"Classroom/Lessons/internal/producer"
 	"Classroom/Lessons/internal/repo"
 	"Classroom/Lessons/internal/service"
+	"Classroom/Lessons/internal/storage"
+	"Classroom/Lessons/pkg/events"
 	"Classroom/Lessons/pkg/postgres"
 	"context"
 	"fmt"
@@ -15,6 +18,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -35,8 +39,10 @@ func main() {
 	defer producer.Close()
 
 	lessonRepo := repo.NewLessonRepo(postgres)
+	files := mustNewStorage(conf.Storage)
 	lessonService := service.NewLessonService(logger, lessonRepo, producer)
-	lessonController := controller.NewLessonController(logger, lessonService)
+	attachmentService := service.NewAttachmentService(logger, lessonRepo, files, conf.Storage.MaxAttachmentSize)
+	lessonController := controller.NewLessonController(logger, lessonService, attachmentService)
 
 	server := grpc.NewServer()
 	lessonController.Init(server)
@@ -45,8 +51,19 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Из событий Courses узнаём об архивации и удалении курсов
+	consumer := consumer.MustNew([]string{conf.KafkaBroker}, logger, lessonService, attachmentService)
+	defer consumer.Close()
+
+	consumer.ConsumeTopic(ctx, events.CourseArchivedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseDeletedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseRestoredTopic)
+	consumer.ConsumeTopic(ctx, events.CoursePurgedTopic)
+
 	logger.Info("starting grpc server", "port", conf.Port)
 	go startServer(server, conf.Port)
+	go startPublisher(ctx, logger, lessonService, conf.PublishInterval)
+	go renderPending(ctx, logger, lessonService)
 
 	<-ctx.Done()
 
@@ -65,6 +82,68 @@ func startServer(server *grpc.Server, port int) {
 	}
 }
 
+// Публикует запланированные уроки, пока не отменён контекст
+func startPublisher(ctx context.Context, logger *slog.Logger, lessonService lessonPublisher, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := lessonService.PublishDue(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("failed to publish scheduled lessons", "err", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type lessonPublisher interface {
+	PublishDue(ctx context.Context) error
+}
+
+// Уроки, созданные до отрисовки Markdown, отрисовываются один раз при запуске
+func renderPending(ctx context.Context, logger *slog.Logger, lessonService lessonRenderer) {
+	if err := lessonService.RenderPending(ctx); err != nil && ctx.Err() == nil {
+		logger.Error("failed to render lessons content", "err", err)
+	}
+}
+
+type lessonRenderer interface {
+	RenderPending(ctx context.Context) error
+}
+
+// Файлы уроков хранятся локально или в S3 совместимом хранилище, например MinIO
+func mustNewStorage(conf config.StorageConfig) service.FileStorage {
+	switch conf.Driver {
+	case "local":
+		files, err := storage.NewLocalStorage(conf.LocalDir)
+		if err != nil {
+			log.Fatalf("failed to init local storage: %v", err)
+		}
+		return files
+	case "s3":
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		files, err := storage.NewS3Storage(ctx, storage.S3Config{
+			Endpoint:  conf.Endpoint,
+			Bucket:    conf.Bucket,
+			AccessKey: conf.AccessKey,
+			SecretKey: conf.SecretKey,
+			Region:    conf.Region,
+			UseSSL:    conf.UseSSL,
+		})
+		if err != nil {
+			log.Fatalf("failed to init s3 storage: %v", err)
+		}
+		return files
+	default:
+		log.Fatalf("unknown storage driver %q", conf.Driver)
+		return nil
+	}
+}
+
 // Загружаем переменные окружения перед стартом
 func init() {
 	godotenv.Load()
@@ -4,27 +4,68 @@ import (
 	"flag"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Port        int    `mapstructure:"port"`
-	PostgresURL string `mapstructure:"postgres_url"`
-	KafkaBroker string `mapstructure:"kafka_broker"`
+	Port        int           `mapstructure:"port"`
+	PostgresURL string        `mapstructure:"postgres_url"`
+	KafkaBroker string        `mapstructure:"kafka_broker"`
+	Storage     StorageConfig `mapstructure:"storage"`
+	// Как часто планировщик публикует запланированные уроки
+	PublishInterval time.Duration `mapstructure:"publish_interval"`
 }
 
+// StorageConfig описывает хранилище файлов уроков
+type StorageConfig struct {
+	Driver            string `mapstructure:"driver"`              // local или s3
+	LocalDir          string `mapstructure:"local_dir"`           // Каталог для драйвера local
+	Endpoint          string `mapstructure:"endpoint"`            // Адрес S3 совместимого хранилища
+	Bucket            string `mapstructure:"bucket"`              // Бакет для файлов
+	AccessKey         string `mapstructure:"access_key"`          // Ключ доступа S3
+	SecretKey         string `mapstructure:"secret_key"`          // Секретный ключ S3
+	Region            string `mapstructure:"region"`              // Регион S3
+	UseSSL            bool   `mapstructure:"use_ssl"`             // Подключаться к S3 по https
+	MaxAttachmentSize int64  `mapstructure:"max_attachment_size"` // Максимальный размер файла в байтах
+}
+
+const (
+	DefaultStorageDriver     = "local"
+	DefaultStorageLocalDir   = "./data/attachments"
+	DefaultStorageBucket     = "lessons"
+	DefaultMaxAttachmentSize = 50 << 20
+	DefaultPublishInterval   = 30 * time.Second
+)
+
 func MustNew() *Config {
 	configPath := flag.String("config", "./config/config.yaml", "path to config file")
 	flag.Parse()
 
 	v := viper.New()
 
+	v.SetDefault("storage.driver", DefaultStorageDriver)
+	v.SetDefault("storage.local_dir", DefaultStorageLocalDir)
+	v.SetDefault("storage.bucket", DefaultStorageBucket)
+	v.SetDefault("storage.max_attachment_size", DefaultMaxAttachmentSize)
+	v.SetDefault("publish_interval", DefaultPublishInterval)
+
 	v.AutomaticEnv()
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.BindEnv("postgres_url")
 	v.BindEnv("kafka_broker")
 	v.BindEnv("port")
+	v.BindEnv("publish_interval")
+	v.BindEnv("storage.driver")
+	v.BindEnv("storage.local_dir")
+	v.BindEnv("storage.endpoint")
+	v.BindEnv("storage.bucket")
+	v.BindEnv("storage.access_key")
+	v.BindEnv("storage.secret_key")
+	v.BindEnv("storage.region")
+	v.BindEnv("storage.use_ssl")
+	v.BindEnv("storage.max_attachment_size")
 
 	v.SetConfigFile(*configPath)
 
@@ -0,0 +1,140 @@
+package consumer
+
+import (
+	"Classroom/Lessons/pkg/events"
+	"context"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Принимает изменения состояния курсов, уроки архивных и удалённых курсов доступны только для чтения
+type CourseStateService interface {
+	CourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error
+	CourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error
+	CourseRestored(ctx context.Context, courseID string) error
+}
+
+// Удаляет файлы уроков окончательно удалённого курса
+type CourseFileService interface {
+	DeleteCourseFiles(ctx context.Context, courseID string) error
+}
+
+type EventHandler func(ctx context.Context, msg *sarama.ConsumerMessage)
+
+type consumer struct {
+	master   sarama.Consumer
+	logger   *slog.Logger
+	svc      CourseStateService
+	files    CourseFileService
+	handlers map[string]EventHandler
+}
+
+func MustNew(brokers []string, logger *slog.Logger, svc CourseStateService, files CourseFileService) *consumer {
+	config := sarama.NewConfig()
+	config.Consumer.Return.Errors = true
+
+	master, err := sarama.NewConsumer(brokers, config)
+	if err != nil {
+		log.Fatalf("failed to create consumer: %v", err)
+	}
+
+	consumer := &consumer{master: master, logger: logger, svc: svc, files: files}
+
+	consumer.handlers = map[string]EventHandler{
+		events.CourseArchivedTopic: consumer.handleCourseArchived,
+		events.CourseDeletedTopic:  consumer.handleCourseDeleted,
+		events.CourseRestoredTopic: consumer.handleCourseRestored,
+		events.CoursePurgedTopic:   consumer.handleCoursePurged,
+	}
+
+	return consumer
+}
+
+func (c *consumer) Close() error {
+	return c.master.Close()
+}
+
+func (c *consumer) ConsumeTopic(ctx context.Context, topic string) {
+	partitions, err := c.master.Partitions(topic)
+	if err != nil {
+		log.Fatalf("failed to get partitions: %v", err)
+	}
+
+	for _, partition := range partitions {
+		pc, err := c.master.ConsumePartition(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			log.Fatalf("failed to subscribe partition %d: %v", partition, err)
+		}
+
+		go func(pc sarama.PartitionConsumer) {
+			defer pc.Close()
+			for {
+				select {
+				case msg := <-pc.Messages():
+					handler, ok := c.handlers[msg.Topic]
+					if !ok {
+						c.logger.Error("unknown topic", "topic", msg.Topic)
+						continue
+					}
+					handler(ctx, msg)
+				case err := <-pc.Errors():
+					c.logger.Error("failed to consume message", "err", err)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(pc)
+	}
+}
+
+func (c *consumer) handleCourseArchived(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.CourseArchived
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		c.logger.Error("invalid course archived payload")
+		return
+	}
+
+	if err := c.svc.CourseArchived(ctx, payload.CourseID, payload.ArchivedAt); err != nil {
+		c.logger.Error("failed to handle course archived", "course_id", payload.CourseID, "err", err)
+	}
+}
+
+func (c *consumer) handleCourseDeleted(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.CourseDeleted
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		c.logger.Error("invalid course deleted payload")
+		return
+	}
+
+	if err := c.svc.CourseDeleted(ctx, payload.CourseID, payload.DeletedAt); err != nil {
+		c.logger.Error("failed to handle course deleted", "course_id", payload.CourseID, "err", err)
+	}
+}
+
+func (c *consumer) handleCourseRestored(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.CourseRestored
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		c.logger.Error("invalid course restored payload")
+		return
+	}
+
+	if err := c.svc.CourseRestored(ctx, payload.CourseID); err != nil {
+		c.logger.Error("failed to handle course restored", "course_id", payload.CourseID, "err", err)
+	}
+}
+
+func (c *consumer) handleCoursePurged(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.CoursePurged
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		c.logger.Error("invalid course purged payload")
+		return
+	}
+
+	if err := c.files.DeleteCourseFiles(ctx, payload.CourseID); err != nil {
+		c.logger.Error("failed to handle course purged", "course_id", payload.CourseID, "err", err)
+	}
+}
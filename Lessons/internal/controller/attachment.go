@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	pb "Classroom/Lessons/pkg/api/lessons"
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Размер части файла в потоке скачивания
+const downloadChunkSize = 64 << 10
+
+type AttachmentService interface {
+	Upload(ctx context.Context, dto dto.UploadAttachmentDTO, r io.Reader) (domain.Attachment, error)
+	Get(ctx context.Context, id string) (domain.Attachment, error)
+	List(ctx context.Context, lessonID string) ([]domain.Attachment, error)
+	Open(ctx context.Context, id string) (domain.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, id string) (domain.Attachment, error)
+	DeleteLessonFiles(ctx context.Context, courseID, lessonID string) error
+}
+
+func (c *lessonController) UploadAttachment(stream pb.LessonsService_UploadAttachmentServer) error {
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "attachment info is required")
+	}
+	if err != nil {
+		return err
+	}
+	info := req.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain attachment info")
+	}
+
+	dto := dto.UploadAttachmentDTO{
+		LessonID:    info.LessonId,
+		FileName:    info.FileName,
+		ContentType: info.ContentType,
+		UserID:      info.UserId,
+	}
+	if err := c.validate.Struct(dto); err != nil {
+		c.logger.Debug("invalid request", "err", err)
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	attachment, err := c.attachments.Upload(stream.Context(), dto, &chunkReader{stream: stream})
+	if errors.Is(err, domain.ErrNotFound) {
+		return status.Error(codes.NotFound, "lesson not found")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if errors.Is(err, domain.ErrTooLarge) {
+		return status.Error(codes.ResourceExhausted, "file is too large")
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, "attachment info must be sent only once")
+	}
+	if err != nil {
+		c.logger.Error("failed to upload attachment", "err", err, "lesson_id", dto.LessonID)
+		return status.Error(codes.Internal, "failed to upload attachment")
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{Attachment: attachmentToPb(attachment)})
+}
+
+func (c *lessonController) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.GetAttachmentResponse, error) {
+	if err := c.validate.Var(req.AttachmentId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attachment id")
+	}
+
+	attachment, err := c.attachments.Get(ctx, req.AttachmentId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to get attachment", "err", err, "id", req.AttachmentId)
+		return nil, status.Error(codes.Internal, "failed to get attachment")
+	}
+	return &pb.GetAttachmentResponse{Attachment: attachmentToPb(attachment)}, nil
+}
+
+func (c *lessonController) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	if err := c.validate.Var(req.LessonId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lesson id")
+	}
+
+	attachments, err := c.attachments.List(ctx, req.LessonId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "lesson not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to list attachments", "err", err, "lesson_id", req.LessonId)
+		return nil, status.Error(codes.Internal, "failed to list attachments")
+	}
+
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		pbAttachments[i] = attachmentToPb(attachment)
+	}
+	return &pb.ListAttachmentsResponse{Attachments: pbAttachments}, nil
+}
+
+func (c *lessonController) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	if err := c.validate.Var(req.AttachmentId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attachment id")
+	}
+
+	attachment, err := c.attachments.Delete(ctx, req.AttachmentId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "attachment not found")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if err != nil {
+		c.logger.Error("failed to delete attachment", "err", err, "id", req.AttachmentId)
+		return nil, status.Error(codes.Internal, "failed to delete attachment")
+	}
+	return &pb.DeleteAttachmentResponse{Attachment: attachmentToPb(attachment)}, nil
+}
+
+func (c *lessonController) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.LessonsService_DownloadAttachmentServer) error {
+	if err := c.validate.Var(req.AttachmentId, "required,uuid"); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid attachment id")
+	}
+
+	attachment, body, err := c.attachments.Open(stream.Context(), req.AttachmentId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return status.Error(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to open attachment", "err", err, "id", req.AttachmentId)
+		return status.Error(codes.Internal, "failed to download attachment")
+	}
+	defer body.Close()
+
+	err = stream.Send(&pb.DownloadAttachmentResponse{
+		Payload: &pb.DownloadAttachmentResponse_Attachment{Attachment: attachmentToPb(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&pb.DownloadAttachmentResponse{
+				Payload: &pb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			c.logger.Error("failed to read attachment", "err", err, "id", req.AttachmentId)
+			return status.Error(codes.Internal, "failed to download attachment")
+		}
+	}
+}
+
+// chunkReader отдаёт содержимое файла из потока загрузки как io.Reader,
+// конец потока клиента становится io.EOF
+type chunkReader struct {
+	stream pb.LessonsService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetInfo() != nil {
+			return 0, domain.ErrInvalidInput
+		}
+		r.buf = req.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func attachmentToPb(a domain.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		AttachmentId: a.ID,
+		LessonId:     a.LessonID,
+		CourseId:     a.CourseID,
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		UploadedBy:   a.UploadedBy,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+	}
+}
@@ -0,0 +1,147 @@
+package controller_test
+
+import (
+	"Classroom/Lessons/internal/controller"
+	mocks "Classroom/Lessons/internal/controller/mocks"
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	pb "Classroom/Lessons/pkg/api/lessons"
+	"context"
+	"io"
+	"log/slog"
+
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadStream отдаёт заранее заданные сообщения клиента и запоминает ответ
+type uploadStream struct {
+	grpc.ServerStream
+	reqs []*pb.UploadAttachmentRequest
+	resp *pb.UploadAttachmentResponse
+}
+
+func (s *uploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadStream) Recv() (*pb.UploadAttachmentRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *uploadStream) SendAndClose(resp *pb.UploadAttachmentResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func infoMsg(info *pb.AttachmentInfo) *pb.UploadAttachmentRequest {
+	return &pb.UploadAttachmentRequest{Payload: &pb.UploadAttachmentRequest_Info{Info: info}}
+}
+
+func chunkMsg(chunk string) *pb.UploadAttachmentRequest {
+	return &pb.UploadAttachmentRequest{Payload: &pb.UploadAttachmentRequest_Chunk{Chunk: []byte(chunk)}}
+}
+
+func TestLessonController_UploadAttachment(t *testing.T) {
+	type MockBehavior func(svc *mocks.MockAttachmentService, info *pb.AttachmentInfo)
+
+	info := &pb.AttachmentInfo{
+		LessonId:    uuid.NewString(),
+		FileName:    "slides.pdf",
+		ContentType: "application/pdf",
+		UserId:      uuid.NewString(),
+	}
+	// Сервис читает содержимое потока целиком и проверяет, что части склеились
+	upload := func(content string, attachment domain.Attachment, err error) func(context.Context, dto.UploadAttachmentDTO, io.Reader) (domain.Attachment, error) {
+		return func(ctx context.Context, dto dto.UploadAttachmentDTO, r io.Reader) (domain.Attachment, error) {
+			body, readErr := io.ReadAll(r)
+			if readErr != nil {
+				return domain.Attachment{}, readErr
+			}
+			if string(body) != content {
+				return domain.Attachment{}, assert.AnError
+			}
+			return attachment, err
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		reqs         []*pb.UploadAttachmentRequest
+		wantID       string
+		wantErr      error
+	}{
+		{
+			name: "success",
+			reqs: []*pb.UploadAttachmentRequest{infoMsg(info), chunkMsg("hello "), chunkMsg("world")},
+			mockBehavior: func(svc *mocks.MockAttachmentService, info *pb.AttachmentInfo) {
+				svc.EXPECT().Upload(mock.Anything, dto.UploadAttachmentDTO{
+					LessonID:    info.LessonId,
+					FileName:    info.FileName,
+					ContentType: info.ContentType,
+					UserID:      info.UserId,
+				}, mock.Anything).RunAndReturn(upload("hello world", domain.Attachment{ID: "attachment-id"}, nil))
+			},
+			wantID: "attachment-id",
+		},
+		{
+			name:         "first message is not info",
+			reqs:         []*pb.UploadAttachmentRequest{chunkMsg("hello")},
+			mockBehavior: func(svc *mocks.MockAttachmentService, info *pb.AttachmentInfo) {},
+			wantErr:      status.Error(codes.InvalidArgument, "first message must contain attachment info"),
+		},
+		{
+			name:         "empty stream",
+			mockBehavior: func(svc *mocks.MockAttachmentService, info *pb.AttachmentInfo) {},
+			wantErr:      status.Error(codes.InvalidArgument, "attachment info is required"),
+		},
+		{
+			name: "file is too large",
+			reqs: []*pb.UploadAttachmentRequest{infoMsg(info), chunkMsg("hello")},
+			mockBehavior: func(svc *mocks.MockAttachmentService, info *pb.AttachmentInfo) {
+				svc.EXPECT().Upload(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(upload("hello", domain.Attachment{}, domain.ErrTooLarge))
+			},
+			wantErr: status.Error(codes.ResourceExhausted, "file is too large"),
+		},
+		{
+			name: "course is read-only",
+			reqs: []*pb.UploadAttachmentRequest{infoMsg(info), chunkMsg("hello")},
+			mockBehavior: func(svc *mocks.MockAttachmentService, info *pb.AttachmentInfo) {
+				svc.EXPECT().Upload(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(upload("hello", domain.Attachment{}, domain.ErrReadOnly))
+			},
+			wantErr: status.Error(codes.FailedPrecondition, "course is read-only"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewMockAttachmentService(t)
+			tc.mockBehavior(svc, info)
+			c := controller.NewLessonController(slog.Default(), mocks.NewMockLessonService(t), svc)
+			stream := &uploadStream{reqs: tc.reqs}
+			err := c.UploadAttachment(stream)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, stream.resp)
+			assert.Equal(t, tc.wantID, stream.resp.Attachment.AttachmentId)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"Classroom/Lessons/internal/domain"
 	"Classroom/Lessons/internal/dto"
@@ -19,24 +20,33 @@ import (
 type LessonService interface {
 	Create(ctx context.Context, dto dto.CreateLessonDTO) (domain.Lesson, error)
 	GetByID(ctx context.Context, id string) (domain.Lesson, error)
-	ListByCourseID(ctx context.Context, courseID string) ([]domain.Lesson, error)
+	ListByCourseID(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, int64, error)
+	Reorder(ctx context.Context, dto dto.ReorderLessonsDTO) ([]domain.Lesson, int64, error)
+	Preview(content string) string
 	Update(ctx context.Context, dto dto.UpdateLessonDTO) (domain.Lesson, error)
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) (domain.Lesson, error)
+	CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string, shiftDays int32) (int, error)
+
+	ListRevisions(ctx context.Context, lessonID string) ([]domain.LessonRevision, error)
+	GetRevision(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error)
+	RestoreRevision(ctx context.Context, dto dto.RestoreRevisionDTO) (domain.Lesson, error)
 }
 
 type lessonController struct {
-	svc      LessonService
-	logger   *slog.Logger // Для логирования ошибок и дебага запросов
-	validate *validator.Validate
+	svc         LessonService
+	attachments AttachmentService
+	logger      *slog.Logger // Для логирования ошибок и дебага запросов
+	validate    *validator.Validate
 	pb.UnimplementedLessonsServiceServer
 }
 
-func NewLessonController(logger *slog.Logger, svc LessonService) *lessonController {
+func NewLessonController(logger *slog.Logger, svc LessonService, attachments AttachmentService) *lessonController {
 	validate := validator.New()
 	return &lessonController{
-		svc:      svc,
-		logger:   logger,
-		validate: validate,
+		svc:         svc,
+		attachments: attachments,
+		logger:      logger,
+		validate:    validate,
 	}
 }
 
@@ -46,19 +56,37 @@ func (c *lessonController) Init(srv *grpc.Server) {
 
 func (c *lessonController) CreateLesson(ctx context.Context, req *pb.CreateLessonRequest) (*pb.CreateLessonResponse, error) {
 	dto := dto.CreateLessonDTO{
-		Title:    req.Title,
-		Content:  req.Content,
-		CourseID: req.CourseId,
+		Title:     req.Title,
+		Content:   req.Content,
+		CourseID:  req.CourseId,
+		ModuleID:  req.ModuleId,
+		Status:    req.GetStatus(),
+		PublishAt: timePtr(req.PublishAt),
+		UserID:    req.UserId,
 	}
 	if err := c.validate.Struct(dto); err != nil {
 		c.logger.Debug("invalid request", "err", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 	}
+	if dto.ModuleID != nil {
+		if err := c.validate.Var(*dto.ModuleID, "uuid"); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid module id")
+		}
+	}
+	if err := checkPublishAt(dto.Status, dto.PublishAt); err != nil {
+		return nil, err
+	}
 
 	lesson, err := c.svc.Create(ctx, dto)
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "course not found")
 	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return nil, status.Error(codes.InvalidArgument, "module not found in the course")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
 	if err != nil {
 		c.logger.Error("failed to create lesson", "err", err)
 		return nil, status.Error(codes.Internal, "failed to create lesson")
@@ -82,13 +110,7 @@ func (c *lessonController) GetLesson(ctx context.Context, req *pb.GetLessonReque
 	}
 
 	return &pb.GetLessonResponse{
-		Lesson: &pb.Lesson{
-			LessonId:  lesson.ID,
-			Title:     lesson.Title,
-			Content:   lesson.Content,
-			CourseId:  lesson.CourseID,
-			CreatedAt: timestamppb.New(lesson.CreatedAt),
-		},
+		Lesson: lessonToPb(lesson),
 	}, nil
 }
 
@@ -96,53 +118,125 @@ func (c *lessonController) GetLessons(ctx context.Context, req *pb.GetLessonsReq
 	if err := c.validate.Var(req.CourseId, "required,uuid"); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid task id")
 	}
-	lessons, err := c.svc.ListByCourseID(ctx, req.CourseId)
+	lessons, version, err := c.svc.ListByCourseID(ctx, req.CourseId, req.IncludeUnpublished)
 	if err != nil {
 		c.logger.Error("failed to get tasks", "err", err, "course_id", req.CourseId)
 		return nil, status.Error(codes.Internal, "failed to get tasks")
 	}
 
+	return &pb.GetLessonsResponse{Lessons: lessonsToPb(lessons), Version: version}, nil
+}
+
+func (c *lessonController) ReorderLessons(ctx context.Context, req *pb.ReorderLessonsRequest) (*pb.ReorderLessonsResponse, error) {
+	dto := dto.ReorderLessonsDTO{
+		CourseID:  req.CourseId,
+		LessonIDs: req.LessonIds,
+		Version:   req.Version,
+	}
+	if err := c.validate.Struct(dto); err != nil {
+		c.logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	lessons, version, err := c.svc.Reorder(ctx, dto)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return nil, status.Error(codes.InvalidArgument, "lesson ids must contain every lesson of the course exactly once")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.Aborted, "lessons order was changed, reload lessons and retry")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if err != nil {
+		c.logger.Error("failed to reorder lessons", "err", err, "course_id", req.CourseId)
+		return nil, status.Error(codes.Internal, "failed to reorder lessons")
+	}
+	return &pb.ReorderLessonsResponse{Lessons: lessonsToPb(lessons), Version: version}, nil
+}
+
+// Содержание не сохраняется, поэтому права на курс не нужны, их проверяет Gateway
+func (c *lessonController) PreviewLesson(ctx context.Context, req *pb.PreviewLessonRequest) (*pb.PreviewLessonResponse, error) {
+	return &pb.PreviewLessonResponse{ContentHtml: c.svc.Preview(req.Content)}, nil
+}
+
+func lessonToPb(lesson domain.Lesson) *pb.Lesson {
+	pbLesson := &pb.Lesson{
+		LessonId:    lesson.ID,
+		Title:       lesson.Title,
+		Content:     lesson.Content,
+		CourseId:    lesson.CourseID,
+		CreatedAt:   timestamppb.New(lesson.CreatedAt),
+		ModuleId:    lesson.ModuleID,
+		Position:    lesson.Position,
+		Status:      string(lesson.Status),
+		ContentHtml: lesson.ContentHTML,
+	}
+	if lesson.PublishAt != nil {
+		pbLesson.PublishAt = timestamppb.New(*lesson.PublishAt)
+	}
+	return pbLesson
+}
+
+func lessonsToPb(lessons []domain.Lesson) []*pb.Lesson {
 	pbLessons := make([]*pb.Lesson, len(lessons))
 	for i, lesson := range lessons {
-		pbLessons[i] = &pb.Lesson{
-			LessonId:  lesson.ID,
-			Title:     lesson.Title,
-			Content:   lesson.Content,
-			CourseId:  lesson.CourseID,
-			CreatedAt: timestamppb.New(lesson.CreatedAt),
-		}
+		pbLessons[i] = lessonToPb(lesson)
 	}
-	return &pb.GetLessonsResponse{Lessons: pbLessons}, nil
+	return pbLessons
 }
 
 func (c *lessonController) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest) (*pb.UpdateLessonResponse, error) {
 	dto := dto.UpdateLessonDTO{
-		LessonID: req.LessonId,
-		Title:    req.Title,
-		Content:  req.Content,
+		LessonID:  req.LessonId,
+		Title:     req.Title,
+		Content:   req.Content,
+		ModuleID:  req.ModuleId,
+		Status:    req.Status,
+		PublishAt: timePtr(req.PublishAt),
+		UserID:    req.UserId,
 	}
 	if err := c.validate.Struct(dto); err != nil {
 		c.logger.Debug("invalid request", "err", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 	}
+	if dto.ModuleID != nil && *dto.ModuleID != "" {
+		if err := c.validate.Var(*dto.ModuleID, "uuid"); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid module id")
+		}
+	}
+	if dto.Status != nil {
+		if err := checkPublishAt(*dto.Status, dto.PublishAt); err != nil {
+			return nil, err
+		}
+	}
 
 	lesson, err := c.svc.Update(ctx, dto)
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "lesson not found")
 	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return nil, status.Error(codes.InvalidArgument, "module not found in the course")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if errors.Is(err, domain.ErrPublished) {
+		return nil, status.Error(codes.FailedPrecondition, "lesson is already published")
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return nil, status.Error(codes.Aborted, "lesson was published concurrently")
+	}
 	if err != nil {
 		c.logger.Error("failed to update lesson", "err", err, "id", req.LessonId)
 		return nil, status.Error(codes.Internal, "failed to update lesson")
 	}
 
 	return &pb.UpdateLessonResponse{
-		Lesson: &pb.Lesson{
-			LessonId:  lesson.ID,
-			Title:     lesson.Title,
-			Content:   lesson.Content,
-			CourseId:  lesson.CourseID,
-			CreatedAt: timestamppb.New(lesson.CreatedAt),
-		},
+		Lesson: lessonToPb(lesson),
 	}, nil
 }
 
@@ -151,13 +245,62 @@ func (c *lessonController) DeleteLesson(ctx context.Context, req *pb.DeleteLesso
 		return nil, status.Error(codes.InvalidArgument, "invalid lesson id")
 	}
 
-	err := c.svc.Delete(ctx, req.LessonId)
+	lesson, err := c.svc.Delete(ctx, req.LessonId)
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "lesson not found")
 	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
 	if err != nil {
 		c.logger.Error("failed to delete lesson", "err", err, "id", req.LessonId)
 		return nil, status.Error(codes.Internal, "failed to delete lesson")
 	}
+	// не возвращаем ошибку потому что урок уже удалён из бд, фикс будет через логи
+	if err := c.attachments.DeleteLessonFiles(ctx, lesson.CourseID, lesson.ID); err != nil {
+		c.logger.Error("failed to delete lesson files", "err", err, "id", req.LessonId)
+	}
 	return &pb.DeleteLessonResponse{Success: true}, nil
 }
+
+func (c *lessonController) CloneFromCourse(ctx context.Context, req *pb.CloneFromCourseRequest) (*pb.CloneFromCourseResponse, error) {
+	if err := c.validate.Var(req.SourceCourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid source course id")
+	}
+	if err := c.validate.Var(req.TargetCourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target course id")
+	}
+
+	copied, err := c.svc.CloneFromCourse(ctx, req.SourceCourseId, req.TargetCourseId, req.ShiftDays)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if err != nil {
+		c.logger.Error("failed to clone lessons", "err", err, "source_course_id", req.SourceCourseId, "target_course_id", req.TargetCourseId)
+		return nil, status.Error(codes.Internal, "failed to clone lessons")
+	}
+	return &pb.CloneFromCourseResponse{Copied: int32(copied)}, nil
+}
+
+// Время публикации задаётся только для запланированного урока
+func checkPublishAt(lessonStatus string, publishAt *time.Time) error {
+	scheduled := lessonStatus == string(domain.LessonScheduled)
+	if scheduled && publishAt == nil {
+		return status.Error(codes.InvalidArgument, "publish_at is required for scheduled lesson")
+	}
+	if !scheduled && publishAt != nil {
+		return status.Error(codes.InvalidArgument, "publish_at is allowed only for scheduled lesson")
+	}
+	return nil
+}
+
+func timePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -8,6 +8,7 @@ import (
 	pb "Classroom/Lessons/pkg/api/lessons"
 	"context"
 	"log/slog"
+	"time"
 
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestLessonController_CreateLesson(t *testing.T) {
@@ -36,6 +38,7 @@ func TestLessonController_CreateLesson(t *testing.T) {
 					Title:    req.Title,
 					CourseID: req.CourseId,
 					Content:  req.Content,
+					UserID:   req.UserId,
 				}).Return(domain.Lesson{
 					ID:       "lesson-id",
 					Title:    req.Title,
@@ -47,6 +50,7 @@ func TestLessonController_CreateLesson(t *testing.T) {
 				Title:    "title",
 				Content:  "content",
 				CourseId: uuid.NewString(),
+				UserId:   uuid.NewString(),
 			},
 			want: &pb.CreateLessonResponse{
 				LessonId: "lesson-id",
@@ -59,16 +63,29 @@ func TestLessonController_CreateLesson(t *testing.T) {
 				Title:    "title",
 				Content:  "content",
 				CourseId: "not uuid",
+				UserId:   uuid.NewString(),
 			},
 			wantErr: status.Error(codes.InvalidArgument, "invalid request: Key: 'CreateLessonDTO.CourseID' Error:Field validation for 'CourseID' failed on the 'uuid' tag"),
 		},
+		{
+			name:         "scheduled without publish time",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.CreateLessonRequest) {},
+			req: &pb.CreateLessonRequest{
+				Title:    "title",
+				Content:  "content",
+				CourseId: uuid.NewString(),
+				UserId:   uuid.NewString(),
+				Status:   strPtr("scheduled"),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "publish_at is required for scheduled lesson"),
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			svc := mocks.NewMockLessonService(t)
 			tc.mockBehavior(svc, tc.req)
-			c := controller.NewLessonController(slog.Default(), svc)
+			c := controller.NewLessonController(slog.Default(), svc, mocks.NewMockAttachmentService(t))
 			got, err := c.CreateLesson(context.Background(), tc.req)
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
@@ -97,6 +114,7 @@ func TestLessonController_UpdateLesson(t *testing.T) {
 					LessonID: req.LessonId,
 					Title:    req.Title,
 					Content:  req.Content,
+					UserID:   req.UserId,
 				}).Return(domain.Lesson{
 					ID:      req.LessonId,
 					Title:   *req.Title,
@@ -107,6 +125,7 @@ func TestLessonController_UpdateLesson(t *testing.T) {
 				LessonId: uuid.NewString(),
 				Title:    strPtr("title"),
 				Content:  strPtr("content"),
+				UserId:   uuid.NewString(),
 			},
 			want: &pb.UpdateLessonResponse{
 				Lesson: &pb.Lesson{
@@ -122,6 +141,7 @@ func TestLessonController_UpdateLesson(t *testing.T) {
 				LessonId: "not uuid",
 				Title:    strPtr("title"),
 				Content:  strPtr("content"),
+				UserId:   uuid.NewString(),
 			},
 			wantErr: status.Error(codes.InvalidArgument, "invalid request: Key: 'UpdateLessonDTO.LessonID' Error:Field validation for 'LessonID' failed on the 'uuid' tag"),
 		},
@@ -131,7 +151,7 @@ func TestLessonController_UpdateLesson(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			svc := mocks.NewMockLessonService(t)
 			tc.mockBehavior(svc, tc.req)
-			c := controller.NewLessonController(slog.Default(), svc)
+			c := controller.NewLessonController(slog.Default(), svc, mocks.NewMockAttachmentService(t))
 			got, err := c.UpdateLesson(context.Background(), tc.req)
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
@@ -145,7 +165,9 @@ func TestLessonController_UpdateLesson(t *testing.T) {
 }
 
 func TestLessonController_DeleteLesson(t *testing.T) {
-	type MockBehavior func(svc *mocks.MockLessonService, req *pb.DeleteLessonRequest)
+	type MockBehavior func(svc *mocks.MockLessonService, attachments *mocks.MockAttachmentService, req *pb.DeleteLessonRequest)
+
+	courseID := uuid.NewString()
 
 	testCases := []struct {
 		name         string
@@ -156,8 +178,9 @@ func TestLessonController_DeleteLesson(t *testing.T) {
 	}{
 		{
 			name: "success",
-			mockBehavior: func(svc *mocks.MockLessonService, req *pb.DeleteLessonRequest) {
-				svc.EXPECT().Delete(mock.Anything, req.LessonId).Return(nil)
+			mockBehavior: func(svc *mocks.MockLessonService, attachments *mocks.MockAttachmentService, req *pb.DeleteLessonRequest) {
+				svc.EXPECT().Delete(mock.Anything, req.LessonId).Return(domain.Lesson{ID: req.LessonId, CourseID: courseID}, nil)
+				attachments.EXPECT().DeleteLessonFiles(mock.Anything, courseID, req.LessonId).Return(nil)
 			},
 			req: &pb.DeleteLessonRequest{
 				LessonId: uuid.NewString(),
@@ -165,8 +188,9 @@ func TestLessonController_DeleteLesson(t *testing.T) {
 			want: &pb.DeleteLessonResponse{Success: true},
 		},
 		{
-			name:         "invalid request",
-			mockBehavior: func(svc *mocks.MockLessonService, req *pb.DeleteLessonRequest) {},
+			name: "invalid request",
+			mockBehavior: func(svc *mocks.MockLessonService, attachments *mocks.MockAttachmentService, req *pb.DeleteLessonRequest) {
+			},
 			req: &pb.DeleteLessonRequest{
 				LessonId: "not uuid",
 			},
@@ -177,8 +201,9 @@ func TestLessonController_DeleteLesson(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			svc := mocks.NewMockLessonService(t)
-			tc.mockBehavior(svc, tc.req)
-			c := controller.NewLessonController(slog.Default(), svc)
+			attachments := mocks.NewMockAttachmentService(t)
+			tc.mockBehavior(svc, attachments, tc.req)
+			c := controller.NewLessonController(slog.Default(), svc, attachments)
 			got, err := c.DeleteLesson(context.Background(), tc.req)
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
@@ -190,6 +215,83 @@ func TestLessonController_DeleteLesson(t *testing.T) {
 	}
 }
 
+func TestLessonController_ReorderLessons(t *testing.T) {
+	type MockBehavior func(svc *mocks.MockLessonService, req *pb.ReorderLessonsRequest)
+
+	courseID := uuid.NewString()
+	first, second := uuid.NewString(), uuid.NewString()
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.ReorderLessonsRequest
+		want         *pb.ReorderLessonsResponse
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.ReorderLessonsRequest) {
+				svc.EXPECT().Reorder(mock.Anything, dto.ReorderLessonsDTO{
+					CourseID:  req.CourseId,
+					LessonIDs: req.LessonIds,
+					Version:   req.Version,
+				}).Return([]domain.Lesson{
+					{ID: second, CourseID: courseID, Position: 0},
+					{ID: first, CourseID: courseID, Position: 1},
+				}, 3, nil)
+			},
+			req: &pb.ReorderLessonsRequest{
+				CourseId:  courseID,
+				LessonIds: []string{second, first},
+				Version:   2,
+			},
+			want: &pb.ReorderLessonsResponse{
+				Lessons: []*pb.Lesson{
+					{LessonId: second, CourseId: courseID, Position: 0, CreatedAt: timestamppb.New(time.Time{})},
+					{LessonId: first, CourseId: courseID, Position: 1, CreatedAt: timestamppb.New(time.Time{})},
+				},
+				Version: 3,
+			},
+		},
+		{
+			name:         "duplicate lesson ids",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.ReorderLessonsRequest) {},
+			req: &pb.ReorderLessonsRequest{
+				CourseId:  courseID,
+				LessonIds: []string{first, first},
+			},
+			wantErr: status.Error(codes.InvalidArgument, "invalid request: Key: 'ReorderLessonsDTO.LessonIDs' Error:Field validation for 'LessonIDs' failed on the 'unique' tag"),
+		},
+		{
+			name: "order changed concurrently",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.ReorderLessonsRequest) {
+				svc.EXPECT().Reorder(mock.Anything, mock.Anything).Return(nil, 0, domain.ErrConflict)
+			},
+			req: &pb.ReorderLessonsRequest{
+				CourseId:  courseID,
+				LessonIds: []string{second, first},
+				Version:   1,
+			},
+			wantErr: status.Error(codes.Aborted, "lessons order was changed, reload lessons and retry"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewMockLessonService(t)
+			tc.mockBehavior(svc, tc.req)
+			c := controller.NewLessonController(slog.Default(), svc, mocks.NewMockAttachmentService(t))
+			got, err := c.ReorderLessons(context.Background(), tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func strPtr(s string) *string {
 	return &s
 }
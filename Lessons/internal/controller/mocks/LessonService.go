@@ -39,6 +39,63 @@ func (_m *MockLessonService) EXPECT() *MockLessonService_Expecter {
 	return &MockLessonService_Expecter{mock: &_m.Mock}
 }
 
+// CloneFromCourse provides a mock function for the type MockLessonService
+func (_mock *MockLessonService) CloneFromCourse(ctx context.Context, sourceCourseID string, targetCourseID string, shiftDays int32) (int, error) {
+	ret := _mock.Called(ctx, sourceCourseID, targetCourseID, shiftDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneFromCourse")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int32) (int, error)); ok {
+		return returnFunc(ctx, sourceCourseID, targetCourseID, shiftDays)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int32) int); ok {
+		r0 = returnFunc(ctx, sourceCourseID, targetCourseID, shiftDays)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = returnFunc(ctx, sourceCourseID, targetCourseID, shiftDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonService_CloneFromCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneFromCourse'
+type MockLessonService_CloneFromCourse_Call struct {
+	*mock.Call
+}
+
+// CloneFromCourse is a helper method to define mock.On call
+//   - ctx
+//   - sourceCourseID
+//   - targetCourseID
+//   - shiftDays
+func (_e *MockLessonService_Expecter) CloneFromCourse(ctx interface{}, sourceCourseID interface{}, targetCourseID interface{}, shiftDays interface{}) *MockLessonService_CloneFromCourse_Call {
+	return &MockLessonService_CloneFromCourse_Call{Call: _e.mock.On("CloneFromCourse", ctx, sourceCourseID, targetCourseID, shiftDays)}
+}
+
+func (_c *MockLessonService_CloneFromCourse_Call) Run(run func(ctx context.Context, sourceCourseID string, targetCourseID string, shiftDays int32)) *MockLessonService_CloneFromCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockLessonService_CloneFromCourse_Call) Return(n int, err error) *MockLessonService_CloneFromCourse_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLessonService_CloneFromCourse_Call) RunAndReturn(run func(ctx context.Context, sourceCourseID string, targetCourseID string, shiftDays int32) (int, error)) *MockLessonService_CloneFromCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockLessonService
 func (_mock *MockLessonService) Create(ctx context.Context, dto1 dto.CreateLessonDTO) (domain.Lesson, error) {
 	ret := _mock.Called(ctx, dto1)
@@ -95,20 +152,29 @@ func (_c *MockLessonService_Create_Call) RunAndReturn(run func(ctx context.Conte
 }
 
 // Delete provides a mock function for the type MockLessonService
-func (_mock *MockLessonService) Delete(ctx context.Context, id string) error {
+func (_mock *MockLessonService) Delete(ctx context.Context, id string) (domain.Lesson, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+	var r0 domain.Lesson
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Lesson, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Lesson); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(domain.Lesson)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockLessonService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
@@ -130,12 +196,12 @@ func (_c *MockLessonService_Delete_Call) Run(run func(ctx context.Context, id st
 	return _c
 }
 
-func (_c *MockLessonService_Delete_Call) Return(err error) *MockLessonService_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockLessonService_Delete_Call) Return(lesson domain.Lesson, err error) *MockLessonService_Delete_Call {
+	_c.Call.Return(lesson, err)
 	return _c
 }
 
-func (_c *MockLessonService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockLessonService_Delete_Call {
+func (_c *MockLessonService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Lesson, error)) *MockLessonService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -195,32 +261,94 @@ func (_c *MockLessonService_GetByID_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// GetRevision provides a mock function for the type MockLessonService
+func (_mock *MockLessonService) GetRevision(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error) {
+	ret := _mock.Called(ctx, lessonID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 domain.LessonRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32) (domain.LessonRevision, error)); ok {
+		return returnFunc(ctx, lessonID, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32) domain.LessonRevision); ok {
+		r0 = returnFunc(ctx, lessonID, revision)
+	} else {
+		r0 = ret.Get(0).(domain.LessonRevision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = returnFunc(ctx, lessonID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonService_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockLessonService_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - ctx
+//   - lessonID
+//   - revision
+func (_e *MockLessonService_Expecter) GetRevision(ctx interface{}, lessonID interface{}, revision interface{}) *MockLessonService_GetRevision_Call {
+	return &MockLessonService_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx, lessonID, revision)}
+}
+
+func (_c *MockLessonService_GetRevision_Call) Run(run func(ctx context.Context, lessonID string, revision int32)) *MockLessonService_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockLessonService_GetRevision_Call) Return(lessonRevision domain.LessonRevision, err error) *MockLessonService_GetRevision_Call {
+	_c.Call.Return(lessonRevision, err)
+	return _c
+}
+
+func (_c *MockLessonService_GetRevision_Call) RunAndReturn(run func(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error)) *MockLessonService_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByCourseID provides a mock function for the type MockLessonService
-func (_mock *MockLessonService) ListByCourseID(ctx context.Context, courseID string) ([]domain.Lesson, error) {
-	ret := _mock.Called(ctx, courseID)
+func (_mock *MockLessonService) ListByCourseID(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, int64, error) {
+	ret := _mock.Called(ctx, courseID, includeUnpublished)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListByCourseID")
 	}
 
 	var r0 []domain.Lesson
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Lesson, error)); ok {
-		return returnFunc(ctx, courseID)
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) ([]domain.Lesson, int64, error)); ok {
+		return returnFunc(ctx, courseID, includeUnpublished)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Lesson); ok {
-		r0 = returnFunc(ctx, courseID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) []domain.Lesson); ok {
+		r0 = returnFunc(ctx, courseID, includeUnpublished)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Lesson)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, courseID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) int64); ok {
+		r1 = returnFunc(ctx, courseID, includeUnpublished)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, bool) error); ok {
+		r2 = returnFunc(ctx, courseID, includeUnpublished)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockLessonService_ListByCourseID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByCourseID'
@@ -231,23 +359,244 @@ type MockLessonService_ListByCourseID_Call struct {
 // ListByCourseID is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-func (_e *MockLessonService_Expecter) ListByCourseID(ctx interface{}, courseID interface{}) *MockLessonService_ListByCourseID_Call {
-	return &MockLessonService_ListByCourseID_Call{Call: _e.mock.On("ListByCourseID", ctx, courseID)}
+//   - includeUnpublished
+func (_e *MockLessonService_Expecter) ListByCourseID(ctx interface{}, courseID interface{}, includeUnpublished interface{}) *MockLessonService_ListByCourseID_Call {
+	return &MockLessonService_ListByCourseID_Call{Call: _e.mock.On("ListByCourseID", ctx, courseID, includeUnpublished)}
+}
+
+func (_c *MockLessonService_ListByCourseID_Call) Run(run func(ctx context.Context, courseID string, includeUnpublished bool)) *MockLessonService_ListByCourseID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockLessonService_ListByCourseID_Call) Return(lessons []domain.Lesson, n int64, err error) *MockLessonService_ListByCourseID_Call {
+	_c.Call.Return(lessons, n, err)
+	return _c
+}
+
+func (_c *MockLessonService_ListByCourseID_Call) RunAndReturn(run func(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, int64, error)) *MockLessonService_ListByCourseID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function for the type MockLessonService
+func (_mock *MockLessonService) ListRevisions(ctx context.Context, lessonID string) ([]domain.LessonRevision, error) {
+	ret := _mock.Called(ctx, lessonID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []domain.LessonRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.LessonRevision, error)); ok {
+		return returnFunc(ctx, lessonID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.LessonRevision); ok {
+		r0 = returnFunc(ctx, lessonID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LessonRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, lessonID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonService_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type MockLessonService_ListRevisions_Call struct {
+	*mock.Call
 }
 
-func (_c *MockLessonService_ListByCourseID_Call) Run(run func(ctx context.Context, courseID string)) *MockLessonService_ListByCourseID_Call {
+// ListRevisions is a helper method to define mock.On call
+//   - ctx
+//   - lessonID
+func (_e *MockLessonService_Expecter) ListRevisions(ctx interface{}, lessonID interface{}) *MockLessonService_ListRevisions_Call {
+	return &MockLessonService_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, lessonID)}
+}
+
+func (_c *MockLessonService_ListRevisions_Call) Run(run func(ctx context.Context, lessonID string)) *MockLessonService_ListRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockLessonService_ListByCourseID_Call) Return(lessons []domain.Lesson, err error) *MockLessonService_ListByCourseID_Call {
-	_c.Call.Return(lessons, err)
+func (_c *MockLessonService_ListRevisions_Call) Return(lessonRevisions []domain.LessonRevision, err error) *MockLessonService_ListRevisions_Call {
+	_c.Call.Return(lessonRevisions, err)
+	return _c
+}
+
+func (_c *MockLessonService_ListRevisions_Call) RunAndReturn(run func(ctx context.Context, lessonID string) ([]domain.LessonRevision, error)) *MockLessonService_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preview provides a mock function for the type MockLessonService
+func (_mock *MockLessonService) Preview(content string) string {
+	ret := _mock.Called(content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preview")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(content)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockLessonService_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type MockLessonService_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - content
+func (_e *MockLessonService_Expecter) Preview(content interface{}) *MockLessonService_Preview_Call {
+	return &MockLessonService_Preview_Call{Call: _e.mock.On("Preview", content)}
+}
+
+func (_c *MockLessonService_Preview_Call) Run(run func(content string)) *MockLessonService_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonService_Preview_Call) Return(s string) *MockLessonService_Preview_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockLessonService_Preview_Call) RunAndReturn(run func(content string) string) *MockLessonService_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reorder provides a mock function for the type MockLessonService
+func (_mock *MockLessonService) Reorder(ctx context.Context, dto1 dto.ReorderLessonsDTO) ([]domain.Lesson, int64, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 []domain.Lesson
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ReorderLessonsDTO) ([]domain.Lesson, int64, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.ReorderLessonsDTO) []domain.Lesson); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Lesson)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.ReorderLessonsDTO) int64); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, dto.ReorderLessonsDTO) error); ok {
+		r2 = returnFunc(ctx, dto1)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLessonService_Reorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reorder'
+type MockLessonService_Reorder_Call struct {
+	*mock.Call
+}
+
+// Reorder is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockLessonService_Expecter) Reorder(ctx interface{}, dto1 interface{}) *MockLessonService_Reorder_Call {
+	return &MockLessonService_Reorder_Call{Call: _e.mock.On("Reorder", ctx, dto1)}
+}
+
+func (_c *MockLessonService_Reorder_Call) Run(run func(ctx context.Context, dto1 dto.ReorderLessonsDTO)) *MockLessonService_Reorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ReorderLessonsDTO))
+	})
+	return _c
+}
+
+func (_c *MockLessonService_Reorder_Call) Return(lessons []domain.Lesson, n int64, err error) *MockLessonService_Reorder_Call {
+	_c.Call.Return(lessons, n, err)
+	return _c
+}
+
+func (_c *MockLessonService_Reorder_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.ReorderLessonsDTO) ([]domain.Lesson, int64, error)) *MockLessonService_Reorder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreRevision provides a mock function for the type MockLessonService
+func (_mock *MockLessonService) RestoreRevision(ctx context.Context, dto1 dto.RestoreRevisionDTO) (domain.Lesson, error) {
+	ret := _mock.Called(ctx, dto1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 domain.Lesson
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RestoreRevisionDTO) (domain.Lesson, error)); ok {
+		return returnFunc(ctx, dto1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, dto.RestoreRevisionDTO) domain.Lesson); ok {
+		r0 = returnFunc(ctx, dto1)
+	} else {
+		r0 = ret.Get(0).(domain.Lesson)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, dto.RestoreRevisionDTO) error); ok {
+		r1 = returnFunc(ctx, dto1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonService_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type MockLessonService_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - ctx
+//   - dto1
+func (_e *MockLessonService_Expecter) RestoreRevision(ctx interface{}, dto1 interface{}) *MockLessonService_RestoreRevision_Call {
+	return &MockLessonService_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", ctx, dto1)}
+}
+
+func (_c *MockLessonService_RestoreRevision_Call) Run(run func(ctx context.Context, dto1 dto.RestoreRevisionDTO)) *MockLessonService_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.RestoreRevisionDTO))
+	})
+	return _c
+}
+
+func (_c *MockLessonService_RestoreRevision_Call) Return(lesson domain.Lesson, err error) *MockLessonService_RestoreRevision_Call {
+	_c.Call.Return(lesson, err)
 	return _c
 }
 
-func (_c *MockLessonService_ListByCourseID_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.Lesson, error)) *MockLessonService_ListByCourseID_Call {
+func (_c *MockLessonService_RestoreRevision_Call) RunAndReturn(run func(ctx context.Context, dto1 dto.RestoreRevisionDTO) (domain.Lesson, error)) *MockLessonService_RestoreRevision_Call {
 	_c.Call.Return(run)
 	return _c
 }
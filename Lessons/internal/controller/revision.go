@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	pb "Classroom/Lessons/pkg/api/lessons"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *lessonController) ListLessonRevisions(ctx context.Context, req *pb.ListLessonRevisionsRequest) (*pb.ListLessonRevisionsResponse, error) {
+	if err := c.validate.Var(req.LessonId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lesson id")
+	}
+
+	revisions, err := c.svc.ListRevisions(ctx, req.LessonId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "lesson not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to list lesson revisions", "err", err, "id", req.LessonId)
+		return nil, status.Error(codes.Internal, "failed to list lesson revisions")
+	}
+
+	pbRevisions := make([]*pb.LessonRevision, len(revisions))
+	for i, revision := range revisions {
+		pbRevisions[i] = revisionToPb(revision)
+	}
+	return &pb.ListLessonRevisionsResponse{Revisions: pbRevisions}, nil
+}
+
+func (c *lessonController) GetLessonRevision(ctx context.Context, req *pb.GetLessonRevisionRequest) (*pb.GetLessonRevisionResponse, error) {
+	if err := c.validate.Var(req.LessonId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lesson id")
+	}
+	if req.Revision < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid revision")
+	}
+
+	revision, err := c.svc.GetRevision(ctx, req.LessonId, req.Revision)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+	if err != nil {
+		c.logger.Error("failed to get lesson revision", "err", err, "id", req.LessonId, "revision", req.Revision)
+		return nil, status.Error(codes.Internal, "failed to get lesson revision")
+	}
+	return &pb.GetLessonRevisionResponse{Revision: revisionToPb(revision)}, nil
+}
+
+func (c *lessonController) RestoreLessonRevision(ctx context.Context, req *pb.RestoreLessonRevisionRequest) (*pb.RestoreLessonRevisionResponse, error) {
+	dto := dto.RestoreRevisionDTO{
+		LessonID: req.LessonId,
+		Revision: req.Revision,
+		UserID:   req.UserId,
+	}
+	if err := c.validate.Struct(dto); err != nil {
+		c.logger.Debug("invalid request", "err", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	lesson, err := c.svc.RestoreRevision(ctx, dto)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if err != nil {
+		c.logger.Error("failed to restore lesson revision", "err", err, "id", req.LessonId, "revision", req.Revision)
+		return nil, status.Error(codes.Internal, "failed to restore lesson revision")
+	}
+	return &pb.RestoreLessonRevisionResponse{Lesson: lessonToPb(lesson)}, nil
+}
+
+func revisionToPb(revision domain.LessonRevision) *pb.LessonRevision {
+	return &pb.LessonRevision{
+		LessonId:     revision.LessonID,
+		Revision:     revision.Revision,
+		Title:        revision.Title,
+		Content:      revision.Content,
+		AuthorId:     revision.AuthorID,
+		RestoredFrom: revision.RestoredFrom,
+		CreatedAt:    timestamppb.New(revision.CreatedAt),
+	}
+}
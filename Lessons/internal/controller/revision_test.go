@@ -0,0 +1,97 @@
+package controller_test
+
+import (
+	"Classroom/Lessons/internal/controller"
+	mocks "Classroom/Lessons/internal/controller/mocks"
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	pb "Classroom/Lessons/pkg/api/lessons"
+	"context"
+	"log/slog"
+
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLessonController_RestoreLessonRevision(t *testing.T) {
+	type MockBehavior func(svc *mocks.MockLessonService, req *pb.RestoreLessonRevisionRequest)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          *pb.RestoreLessonRevisionRequest
+		wantTitle    string
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.RestoreLessonRevisionRequest) {
+				svc.EXPECT().RestoreRevision(mock.Anything, dto.RestoreRevisionDTO{
+					LessonID: req.LessonId,
+					Revision: req.Revision,
+					UserID:   req.UserId,
+				}).Return(domain.Lesson{ID: req.LessonId, Title: "old title"}, nil)
+			},
+			req: &pb.RestoreLessonRevisionRequest{
+				LessonId: uuid.NewString(),
+				Revision: 2,
+				UserId:   uuid.NewString(),
+			},
+			wantTitle: "old title",
+		},
+		{
+			name:         "invalid revision",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.RestoreLessonRevisionRequest) {},
+			req: &pb.RestoreLessonRevisionRequest{
+				LessonId: uuid.NewString(),
+				UserId:   uuid.NewString(),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "invalid request: Key: 'RestoreRevisionDTO.Revision' Error:Field validation for 'Revision' failed on the 'min' tag"),
+		},
+		{
+			name: "revision not found",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.RestoreLessonRevisionRequest) {
+				svc.EXPECT().RestoreRevision(mock.Anything, mock.Anything).Return(domain.Lesson{}, domain.ErrNotFound)
+			},
+			req: &pb.RestoreLessonRevisionRequest{
+				LessonId: uuid.NewString(),
+				Revision: 9,
+				UserId:   uuid.NewString(),
+			},
+			wantErr: status.Error(codes.NotFound, "revision not found"),
+		},
+		{
+			name: "course is read-only",
+			mockBehavior: func(svc *mocks.MockLessonService, req *pb.RestoreLessonRevisionRequest) {
+				svc.EXPECT().RestoreRevision(mock.Anything, mock.Anything).Return(domain.Lesson{}, domain.ErrReadOnly)
+			},
+			req: &pb.RestoreLessonRevisionRequest{
+				LessonId: uuid.NewString(),
+				Revision: 1,
+				UserId:   uuid.NewString(),
+			},
+			wantErr: status.Error(codes.FailedPrecondition, "course is read-only"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewMockLessonService(t)
+			tc.mockBehavior(svc, tc.req)
+			c := controller.NewLessonController(slog.Default(), svc, mocks.NewMockAttachmentService(t))
+			got, err := c.RestoreLessonRevision(context.Background(), tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantTitle, got.Lesson.Title)
+		})
+	}
+}
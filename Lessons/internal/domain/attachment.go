@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Attachment представляет файл, прикреплённый к уроку
+type Attachment struct {
+	ID          string    // Уникальный идентификатор файла
+	LessonID    string    // Идентификатор урока
+	CourseID    string    // Идентификатор курса урока
+	FileName    string    // Имя файла
+	ContentType string    // MIME тип файла
+	Size        int64     // Размер файла в байтах
+	StorageKey  string    // Ключ содержимого в файловом хранилище
+	UploadedBy  string    // Идентификатор пользователя, загрузившего файл
+	CreatedAt   time.Time // Время загрузки
+}
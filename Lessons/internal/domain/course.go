@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// CourseState хранит состояние курса, полученное из событий Courses
+type CourseState struct {
+	CourseID   string     // Идентификатор курса
+	ArchivedAt *time.Time // Время архивации курса
+	DeletedAt  *time.Time // Время удаления курса
+}
+
+// ReadOnly сообщает, что уроки курса нельзя создавать, изменять и удалять
+func (s CourseState) ReadOnly() bool {
+	return s.ArchivedAt != nil || s.DeletedAt != nil
+}
@@ -8,4 +8,8 @@ var (
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrAlreadyExists = errors.New("entity already exists")
 	ErrDatabase      = errors.New("database error")
+	ErrReadOnly      = errors.New("course is read-only")
+	ErrTooLarge      = errors.New("file is too large")
+	ErrConflict      = errors.New("entity was modified concurrently")
+	ErrPublished     = errors.New("lesson is already published")
 )
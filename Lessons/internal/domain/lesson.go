@@ -2,11 +2,30 @@ package domain
 
 import "time"
 
+// Состояние публикации урока
+type LessonStatus string
+
+const (
+	LessonDraft     LessonStatus = "draft"     // Урок виден только преподавателям
+	LessonScheduled LessonStatus = "scheduled" // Урок будет опубликован в PublishAt
+	LessonPublished LessonStatus = "published" // Урок виден студентам
+)
+
+// Valid сообщает, что состояние известно
+func (s LessonStatus) Valid() bool {
+	return s == LessonDraft || s == LessonScheduled || s == LessonPublished
+}
+
 // Lesson представляет доменную модель урока
 type Lesson struct {
-	ID        string    // Уникальный идентификатор урока
-	CourseID  string    // Идентификатор курса, к которому относится урок
-	Title     string    // Название урока
-	Content   string    // Содержание урока
-	CreatedAt time.Time // Время создания урока
+	ID          string       // Уникальный идентификатор урока
+	CourseID    string       // Идентификатор курса, к которому относится урок
+	Title       string       // Название урока
+	Content     string       // Содержание урока
+	CreatedAt   time.Time    // Время создания урока
+	ModuleID    *string      // Идентификатор модуля курса, nil - урок без модуля
+	Position    int32        // Порядок урока в курсе
+	Status      LessonStatus // Состояние публикации урока
+	PublishAt   *time.Time   // Запланированное или фактическое время публикации, nil у черновика
+	ContentHTML string       // Содержание в HTML, отрисованное из Markdown и очищенное
 }
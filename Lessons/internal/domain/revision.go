@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// LessonRevision - снимок названия и содержания урока после создания, изменения или восстановления
+type LessonRevision struct {
+	LessonID     string    // Идентификатор урока
+	Revision     int32     // Номер ревизии, начиная с 1
+	Title        string    // Название урока
+	Content      string    // Содержание урока, пустое в списке ревизий
+	AuthorID     *string   // Автор изменений, nil у ревизий, созданных до ведения истории
+	RestoredFrom *int32    // Номер ревизии, из которой урок восстановлен
+	CreatedAt    time.Time // Время изменения
+}
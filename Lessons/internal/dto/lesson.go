@@ -1,13 +1,44 @@
 package dto
 
+import "time"
+
 type CreateLessonDTO struct {
-	Title    string `validate:"required"`
-	Content  string `validate:"required"`
-	CourseID string `validate:"required,uuid"`
+	Title       string `validate:"required"`
+	Content     string `validate:"required"`
+	CourseID    string `validate:"required,uuid"`
+	ModuleID    *string
+	Status      string     `validate:"omitempty,oneof=draft scheduled published"` // Пустое состояние - черновик
+	PublishAt   *time.Time // Только для scheduled, у published сервис ставит текущее время
+	UserID      string     `validate:"required,uuid"` // Автор первой ревизии
+	ContentHTML string     // Отрисовывает сервис из Content
 }
 
 type UpdateLessonDTO struct {
+	LessonID    string `validate:"required,uuid"`
+	Title       *string // Ревизия создаётся, только если изменено название или содержание
+	Content     *string
+	ModuleID    *string    // Пустая строка убирает урок из модуля
+	Status      *string    `validate:"omitempty,oneof=draft scheduled published"`
+	PublishAt   *time.Time // Без Status переносит публикацию, урок становится scheduled
+	UserID      string     `validate:"required,uuid"` // Автор ревизии
+	ContentHTML string     // Отрисовывает сервис, если передан Content
+}
+
+type RestoreRevisionDTO struct {
 	LessonID string `validate:"required,uuid"`
-	Title    *string
-	Content  *string
+	Revision int32  `validate:"min=1"`
+	UserID   string `validate:"required,uuid"` // Автор новой ревизии
+}
+
+type ReorderLessonsDTO struct {
+	CourseID  string   `validate:"required,uuid"`
+	LessonIDs []string `validate:"required,unique,dive,uuid"` // Все уроки курса в новом порядке
+	Version   int64    `validate:"min=0"`
+}
+
+type UploadAttachmentDTO struct {
+	LessonID    string `validate:"required,uuid"`
+	FileName    string `validate:"required,max=255"`
+	ContentType string `validate:"required"`
+	UserID      string `validate:"required,uuid"`
 }
@@ -0,0 +1,216 @@
+package markdown
+
+import (
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// Таблицы, блоки кода с подсветкой по языку, зачёркивание и автоссылки
+const extensions = blackfriday.CommonExtensions
+
+// Render переводит Markdown в HTML и оставляет в нём только разрешённые теги и атрибуты.
+// Формулы $...$ и $$...$$ выводятся как \(...\) и \[...\] в span с классом math,
+// их отрисовывает клиент, например KaTeX или MathJax
+func Render(source string) string {
+	if strings.TrimSpace(source) == "" {
+		return ""
+	}
+	source = strings.ReplaceAll(source, "\r\n", "\n")
+
+	text, formulas := extractMath(source)
+	renderer := blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{})
+	out := string(blackfriday.Run([]byte(text), blackfriday.WithExtensions(extensions), blackfriday.WithRenderer(renderer)))
+	out = formulas.restore(out)
+	return Sanitize(out)
+}
+
+type formula struct {
+	placeholder string
+	tex         string
+	display     bool
+}
+
+type formulas []formula
+
+// Подставляет формулы на место меток. Текст формулы экранируется, результат
+// всё равно проходит через Sanitize
+func (f formulas) restore(out string) string {
+	if len(f) == 0 {
+		return out
+	}
+	pairs := make([]string, 0, 2*len(f))
+	for _, m := range f {
+		tex := html.EscapeString(m.tex)
+		if m.display {
+			pairs = append(pairs, m.placeholder, `<span class="math math-display">\[`+tex+`\]</span>`)
+		} else {
+			pairs = append(pairs, m.placeholder, `<span class="math math-inline">\(`+tex+`\)</span>`)
+		}
+	}
+	return strings.NewReplacer(pairs...).Replace(out)
+}
+
+// Markdown разбирает _, * и \ внутри формул как разметку, поэтому до разбора формулы
+// заменяются метками из букв и цифр. Код в ``` и `...` не меняется
+func extractMath(source string) (string, formulas) {
+	// Префикс меток не встречается в тексте, поэтому метка не совпадёт с текстом урока
+	prefix := "mathph"
+	for strings.Contains(source, prefix) {
+		prefix += "x"
+	}
+
+	var (
+		b     strings.Builder
+		chunk strings.Builder
+		found formulas
+		fence string
+	)
+	flush := func() {
+		found = extractInline(&b, chunk.String(), prefix, found)
+		chunk.Reset()
+	}
+	for _, line := range strings.SplitAfter(source, "\n") {
+		marker := fenceMarker(line)
+		switch {
+		case fence != "":
+			b.WriteString(line)
+			if closesFence(line, marker, fence) {
+				fence = ""
+			}
+		case marker != "":
+			flush()
+			b.WriteString(line)
+			fence = marker
+		default:
+			chunk.WriteString(line)
+		}
+	}
+	flush()
+	return b.String(), found
+}
+
+// Открывающая строка блока кода: до трёх пробелов и не меньше трёх ` или ~
+func fenceMarker(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || len(trimmed) < 3 || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return ""
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == trimmed[0] {
+		n++
+	}
+	if n < 3 {
+		return ""
+	}
+	return trimmed[:n]
+}
+
+// Блок закрывается тем же символом не короче открывающего, после него только пробелы
+func closesFence(line, marker, fence string) bool {
+	if marker == "" || marker[0] != fence[0] || len(marker) < len(fence) {
+		return false
+	}
+	rest := strings.TrimLeft(line, " ")[len(marker):]
+	return strings.TrimSpace(rest) == ""
+}
+
+// Формулы ищутся по правилам pandoc: после открывающего $ и перед закрывающим нет
+// пробела, а за закрывающим нет цифры, поэтому "$5 и $10" остаются текстом
+func extractInline(b *strings.Builder, text, prefix string, found formulas) formulas {
+	add := func(tex string, display bool) {
+		placeholder := prefix + strconv.Itoa(len(found)) + "e"
+		found = append(found, formula{placeholder: placeholder, tex: tex, display: display})
+		b.WriteString(placeholder)
+	}
+
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == '\\' && i+1 < len(text):
+			b.WriteString(text[i : i+2])
+			i += 2
+		case text[i] == '`':
+			n := runLength(text, i, '`')
+			end := closingBackticks(text, i+n, n)
+			if end < 0 {
+				b.WriteString(text[i : i+n])
+				i += n
+				continue
+			}
+			b.WriteString(text[i : end+n])
+			i = end + n
+		case strings.HasPrefix(text[i:], "$$"):
+			end := strings.Index(text[i+2:], "$$")
+			if end < 0 || strings.TrimSpace(text[i+2:i+2+end]) == "" {
+				b.WriteString("$$")
+				i += 2
+				continue
+			}
+			add(strings.TrimSpace(text[i+2:i+2+end]), true)
+			i += end + 4
+		case text[i] == '$':
+			end := closingDollar(text, i+1)
+			if end < 0 {
+				b.WriteByte('$')
+				i++
+				continue
+			}
+			add(text[i+1:end], false)
+			i = end + 1
+		default:
+			b.WriteByte(text[i])
+			i++
+		}
+	}
+	return found
+}
+
+func runLength(text string, i int, c byte) int {
+	n := 0
+	for i+n < len(text) && text[i+n] == c {
+		n++
+	}
+	return n
+}
+
+// Код `...` закрывается такой же по длине последовательностью `
+func closingBackticks(text string, from, n int) int {
+	for i := from; i < len(text); {
+		if text[i] != '`' {
+			i++
+			continue
+		}
+		run := runLength(text, i, '`')
+		if run == n {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
+func closingDollar(text string, from int) int {
+	if from >= len(text) || isSpace(text[from]) {
+		return -1
+	}
+	for i := from; i < len(text); i++ {
+		switch text[i] {
+		case '\n':
+			return -1
+		case '\\':
+			i++
+		case '$':
+			if isSpace(text[i-1]) || (i+1 < len(text) && text[i+1] >= '0' && text[i+1] <= '9') {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
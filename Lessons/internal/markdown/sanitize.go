@@ -0,0 +1,215 @@
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// Разрешённые теги и их атрибуты. Остальные теги вырезаются, их текст остаётся
+var allowedTags = map[string][]string{
+	"a":          {"href", "title"},
+	"b":          nil,
+	"blockquote": nil,
+	"br":         nil,
+	"code":       {"class"},
+	"dd":         nil,
+	"del":        nil,
+	"dl":         nil,
+	"dt":         nil,
+	"em":         nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title"},
+	"kbd":        nil,
+	"li":         nil,
+	"ol":         {"start"},
+	"p":          nil,
+	"pre":        nil,
+	"s":          nil,
+	"span":       {"class"},
+	"strong":     nil,
+	"sub":        nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"align"},
+	"th":         {"align"},
+	"thead":      nil,
+	"tr":         nil,
+	"ul":         nil,
+}
+
+// Теги, которые вырезаются вместе с содержимым
+var droppedTags = map[string]bool{
+	"embed":     true,
+	"frameset":  true,
+	"iframe":    true,
+	"math":      true,
+	"noembed":   true,
+	"noframes":  true,
+	"noscript":  true,
+	"object":    true,
+	"plaintext": true,
+	"script":    true,
+	"select":    true,
+	"style":     true,
+	"svg":       true,
+	"template":  true,
+	"textarea":  true,
+	"title":     true,
+	"xmp":       true,
+}
+
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+// Классы формул и языка блока кода
+var allowedClass = regexp.MustCompile(`^(math|math-inline|math-display|language-[A-Za-z0-9_+#.-]+)$`)
+
+// Sanitize оставляет в HTML только разрешённые теги, атрибуты и ссылки http, https
+// и mailto. Незакрытые теги закрываются, лишние закрывающие отбрасываются
+func Sanitize(s string) string {
+	var (
+		b    strings.Builder
+		open []string
+		// Вырезаемый тег и глубина его вложенности
+		skip      string
+		skipDepth int
+	)
+
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		// Токенизатор читает из строки, поэтому ошибка только io.EOF
+		if tt == nethtml.ErrorToken {
+			break
+		}
+		tok := z.Token()
+
+		if skip != "" {
+			switch {
+			case tt == nethtml.StartTagToken && tok.Data == skip:
+				skipDepth++
+			case tt == nethtml.EndTagToken && tok.Data == skip:
+				skipDepth--
+				if skipDepth == 0 {
+					skip = ""
+				}
+			}
+			continue
+		}
+
+		switch tt {
+		case nethtml.TextToken:
+			b.WriteString(html.EscapeString(tok.Data))
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if droppedTags[tok.Data] {
+				if tt == nethtml.StartTagToken {
+					skip, skipDepth = tok.Data, 1
+				}
+				continue
+			}
+			attrs, ok := allowedTags[tok.Data]
+			if !ok {
+				continue
+			}
+			writeStartTag(&b, tok, attrs)
+			if !voidTags[tok.Data] && tt == nethtml.StartTagToken {
+				open = append(open, tok.Data)
+			}
+		case nethtml.EndTagToken:
+			// Закрываются все теги до последнего открытого с этим именем
+			i := -1
+			for j := len(open) - 1; j >= 0; j-- {
+				if open[j] == tok.Data {
+					i = j
+					break
+				}
+			}
+			if i < 0 {
+				continue
+			}
+			for len(open) > i {
+				b.WriteString("</" + open[len(open)-1] + ">")
+				open = open[:len(open)-1]
+			}
+		}
+	}
+
+	for len(open) > 0 {
+		b.WriteString("</" + open[len(open)-1] + ">")
+		open = open[:len(open)-1]
+	}
+	return b.String()
+}
+
+func writeStartTag(b *strings.Builder, tok nethtml.Token, allowed []string) {
+	b.WriteString("<" + tok.Data)
+	written := map[string]bool{}
+	for _, attr := range tok.Attr {
+		if attr.Namespace != "" || written[attr.Key] || !slices.Contains(allowed, attr.Key) {
+			continue
+		}
+		value, ok := sanitizeAttr(attr.Key, attr.Val)
+		if !ok {
+			continue
+		}
+		written[attr.Key] = true
+		b.WriteString(" " + attr.Key + `="` + html.EscapeString(value) + `"`)
+	}
+	if tok.Data == "a" && written["href"] {
+		b.WriteString(` rel="nofollow noopener noreferrer"`)
+	}
+	if voidTags[tok.Data] {
+		b.WriteString(" />")
+		return
+	}
+	b.WriteString(">")
+}
+
+func sanitizeAttr(key, value string) (string, bool) {
+	switch key {
+	case "href":
+		return value, safeURL(value, "http", "https", "mailto")
+	case "src":
+		return value, safeURL(value, "http", "https")
+	case "class":
+		classes := strings.Fields(value)
+		classes = slices.DeleteFunc(classes, func(c string) bool { return !allowedClass.MatchString(c) })
+		return strings.Join(classes, " "), len(classes) > 0
+	case "align":
+		return value, value == "left" || value == "right" || value == "center"
+	case "start":
+		for _, c := range value {
+			if c < '0' || c > '9' {
+				return "", false
+			}
+		}
+		return value, value != "" && len(value) < 10
+	default:
+		return value, true
+	}
+}
+
+// Относительные ссылки разрешены. url.Parse не разбирает ссылки с управляющими
+// символами и пробелом перед схемой, поэтому "java\tscript:" и " javascript:" отклоняются
+func safeURL(value string, schemes ...string) bool {
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return true
+	}
+	return slices.Contains(schemes, strings.ToLower(u.Scheme))
+}
@@ -44,3 +44,18 @@ func (p *kafkaProducer) PublishLessonCreated(msg events.LessonCreated) error {
 	_, _, err = p.producer.SendMessage(kafkaMsg)
 	return err
 }
+
+func (p *kafkaProducer) PublishLessonPublished(msg events.LessonPublished) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	kafkaMsg := &sarama.ProducerMessage{
+		Topic: events.LessonPublishedTopic,
+		Value: sarama.ByteEncoder(data),
+	}
+
+	_, _, err = p.producer.SendMessage(kafkaMsg)
+	return err
+}
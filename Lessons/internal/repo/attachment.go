@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// Вставка возвращает файл вместе с курсом урока, как и остальные запросы
+func (r *lessonRepo) CreateAttachment(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error) {
+	query, args := r.qb.
+		Insert("attachments").
+		Columns("attachment_id", "lesson_id", "file_name", "content_type", "size", "storage_key", "uploaded_by").
+		Values(attachment.ID, attachment.LessonID, attachment.FileName, attachment.ContentType, attachment.Size, attachment.StorageKey, attachment.UploadedBy).
+		Suffix("RETURNING attachment_id").
+		MustSql()
+
+	var id string
+	err := r.storage.GetContext(ctx, &id, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return domain.Attachment{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	return r.GetAttachment(ctx, id)
+}
+
+func (r *lessonRepo) GetAttachment(ctx context.Context, id string) (domain.Attachment, error) {
+	query, args := r.attachmentQuery().
+		Where(sq.Eq{"a.attachment_id": id}).
+		MustSql()
+
+	var attachment Attachment
+	err := r.storage.GetContext(ctx, &attachment, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Attachment{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	return attachment.ToEntity(), nil
+}
+
+func (r *lessonRepo) ListAttachments(ctx context.Context, lessonID string) ([]domain.Attachment, error) {
+	query, args := r.attachmentQuery().
+		Where(sq.Eq{"a.lesson_id": lessonID}).
+		OrderBy("a.created_at", "a.attachment_id").
+		MustSql()
+
+	var attachments []Attachment
+	if err := r.storage.SelectContext(ctx, &attachments, query, args...); err != nil {
+		return nil, err
+	}
+	result := make([]domain.Attachment, len(attachments))
+	for i, a := range attachments {
+		result[i] = a.ToEntity()
+	}
+	return result, nil
+}
+
+func (r *lessonRepo) DeleteAttachment(ctx context.Context, id string) error {
+	query, args := r.qb.
+		Delete("attachments").
+		Where(sq.Eq{"attachment_id": id}).
+		MustSql()
+
+	res, err := r.storage.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *lessonRepo) attachmentQuery() sq.SelectBuilder {
+	return r.qb.
+		Select("a.attachment_id", "a.lesson_id", "l.course_id", "a.file_name", "a.content_type", "a.size", "a.storage_key", "a.uploaded_by", "a.created_at").
+		From("attachments a").
+		Join("lessons l ON l.lesson_id = a.lesson_id")
+}
@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Возвращает пустое состояние, если событий по курсу не было
+func (r *lessonRepo) GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error) {
+	query, args := r.qb.
+		Select("*").
+		From("lesson_course_states").
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+
+	var state CourseState
+	err := r.storage.GetContext(ctx, &state, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.CourseState{CourseID: courseID}, nil
+	}
+	if err != nil {
+		return domain.CourseState{}, err
+	}
+	return state.ToEntity(), nil
+}
+
+func (r *lessonRepo) SetCourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error {
+	return r.upsertCourseState(ctx, courseID, "archived_at", archivedAt)
+}
+
+func (r *lessonRepo) SetCourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error {
+	return r.upsertCourseState(ctx, courseID, "deleted_at", deletedAt)
+}
+
+func (r *lessonRepo) ClearCourseState(ctx context.Context, courseID string) error {
+	query, args := r.qb.
+		Delete("lesson_course_states").
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+	_, err := r.storage.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *lessonRepo) upsertCourseState(ctx context.Context, courseID, column string, at time.Time) error {
+	query, args := r.qb.
+		Insert("lesson_course_states").
+		Columns("course_id", column).
+		Values(courseID, at).
+		Suffix("ON CONFLICT (course_id) DO UPDATE SET " + column + " = EXCLUDED." + column).
+		MustSql()
+	_, err := r.storage.ExecContext(ctx, query, args...)
+	return err
+}
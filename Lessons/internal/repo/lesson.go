@@ -6,6 +6,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -41,16 +42,63 @@ func (r *lessonRepo) CourseExists(ctx context.Context, courseID string) (bool, e
 	return isExists, nil
 }
 
+// Проверяет, что модуль принадлежит курсу
+func (r *lessonRepo) ModuleExists(ctx context.Context, courseID, moduleID string) (bool, error) {
+	query, args := r.qb.
+		Select("TRUE").
+		From("course_modules").
+		Where(sq.Eq{"course_id": courseID, "module_id": moduleID}).
+		MustSql()
+	var isExists bool
+	err := r.storage.GetContext(ctx, &isExists, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return isExists, nil
+}
+
+// Урок добавляется в конец курса. Версия порядка поднимается первой, её блокировка
+// не даёт двум урокам получить одну позицию
 func (r *lessonRepo) Create(ctx context.Context, dto dto.CreateLessonDTO) (domain.Lesson, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := r.bumpOrderVersion(ctx, tx, dto.CourseID); err != nil {
+		return domain.Lesson{}, err
+	}
+
 	query, args := r.qb.
 		Insert("lessons").
-		Columns("course_id", "title", "content").
-		Values(dto.CourseID, dto.Title, dto.Content).
+		Columns("course_id", "title", "content", "content_html", "module_id", "position", "status", "publish_at").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", dto.CourseID).
+			Column("?", dto.Title).
+			Column("?", dto.Content).
+			Column("?", dto.ContentHTML).
+			Column("?::uuid", dto.ModuleID).
+			Column("COALESCE(MAX(position) + 1, 0)").
+			Column("?", dto.Status).
+			Column("?::timestamp", dto.PublishAt).
+			From("lessons").
+			Where(sq.Eq{"course_id": dto.CourseID})).
 		Suffix("RETURNING *").
 		MustSql()
 
 	var lesson Lesson
-	if err := r.storage.GetContext(ctx, &lesson, query, args...); err != nil {
+	if err := tx.GetContext(ctx, &lesson, query, args...); err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := r.insertRevision(ctx, tx, lesson, &dto.UserID, nil); err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := tx.Commit(); err != nil {
 		return domain.Lesson{}, err
 	}
 	return lesson.ToEntity(), nil
@@ -74,12 +122,17 @@ func (r *lessonRepo) GetByID(ctx context.Context, id string) (domain.Lesson, err
 	return lesson.ToEntity(), nil
 }
 
-func (r *lessonRepo) ListByCourseID(ctx context.Context, courseID string) ([]domain.Lesson, error) {
-	query, args := r.qb.
+// Без includeUnpublished возвращаются только опубликованные уроки
+func (r *lessonRepo) ListByCourseID(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, error) {
+	builder := r.qb.
 		Select("*").
 		From("lessons").
 		Where(sq.Eq{"course_id": courseID}).
-		MustSql()
+		OrderBy("position", "created_at")
+	if !includeUnpublished {
+		builder = builder.Where(sq.Eq{"status": domain.LessonPublished})
+	}
+	query, args := builder.MustSql()
 	var lessons []Lesson
 	err := r.storage.SelectContext(ctx, &lessons, query, args...)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -95,47 +148,220 @@ func (r *lessonRepo) ListByCourseID(ctx context.Context, courseID string) ([]dom
 	return result, nil
 }
 
+// Каждое изменение записывается ревизией в той же транзакции
 func (r *lessonRepo) Update(ctx context.Context, dto dto.UpdateLessonDTO) (domain.Lesson, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	defer tx.Rollback()
+
 	m := make(map[string]any)
 	if dto.Title != nil {
 		m["title"] = *dto.Title
 	}
 	if dto.Content != nil {
 		m["content"] = *dto.Content
+		m["content_html"] = dto.ContentHTML
 	}
-	query, args := r.qb.
+	if dto.ModuleID != nil {
+		if *dto.ModuleID == "" {
+			m["module_id"] = nil
+		} else {
+			m["module_id"] = *dto.ModuleID
+		}
+	}
+	builder := r.qb.
 		Update("lessons").
-		SetMap(m).
 		Where(sq.Eq{"lesson_id": dto.LessonID}).
-		Suffix("RETURNING *").
-		MustSql()
+		Suffix("RETURNING *")
+	if dto.Status != nil {
+		m["status"] = *dto.Status
+		m["publish_at"] = dto.PublishAt
+		// Опубликованный урок не меняет состояние, иначе событие о публикации ушло бы дважды
+		builder = builder.Where(sq.NotEq{"status": domain.LessonPublished})
+	}
+	query, args := builder.SetMap(m).MustSql()
 
 	var lesson Lesson
-	err := r.storage.GetContext(ctx, &lesson, query, args...)
+	err = tx.GetContext(ctx, &lesson, query, args...)
+	if errors.Is(err, sql.ErrNoRows) && dto.Status != nil {
+		return domain.Lesson{}, domain.ErrConflict
+	}
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Lesson{}, domain.ErrNotFound
 	}
 	if err != nil {
 		return domain.Lesson{}, err
 	}
+
+	// Смена состояния и модуля не меняет содержание урока, ревизия не нужна
+	if dto.Title != nil || dto.Content != nil {
+		if err := r.insertRevision(ctx, tx, lesson, &dto.UserID, nil); err != nil {
+			return domain.Lesson{}, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.Lesson{}, err
+	}
 	return lesson.ToEntity(), nil
 }
 
 func (r *lessonRepo) Delete(ctx context.Context, id string) error {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query, args := r.qb.
 		Delete("lessons").
 		Where(sq.Eq{"lesson_id": id}).
+		Suffix("RETURNING course_id").
 		MustSql()
-	res, err := r.storage.ExecContext(ctx, query, args...)
+	var courseID string
+	err = tx.GetContext(ctx, &courseID, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
 	if err != nil {
 		return err
 	}
-	aff, err := res.RowsAffected()
-	if err != nil {
+	if _, err := r.bumpOrderVersion(ctx, tx, courseID); err != nil {
 		return err
 	}
-	if aff == 0 {
-		return domain.ErrNotFound
+	return tx.Commit()
+}
+
+// CloneFromCourse заменяет уроки целевого курса копиями уроков исходного курса.
+// Повторный вызов не создаёт дубликатов, порядок уроков сохраняется. Модули целевого
+// курса Courses копирует заранее, урок попадает в модуль с той же позицией. Время
+// публикации запланированных уроков сдвигается вместе с датами курса на shiftDays дней
+func (r *lessonRepo) CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string, shiftDays int32) (int, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := r.bumpOrderVersion(ctx, tx, targetCourseID); err != nil {
+		return 0, err
+	}
+
+	query, args := r.qb.
+		Delete("lessons").
+		Where(sq.Eq{"course_id": targetCourseID}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, err
+	}
+
+	query, args = r.qb.
+		Insert("lessons").
+		Columns("course_id", "title", "content", "created_at", "module_id", "position", "status", "publish_at", "content_html").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", targetCourseID).
+			Columns("l.title", "l.content").
+			Column("NOW() + ROW_NUMBER() OVER (ORDER BY l.created_at, l.lesson_id) * INTERVAL '1 microsecond'").
+			Columns("tm.module_id", "l.position", "l.status").
+			Column("CASE WHEN l.status = 'published' THEN NOW() ELSE l.publish_at + make_interval(days => ?::int) END", shiftDays).
+			Column("l.content_html").
+			From("lessons l").
+			LeftJoin("course_modules sm ON sm.module_id = l.module_id").
+			LeftJoin("course_modules tm ON tm.course_id = ? AND tm.position = sm.position", targetCourseID).
+			Where(sq.Eq{"l.course_id": sourceCourseID})).
+		MustSql()
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
 	}
-	return nil
+	copied, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	// История исходных уроков не копируется, копии начинаются с первой ревизии
+	query, args = r.qb.
+		Insert("lesson_revisions").
+		Columns("lesson_id", "revision", "title", "content").
+		Select(r.qb.
+			Select("lesson_id", "1", "title", "content").
+			From("lessons").
+			Where(sq.Eq{"course_id": targetCourseID})).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(copied), nil
+}
+
+// PublishDue публикует до limit запланированных уроков, время публикации которых
+// наступило. Уроки архивных и удалённых курсов ждут восстановления курса. Строки
+// забираются с SKIP LOCKED, поэтому несколько экземпляров сервиса не публикуют урок дважды
+func (r *lessonRepo) PublishDue(ctx context.Context, now time.Time, limit uint64) ([]domain.Lesson, error) {
+	// Вложенный запрос собирается с плейсхолдерами "?", номера расставит внешний запрос
+	due := sq.
+		Select("lesson_id").
+		From("lessons").
+		Where(sq.Eq{"status": domain.LessonScheduled}).
+		Where(sq.LtOrEq{"publish_at": now}).
+		Where("course_id NOT IN (SELECT course_id FROM lesson_course_states)").
+		OrderBy("publish_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	query, args := r.qb.
+		Update("lessons").
+		Set("status", domain.LessonPublished).
+		Where(sq.Expr("lesson_id IN (?)", due)).
+		Suffix("RETURNING *").
+		MustSql()
+
+	var lessons []Lesson
+	if err := r.storage.SelectContext(ctx, &lessons, query, args...); err != nil {
+		return nil, err
+	}
+	result := make([]domain.Lesson, len(lessons))
+	for i, l := range lessons {
+		result[i] = l.ToEntity()
+	}
+	return result, nil
+}
+
+// Уроки без HTML содержания по возрастанию ID, начиная после afterID
+func (r *lessonRepo) ListUnrendered(ctx context.Context, afterID string, limit uint64) ([]domain.Lesson, error) {
+	builder := r.qb.
+		Select("*").
+		From("lessons").
+		Where(sq.Eq{"content_html": nil}).
+		OrderBy("lesson_id").
+		Limit(limit)
+	if afterID != "" {
+		builder = builder.Where(sq.Gt{"lesson_id": afterID})
+	}
+	query, args := builder.MustSql()
+
+	var lessons []Lesson
+	if err := r.storage.SelectContext(ctx, &lessons, query, args...); err != nil {
+		return nil, err
+	}
+	result := make([]domain.Lesson, len(lessons))
+	for i, l := range lessons {
+		result[i] = l.ToEntity()
+	}
+	return result, nil
+}
+
+// HTML записывается, только если содержание не изменилось с момента отрисовки
+func (r *lessonRepo) SetContentHTML(ctx context.Context, lessonID, content, contentHTML string) error {
+	query, args := r.qb.
+		Update("lessons").
+		Set("content_html", contentHTML).
+		Where(sq.Eq{"lesson_id": lessonID, "content": content}).
+		MustSql()
+	_, err := r.storage.ExecContext(ctx, query, args...)
+	return err
 }
@@ -2,23 +2,110 @@ package repo
 
 import (
 	"Classroom/Lessons/internal/domain"
+	"database/sql"
 	"time"
 )
 
 type Lesson struct {
-	ID        string    `db:"lesson_id"`
-	CourseID  string    `db:"course_id"`
-	Title     string    `db:"title"`
-	Content   string    `db:"content"`
-	CreatedAt time.Time `db:"created_at"`
+	ID        string         `db:"lesson_id"`
+	CourseID  string         `db:"course_id"`
+	Title     string         `db:"title"`
+	Content   string         `db:"content"`
+	CreatedAt time.Time      `db:"created_at"`
+	ModuleID  sql.NullString `db:"module_id"`
+	Position  int32          `db:"position"`
+	Status    string         `db:"status"`
+	PublishAt sql.NullTime   `db:"publish_at"`
+	// NULL, пока урок, созданный до отрисовки Markdown, не отрисован
+	ContentHTML sql.NullString `db:"content_html"`
 }
 
 func (l Lesson) ToEntity() domain.Lesson {
-	return domain.Lesson{
-		ID:        l.ID,
-		CourseID:  l.CourseID,
-		Title:     l.Title,
-		Content:   l.Content,
-		CreatedAt: l.CreatedAt,
+	lesson := domain.Lesson{
+		ID:          l.ID,
+		CourseID:    l.CourseID,
+		Title:       l.Title,
+		Content:     l.Content,
+		CreatedAt:   l.CreatedAt,
+		ContentHTML: l.ContentHTML.String,
+		Position:    l.Position,
+		Status:      domain.LessonStatus(l.Status),
 	}
+	if l.ModuleID.Valid {
+		lesson.ModuleID = &l.ModuleID.String
+	}
+	if l.PublishAt.Valid {
+		lesson.PublishAt = &l.PublishAt.Time
+	}
+	return lesson
+}
+
+type CourseState struct {
+	CourseID   string       `db:"course_id"`
+	ArchivedAt sql.NullTime `db:"archived_at"`
+	DeletedAt  sql.NullTime `db:"deleted_at"`
+}
+
+func (s CourseState) ToEntity() domain.CourseState {
+	state := domain.CourseState{CourseID: s.CourseID}
+	if s.ArchivedAt.Valid {
+		state.ArchivedAt = &s.ArchivedAt.Time
+	}
+	if s.DeletedAt.Valid {
+		state.DeletedAt = &s.DeletedAt.Time
+	}
+	return state
+}
+
+type Attachment struct {
+	ID          string    `db:"attachment_id"`
+	LessonID    string    `db:"lesson_id"`
+	CourseID    string    `db:"course_id"`
+	FileName    string    `db:"file_name"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	StorageKey  string    `db:"storage_key"`
+	UploadedBy  string    `db:"uploaded_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (a Attachment) ToEntity() domain.Attachment {
+	return domain.Attachment{
+		ID:          a.ID,
+		LessonID:    a.LessonID,
+		CourseID:    a.CourseID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		StorageKey:  a.StorageKey,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+type LessonRevision struct {
+	LessonID     string         `db:"lesson_id"`
+	Revision     int32          `db:"revision"`
+	Title        string         `db:"title"`
+	Content      string         `db:"content"`
+	AuthorID     sql.NullString `db:"author_id"`
+	RestoredFrom sql.NullInt32  `db:"restored_from"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+func (r LessonRevision) ToEntity() domain.LessonRevision {
+	revision := domain.LessonRevision{
+		LessonID:  r.LessonID,
+		Revision:  r.Revision,
+		Title:     r.Title,
+		Content:   r.Content,
+		CreatedAt: r.CreatedAt,
+	}
+	if r.AuthorID.Valid {
+		revision.AuthorID = &r.AuthorID.String
+	}
+	if r.RestoredFrom.Valid {
+		revision.RestoredFrom = &r.RestoredFrom.Int32
+	}
+	return revision
 }
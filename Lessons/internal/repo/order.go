@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Возвращает 0, если порядок уроков курса ещё не менялся
+func (r *lessonRepo) GetOrderVersion(ctx context.Context, courseID string) (int64, error) {
+	query, args := r.qb.
+		Select("version").
+		From("lesson_order_versions").
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+
+	var version int64
+	err := r.storage.GetContext(ctx, &version, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// ReorderLessons расставляет уроки курса в порядке lessonIDs. Список должен содержать
+// все уроки курса, версия должна совпадать с текущей, иначе порядок не меняется
+func (r *lessonRepo) ReorderLessons(ctx context.Context, courseID string, lessonIDs []string, version int64) ([]domain.Lesson, int64, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	current, err := r.lockOrderVersion(ctx, tx, courseID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if current != version {
+		return nil, 0, domain.ErrConflict
+	}
+
+	query, args := r.qb.
+		Select("lesson_id").
+		From("lessons").
+		Where(sq.Eq{"course_id": courseID}).
+		MustSql()
+	var existing []string
+	if err := tx.SelectContext(ctx, &existing, query, args...); err != nil {
+		return nil, 0, err
+	}
+	if !sameIDs(existing, lessonIDs) {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	// Плейсхолдер внутри FROM squirrel не видит, поэтому массив идёт первым аргументом
+	query, args = r.qb.
+		Update("lessons l").
+		Set("position", sq.Expr("o.ord - 1")).
+		From("unnest(?::uuid[]) WITH ORDINALITY AS o(lesson_id, ord)").
+		Where("l.lesson_id = o.lesson_id").
+		MustSql()
+	args = append([]any{pq.Array(lessonIDs)}, args...)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, 0, err
+	}
+
+	newVersion, err := r.bumpOrderVersion(ctx, tx, courseID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, args = r.qb.
+		Select("*").
+		From("lessons").
+		Where(sq.Eq{"course_id": courseID}).
+		OrderBy("position", "created_at").
+		MustSql()
+	var lessons []Lesson
+	if err := tx.SelectContext(ctx, &lessons, query, args...); err != nil {
+		return nil, 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]domain.Lesson, len(lessons))
+	for i, l := range lessons {
+		result[i] = l.ToEntity()
+	}
+	return result, newVersion, nil
+}
+
+// Блокирует строку версии до конца транзакции и возвращает текущую версию
+func (r *lessonRepo) lockOrderVersion(ctx context.Context, tx *sqlx.Tx, courseID string) (int64, error) {
+	query, args := r.qb.
+		Insert("lesson_order_versions").
+		Columns("course_id", "version").
+		Values(courseID, 0).
+		Suffix("ON CONFLICT (course_id) DO UPDATE SET version = lesson_order_versions.version RETURNING version").
+		MustSql()
+	var version int64
+	err := tx.GetContext(ctx, &version, query, args...)
+	return version, err
+}
+
+// Увеличивает версию порядка курса. Строка версии блокируется до конца транзакции,
+// поэтому изменения порядка одного курса выполняются по очереди
+func (r *lessonRepo) bumpOrderVersion(ctx context.Context, tx *sqlx.Tx, courseID string) (int64, error) {
+	query, args := r.qb.
+		Insert("lesson_order_versions").
+		Columns("course_id", "version").
+		Values(courseID, 1).
+		Suffix("ON CONFLICT (course_id) DO UPDATE SET version = lesson_order_versions.version + 1 RETURNING version").
+		MustSql()
+	var version int64
+	err := tx.GetContext(ctx, &version, query, args...)
+	return version, err
+}
+
+func sameIDs(existing, ids []string) bool {
+	if len(existing) != len(ids) {
+		return false
+	}
+	set := make(map[string]struct{}, len(existing))
+	for _, id := range existing {
+		set[id] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+		delete(set, id)
+	}
+	return true
+}
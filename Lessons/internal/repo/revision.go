@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Список ревизий без содержания, от новых к старым
+func (r *lessonRepo) ListRevisions(ctx context.Context, lessonID string) ([]domain.LessonRevision, error) {
+	query, args := r.qb.
+		Select("lesson_id", "revision", "title", "'' AS content", "author_id", "restored_from", "created_at").
+		From("lesson_revisions").
+		Where(sq.Eq{"lesson_id": lessonID}).
+		OrderBy("revision DESC").
+		MustSql()
+
+	var revisions []LessonRevision
+	if err := r.storage.SelectContext(ctx, &revisions, query, args...); err != nil {
+		return nil, err
+	}
+	result := make([]domain.LessonRevision, len(revisions))
+	for i, rev := range revisions {
+		result[i] = rev.ToEntity()
+	}
+	return result, nil
+}
+
+func (r *lessonRepo) GetRevision(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error) {
+	return r.getRevision(ctx, r.storage, lessonID, revision)
+}
+
+// RestoreRevision возвращает уроку название и содержание ревизии. Восстановление само
+// становится новой ревизией, поэтому его тоже можно откатить
+func (r *lessonRepo) RestoreRevision(ctx context.Context, lessonID string, revision int32, contentHTML, authorID string) (domain.Lesson, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	defer tx.Rollback()
+
+	rev, err := r.getRevision(ctx, tx, lessonID, revision)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+
+	query, args := r.qb.
+		Update("lessons").
+		Set("title", rev.Title).
+		Set("content", rev.Content).
+		Set("content_html", contentHTML).
+		Where(sq.Eq{"lesson_id": lessonID}).
+		Suffix("RETURNING *").
+		MustSql()
+	var lesson Lesson
+	err = tx.GetContext(ctx, &lesson, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Lesson{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+
+	if err := r.insertRevision(ctx, tx, lesson, &authorID, &revision); err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.Lesson{}, err
+	}
+	return lesson.ToEntity(), nil
+}
+
+func (r *lessonRepo) getRevision(ctx context.Context, db sqlx.QueryerContext, lessonID string, revision int32) (domain.LessonRevision, error) {
+	query, args := r.qb.
+		Select("lesson_id", "revision", "title", "content", "author_id", "restored_from", "created_at").
+		From("lesson_revisions").
+		Where(sq.Eq{"lesson_id": lessonID, "revision": revision}).
+		MustSql()
+
+	var rev LessonRevision
+	err := sqlx.GetContext(ctx, db, &rev, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.LessonRevision{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.LessonRevision{}, err
+	}
+	return rev.ToEntity(), nil
+}
+
+// Записывает снимок урока следующей ревизией. Вызывается после UPDATE или INSERT урока
+// в той же транзакции: строка урока заблокирована, и номера ревизий не повторяются
+func (r *lessonRepo) insertRevision(ctx context.Context, tx *sqlx.Tx, lesson Lesson, authorID *string, restoredFrom *int32) error {
+	query, args := r.qb.
+		Insert("lesson_revisions").
+		Columns("lesson_id", "revision", "title", "content", "author_id", "restored_from").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", lesson.ID).
+			Column("COALESCE(MAX(revision) + 1, 1)").
+			Column("?", lesson.Title).
+			Column("?", lesson.Content).
+			Column("?::uuid", authorID).
+			Column("?::int", restoredFrom).
+			From("lesson_revisions").
+			Where(sq.Eq{"lesson_id": lesson.ID})).
+		MustSql()
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentRepo interface {
+	GetByID(ctx context.Context, id string) (domain.Lesson, error)
+	GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error)
+
+	CreateAttachment(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error)
+	GetAttachment(ctx context.Context, id string) (domain.Attachment, error)
+	ListAttachments(ctx context.Context, lessonID string) ([]domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, id string) error
+}
+
+// FileStorage хранит содержимое файлов по ключу. Реализации лежат в пакете storage:
+// локальный каталог и S3 совместимое хранилище
+type FileStorage interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+type attachmentService struct {
+	logger  *slog.Logger
+	repo    AttachmentRepo
+	files   FileStorage
+	maxSize int64 // Максимальный размер файла в байтах
+}
+
+func NewAttachmentService(logger *slog.Logger, repo AttachmentRepo, files FileStorage, maxSize int64) *attachmentService {
+	return &attachmentService{logger: logger, repo: repo, files: files, maxSize: maxSize}
+}
+
+// Файл сначала сохраняется в хранилище, потом записывается в бд. Если запись
+// в бд не удалась, файл удаляется из хранилища
+func (s *attachmentService) Upload(ctx context.Context, dto dto.UploadAttachmentDTO, r io.Reader) (domain.Attachment, error) {
+	lesson, err := s.repo.GetByID(ctx, dto.LessonID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if err := checkCourseWritable(ctx, s.repo, lesson.CourseID); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	id := uuid.NewString()
+	key := attachmentKey(lesson.CourseID, lesson.ID, id)
+	body := &limitedReader{r: r, limit: s.maxSize}
+	if err := s.files.Put(ctx, key, body, dto.ContentType); err != nil {
+		if body.exceeded {
+			return domain.Attachment{}, domain.ErrTooLarge
+		}
+		return domain.Attachment{}, fmt.Errorf("failed to put file: %w", err)
+	}
+
+	attachment, err := s.repo.CreateAttachment(ctx, domain.Attachment{
+		ID:          id,
+		LessonID:    lesson.ID,
+		FileName:    dto.FileName,
+		ContentType: dto.ContentType,
+		Size:        body.read,
+		StorageKey:  key,
+		UploadedBy:  dto.UserID,
+	})
+	if err != nil {
+		if delErr := s.files.Delete(ctx, key); delErr != nil {
+			s.logger.Error("failed to delete orphaned file", "key", key, "err", delErr)
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Attachment{}, err
+		}
+		return domain.Attachment{}, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	s.logger.Info("attachment uploaded", "id", attachment.ID, "lesson_id", attachment.LessonID, "size", attachment.Size)
+	return attachment, nil
+}
+
+func (s *attachmentService) Get(ctx context.Context, id string) (domain.Attachment, error) {
+	return s.repo.GetAttachment(ctx, id)
+}
+
+func (s *attachmentService) List(ctx context.Context, lessonID string) ([]domain.Attachment, error) {
+	if _, err := s.repo.GetByID(ctx, lessonID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAttachments(ctx, lessonID)
+}
+
+// Открывает содержимое файла, закрыть его должен вызывающий
+func (s *attachmentService) Open(ctx context.Context, id string) (domain.Attachment, io.ReadCloser, error) {
+	attachment, err := s.repo.GetAttachment(ctx, id)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+	body, err := s.files.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return domain.Attachment{}, nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	return attachment, body, nil
+}
+
+func (s *attachmentService) Delete(ctx context.Context, id string) (domain.Attachment, error) {
+	attachment, err := s.repo.GetAttachment(ctx, id)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if err := checkCourseWritable(ctx, s.repo, attachment.CourseID); err != nil {
+		return domain.Attachment{}, err
+	}
+	if err := s.repo.DeleteAttachment(ctx, id); err != nil {
+		return domain.Attachment{}, err
+	}
+	// не возвращаем ошибку потому что файл уже удалён из бд, фикс будет через логи
+	if err := s.files.Delete(ctx, attachment.StorageKey); err != nil {
+		s.logger.Error("failed to delete file", "key", attachment.StorageKey, "err", err)
+	}
+	s.logger.Info("attachment deleted", "id", attachment.ID, "lesson_id", attachment.LessonID)
+	return attachment, nil
+}
+
+// Записи о файлах удаляются из бд каскадно вместе с уроком, а содержимое
+// удаляется здесь по общему префиксу ключей урока
+func (s *attachmentService) DeleteLessonFiles(ctx context.Context, courseID, lessonID string) error {
+	return s.files.DeletePrefix(ctx, lessonPrefix(courseID, lessonID))
+}
+
+// Уроки окончательно удалённого курса удаляются из бд каскадно вместе с курсом,
+// поэтому файлы всех уроков удаляются по префиксу курса
+func (s *attachmentService) DeleteCourseFiles(ctx context.Context, courseID string) error {
+	if err := s.files.DeletePrefix(ctx, coursePrefix(courseID)); err != nil {
+		return fmt.Errorf("failed to delete course files: %w", err)
+	}
+	s.logger.Info("course files deleted", "course_id", courseID)
+	return nil
+}
+
+func coursePrefix(courseID string) string {
+	return fmt.Sprintf("courses/%s/", courseID)
+}
+
+func lessonPrefix(courseID, lessonID string) string {
+	return fmt.Sprintf("%slessons/%s/", coursePrefix(courseID), lessonID)
+}
+
+func attachmentKey(courseID, lessonID, attachmentID string) string {
+	return lessonPrefix(courseID, lessonID) + attachmentID
+}
+
+// limitedReader считает прочитанные байты и возвращает ErrTooLarge, как только
+// файл превысил лимит. В отличие от io.LimitReader не обрезает файл молча
+type limitedReader struct {
+	r        io.Reader
+	limit    int64
+	read     int64
+	exceeded bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		l.exceeded = true
+		return 0, domain.ErrTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	"Classroom/Lessons/internal/service"
+	mocks "Classroom/Lessons/internal/service/mocks"
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentService_Upload(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockAttachmentRepo, files *mocks.MockFileStorage, payload dto.UploadAttachmentDTO)
+
+	payload := dto.UploadAttachmentDTO{
+		LessonID:    "lesson-id",
+		FileName:    "slides.pdf",
+		ContentType: "application/pdf",
+		UserID:      "user-id",
+	}
+	lesson := domain.Lesson{ID: "lesson-id", CourseID: "course-id"}
+	// Содержимое файла читается из хранилища целиком, как это делает настоящая реализация
+	readAll := func(ctx context.Context, key string, r io.Reader, contentType string) error {
+		_, err := io.Copy(io.Discard, r)
+		return err
+	}
+	isLessonKey := mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "courses/course-id/lessons/lesson-id/")
+	})
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		body         string
+		maxSize      int64
+		want         domain.Attachment
+		wantErr      error
+	}{
+		{
+			name:    "success",
+			body:    "hello",
+			maxSize: 10,
+			mockBehavior: func(repo *mocks.MockAttachmentRepo, files *mocks.MockFileStorage, payload dto.UploadAttachmentDTO) {
+				repo.EXPECT().GetByID(mock.Anything, payload.LessonID).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, lesson.CourseID).Return(domain.CourseState{CourseID: lesson.CourseID}, nil)
+				files.EXPECT().Put(mock.Anything, isLessonKey, mock.Anything, payload.ContentType).RunAndReturn(readAll)
+				repo.EXPECT().CreateAttachment(mock.Anything, mock.MatchedBy(func(a domain.Attachment) bool {
+					return a.LessonID == payload.LessonID && a.Size == 5 && a.UploadedBy == payload.UserID &&
+						a.StorageKey == "courses/course-id/lessons/lesson-id/"+a.ID
+				})).Return(domain.Attachment{ID: "attachment-id", LessonID: payload.LessonID, CourseID: lesson.CourseID, Size: 5}, nil)
+			},
+			want: domain.Attachment{ID: "attachment-id", LessonID: "lesson-id", CourseID: "course-id", Size: 5},
+		},
+		{
+			name:    "file is too large",
+			body:    "hello world",
+			maxSize: 10,
+			mockBehavior: func(repo *mocks.MockAttachmentRepo, files *mocks.MockFileStorage, payload dto.UploadAttachmentDTO) {
+				repo.EXPECT().GetByID(mock.Anything, payload.LessonID).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, lesson.CourseID).Return(domain.CourseState{CourseID: lesson.CourseID}, nil)
+				files.EXPECT().Put(mock.Anything, isLessonKey, mock.Anything, payload.ContentType).RunAndReturn(readAll)
+			},
+			wantErr: domain.ErrTooLarge,
+		},
+		{
+			name:    "file is removed if db insert failed",
+			body:    "hello",
+			maxSize: 10,
+			mockBehavior: func(repo *mocks.MockAttachmentRepo, files *mocks.MockFileStorage, payload dto.UploadAttachmentDTO) {
+				repo.EXPECT().GetByID(mock.Anything, payload.LessonID).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, lesson.CourseID).Return(domain.CourseState{CourseID: lesson.CourseID}, nil)
+				files.EXPECT().Put(mock.Anything, isLessonKey, mock.Anything, payload.ContentType).RunAndReturn(readAll)
+				repo.EXPECT().CreateAttachment(mock.Anything, mock.Anything).Return(domain.Attachment{}, assert.AnError)
+				files.EXPECT().Delete(mock.Anything, isLessonKey).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "course is archived",
+			body:    "hello",
+			maxSize: 10,
+			mockBehavior: func(repo *mocks.MockAttachmentRepo, files *mocks.MockFileStorage, payload dto.UploadAttachmentDTO) {
+				archivedAt := time.Now()
+				repo.EXPECT().GetByID(mock.Anything, payload.LessonID).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, lesson.CourseID).
+					Return(domain.CourseState{CourseID: lesson.CourseID, ArchivedAt: &archivedAt}, nil)
+			},
+			wantErr: domain.ErrReadOnly,
+		},
+		{
+			name:    "lesson not found",
+			body:    "hello",
+			maxSize: 10,
+			mockBehavior: func(repo *mocks.MockAttachmentRepo, files *mocks.MockFileStorage, payload dto.UploadAttachmentDTO) {
+				repo.EXPECT().GetByID(mock.Anything, payload.LessonID).Return(domain.Lesson{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockAttachmentRepo(t)
+			files := mocks.NewMockFileStorage(t)
+			tc.mockBehavior(repo, files, payload)
+			svc := service.NewAttachmentService(slog.Default(), repo, files, tc.maxSize)
+			got, err := svc.Upload(context.Background(), payload, strings.NewReader(tc.body))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAttachmentService_Delete(t *testing.T) {
+	attachment := domain.Attachment{
+		ID:         "attachment-id",
+		LessonID:   "lesson-id",
+		CourseID:   "course-id",
+		StorageKey: "courses/course-id/lessons/lesson-id/attachment-id",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		repo := mocks.NewMockAttachmentRepo(t)
+		files := mocks.NewMockFileStorage(t)
+		repo.EXPECT().GetAttachment(mock.Anything, attachment.ID).Return(attachment, nil)
+		repo.EXPECT().GetCourseState(mock.Anything, attachment.CourseID).Return(domain.CourseState{CourseID: attachment.CourseID}, nil)
+		repo.EXPECT().DeleteAttachment(mock.Anything, attachment.ID).Return(nil)
+		files.EXPECT().Delete(mock.Anything, attachment.StorageKey).Return(nil)
+
+		svc := service.NewAttachmentService(slog.Default(), repo, files, 10)
+		got, err := svc.Delete(context.Background(), attachment.ID)
+		require.NoError(t, err)
+		assert.Equal(t, attachment, got)
+	})
+
+	t.Run("course is deleted", func(t *testing.T) {
+		repo := mocks.NewMockAttachmentRepo(t)
+		files := mocks.NewMockFileStorage(t)
+		deletedAt := time.Now()
+		repo.EXPECT().GetAttachment(mock.Anything, attachment.ID).Return(attachment, nil)
+		repo.EXPECT().GetCourseState(mock.Anything, attachment.CourseID).
+			Return(domain.CourseState{CourseID: attachment.CourseID, DeletedAt: &deletedAt}, nil)
+
+		svc := service.NewAttachmentService(slog.Default(), repo, files, 10)
+		_, err := svc.Delete(context.Background(), attachment.ID)
+		assert.ErrorIs(t, err, domain.ErrReadOnly)
+	})
+}
@@ -3,25 +3,48 @@ package service
 import (
 	"Classroom/Lessons/internal/domain"
 	"Classroom/Lessons/internal/dto"
+	"Classroom/Lessons/internal/markdown"
 	"Classroom/Lessons/pkg/events"
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 )
 
 type LessonRepo interface {
 	Create(ctx context.Context, dto dto.CreateLessonDTO) (domain.Lesson, error)
 	GetByID(ctx context.Context, id string) (domain.Lesson, error)
-	ListByCourseID(ctx context.Context, courseID string) ([]domain.Lesson, error)
+	ListByCourseID(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, error)
+	PublishDue(ctx context.Context, now time.Time, limit uint64) ([]domain.Lesson, error)
+	GetOrderVersion(ctx context.Context, courseID string) (int64, error)
+	ReorderLessons(ctx context.Context, courseID string, lessonIDs []string, version int64) ([]domain.Lesson, int64, error)
 	Update(ctx context.Context, dto dto.UpdateLessonDTO) (domain.Lesson, error)
 	Delete(ctx context.Context, id string) error
 	CourseExists(ctx context.Context, courseID string) (bool, error)
+	ModuleExists(ctx context.Context, courseID, moduleID string) (bool, error)
+	CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string, shiftDays int32) (int, error)
+
+	ListRevisions(ctx context.Context, lessonID string) ([]domain.LessonRevision, error)
+	GetRevision(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error)
+	RestoreRevision(ctx context.Context, lessonID string, revision int32, contentHTML, authorID string) (domain.Lesson, error)
+
+	ListUnrendered(ctx context.Context, afterID string, limit uint64) ([]domain.Lesson, error)
+	SetContentHTML(ctx context.Context, lessonID, content, contentHTML string) error
+
+	GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error)
+	SetCourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error
+	SetCourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error
+	ClearCourseState(ctx context.Context, courseID string) error
 }
 
 type Producer interface {
 	PublishLessonCreated(msg events.LessonCreated) error
+	PublishLessonPublished(msg events.LessonPublished) error
 }
 
+// Сколько запланированных уроков публикуется за один запрос к бд
+const publishBatchSize = 100
+
 type lessonService struct {
 	logger   *slog.Logger // Для дебага и информации, ошибки логируются в контроллере
 	lessons  LessonRepo
@@ -40,35 +63,277 @@ func (s *lessonService) Create(ctx context.Context, dto dto.CreateLessonDTO) (do
 	if !courseExists {
 		return domain.Lesson{}, domain.ErrNotFound
 	}
+	if err := s.checkWritable(ctx, dto.CourseID); err != nil {
+		return domain.Lesson{}, err
+	}
+	if dto.ModuleID != nil {
+		if err := s.checkModule(ctx, dto.CourseID, *dto.ModuleID); err != nil {
+			return domain.Lesson{}, err
+		}
+	}
+
+	switch domain.LessonStatus(dto.Status) {
+	case "", domain.LessonDraft:
+		dto.Status = string(domain.LessonDraft)
+		dto.PublishAt = nil
+	case domain.LessonPublished:
+		now := time.Now().UTC()
+		dto.PublishAt = &now
+	}
+	dto.ContentHTML = markdown.Render(dto.Content)
 
 	lesson, err := s.lessons.Create(ctx, dto)
 	if err != nil {
 		return domain.Lesson{}, fmt.Errorf("failed to create lesson: %w", err)
 	}
-	s.logger.Info("lesson created", "id", lesson.ID, "title", lesson.Title)
+	s.logger.Info("lesson created", "id", lesson.ID, "title", lesson.Title, "status", lesson.Status)
+
+	if lesson.Status == domain.LessonPublished {
+		s.notifyPublished(lesson)
+	}
+	return lesson, nil
+}
+
+func (s *lessonService) GetByID(ctx context.Context, id string) (domain.Lesson, error) {
+	return s.lessons.GetByID(ctx, id)
+}
+
+// Версия читается до списка: если порядок изменится между запросами, клиент получит
+// конфликт при перестановке, а не перезапишет чужие изменения
+func (s *lessonService) ListByCourseID(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, int64, error) {
+	version, err := s.lessons.GetOrderVersion(ctx, courseID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get order version: %w", err)
+	}
+	lessons, err := s.lessons.ListByCourseID(ctx, courseID, includeUnpublished)
+	if err != nil {
+		return nil, 0, err
+	}
+	return lessons, version, nil
+}
+
+func (s *lessonService) Reorder(ctx context.Context, dto dto.ReorderLessonsDTO) ([]domain.Lesson, int64, error) {
+	courseExists, err := s.lessons.CourseExists(ctx, dto.CourseID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check course exists: %w", err)
+	}
+	if !courseExists {
+		return nil, 0, domain.ErrNotFound
+	}
+	if err := s.checkWritable(ctx, dto.CourseID); err != nil {
+		return nil, 0, err
+	}
+
+	lessons, version, err := s.lessons.ReorderLessons(ctx, dto.CourseID, dto.LessonIDs, dto.Version)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to reorder lessons: %w", err)
+	}
+	s.logger.Info("lessons reordered", "course_id", dto.CourseID, "version", version)
+	return lessons, version, nil
+}
+
+func (s *lessonService) Update(ctx context.Context, dto dto.UpdateLessonDTO) (domain.Lesson, error) {
+	lesson, err := s.lessons.GetByID(ctx, dto.LessonID)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := s.checkWritable(ctx, lesson.CourseID); err != nil {
+		return domain.Lesson{}, err
+	}
+	if dto.ModuleID != nil && *dto.ModuleID != "" {
+		if err := s.checkModule(ctx, lesson.CourseID, *dto.ModuleID); err != nil {
+			return domain.Lesson{}, err
+		}
+	}
+	if err := normalizeStatusUpdate(lesson, &dto); err != nil {
+		return domain.Lesson{}, err
+	}
+	// Неизменённые название и содержание не передаются, чтобы не создавать одинаковых ревизий
+	if dto.Title != nil && *dto.Title == lesson.Title {
+		dto.Title = nil
+	}
+	if dto.Content != nil && *dto.Content == lesson.Content {
+		dto.Content = nil
+	}
+	if dto.Title == nil && dto.Content == nil && dto.ModuleID == nil && dto.Status == nil {
+		return lesson, nil
+	}
+	if dto.Content != nil {
+		dto.ContentHTML = markdown.Render(*dto.Content)
+	}
+
+	updated, err := s.lessons.Update(ctx, dto)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	if lesson.Status != domain.LessonPublished && updated.Status == domain.LessonPublished {
+		s.logger.Info("lesson published", "id", updated.ID)
+		s.notifyPublished(updated)
+	}
+	return updated, nil
+}
+
+// Новое время публикации без состояния означает перенос публикации. Опубликованный
+// урок нельзя вернуть в черновики или запланировать заново, повторная публикация ничего не меняет
+func normalizeStatusUpdate(lesson domain.Lesson, dto *dto.UpdateLessonDTO) error {
+	if dto.Status == nil && dto.PublishAt == nil {
+		return nil
+	}
+	if dto.Status == nil {
+		scheduled := string(domain.LessonScheduled)
+		dto.Status = &scheduled
+	}
+	if lesson.Status == domain.LessonPublished {
+		if domain.LessonStatus(*dto.Status) != domain.LessonPublished {
+			return domain.ErrPublished
+		}
+		dto.Status, dto.PublishAt = nil, nil
+		return nil
+	}
+
+	switch domain.LessonStatus(*dto.Status) {
+	case domain.LessonDraft:
+		dto.PublishAt = nil
+	case domain.LessonPublished:
+		now := time.Now().UTC()
+		dto.PublishAt = &now
+	}
+	return nil
+}
+
+// PublishDue публикует запланированные уроки, время которых наступило, и отправляет
+// события о них. Вызывается планировщиком
+func (s *lessonService) PublishDue(ctx context.Context) error {
+	for {
+		lessons, err := s.lessons.PublishDue(ctx, time.Now().UTC(), publishBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to publish due lessons: %w", err)
+		}
+		for _, lesson := range lessons {
+			s.logger.Info("scheduled lesson published", "id", lesson.ID, "publish_at", lesson.PublishAt)
+			s.notifyPublished(lesson)
+		}
+		if len(lessons) < publishBatchSize {
+			return nil
+		}
+	}
+}
 
-	msg := events.LessonCreated{
+// Студенты узнают об уроке, только когда он становится им виден
+func (s *lessonService) notifyPublished(lesson domain.Lesson) {
+	created := events.LessonCreated{
 		LessonID: lesson.ID,
 		CourseID: lesson.CourseID,
 	}
-	if err := s.producer.PublishLessonCreated(msg); err != nil {
+	if err := s.producer.PublishLessonCreated(created); err != nil {
 		s.logger.Error("failed to publish lesson created event", "err", err)
 	}
+
+	published := events.LessonPublished{
+		LessonID: lesson.ID,
+		CourseID: lesson.CourseID,
+	}
+	if lesson.PublishAt != nil {
+		published.PublishedAt = *lesson.PublishAt
+	}
+	if err := s.producer.PublishLessonPublished(published); err != nil {
+		s.logger.Error("failed to publish lesson published event", "err", err)
+	}
+}
+
+// Возвращает удалённый урок, по его курсу удаляются файлы урока
+func (s *lessonService) Delete(ctx context.Context, id string) (domain.Lesson, error) {
+	lesson, err := s.lessons.GetByID(ctx, id)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := s.checkWritable(ctx, lesson.CourseID); err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := s.lessons.Delete(ctx, id); err != nil {
+		return domain.Lesson{}, err
+	}
 	return lesson, nil
 }
 
-func (s *lessonService) GetByID(ctx context.Context, id string) (domain.Lesson, error) {
-	return s.lessons.GetByID(ctx, id)
+// Копирует уроки в курс, созданный клонированием в Courses. События о создании
+// уроков не публикуются, чтобы студенты не получали уведомления о копиях.
+// Запланированные уроки публикуются на shiftDays дней позже исходных
+func (s *lessonService) CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string, shiftDays int32) (int, error) {
+	for _, courseID := range []string{sourceCourseID, targetCourseID} {
+		courseExists, err := s.lessons.CourseExists(ctx, courseID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check course exists: %w", err)
+		}
+		if !courseExists {
+			return 0, domain.ErrNotFound
+		}
+	}
+	if err := s.checkWritable(ctx, targetCourseID); err != nil {
+		return 0, err
+	}
+
+	copied, err := s.lessons.CloneFromCourse(ctx, sourceCourseID, targetCourseID, shiftDays)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clone lessons: %w", err)
+	}
+	s.logger.Info("lessons cloned", "source_course_id", sourceCourseID, "target_course_id", targetCourseID, "copied", copied)
+	return copied, nil
 }
 
-func (s *lessonService) ListByCourseID(ctx context.Context, courseID string) ([]domain.Lesson, error) {
-	return s.lessons.ListByCourseID(ctx, courseID)
+// Уроки архивного и удалённого курса доступны только для чтения
+func (s *lessonService) checkWritable(ctx context.Context, courseID string) error {
+	return checkCourseWritable(ctx, s.lessons, courseID)
 }
 
-func (s *lessonService) Update(ctx context.Context, dto dto.UpdateLessonDTO) (domain.Lesson, error) {
-	return s.lessons.Update(ctx, dto)
+type courseStates interface {
+	GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error)
+}
+
+func checkCourseWritable(ctx context.Context, states courseStates, courseID string) error {
+	state, err := states.GetCourseState(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course state: %w", err)
+	}
+	if state.ReadOnly() {
+		return domain.ErrReadOnly
+	}
+	return nil
 }
 
-func (s *lessonService) Delete(ctx context.Context, id string) error {
-	return s.lessons.Delete(ctx, id)
+// Урок можно положить только в модуль своего курса
+func (s *lessonService) checkModule(ctx context.Context, courseID, moduleID string) error {
+	moduleExists, err := s.lessons.ModuleExists(ctx, courseID, moduleID)
+	if err != nil {
+		return fmt.Errorf("failed to check module exists: %w", err)
+	}
+	if !moduleExists {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+// Состояние курса приходит событиями из Courses
+func (s *lessonService) CourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error {
+	if err := s.lessons.SetCourseArchived(ctx, courseID, archivedAt); err != nil {
+		return fmt.Errorf("failed to set course archived: %w", err)
+	}
+	s.logger.Info("course archived", "course_id", courseID)
+	return nil
+}
+
+func (s *lessonService) CourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error {
+	if err := s.lessons.SetCourseDeleted(ctx, courseID, deletedAt); err != nil {
+		return fmt.Errorf("failed to set course deleted: %w", err)
+	}
+	s.logger.Info("course deleted", "course_id", courseID)
+	return nil
+}
+
+func (s *lessonService) CourseRestored(ctx context.Context, courseID string) error {
+	if err := s.lessons.ClearCourseState(ctx, courseID); err != nil {
+		return fmt.Errorf("failed to clear course state: %w", err)
+	}
+	s.logger.Info("course restored", "course_id", courseID)
+	return nil
 }
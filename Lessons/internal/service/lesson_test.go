@@ -8,6 +8,7 @@ import (
 	"Classroom/Lessons/pkg/events"
 	"context"
 	"log/slog"
+	"time"
 
 	"testing"
 
@@ -19,6 +20,9 @@ import (
 func TestLessonService_Create(t *testing.T) {
 	type MockBehavior func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer, payload dto.CreateLessonDTO)
 
+	moduleID := "module-id"
+	publishAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
 	testCases := []struct {
 		name         string
 		mockBehavior MockBehavior
@@ -27,31 +31,72 @@ func TestLessonService_Create(t *testing.T) {
 		wantErr      error
 	}{
 		{
-			name: "success",
+			name: "draft by default",
 			payload: dto.CreateLessonDTO{
 				Title:    "Math",
 				CourseID: "course-id",
 				Content:  "Math content",
 			},
 			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer, payload dto.CreateLessonDTO) {
-				lesson := domain.Lesson{
+				payload.Status = string(domain.LessonDraft)
+				payload.ContentHTML = "<p>Math content</p>\n"
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
+				repo.EXPECT().Create(mock.Anything, payload).Return(domain.Lesson{
 					ID:       "lesson-id",
 					Title:    payload.Title,
 					CourseID: payload.CourseID,
 					Content:  payload.Content,
+					Status:   domain.LessonDraft,
+				}, nil)
+			},
+			want: domain.Lesson{
+				ID:       "lesson-id",
+				Title:    "Math",
+				CourseID: "course-id",
+				Content:  "Math content",
+				Status:   domain.LessonDraft,
+			},
+		},
+		{
+			name: "published immediately",
+			payload: dto.CreateLessonDTO{
+				Title:    "Math",
+				CourseID: "course-id",
+				Content:  "Math content",
+				Status:   string(domain.LessonPublished),
+			},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer, payload dto.CreateLessonDTO) {
+				lesson := domain.Lesson{
+					ID:        "lesson-id",
+					Title:     payload.Title,
+					CourseID:  payload.CourseID,
+					Content:   payload.Content,
+					Status:    domain.LessonPublished,
+					PublishAt: &publishAt,
 				}
 				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
-				repo.EXPECT().Create(mock.Anything, payload).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(d dto.CreateLessonDTO) bool {
+					return d.Status == payload.Status && d.PublishAt != nil
+				})).Return(lesson, nil)
 				pr.EXPECT().PublishLessonCreated(events.LessonCreated{
 					LessonID: lesson.ID,
 					CourseID: lesson.CourseID,
 				}).Return(nil)
+				pr.EXPECT().PublishLessonPublished(events.LessonPublished{
+					LessonID:    lesson.ID,
+					CourseID:    lesson.CourseID,
+					PublishedAt: publishAt,
+				}).Return(nil)
 			},
 			want: domain.Lesson{
-				ID:       "lesson-id",
-				Title:    "Math",
-				CourseID: "course-id",
-				Content:  "Math content",
+				ID:        "lesson-id",
+				Title:     "Math",
+				CourseID:  "course-id",
+				Content:   "Math content",
+				Status:    domain.LessonPublished,
+				PublishAt: &publishAt,
 			},
 		},
 		{
@@ -62,11 +107,44 @@ func TestLessonService_Create(t *testing.T) {
 				Content:  "Math content",
 			},
 			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer, payload dto.CreateLessonDTO) {
+				payload.Status = string(domain.LessonDraft)
+				payload.ContentHTML = "<p>Math content</p>\n"
 				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
 				repo.EXPECT().Create(mock.Anything, payload).Return(domain.Lesson{}, assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
+		{
+			name: "course is archived",
+			payload: dto.CreateLessonDTO{
+				Title:    "Math",
+				CourseID: "course-id",
+				Content:  "Math content",
+			},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer, payload dto.CreateLessonDTO) {
+				archivedAt := time.Now()
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).
+					Return(domain.CourseState{CourseID: payload.CourseID, ArchivedAt: &archivedAt}, nil)
+			},
+			wantErr: domain.ErrReadOnly,
+		},
+		{
+			name: "module of another course",
+			payload: dto.CreateLessonDTO{
+				Title:    "Math",
+				CourseID: "course-id",
+				Content:  "Math content",
+				ModuleID: &moduleID,
+			},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer, payload dto.CreateLessonDTO) {
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
+				repo.EXPECT().ModuleExists(mock.Anything, payload.CourseID, moduleID).Return(false, nil)
+			},
+			wantErr: domain.ErrInvalidInput,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -85,3 +163,302 @@ func TestLessonService_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestLessonService_CloneFromCourse(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockLessonRepo)
+
+	const (
+		sourceID  = "source-id"
+		targetID  = "target-id"
+		shiftDays = int32(365)
+	)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         int
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockLessonRepo) {
+				repo.EXPECT().CourseExists(mock.Anything, sourceID).Return(true, nil)
+				repo.EXPECT().CourseExists(mock.Anything, targetID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, targetID).Return(domain.CourseState{CourseID: targetID}, nil)
+				repo.EXPECT().CloneFromCourse(mock.Anything, sourceID, targetID, shiftDays).Return(3, nil)
+			},
+			want: 3,
+		},
+		{
+			name: "target course not found",
+			mockBehavior: func(repo *mocks.MockLessonRepo) {
+				repo.EXPECT().CourseExists(mock.Anything, sourceID).Return(true, nil)
+				repo.EXPECT().CourseExists(mock.Anything, targetID).Return(false, nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "target course is deleted",
+			mockBehavior: func(repo *mocks.MockLessonRepo) {
+				deletedAt := time.Now()
+				repo.EXPECT().CourseExists(mock.Anything, sourceID).Return(true, nil)
+				repo.EXPECT().CourseExists(mock.Anything, targetID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, targetID).
+					Return(domain.CourseState{CourseID: targetID, DeletedAt: &deletedAt}, nil)
+			},
+			wantErr: domain.ErrReadOnly,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockLessonRepo(t)
+			pr := mocks.NewMockProducer(t)
+			tc.mockBehavior(repo)
+			svc := service.NewLessonService(slog.Default(), repo, pr)
+			got, err := svc.CloneFromCourse(context.Background(), sourceID, targetID, shiftDays)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLessonService_Reorder(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockLessonRepo, payload dto.ReorderLessonsDTO)
+
+	payload := dto.ReorderLessonsDTO{
+		CourseID:  "course-id",
+		LessonIDs: []string{"lesson-2", "lesson-1"},
+		Version:   4,
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         []domain.Lesson
+		wantVersion  int64
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockLessonRepo, payload dto.ReorderLessonsDTO) {
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
+				repo.EXPECT().ReorderLessons(mock.Anything, payload.CourseID, payload.LessonIDs, payload.Version).
+					Return([]domain.Lesson{{ID: "lesson-2", Position: 0}, {ID: "lesson-1", Position: 1}}, 5, nil)
+			},
+			want:        []domain.Lesson{{ID: "lesson-2", Position: 0}, {ID: "lesson-1", Position: 1}},
+			wantVersion: 5,
+		},
+		{
+			name: "course not found",
+			mockBehavior: func(repo *mocks.MockLessonRepo, payload dto.ReorderLessonsDTO) {
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(false, nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "course is archived",
+			mockBehavior: func(repo *mocks.MockLessonRepo, payload dto.ReorderLessonsDTO) {
+				archivedAt := time.Now()
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).
+					Return(domain.CourseState{CourseID: payload.CourseID, ArchivedAt: &archivedAt}, nil)
+			},
+			wantErr: domain.ErrReadOnly,
+		},
+		{
+			name: "order changed concurrently",
+			mockBehavior: func(repo *mocks.MockLessonRepo, payload dto.ReorderLessonsDTO) {
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
+				repo.EXPECT().ReorderLessons(mock.Anything, payload.CourseID, payload.LessonIDs, payload.Version).
+					Return(nil, 0, domain.ErrConflict)
+			},
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name: "lesson ids do not match the course",
+			mockBehavior: func(repo *mocks.MockLessonRepo, payload dto.ReorderLessonsDTO) {
+				repo.EXPECT().CourseExists(mock.Anything, payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
+				repo.EXPECT().ReorderLessons(mock.Anything, payload.CourseID, payload.LessonIDs, payload.Version).
+					Return(nil, 0, domain.ErrInvalidInput)
+			},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockLessonRepo(t)
+			pr := mocks.NewMockProducer(t)
+			tc.mockBehavior(repo, payload)
+			svc := service.NewLessonService(slog.Default(), repo, pr)
+			got, version, err := svc.Reorder(context.Background(), payload)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantVersion, version)
+		})
+	}
+}
+
+func TestLessonService_Update(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer)
+
+	const lessonID = "lesson-id"
+	publishAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	draft := domain.Lesson{ID: lessonID, CourseID: "course-id", Status: domain.LessonDraft}
+	published := domain.Lesson{ID: lessonID, CourseID: "course-id", Status: domain.LessonPublished, PublishAt: &publishAt}
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		payload      dto.UpdateLessonDTO
+		want         domain.Lesson
+		wantErr      error
+	}{
+		{
+			name:    "publish draft",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, Status: strPtr("published")},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(draft, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, draft.CourseID).Return(domain.CourseState{CourseID: draft.CourseID}, nil)
+				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(d dto.UpdateLessonDTO) bool {
+					return *d.Status == "published" && d.PublishAt != nil
+				})).Return(published, nil)
+				pr.EXPECT().PublishLessonCreated(events.LessonCreated{LessonID: lessonID, CourseID: draft.CourseID}).Return(nil)
+				pr.EXPECT().PublishLessonPublished(events.LessonPublished{
+					LessonID:    lessonID,
+					CourseID:    draft.CourseID,
+					PublishedAt: publishAt,
+				}).Return(nil)
+			},
+			want: published,
+		},
+		{
+			name:    "reschedule draft",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, PublishAt: &publishAt},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				scheduled := domain.Lesson{ID: lessonID, CourseID: "course-id", Status: domain.LessonScheduled, PublishAt: &publishAt}
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(draft, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, draft.CourseID).Return(domain.CourseState{CourseID: draft.CourseID}, nil)
+				repo.EXPECT().Update(mock.Anything, dto.UpdateLessonDTO{
+					LessonID:  lessonID,
+					Status:    strPtr("scheduled"),
+					PublishAt: &publishAt,
+				}).Return(scheduled, nil)
+			},
+			want: domain.Lesson{ID: lessonID, CourseID: "course-id", Status: domain.LessonScheduled, PublishAt: &publishAt},
+		},
+		{
+			name:    "status change with unchanged content",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, Title: strPtr("Intro"), Content: strPtr("# Intro"), Status: strPtr("published")},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				lesson := draft
+				lesson.Title, lesson.Content = "Intro", "# Intro"
+				updated := published
+				updated.Title, updated.Content = "Intro", "# Intro"
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, draft.CourseID).Return(domain.CourseState{CourseID: draft.CourseID}, nil)
+				// Без названия и содержания репозиторий не создаёт ревизию
+				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(d dto.UpdateLessonDTO) bool {
+					return d.Title == nil && d.Content == nil && *d.Status == "published"
+				})).Return(updated, nil)
+				pr.EXPECT().PublishLessonCreated(events.LessonCreated{LessonID: lessonID, CourseID: draft.CourseID}).Return(nil)
+				pr.EXPECT().PublishLessonPublished(events.LessonPublished{
+					LessonID:    lessonID,
+					CourseID:    draft.CourseID,
+					PublishedAt: publishAt,
+				}).Return(nil)
+			},
+			want: domain.Lesson{ID: lessonID, CourseID: "course-id", Title: "Intro", Content: "# Intro", Status: domain.LessonPublished, PublishAt: &publishAt},
+		},
+		{
+			name:    "unchanged content is not saved",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, Title: strPtr("Intro")},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				lesson := draft
+				lesson.Title = "Intro"
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(lesson, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, draft.CourseID).Return(domain.CourseState{CourseID: draft.CourseID}, nil)
+			},
+			want: domain.Lesson{ID: lessonID, CourseID: "course-id", Title: "Intro", Status: domain.LessonDraft},
+		},
+		{
+			name:    "unpublish published lesson",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, Status: strPtr("draft")},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(published, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, published.CourseID).Return(domain.CourseState{CourseID: published.CourseID}, nil)
+			},
+			wantErr: domain.ErrPublished,
+		},
+		{
+			name:    "publish published lesson again",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, Status: strPtr("published")},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(published, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, published.CourseID).Return(domain.CourseState{CourseID: published.CourseID}, nil)
+			},
+			want: published,
+		},
+		{
+			name:    "published concurrently",
+			payload: dto.UpdateLessonDTO{LessonID: lessonID, Status: strPtr("published")},
+			mockBehavior: func(repo *mocks.MockLessonRepo, pr *mocks.MockProducer) {
+				repo.EXPECT().GetByID(mock.Anything, lessonID).Return(draft, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, draft.CourseID).Return(domain.CourseState{CourseID: draft.CourseID}, nil)
+				repo.EXPECT().Update(mock.Anything, mock.Anything).Return(domain.Lesson{}, domain.ErrConflict)
+			},
+			wantErr: domain.ErrConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockLessonRepo(t)
+			pr := mocks.NewMockProducer(t)
+			tc.mockBehavior(repo, pr)
+			svc := service.NewLessonService(slog.Default(), repo, pr)
+			got, err := svc.Update(context.Background(), tc.payload)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLessonService_PublishDue(t *testing.T) {
+	repo := mocks.NewMockLessonRepo(t)
+	pr := mocks.NewMockProducer(t)
+
+	publishAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	lesson := domain.Lesson{ID: "lesson-id", CourseID: "course-id", Status: domain.LessonPublished, PublishAt: &publishAt}
+	repo.EXPECT().PublishDue(mock.Anything, mock.Anything, uint64(100)).Return([]domain.Lesson{lesson}, nil)
+	pr.EXPECT().PublishLessonCreated(events.LessonCreated{LessonID: lesson.ID, CourseID: lesson.CourseID}).Return(nil)
+	pr.EXPECT().PublishLessonPublished(events.LessonPublished{
+		LessonID:    lesson.ID,
+		CourseID:    lesson.CourseID,
+		PublishedAt: publishAt,
+	}).Return(assert.AnError)
+
+	svc := service.NewLessonService(slog.Default(), repo, pr)
+	// Ошибка отправки события не откатывает публикацию
+	require.NoError(t, svc.PublishDue(context.Background()))
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"Classroom/Lessons/internal/markdown"
+	"context"
+	"fmt"
+)
+
+// Сколько уроков отрисовывается за один запрос к бд
+const renderBatchSize = 100
+
+// Preview отрисовывает содержание так же, как оно сохранится в уроке
+func (s *lessonService) Preview(content string) string {
+	return markdown.Render(content)
+}
+
+// RenderPending отрисовывает уроки, созданные до появления HTML содержания.
+// Вызывается при запуске сервиса
+func (s *lessonService) RenderPending(ctx context.Context) error {
+	var afterID string
+	rendered := 0
+	for {
+		lessons, err := s.lessons.ListUnrendered(ctx, afterID, renderBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list unrendered lessons: %w", err)
+		}
+		for _, lesson := range lessons {
+			// Если урок успели изменить, HTML уже записан вместе с новым содержанием
+			if err := s.lessons.SetContentHTML(ctx, lesson.ID, lesson.Content, markdown.Render(lesson.Content)); err != nil {
+				return fmt.Errorf("failed to set content html: %w", err)
+			}
+			afterID = lesson.ID
+		}
+		rendered += len(lessons)
+		if len(lessons) < renderBatchSize {
+			if rendered > 0 {
+				s.logger.Info("lessons content rendered", "count", rendered)
+			}
+			return nil
+		}
+	}
+}
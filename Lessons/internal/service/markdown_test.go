@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/service"
+	mocks "Classroom/Lessons/internal/service/mocks"
+	"context"
+	"log/slog"
+
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonService_Preview(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "empty content",
+			content: "  \n",
+			want:    "",
+		},
+		{
+			name:    "code block",
+			content: "```go\nfmt.Println(\"<b>$x$</b>\")\n```",
+			want:    "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;b&gt;$x$&lt;/b&gt;&#34;)\n</code></pre>\n",
+		},
+		{
+			name:    "table",
+			content: "| a | b |\n|:--|--:|\n| 1 | **2** |",
+			want: "<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"right\">b</th>\n</tr>\n</thead>\n\n" +
+				"<tbody>\n<tr>\n<td align=\"left\">1</td>\n<td align=\"right\"><strong>2</strong></td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			name:    "math",
+			content: "Sum $a_1 + b_1$ costs $5 and $10\n\n$$\nx < y_i\n$$",
+			want: "<p>Sum <span class=\"math math-inline\">\\(a_1 + b_1\\)</span> costs $5 and $10</p>\n\n" +
+				"<p><span class=\"math math-display\">\\[x &lt; y_i\\]</span></p>\n",
+		},
+		{
+			name:    "script and event handlers",
+			content: "<script>alert(1)</script><img src=\"x.png\" onerror=\"alert(1)\"><div onclick=\"alert(1)\">text</div>",
+			want:    "<p><img src=\"x.png\" />text</p>\n",
+		},
+		{
+			name:    "unsafe links",
+			content: "[a](javascript:alert) <a href=\"&#106;avascript:alert(1)\">b</a> [c](https://example.com)",
+			want:    "<p><a>a</a> <a>b</a> <a href=\"https://example.com\" rel=\"nofollow noopener noreferrer\">c</a></p>\n",
+		},
+		{
+			name:    "unknown classes",
+			content: "<span class=\"math evil\">x</span>",
+			want:    "<p><span class=\"math\">x</span></p>\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewLessonService(slog.Default(), mocks.NewMockLessonRepo(t), mocks.NewMockProducer(t))
+			assert.Equal(t, tc.want, svc.Preview(tc.content))
+		})
+	}
+}
+
+func TestLessonService_RenderPending(t *testing.T) {
+	repo := mocks.NewMockLessonRepo(t)
+	repo.EXPECT().ListUnrendered(mock.Anything, "", uint64(100)).Return([]domain.Lesson{
+		{ID: "first", Content: "*first*"},
+		{ID: "second", Content: "second"},
+	}, nil)
+	repo.EXPECT().SetContentHTML(mock.Anything, "first", "*first*", "<p><em>first</em></p>\n").Return(nil)
+	repo.EXPECT().SetContentHTML(mock.Anything, "second", "second", "<p>second</p>\n").Return(nil)
+
+	svc := service.NewLessonService(slog.Default(), repo, mocks.NewMockProducer(t))
+	require.NoError(t, svc.RenderPending(context.Background()))
+}
@@ -8,6 +8,7 @@ import (
 	"Classroom/Lessons/internal/domain"
 	"Classroom/Lessons/internal/dto"
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,6 +40,109 @@ func (_m *MockLessonRepo) EXPECT() *MockLessonRepo_Expecter {
 	return &MockLessonRepo_Expecter{mock: &_m.Mock}
 }
 
+// ClearCourseState provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) ClearCourseState(ctx context.Context, courseID string) error {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearCourseState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLessonRepo_ClearCourseState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCourseState'
+type MockLessonRepo_ClearCourseState_Call struct {
+	*mock.Call
+}
+
+// ClearCourseState is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockLessonRepo_Expecter) ClearCourseState(ctx interface{}, courseID interface{}) *MockLessonRepo_ClearCourseState_Call {
+	return &MockLessonRepo_ClearCourseState_Call{Call: _e.mock.On("ClearCourseState", ctx, courseID)}
+}
+
+func (_c *MockLessonRepo_ClearCourseState_Call) Run(run func(ctx context.Context, courseID string)) *MockLessonRepo_ClearCourseState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_ClearCourseState_Call) Return(err error) *MockLessonRepo_ClearCourseState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLessonRepo_ClearCourseState_Call) RunAndReturn(run func(ctx context.Context, courseID string) error) *MockLessonRepo_ClearCourseState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneFromCourse provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) CloneFromCourse(ctx context.Context, sourceCourseID string, targetCourseID string, shiftDays int32) (int, error) {
+	ret := _mock.Called(ctx, sourceCourseID, targetCourseID, shiftDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneFromCourse")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int32) (int, error)); ok {
+		return returnFunc(ctx, sourceCourseID, targetCourseID, shiftDays)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int32) int); ok {
+		r0 = returnFunc(ctx, sourceCourseID, targetCourseID, shiftDays)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = returnFunc(ctx, sourceCourseID, targetCourseID, shiftDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_CloneFromCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneFromCourse'
+type MockLessonRepo_CloneFromCourse_Call struct {
+	*mock.Call
+}
+
+// CloneFromCourse is a helper method to define mock.On call
+//   - ctx
+//   - sourceCourseID
+//   - targetCourseID
+//   - shiftDays
+func (_e *MockLessonRepo_Expecter) CloneFromCourse(ctx interface{}, sourceCourseID interface{}, targetCourseID interface{}, shiftDays interface{}) *MockLessonRepo_CloneFromCourse_Call {
+	return &MockLessonRepo_CloneFromCourse_Call{Call: _e.mock.On("CloneFromCourse", ctx, sourceCourseID, targetCourseID, shiftDays)}
+}
+
+func (_c *MockLessonRepo_CloneFromCourse_Call) Run(run func(ctx context.Context, sourceCourseID string, targetCourseID string, shiftDays int32)) *MockLessonRepo_CloneFromCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_CloneFromCourse_Call) Return(n int, err error) *MockLessonRepo_CloneFromCourse_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_CloneFromCourse_Call) RunAndReturn(run func(ctx context.Context, sourceCourseID string, targetCourseID string, shiftDays int32) (int, error)) *MockLessonRepo_CloneFromCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CourseExists provides a mock function for the type MockLessonRepo
 func (_mock *MockLessonRepo) CourseExists(ctx context.Context, courseID string) (bool, error) {
 	ret := _mock.Called(ctx, courseID)
@@ -250,28 +354,194 @@ func (_c *MockLessonRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
-// ListByCourseID provides a mock function for the type MockLessonRepo
-func (_mock *MockLessonRepo) ListByCourseID(ctx context.Context, courseID string) ([]domain.Lesson, error) {
+// GetCourseState provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error) {
 	ret := _mock.Called(ctx, courseID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByCourseID")
+		panic("no return value specified for GetCourseState")
 	}
 
-	var r0 []domain.Lesson
+	var r0 domain.CourseState
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Lesson, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.CourseState, error)); ok {
 		return returnFunc(ctx, courseID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Lesson); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.CourseState); ok {
 		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Get(0).(domain.CourseState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_GetCourseState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCourseState'
+type MockLessonRepo_GetCourseState_Call struct {
+	*mock.Call
+}
+
+// GetCourseState is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockLessonRepo_Expecter) GetCourseState(ctx interface{}, courseID interface{}) *MockLessonRepo_GetCourseState_Call {
+	return &MockLessonRepo_GetCourseState_Call{Call: _e.mock.On("GetCourseState", ctx, courseID)}
+}
+
+func (_c *MockLessonRepo_GetCourseState_Call) Run(run func(ctx context.Context, courseID string)) *MockLessonRepo_GetCourseState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_GetCourseState_Call) Return(courseState domain.CourseState, err error) *MockLessonRepo_GetCourseState_Call {
+	_c.Call.Return(courseState, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_GetCourseState_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.CourseState, error)) *MockLessonRepo_GetCourseState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderVersion provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) GetOrderVersion(ctx context.Context, courseID string) (int64, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderVersion")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_GetOrderVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderVersion'
+type MockLessonRepo_GetOrderVersion_Call struct {
+	*mock.Call
+}
+
+// GetOrderVersion is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockLessonRepo_Expecter) GetOrderVersion(ctx interface{}, courseID interface{}) *MockLessonRepo_GetOrderVersion_Call {
+	return &MockLessonRepo_GetOrderVersion_Call{Call: _e.mock.On("GetOrderVersion", ctx, courseID)}
+}
+
+func (_c *MockLessonRepo_GetOrderVersion_Call) Run(run func(ctx context.Context, courseID string)) *MockLessonRepo_GetOrderVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_GetOrderVersion_Call) Return(n int64, err error) *MockLessonRepo_GetOrderVersion_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_GetOrderVersion_Call) RunAndReturn(run func(ctx context.Context, courseID string) (int64, error)) *MockLessonRepo_GetOrderVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) GetRevision(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error) {
+	ret := _mock.Called(ctx, lessonID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 domain.LessonRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32) (domain.LessonRevision, error)); ok {
+		return returnFunc(ctx, lessonID, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32) domain.LessonRevision); ok {
+		r0 = returnFunc(ctx, lessonID, revision)
+	} else {
+		r0 = ret.Get(0).(domain.LessonRevision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = returnFunc(ctx, lessonID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockLessonRepo_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - ctx
+//   - lessonID
+//   - revision
+func (_e *MockLessonRepo_Expecter) GetRevision(ctx interface{}, lessonID interface{}, revision interface{}) *MockLessonRepo_GetRevision_Call {
+	return &MockLessonRepo_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx, lessonID, revision)}
+}
+
+func (_c *MockLessonRepo_GetRevision_Call) Run(run func(ctx context.Context, lessonID string, revision int32)) *MockLessonRepo_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_GetRevision_Call) Return(lessonRevision domain.LessonRevision, err error) *MockLessonRepo_GetRevision_Call {
+	_c.Call.Return(lessonRevision, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_GetRevision_Call) RunAndReturn(run func(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error)) *MockLessonRepo_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByCourseID provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) ListByCourseID(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, error) {
+	ret := _mock.Called(ctx, courseID, includeUnpublished)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCourseID")
+	}
+
+	var r0 []domain.Lesson
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) ([]domain.Lesson, error)); ok {
+		return returnFunc(ctx, courseID, includeUnpublished)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) []domain.Lesson); ok {
+		r0 = returnFunc(ctx, courseID, includeUnpublished)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Lesson)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, courseID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, courseID, includeUnpublished)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -286,13 +556,14 @@ type MockLessonRepo_ListByCourseID_Call struct {
 // ListByCourseID is a helper method to define mock.On call
 //   - ctx
 //   - courseID
-func (_e *MockLessonRepo_Expecter) ListByCourseID(ctx interface{}, courseID interface{}) *MockLessonRepo_ListByCourseID_Call {
-	return &MockLessonRepo_ListByCourseID_Call{Call: _e.mock.On("ListByCourseID", ctx, courseID)}
+//   - includeUnpublished
+func (_e *MockLessonRepo_Expecter) ListByCourseID(ctx interface{}, courseID interface{}, includeUnpublished interface{}) *MockLessonRepo_ListByCourseID_Call {
+	return &MockLessonRepo_ListByCourseID_Call{Call: _e.mock.On("ListByCourseID", ctx, courseID, includeUnpublished)}
 }
 
-func (_c *MockLessonRepo_ListByCourseID_Call) Run(run func(ctx context.Context, courseID string)) *MockLessonRepo_ListByCourseID_Call {
+func (_c *MockLessonRepo_ListByCourseID_Call) Run(run func(ctx context.Context, courseID string, includeUnpublished bool)) *MockLessonRepo_ListByCourseID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
 	})
 	return _c
 }
@@ -302,7 +573,501 @@ func (_c *MockLessonRepo_ListByCourseID_Call) Return(lessons []domain.Lesson, er
 	return _c
 }
 
-func (_c *MockLessonRepo_ListByCourseID_Call) RunAndReturn(run func(ctx context.Context, courseID string) ([]domain.Lesson, error)) *MockLessonRepo_ListByCourseID_Call {
+func (_c *MockLessonRepo_ListByCourseID_Call) RunAndReturn(run func(ctx context.Context, courseID string, includeUnpublished bool) ([]domain.Lesson, error)) *MockLessonRepo_ListByCourseID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) ListRevisions(ctx context.Context, lessonID string) ([]domain.LessonRevision, error) {
+	ret := _mock.Called(ctx, lessonID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []domain.LessonRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.LessonRevision, error)); ok {
+		return returnFunc(ctx, lessonID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.LessonRevision); ok {
+		r0 = returnFunc(ctx, lessonID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LessonRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, lessonID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type MockLessonRepo_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - ctx
+//   - lessonID
+func (_e *MockLessonRepo_Expecter) ListRevisions(ctx interface{}, lessonID interface{}) *MockLessonRepo_ListRevisions_Call {
+	return &MockLessonRepo_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, lessonID)}
+}
+
+func (_c *MockLessonRepo_ListRevisions_Call) Run(run func(ctx context.Context, lessonID string)) *MockLessonRepo_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_ListRevisions_Call) Return(lessonRevisions []domain.LessonRevision, err error) *MockLessonRepo_ListRevisions_Call {
+	_c.Call.Return(lessonRevisions, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_ListRevisions_Call) RunAndReturn(run func(ctx context.Context, lessonID string) ([]domain.LessonRevision, error)) *MockLessonRepo_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnrendered provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) ListUnrendered(ctx context.Context, afterID string, limit uint64) ([]domain.Lesson, error) {
+	ret := _mock.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnrendered")
+	}
+
+	var r0 []domain.Lesson
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64) ([]domain.Lesson, error)); ok {
+		return returnFunc(ctx, afterID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64) []domain.Lesson); ok {
+		r0 = returnFunc(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Lesson)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = returnFunc(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_ListUnrendered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnrendered'
+type MockLessonRepo_ListUnrendered_Call struct {
+	*mock.Call
+}
+
+// ListUnrendered is a helper method to define mock.On call
+//   - ctx
+//   - afterID
+//   - limit
+func (_e *MockLessonRepo_Expecter) ListUnrendered(ctx interface{}, afterID interface{}, limit interface{}) *MockLessonRepo_ListUnrendered_Call {
+	return &MockLessonRepo_ListUnrendered_Call{Call: _e.mock.On("ListUnrendered", ctx, afterID, limit)}
+}
+
+func (_c *MockLessonRepo_ListUnrendered_Call) Run(run func(ctx context.Context, afterID string, limit uint64)) *MockLessonRepo_ListUnrendered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_ListUnrendered_Call) Return(lessons []domain.Lesson, err error) *MockLessonRepo_ListUnrendered_Call {
+	_c.Call.Return(lessons, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_ListUnrendered_Call) RunAndReturn(run func(ctx context.Context, afterID string, limit uint64) ([]domain.Lesson, error)) *MockLessonRepo_ListUnrendered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ModuleExists provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) ModuleExists(ctx context.Context, courseID string, moduleID string) (bool, error) {
+	ret := _mock.Called(ctx, courseID, moduleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModuleExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, courseID, moduleID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, courseID, moduleID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, moduleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_ModuleExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModuleExists'
+type MockLessonRepo_ModuleExists_Call struct {
+	*mock.Call
+}
+
+// ModuleExists is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - moduleID
+func (_e *MockLessonRepo_Expecter) ModuleExists(ctx interface{}, courseID interface{}, moduleID interface{}) *MockLessonRepo_ModuleExists_Call {
+	return &MockLessonRepo_ModuleExists_Call{Call: _e.mock.On("ModuleExists", ctx, courseID, moduleID)}
+}
+
+func (_c *MockLessonRepo_ModuleExists_Call) Run(run func(ctx context.Context, courseID string, moduleID string)) *MockLessonRepo_ModuleExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_ModuleExists_Call) Return(b bool, err error) *MockLessonRepo_ModuleExists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_ModuleExists_Call) RunAndReturn(run func(ctx context.Context, courseID string, moduleID string) (bool, error)) *MockLessonRepo_ModuleExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishDue provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) PublishDue(ctx context.Context, now time.Time, limit uint64) ([]domain.Lesson, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDue")
+	}
+
+	var r0 []domain.Lesson
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, uint64) ([]domain.Lesson, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, uint64) []domain.Lesson); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Lesson)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, uint64) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_PublishDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDue'
+type MockLessonRepo_PublishDue_Call struct {
+	*mock.Call
+}
+
+// PublishDue is a helper method to define mock.On call
+//   - ctx
+//   - now
+//   - limit
+func (_e *MockLessonRepo_Expecter) PublishDue(ctx interface{}, now interface{}, limit interface{}) *MockLessonRepo_PublishDue_Call {
+	return &MockLessonRepo_PublishDue_Call{Call: _e.mock.On("PublishDue", ctx, now, limit)}
+}
+
+func (_c *MockLessonRepo_PublishDue_Call) Run(run func(ctx context.Context, now time.Time, limit uint64)) *MockLessonRepo_PublishDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_PublishDue_Call) Return(lessons []domain.Lesson, err error) *MockLessonRepo_PublishDue_Call {
+	_c.Call.Return(lessons, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_PublishDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit uint64) ([]domain.Lesson, error)) *MockLessonRepo_PublishDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderLessons provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) ReorderLessons(ctx context.Context, courseID string, lessonIDs []string, version int64) ([]domain.Lesson, int64, error) {
+	ret := _mock.Called(ctx, courseID, lessonIDs, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderLessons")
+	}
+
+	var r0 []domain.Lesson
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int64) ([]domain.Lesson, int64, error)); ok {
+		return returnFunc(ctx, courseID, lessonIDs, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int64) []domain.Lesson); ok {
+		r0 = returnFunc(ctx, courseID, lessonIDs, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Lesson)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int64) int64); ok {
+		r1 = returnFunc(ctx, courseID, lessonIDs, version)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, []string, int64) error); ok {
+		r2 = returnFunc(ctx, courseID, lessonIDs, version)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLessonRepo_ReorderLessons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderLessons'
+type MockLessonRepo_ReorderLessons_Call struct {
+	*mock.Call
+}
+
+// ReorderLessons is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - lessonIDs
+//   - version
+func (_e *MockLessonRepo_Expecter) ReorderLessons(ctx interface{}, courseID interface{}, lessonIDs interface{}, version interface{}) *MockLessonRepo_ReorderLessons_Call {
+	return &MockLessonRepo_ReorderLessons_Call{Call: _e.mock.On("ReorderLessons", ctx, courseID, lessonIDs, version)}
+}
+
+func (_c *MockLessonRepo_ReorderLessons_Call) Run(run func(ctx context.Context, courseID string, lessonIDs []string, version int64)) *MockLessonRepo_ReorderLessons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_ReorderLessons_Call) Return(lessons []domain.Lesson, n int64, err error) *MockLessonRepo_ReorderLessons_Call {
+	_c.Call.Return(lessons, n, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_ReorderLessons_Call) RunAndReturn(run func(ctx context.Context, courseID string, lessonIDs []string, version int64) ([]domain.Lesson, int64, error)) *MockLessonRepo_ReorderLessons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreRevision provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) RestoreRevision(ctx context.Context, lessonID string, revision int32, contentHTML string, authorID string) (domain.Lesson, error) {
+	ret := _mock.Called(ctx, lessonID, revision, contentHTML, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 domain.Lesson
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, string, string) (domain.Lesson, error)); ok {
+		return returnFunc(ctx, lessonID, revision, contentHTML, authorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, string, string) domain.Lesson); ok {
+		r0 = returnFunc(ctx, lessonID, revision, contentHTML, authorID)
+	} else {
+		r0 = ret.Get(0).(domain.Lesson)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32, string, string) error); ok {
+		r1 = returnFunc(ctx, lessonID, revision, contentHTML, authorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLessonRepo_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type MockLessonRepo_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - ctx
+//   - lessonID
+//   - revision
+//   - contentHTML
+//   - authorID
+func (_e *MockLessonRepo_Expecter) RestoreRevision(ctx interface{}, lessonID interface{}, revision interface{}, contentHTML interface{}, authorID interface{}) *MockLessonRepo_RestoreRevision_Call {
+	return &MockLessonRepo_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", ctx, lessonID, revision, contentHTML, authorID)}
+}
+
+func (_c *MockLessonRepo_RestoreRevision_Call) Run(run func(ctx context.Context, lessonID string, revision int32, contentHTML string, authorID string)) *MockLessonRepo_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_RestoreRevision_Call) Return(lesson domain.Lesson, err error) *MockLessonRepo_RestoreRevision_Call {
+	_c.Call.Return(lesson, err)
+	return _c
+}
+
+func (_c *MockLessonRepo_RestoreRevision_Call) RunAndReturn(run func(ctx context.Context, lessonID string, revision int32, contentHTML string, authorID string) (domain.Lesson, error)) *MockLessonRepo_RestoreRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetContentHTML provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) SetContentHTML(ctx context.Context, lessonID string, content string, contentHTML string) error {
+	ret := _mock.Called(ctx, lessonID, content, contentHTML)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetContentHTML")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, lessonID, content, contentHTML)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLessonRepo_SetContentHTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetContentHTML'
+type MockLessonRepo_SetContentHTML_Call struct {
+	*mock.Call
+}
+
+// SetContentHTML is a helper method to define mock.On call
+//   - ctx
+//   - lessonID
+//   - content
+//   - contentHTML
+func (_e *MockLessonRepo_Expecter) SetContentHTML(ctx interface{}, lessonID interface{}, content interface{}, contentHTML interface{}) *MockLessonRepo_SetContentHTML_Call {
+	return &MockLessonRepo_SetContentHTML_Call{Call: _e.mock.On("SetContentHTML", ctx, lessonID, content, contentHTML)}
+}
+
+func (_c *MockLessonRepo_SetContentHTML_Call) Run(run func(ctx context.Context, lessonID string, content string, contentHTML string)) *MockLessonRepo_SetContentHTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_SetContentHTML_Call) Return(err error) *MockLessonRepo_SetContentHTML_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLessonRepo_SetContentHTML_Call) RunAndReturn(run func(ctx context.Context, lessonID string, content string, contentHTML string) error) *MockLessonRepo_SetContentHTML_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCourseArchived provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) SetCourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error {
+	ret := _mock.Called(ctx, courseID, archivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCourseArchived")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, courseID, archivedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLessonRepo_SetCourseArchived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCourseArchived'
+type MockLessonRepo_SetCourseArchived_Call struct {
+	*mock.Call
+}
+
+// SetCourseArchived is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - archivedAt
+func (_e *MockLessonRepo_Expecter) SetCourseArchived(ctx interface{}, courseID interface{}, archivedAt interface{}) *MockLessonRepo_SetCourseArchived_Call {
+	return &MockLessonRepo_SetCourseArchived_Call{Call: _e.mock.On("SetCourseArchived", ctx, courseID, archivedAt)}
+}
+
+func (_c *MockLessonRepo_SetCourseArchived_Call) Run(run func(ctx context.Context, courseID string, archivedAt time.Time)) *MockLessonRepo_SetCourseArchived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_SetCourseArchived_Call) Return(err error) *MockLessonRepo_SetCourseArchived_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLessonRepo_SetCourseArchived_Call) RunAndReturn(run func(ctx context.Context, courseID string, archivedAt time.Time) error) *MockLessonRepo_SetCourseArchived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCourseDeleted provides a mock function for the type MockLessonRepo
+func (_mock *MockLessonRepo) SetCourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error {
+	ret := _mock.Called(ctx, courseID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCourseDeleted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, courseID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLessonRepo_SetCourseDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCourseDeleted'
+type MockLessonRepo_SetCourseDeleted_Call struct {
+	*mock.Call
+}
+
+// SetCourseDeleted is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - deletedAt
+func (_e *MockLessonRepo_Expecter) SetCourseDeleted(ctx interface{}, courseID interface{}, deletedAt interface{}) *MockLessonRepo_SetCourseDeleted_Call {
+	return &MockLessonRepo_SetCourseDeleted_Call{Call: _e.mock.On("SetCourseDeleted", ctx, courseID, deletedAt)}
+}
+
+func (_c *MockLessonRepo_SetCourseDeleted_Call) Run(run func(ctx context.Context, courseID string, deletedAt time.Time)) *MockLessonRepo_SetCourseDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLessonRepo_SetCourseDeleted_Call) Return(err error) *MockLessonRepo_SetCourseDeleted_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLessonRepo_SetCourseDeleted_Call) RunAndReturn(run func(ctx context.Context, courseID string, deletedAt time.Time) error) *MockLessonRepo_SetCourseDeleted_Call {
 	_c.Call.Return(run)
 	return _c
 }
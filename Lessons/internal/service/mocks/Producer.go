@@ -81,3 +81,48 @@ func (_c *MockProducer_PublishLessonCreated_Call) RunAndReturn(run func(msg even
 	_c.Call.Return(run)
 	return _c
 }
+
+// PublishLessonPublished provides a mock function for the type MockProducer
+func (_mock *MockProducer) PublishLessonPublished(msg events.LessonPublished) error {
+	ret := _mock.Called(msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishLessonPublished")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(events.LessonPublished) error); ok {
+		r0 = returnFunc(msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProducer_PublishLessonPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishLessonPublished'
+type MockProducer_PublishLessonPublished_Call struct {
+	*mock.Call
+}
+
+// PublishLessonPublished is a helper method to define mock.On call
+//   - msg
+func (_e *MockProducer_Expecter) PublishLessonPublished(msg interface{}) *MockProducer_PublishLessonPublished_Call {
+	return &MockProducer_PublishLessonPublished_Call{Call: _e.mock.On("PublishLessonPublished", msg)}
+}
+
+func (_c *MockProducer_PublishLessonPublished_Call) Run(run func(msg events.LessonPublished)) *MockProducer_PublishLessonPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.LessonPublished))
+	})
+	return _c
+}
+
+func (_c *MockProducer_PublishLessonPublished_Call) Return(err error) *MockProducer_PublishLessonPublished_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProducer_PublishLessonPublished_Call) RunAndReturn(run func(msg events.LessonPublished) error) *MockProducer_PublishLessonPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	"Classroom/Lessons/internal/markdown"
+	"context"
+	"fmt"
+)
+
+func (s *lessonService) ListRevisions(ctx context.Context, lessonID string) ([]domain.LessonRevision, error) {
+	if _, err := s.lessons.GetByID(ctx, lessonID); err != nil {
+		return nil, err
+	}
+	revisions, err := s.lessons.ListRevisions(ctx, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+func (s *lessonService) GetRevision(ctx context.Context, lessonID string, revision int32) (domain.LessonRevision, error) {
+	return s.lessons.GetRevision(ctx, lessonID, revision)
+}
+
+// Восстановление меняет урок, поэтому уроки архивного и удалённого курса не восстанавливаются
+func (s *lessonService) RestoreRevision(ctx context.Context, dto dto.RestoreRevisionDTO) (domain.Lesson, error) {
+	lesson, err := s.lessons.GetByID(ctx, dto.LessonID)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+	if err := s.checkWritable(ctx, lesson.CourseID); err != nil {
+		return domain.Lesson{}, err
+	}
+
+	// Ревизии не меняются, поэтому HTML можно отрисовать до транзакции восстановления
+	revision, err := s.lessons.GetRevision(ctx, dto.LessonID, dto.Revision)
+	if err != nil {
+		return domain.Lesson{}, err
+	}
+
+	restored, err := s.lessons.RestoreRevision(ctx, dto.LessonID, dto.Revision, markdown.Render(revision.Content), dto.UserID)
+	if err != nil {
+		return domain.Lesson{}, fmt.Errorf("failed to restore revision: %w", err)
+	}
+	s.logger.Info("lesson revision restored", "id", restored.ID, "revision", dto.Revision, "user_id", dto.UserID)
+	return restored, nil
+}
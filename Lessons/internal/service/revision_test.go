@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"Classroom/Lessons/internal/dto"
+	"Classroom/Lessons/internal/service"
+	mocks "Classroom/Lessons/internal/service/mocks"
+	"context"
+	"log/slog"
+	"time"
+
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonService_RestoreRevision(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockLessonRepo, req dto.RestoreRevisionDTO)
+
+	const courseID = "course-id"
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		req          dto.RestoreRevisionDTO
+		want         domain.Lesson
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockLessonRepo, req dto.RestoreRevisionDTO) {
+				repo.EXPECT().GetByID(mock.Anything, req.LessonID).Return(domain.Lesson{ID: req.LessonID, CourseID: courseID}, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, courseID).Return(domain.CourseState{CourseID: courseID}, nil)
+				repo.EXPECT().GetRevision(mock.Anything, req.LessonID, req.Revision).
+					Return(domain.LessonRevision{LessonID: req.LessonID, Revision: req.Revision, Title: "old title", Content: "old *content*"}, nil)
+				repo.EXPECT().RestoreRevision(mock.Anything, req.LessonID, req.Revision, "<p>old <em>content</em></p>\n", req.UserID).
+					Return(domain.Lesson{ID: req.LessonID, CourseID: courseID, Title: "old title"}, nil)
+			},
+			req:  dto.RestoreRevisionDTO{LessonID: "lesson-id", Revision: 2, UserID: "user-id"},
+			want: domain.Lesson{ID: "lesson-id", CourseID: courseID, Title: "old title"},
+		},
+		{
+			name: "lesson not found",
+			mockBehavior: func(repo *mocks.MockLessonRepo, req dto.RestoreRevisionDTO) {
+				repo.EXPECT().GetByID(mock.Anything, req.LessonID).Return(domain.Lesson{}, domain.ErrNotFound)
+			},
+			req:     dto.RestoreRevisionDTO{LessonID: "lesson-id", Revision: 2, UserID: "user-id"},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "course is archived",
+			mockBehavior: func(repo *mocks.MockLessonRepo, req dto.RestoreRevisionDTO) {
+				archivedAt := time.Now()
+				repo.EXPECT().GetByID(mock.Anything, req.LessonID).Return(domain.Lesson{ID: req.LessonID, CourseID: courseID}, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, courseID).
+					Return(domain.CourseState{CourseID: courseID, ArchivedAt: &archivedAt}, nil)
+			},
+			req:     dto.RestoreRevisionDTO{LessonID: "lesson-id", Revision: 2, UserID: "user-id"},
+			wantErr: domain.ErrReadOnly,
+		},
+		{
+			name: "revision not found",
+			mockBehavior: func(repo *mocks.MockLessonRepo, req dto.RestoreRevisionDTO) {
+				repo.EXPECT().GetByID(mock.Anything, req.LessonID).Return(domain.Lesson{ID: req.LessonID, CourseID: courseID}, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, courseID).Return(domain.CourseState{CourseID: courseID}, nil)
+				repo.EXPECT().GetRevision(mock.Anything, req.LessonID, req.Revision).Return(domain.LessonRevision{}, domain.ErrNotFound)
+			},
+			req:     dto.RestoreRevisionDTO{LessonID: "lesson-id", Revision: 9, UserID: "user-id"},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockLessonRepo(t)
+			pr := mocks.NewMockProducer(t)
+			tc.mockBehavior(repo, tc.req)
+			svc := service.NewLessonService(slog.Default(), repo, pr)
+			got, err := svc.RestoreRevision(context.Background(), tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
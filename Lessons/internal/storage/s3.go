@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"Classroom/Lessons/internal/domain"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Размер части при загрузке файла неизвестного размера, в памяти держится одна часть
+const s3PartSize = 16 << 20
+
+type S3Config struct {
+	Endpoint  string // Адрес S3 совместимого хранилища, например minio:9000
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	Region    string
+	UseSSL    bool
+}
+
+// s3Storage хранит файлы в бакете S3 совместимого хранилища, например MinIO
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+// Создаёт бакет, если его ещё нет
+func NewS3Storage(ctx context.Context, cfg S3Config) (*s3Storage, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket exists: %w", err)
+	}
+	if !exists {
+		err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bucket: %w", err)
+		}
+	}
+	return &s3Storage{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, -1, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    s3PartSize,
+	})
+	return err
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject не ходит в хранилище, отсутствие объекта видно только после Stat
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return object, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *s3Storage) DeletePrefix(ctx context.Context, prefix string) error {
+	objects := s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+	// Канал результатов дочитывается до конца, иначе удаление остановится на середине
+	var err error
+	for result := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil && err == nil {
+			err = fmt.Errorf("failed to remove %s: %w", result.ObjectName, result.Err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,33 @@
+package events
+
+import "time"
+
+// Сообщение о том, что курс переведён в архив и доступен только для чтения
+type CourseArchived struct {
+	CourseID   string    `json:"course_id"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// Сообщение о том, что курс удалён. До PurgeAt курс можно восстановить
+type CourseDeleted struct {
+	CourseID  string    `json:"course_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+// Сообщение о том, что архивный или удалённый курс восстановлен
+type CourseRestored struct {
+	CourseID string `json:"course_id"`
+}
+
+// Сообщение о том, что удалённый курс окончательно удалён вместе с уроками и заданиями
+type CoursePurged struct {
+	CourseID string `json:"course_id"`
+}
+
+const (
+	CourseArchivedTopic = "course.archived"
+	CourseDeletedTopic  = "course.deleted"
+	CourseRestoredTopic = "course.restored"
+	CoursePurgedTopic   = "course.purged"
+)
@@ -1,9 +1,22 @@
 package events
 
-// Сообщение о том, что на курсе был добавлен новый урок
+import "time"
+
+// Сообщение о том, что на курсе был добавлен новый урок. Отправляется, когда урок
+// становится виден студентам, а не когда преподаватель создал черновик
 type LessonCreated struct {
 	CourseID string `json:"course_id"`
 	LessonID string `json:"lesson_id"`
 }
 
-const LessonCreatedTopic = "lesson.created"
+// Сообщение о том, что урок опубликован: сразу, вручную или по расписанию
+type LessonPublished struct {
+	CourseID    string    `json:"course_id"`
+	LessonID    string    `json:"lesson_id"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+const (
+	LessonCreatedTopic   = "lesson.created"
+	LessonPublishedTopic = "lesson.published"
+)
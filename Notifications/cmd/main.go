@@ -38,15 +38,23 @@ func main() {
 	courseRepo := repo.NewCourseRepo(postgres)
 	taskRepo := repo.NewTaskRepo(postgres)
 	lessonRepo := repo.NewLessonRepo(postgres)
-	service := service.NewNotificationsService(mailer, userRepo, taskRepo, lessonRepo, courseRepo)
+	service := service.NewNotificationsService(mailer, config.AppURL, userRepo, taskRepo, lessonRepo, courseRepo)
 
 	consumer := consumer.MustNew([]string{config.KafkaBroker}, service)
 	defer consumer.Close()
 
 	consumer.ConsumeTopic(ctx, events.CourseEnrolledTopic)
 	consumer.ConsumeTopic(ctx, events.CourseExpelledTopic)
+	consumer.ConsumeTopic(ctx, events.EnrollmentRequestUpdatedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseInvitedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseArchivedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseDeletedTopic)
 	consumer.ConsumeTopic(ctx, events.TaskCreatedTopic)
 	consumer.ConsumeTopic(ctx, events.LessonCreatedTopic)
+	consumer.ConsumeTopic(ctx, events.UserRegisteredTopic)
+	consumer.ConsumeTopic(ctx, events.UserUpdatedTopic)
+	consumer.ConsumeTopic(ctx, events.PasswordResetRequestedTopic)
+	consumer.ConsumeTopic(ctx, events.EmailVerificationRequestedTopic)
 
 	logger.Info(ctx, "service started")
 	<-ctx.Done()
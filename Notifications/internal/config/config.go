@@ -11,6 +11,7 @@ import (
 type Config struct {
 	PostgresURL string `mapstructure:"postgres_url"`
 	KafkaBroker string `mapstructure:"kafka_broker"`
+	AppURL      string `mapstructure:"app_url"` // Адрес фронтенда для ссылок в письмах
 	SMTP        SMTP   `mapstructure:"smtp"`
 }
 
@@ -30,6 +31,7 @@ func MustNew() *Config {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.BindEnv("kafka_broker")
 	v.BindEnv("postgres_url")
+	v.BindEnv("app_url")
 	v.BindEnv("smtp.host")
 	v.BindEnv("smtp.port")
 	v.BindEnv("smtp.user")
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"time"
 
 	"github.com/IBM/sarama"
 )
@@ -13,8 +14,16 @@ import (
 type NotificationsService interface {
 	UserEnrolled(ctx context.Context, userID, courseID string) error
 	UserExpelled(ctx context.Context, userID, courseID string) error
+	EnrollmentRequestUpdated(ctx context.Context, userID, courseID, status string) error
+	UserInvited(ctx context.Context, email, courseID, code string, expiresAt time.Time) error
+	CourseArchived(ctx context.Context, courseID string) error
+	CourseDeleted(ctx context.Context, courseID string, purgeAt time.Time) error
 	LessonCreated(ctx context.Context, lessonID, courseID string) error
 	TaskCreated(ctx context.Context, taskID, courseID string) error
+	PasswordResetRequested(ctx context.Context, userID, token string, expiresAt time.Time) error
+	UserRegistered(ctx context.Context, userID, token string, expiresAt time.Time) error
+	EmailVerificationRequested(ctx context.Context, userID, token string, expiresAt time.Time) error
+	UserUpdated(ctx context.Context, userID, previousEmail string, passwordChanged bool) error
 }
 
 type EventHandler func(ctx context.Context, msg *sarama.ConsumerMessage)
@@ -37,10 +46,19 @@ func MustNew(brokers []string, svc NotificationsService) *consumer {
 	consumer := &consumer{master: master, svc: svc}
 
 	consumer.handlers = map[string]EventHandler{
-		events.CourseEnrolledTopic: consumer.handleUserEnrolled,
-		events.CourseExpelledTopic: consumer.handleUserExpelled,
-		events.LessonCreatedTopic:  consumer.handleLessonCreated,
-		events.TaskCreatedTopic:    consumer.handleTaskCreated,
+		events.CourseEnrolledTopic:           consumer.handleUserEnrolled,
+		events.CourseExpelledTopic:           consumer.handleUserExpelled,
+		events.EnrollmentRequestUpdatedTopic: consumer.handleEnrollmentRequestUpdated,
+		events.CourseInvitedTopic:            consumer.handleUserInvited,
+		events.CourseArchivedTopic:           consumer.handleCourseArchived,
+		events.CourseDeletedTopic:            consumer.handleCourseDeleted,
+		events.LessonCreatedTopic:            consumer.handleLessonCreated,
+		events.TaskCreatedTopic:              consumer.handleTaskCreated,
+
+		events.UserRegisteredTopic:             consumer.handleUserRegistered,
+		events.UserUpdatedTopic:                consumer.handleUserUpdated,
+		events.PasswordResetRequestedTopic:     consumer.handlePasswordResetRequested,
+		events.EmailVerificationRequestedTopic: consumer.handleEmailVerificationRequested,
 	}
 
 	return consumer
@@ -113,6 +131,66 @@ func (c *consumer) handleUserExpelled(ctx context.Context, msg *sarama.ConsumerM
 	logger.Debug(ctx, "notified user expelled", "user_id", payload.UserID)
 }
 
+func (c *consumer) handleEnrollmentRequestUpdated(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.EnrollmentRequestUpdated
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid enrollment request updated payload")
+		return
+	}
+
+	if err := c.svc.EnrollmentRequestUpdated(ctx, payload.UserID, payload.CourseID, payload.Status); err != nil {
+		logger.Error(ctx, "failed to notify enrollment request updated", "request_id", payload.RequestID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified enrollment request updated", "request_id", payload.RequestID)
+}
+
+func (c *consumer) handleUserInvited(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.UserInvited
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid user invited payload")
+		return
+	}
+
+	if err := c.svc.UserInvited(ctx, payload.Email, payload.CourseID, payload.Code, payload.ExpiresAt); err != nil {
+		logger.Error(ctx, "failed to notify user invited", "course_id", payload.CourseID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified user invited", "course_id", payload.CourseID)
+}
+
+func (c *consumer) handleCourseArchived(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.CourseArchived
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid course archived payload")
+		return
+	}
+
+	if err := c.svc.CourseArchived(ctx, payload.CourseID); err != nil {
+		logger.Error(ctx, "failed to notify course archived", "course_id", payload.CourseID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified course archived", "course_id", payload.CourseID)
+}
+
+func (c *consumer) handleCourseDeleted(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.CourseDeleted
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid course deleted payload")
+		return
+	}
+
+	if err := c.svc.CourseDeleted(ctx, payload.CourseID, payload.PurgeAt); err != nil {
+		logger.Error(ctx, "failed to notify course deleted", "course_id", payload.CourseID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified course deleted", "course_id", payload.CourseID)
+}
+
 func (c *consumer) handleLessonCreated(ctx context.Context, msg *sarama.ConsumerMessage) {
 	var payload events.LessonCreated
 	if err := decodeMessage(msg, &payload); err != nil {
@@ -143,6 +221,66 @@ func (c *consumer) handleTaskCreated(ctx context.Context, msg *sarama.ConsumerMe
 	logger.Debug(ctx, "notified task created", "task_id", payload.TaskID)
 }
 
+func (c *consumer) handlePasswordResetRequested(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.PasswordResetRequested
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid password reset requested payload")
+		return
+	}
+
+	if err := c.svc.PasswordResetRequested(ctx, payload.UserID, payload.Token, payload.ExpiresAt); err != nil {
+		logger.Error(ctx, "failed to notify password reset requested", "user_id", payload.UserID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified password reset requested", "user_id", payload.UserID)
+}
+
+func (c *consumer) handleUserRegistered(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.UserRegistered
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid user registered payload")
+		return
+	}
+
+	if err := c.svc.UserRegistered(ctx, payload.UserID, payload.Token, payload.ExpiresAt); err != nil {
+		logger.Error(ctx, "failed to notify user registered", "user_id", payload.UserID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified user registered", "user_id", payload.UserID)
+}
+
+func (c *consumer) handleEmailVerificationRequested(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.EmailVerificationRequested
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid email verification requested payload")
+		return
+	}
+
+	if err := c.svc.EmailVerificationRequested(ctx, payload.UserID, payload.Token, payload.ExpiresAt); err != nil {
+		logger.Error(ctx, "failed to notify email verification requested", "user_id", payload.UserID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified email verification requested", "user_id", payload.UserID)
+}
+
+func (c *consumer) handleUserUpdated(ctx context.Context, msg *sarama.ConsumerMessage) {
+	var payload events.UserUpdated
+	if err := decodeMessage(msg, &payload); err != nil {
+		logger.Error(ctx, "invalid user updated payload")
+		return
+	}
+
+	if err := c.svc.UserUpdated(ctx, payload.UserID, payload.PreviousEmail, payload.PasswordChanged); err != nil {
+		logger.Error(ctx, "failed to notify user updated", "user_id", payload.UserID, "err", err)
+		return
+	}
+
+	logger.Debug(ctx, "notified user updated", "user_id", payload.UserID)
+}
+
 func decodeMessage(msg *sarama.ConsumerMessage, dest any) error {
 	return json.Unmarshal(msg.Value, dest)
 }
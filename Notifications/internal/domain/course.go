@@ -1,6 +1,7 @@
 package domain
 
 type Course struct {
-	ID    string
-	Title string
+	ID        string
+	TeacherID string
+	Title     string
 }
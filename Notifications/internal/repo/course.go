@@ -23,7 +23,7 @@ func NewCourseRepo(storage *sqlx.DB) *courseRepo {
 
 func (r *courseRepo) GetByID(ctx context.Context, id string) (domain.Course, error) {
 	query, args := r.qb.
-		Select("course_id", "title").
+		Select("course_id", "teacher_id", "title").
 		From("courses").
 		Where(sq.Eq{"course_id": id}).
 		MustSql()
@@ -19,14 +19,16 @@ func (u User) ToDomain() domain.User {
 }
 
 type Course struct {
-	ID    string `db:"course_id"`
-	Title string `db:"title"`
+	ID        string `db:"course_id"`
+	TeacherID string `db:"teacher_id"`
+	Title     string `db:"title"`
 }
 
 func (c Course) ToDomain() domain.Course {
 	return domain.Course{
-		ID:    c.ID,
-		Title: c.Title,
+		ID:        c.ID,
+		TeacherID: c.TeacherID,
+		Title:     c.Title,
 	}
 }
 
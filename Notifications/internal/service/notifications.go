@@ -5,6 +5,7 @@ import (
 	"Classroom/Notifications/pkg/mailer"
 	"context"
 	"fmt"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 )
@@ -32,15 +33,17 @@ type notificationsService struct {
 	lessons LessonRepo
 	courses CourseRepo
 	mailer  mailer.Mailer
+	appURL  string
 }
 
-func NewNotificationsService(mailer mailer.Mailer, users UserRepo, tasks TaskRepo, lessons LessonRepo, courses CourseRepo) *notificationsService {
+func NewNotificationsService(mailer mailer.Mailer, appURL string, users UserRepo, tasks TaskRepo, lessons LessonRepo, courses CourseRepo) *notificationsService {
 	return &notificationsService{
 		users:   users,
 		tasks:   tasks,
 		lessons: lessons,
 		courses: courses,
 		mailer:  mailer,
+		appURL:  appURL,
 	}
 }
 
@@ -76,6 +79,112 @@ func (s *notificationsService) UserExpelled(ctx context.Context, userID, courseI
 	return s.mailer.SendEmail(user.Email, subject, body)
 }
 
+// О новой заявке сообщаем владельцу курса, об остальных изменениях - автору заявки.
+// Об одобрении отдельно не пишем: вместе с ним приходит course.enrolled
+func (s *notificationsService) EnrollmentRequestUpdated(ctx context.Context, userID, courseID, status string) error {
+	if status == "approved" {
+		return nil
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+
+	course, err := s.courses.GetByID(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course: %v", err)
+	}
+
+	switch status {
+	case "pending":
+		teacher, err := s.users.GetByID(ctx, course.TeacherID)
+		if err != nil {
+			return fmt.Errorf("failed to get teacher: %v", err)
+		}
+
+		subject := fmt.Sprintf("Новая заявка на курс %s", course.Title)
+		body := fmt.Sprintf(
+			"%s %s, %s %s (%s) подал(а) заявку на запись на курс %s. Рассмотреть заявку можно на странице курса.",
+			teacher.FirstName, teacher.LastName, user.FirstName, user.LastName, user.Email, course.Title)
+		return s.mailer.SendEmail(teacher.Email, subject, body)
+	case "waitlisted":
+		subject := "Заявка на курс в листе ожидания"
+		body := fmt.Sprintf(
+			"%s %s, ваша заявка на курс %s одобрена, но свободных мест пока нет. Мы запишем вас автоматически, как только место освободится.",
+			user.FirstName, user.LastName, course.Title)
+		return s.mailer.SendEmail(user.Email, subject, body)
+	case "rejected":
+		subject := "Заявка на курс отклонена"
+		body := fmt.Sprintf("%s %s, ваша заявка на курс %s отклонена.", user.FirstName, user.LastName, course.Title)
+		return s.mailer.SendEmail(user.Email, subject, body)
+	}
+	return nil
+}
+
+// Аккаунта у адресата ещё нет, поэтому пишем по почте из события
+func (s *notificationsService) UserInvited(ctx context.Context, email, courseID, code string, expiresAt time.Time) error {
+	course, err := s.courses.GetByID(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course: %v", err)
+	}
+
+	subject := fmt.Sprintf("Приглашение на курс %s", course.Title)
+	body := fmt.Sprintf(
+		"Здравствуйте! Вас пригласили на курс %s. Зарегистрируйтесь в Classroom и перейдите по ссылке %s/join?code=%s или введите код %s. Код действителен до %s.",
+		course.Title, s.appURL, code, code, expiresAt.Format("02.01.2006 15:04 MST"))
+	return s.mailer.SendEmail(email, subject, body)
+}
+
+// Участники узнают, что курс теперь доступен только для чтения
+func (s *notificationsService) CourseArchived(ctx context.Context, courseID string) error {
+	users, err := s.users.ListByCourseID(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %v", err)
+	}
+	course, err := s.courses.GetByID(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course: %v", err)
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, user := range users {
+		eg.Go(func() error {
+			subject := fmt.Sprintf("Курс %s перенесён в архив", course.Title)
+			body := fmt.Sprintf(
+				"%s %s, курс %s перенесён в архив. Материалы курса остаются доступны для просмотра, но изменить их и сдать задания больше нельзя.",
+				user.FirstName, user.LastName, course.Title)
+
+			return s.mailer.SendEmail(user.Email, subject, body)
+		})
+	}
+	return eg.Wait()
+}
+
+func (s *notificationsService) CourseDeleted(ctx context.Context, courseID string, purgeAt time.Time) error {
+	users, err := s.users.ListByCourseID(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %v", err)
+	}
+	course, err := s.courses.GetByID(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course: %v", err)
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, user := range users {
+		eg.Go(func() error {
+			subject := fmt.Sprintf("Курс %s удалён", course.Title)
+			body := fmt.Sprintf(
+				"%s %s, курс %s удалён и больше недоступен. Если владелец не восстановит курс, он будет удалён окончательно %s.",
+				user.FirstName, user.LastName, course.Title, purgeAt.Format("02.01.2006 15:04 MST"))
+
+			return s.mailer.SendEmail(user.Email, subject, body)
+		})
+	}
+	return eg.Wait()
+}
+
 func (s *notificationsService) LessonCreated(ctx context.Context, lessonID, courseID string) error {
 	users, err := s.users.ListByCourseID(ctx, courseID)
 	if err != nil {
@@ -131,3 +240,78 @@ func (s *notificationsService) TaskCreated(ctx context.Context, taskID, courseID
 	}
 	return eg.Wait()
 }
+
+func (s *notificationsService) PasswordResetRequested(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+
+	subject := "Сброс пароля"
+	body := fmt.Sprintf(
+		"%s %s, для сброса пароля перейдите по ссылке %s/reset-password?token=%s. Ссылка действительна до %s. Если вы не запрашивали сброс пароля, просто проигнорируйте это письмо.",
+		user.FirstName, user.LastName, s.appURL, token, expiresAt.Format("02.01.2006 15:04 MST"))
+	return s.mailer.SendEmail(user.Email, subject, body)
+}
+
+func (s *notificationsService) UserRegistered(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+
+	subject := "Добро пожаловать в Classroom"
+	body := fmt.Sprintf(
+		"%s %s, спасибо за регистрацию! Подтвердите почту, перейдя по ссылке %s. Ссылка действительна до %s.",
+		user.FirstName, user.LastName, s.verificationLink(token), expiresAt.Format("02.01.2006 15:04 MST"))
+	return s.mailer.SendEmail(user.Email, subject, body)
+}
+
+func (s *notificationsService) EmailVerificationRequested(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+
+	subject := "Подтверждение почты"
+	body := fmt.Sprintf(
+		"%s %s, для подтверждения почты перейдите по ссылке %s. Ссылка действительна до %s.",
+		user.FirstName, user.LastName, s.verificationLink(token), expiresAt.Format("02.01.2006 15:04 MST"))
+	return s.mailer.SendEmail(user.Email, subject, body)
+}
+
+// Имена и почта читаются из базы при отправке каждого письма, поэтому обновлять нечего.
+// Пользователя предупреждаем о смене почты (на старый адрес) и пароля
+func (s *notificationsService) UserUpdated(ctx context.Context, userID, previousEmail string, passwordChanged bool) error {
+	if previousEmail == "" && !passwordChanged {
+		return nil
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+
+	if previousEmail != "" {
+		subject := "Почта изменена"
+		body := fmt.Sprintf(
+			"%s %s, почта вашего аккаунта изменена на %s. Если это сделали не вы, восстановите доступ через сброс пароля.",
+			user.FirstName, user.LastName, user.Email)
+		if err := s.mailer.SendEmail(previousEmail, subject, body); err != nil {
+			return err
+		}
+	}
+
+	if passwordChanged {
+		subject := "Пароль изменен"
+		body := fmt.Sprintf(
+			"%s %s, пароль вашего аккаунта изменен, все остальные сессии завершены. Если это сделали не вы, восстановите доступ через сброс пароля.",
+			user.FirstName, user.LastName)
+		return s.mailer.SendEmail(user.Email, subject, body)
+	}
+	return nil
+}
+
+func (s *notificationsService) verificationLink(token string) string {
+	return fmt.Sprintf("%s/verify-email?token=%s", s.appURL, token)
+}
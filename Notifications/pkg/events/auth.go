@@ -0,0 +1,34 @@
+package events
+
+import "time"
+
+// Сообщение о том, что пользователь запросил сброс пароля
+type PasswordResetRequested struct {
+	UserID    string    `json:"user_id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Сообщение о регистрации нового пользователя, содержит токен для подтверждения почты
+type UserRegistered struct {
+	UserID    string    `json:"user_id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Сообщение о повторном запросе письма для подтверждения почты
+type EmailVerificationRequested struct {
+	UserID    string    `json:"user_id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Сообщение об изменении данных пользователя
+type UserUpdated struct {
+	UserID          string `json:"user_id"`
+	Email           string `json:"email"`
+	FirstName       string `json:"first_name"`
+	LastName        string `json:"last_name"`
+	PreviousEmail   string `json:"previous_email,omitempty"` // Заполнено, если почта изменилась
+	PasswordChanged bool   `json:"password_changed,omitempty"`
+}
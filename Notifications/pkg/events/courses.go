@@ -1,5 +1,7 @@
 package events
 
+import "time"
+
 // Сообщение о том, что пользователь был зачислен на курс
 type UserEnrolled struct {
 	CourseID string `json:"course_id"`
@@ -11,3 +13,32 @@ type UserExpelled struct {
 	CourseID string `json:"course_id"`
 	UserID   string `json:"user_id"`
 }
+
+// Сообщение об изменении состояния заявки на запись на курс
+type EnrollmentRequestUpdated struct {
+	RequestID string `json:"request_id"`
+	CourseID  string `json:"course_id"`
+	UserID    string `json:"user_id"`
+	Status    string `json:"status"`
+}
+
+// Сообщение о том, что на почту без аккаунта отправлено приглашение на курс
+type UserInvited struct {
+	CourseID  string    `json:"course_id"`
+	Email     string    `json:"email"`
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Сообщение о том, что курс переведён в архив и доступен только для чтения
+type CourseArchived struct {
+	CourseID   string    `json:"course_id"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// Сообщение о том, что курс удалён. До PurgeAt курс можно восстановить
+type CourseDeleted struct {
+	CourseID  string    `json:"course_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
@@ -1,8 +1,16 @@
 package events
 
 const (
-	CourseEnrolledTopic = "course.enrolled"
-	CourseExpelledTopic = "course.expelled"
-	LessonCreatedTopic  = "lesson.created"
-	TaskCreatedTopic    = "task.created"
+	CourseEnrolledTopic             = "course.enrolled"
+	CourseExpelledTopic             = "course.expelled"
+	EnrollmentRequestUpdatedTopic   = "course.enrollment_request_updated"
+	CourseInvitedTopic              = "course.invited"
+	CourseArchivedTopic             = "course.archived"
+	CourseDeletedTopic              = "course.deleted"
+	LessonCreatedTopic              = "lesson.created"
+	TaskCreatedTopic                = "task.created"
+	UserRegisteredTopic             = "user.registered"
+	UserUpdatedTopic                = "user.updated"
+	PasswordResetRequestedTopic     = "auth.password_reset_requested"
+	EmailVerificationRequestedTopic = "auth.email_verification_requested"
 )
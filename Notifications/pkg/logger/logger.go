@@ -54,7 +54,6 @@ func NewProduction(ctx context.Context, request bool) context.Context {
 		requestID = uuid.New().String()
 	}
 
-
 	ctx = context.WithValue(ctx, LoggerCtxKey, logger)
 	ctx = context.WithValue(ctx, RequestIDKey, requestID)
 
@@ -2,10 +2,12 @@ package main
 
 import (
 	"Classroom/Tasks/internal/config"
+	"Classroom/Tasks/internal/consumer"
 	"Classroom/Tasks/internal/controller"
 	"Classroom/Tasks/internal/producer"
 	"Classroom/Tasks/internal/repo"
 	"Classroom/Tasks/internal/service"
+	"Classroom/Tasks/pkg/events"
 	"Classroom/Tasks/pkg/postgres"
 	"context"
 	"fmt"
@@ -46,6 +48,14 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Из событий Courses узнаём об архивации и удалении курсов
+	consumer := consumer.MustNew([]string{conf.KafkaBroker}, logger, taskService)
+	defer consumer.Close()
+
+	consumer.ConsumeTopic(ctx, events.CourseArchivedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseDeletedTopic)
+	consumer.ConsumeTopic(ctx, events.CourseRestoredTopic)
+
 	logger.Info("starting grpc server", "port", conf.Port)
 	go startServer(server, conf.Port)
 
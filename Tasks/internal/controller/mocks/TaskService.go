@@ -39,6 +39,62 @@ func (_m *MockTaskService) EXPECT() *MockTaskService_Expecter {
 	return &MockTaskService_Expecter{mock: &_m.Mock}
 }
 
+// CloneFromCourse provides a mock function for the type MockTaskService
+func (_mock *MockTaskService) CloneFromCourse(ctx context.Context, sourceCourseID string, targetCourseID string) (int, error) {
+	ret := _mock.Called(ctx, sourceCourseID, targetCourseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneFromCourse")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return returnFunc(ctx, sourceCourseID, targetCourseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = returnFunc(ctx, sourceCourseID, targetCourseID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, sourceCourseID, targetCourseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskService_CloneFromCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneFromCourse'
+type MockTaskService_CloneFromCourse_Call struct {
+	*mock.Call
+}
+
+// CloneFromCourse is a helper method to define mock.On call
+//   - ctx
+//   - sourceCourseID
+//   - targetCourseID
+func (_e *MockTaskService_Expecter) CloneFromCourse(ctx interface{}, sourceCourseID interface{}, targetCourseID interface{}) *MockTaskService_CloneFromCourse_Call {
+	return &MockTaskService_CloneFromCourse_Call{Call: _e.mock.On("CloneFromCourse", ctx, sourceCourseID, targetCourseID)}
+}
+
+func (_c *MockTaskService_CloneFromCourse_Call) Run(run func(ctx context.Context, sourceCourseID string, targetCourseID string)) *MockTaskService_CloneFromCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskService_CloneFromCourse_Call) Return(n int, err error) *MockTaskService_CloneFromCourse_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTaskService_CloneFromCourse_Call) RunAndReturn(run func(ctx context.Context, sourceCourseID string, targetCourseID string) (int, error)) *MockTaskService_CloneFromCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockTaskService
 func (_mock *MockTaskService) Create(ctx context.Context, dto1 dto.CreateTaskDTO) (string, error) {
 	ret := _mock.Called(ctx, dto1)
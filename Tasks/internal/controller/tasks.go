@@ -26,6 +26,8 @@ type TaskService interface {
 
 	ListTaskStatuses(ctx context.Context, taskID string) ([]domain.TaskStatus, error)
 	ToggleTaskStatus(ctx context.Context, taskID, userID string) (domain.TaskStatus, error)
+
+	CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string) (int, error)
 }
 
 type taskController struct {
@@ -53,17 +55,29 @@ func (c *taskController) CreateTask(ctx context.Context, req *pb.CreateTaskReque
 		Title:    req.Title,
 		Content:  req.Description,
 		CourseID: req.CourseId,
+		ModuleID: req.ModuleId,
 	}
 
 	if err := c.validate.Struct(dto); err != nil {
 		c.logger.Debug("invalid request", "err", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 	}
+	if dto.ModuleID != nil {
+		if err := c.validate.Var(*dto.ModuleID, "uuid"); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid module id")
+		}
+	}
 
 	taskID, err := c.svc.Create(ctx, dto)
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "course not found")
 	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return nil, status.Error(codes.InvalidArgument, "module not found in the course")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
 	if err != nil {
 		c.logger.Error("failed to create task", "err", err)
 		return nil, status.Error(codes.Internal, "failed to create task")
@@ -93,6 +107,8 @@ func (c *taskController) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*
 			Content:   task.Content,
 			CourseId:  task.CourseID,
 			CreatedAt: timestamppb.New(task.CreatedAt),
+			ModuleId:  task.ModuleID,
+			Position:  task.Position,
 		},
 	}, nil
 }
@@ -115,6 +131,8 @@ func (c *taskController) GetTasks(ctx context.Context, req *pb.GetTasksRequest)
 			Content:   task.Content,
 			CourseId:  task.CourseID,
 			CreatedAt: timestamppb.New(task.CreatedAt),
+			ModuleId:  task.ModuleID,
+			Position:  task.Position,
 		}
 	}
 	return &pb.GetTasksResponse{Tasks: pbTasks}, nil
@@ -122,19 +140,31 @@ func (c *taskController) GetTasks(ctx context.Context, req *pb.GetTasksRequest)
 
 func (c *taskController) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	dto := dto.UpdateTaskDTO{
-		Title:   req.Title,
-		Content: req.Content,
-		TaskID:  req.TaskId,
+		Title:    req.Title,
+		Content:  req.Content,
+		TaskID:   req.TaskId,
+		ModuleID: req.ModuleId,
 	}
 	if err := c.validate.Struct(dto); err != nil {
 		c.logger.Debug("invalid request", "err", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 	}
+	if dto.ModuleID != nil && *dto.ModuleID != "" {
+		if err := c.validate.Var(*dto.ModuleID, "uuid"); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid module id")
+		}
+	}
 
 	task, err := c.svc.Update(ctx, dto)
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "task not found")
 	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return nil, status.Error(codes.InvalidArgument, "module not found in the course")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
 	if err != nil {
 		c.logger.Error("failed to update task", "err", err, "id", req.TaskId)
 		return nil, status.Error(codes.Internal, "failed to update task")
@@ -147,6 +177,8 @@ func (c *taskController) UpdateTask(ctx context.Context, req *pb.UpdateTaskReque
 			Content:   task.Content,
 			CourseId:  task.CourseID,
 			CreatedAt: timestamppb.New(task.CreatedAt),
+			ModuleId:  task.ModuleID,
+			Position:  task.Position,
 		},
 	}, nil
 }
@@ -163,6 +195,9 @@ func (c *taskController) ChangeStatusTask(ctx context.Context, req *pb.ChangeSta
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "task not found")
 	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
 	if err != nil {
 		c.logger.Error("failed to update task status", "err", err, "task_id", req.TaskId, "student_id", req.StudentId)
 		return nil, status.Error(codes.Internal, "failed to update task status")
@@ -179,6 +214,9 @@ func (c *taskController) DeleteTask(ctx context.Context, req *pb.DeleteTaskReque
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "task not found")
 	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
 	if err != nil {
 		c.logger.Error("failed to delete task", "err", err, "id", req.TaskId)
 		return nil, status.Error(codes.Internal, "failed to delete task")
@@ -209,6 +247,8 @@ func (c *taskController) GetTasksForStudent(ctx context.Context, req *pb.GetTask
 			CourseId:  task.CourseID,
 			Completed: task.Completed,
 			CreatedAt: timestamppb.New(task.CreatedAt),
+			ModuleId:  task.ModuleID,
+			Position:  task.Position,
 		}
 	}
 	return &pb.GetTasksForStudentResponse{Tasks: pbTasks}, nil
@@ -240,3 +280,25 @@ func (c *taskController) GetStudentStatuses(ctx context.Context, req *pb.GetStud
 
 	return &pb.GetStudentStatusesResponse{Statuses: pbStatuses}, nil
 }
+
+func (c *taskController) CloneFromCourse(ctx context.Context, req *pb.CloneFromCourseRequest) (*pb.CloneFromCourseResponse, error) {
+	if err := c.validate.Var(req.SourceCourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid source course id")
+	}
+	if err := c.validate.Var(req.TargetCourseId, "required,uuid"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target course id")
+	}
+
+	copied, err := c.svc.CloneFromCourse(ctx, req.SourceCourseId, req.TargetCourseId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if errors.Is(err, domain.ErrReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "course is read-only")
+	}
+	if err != nil {
+		c.logger.Error("failed to clone tasks", "err", err, "source_course_id", req.SourceCourseId, "target_course_id", req.TargetCourseId)
+		return nil, status.Error(codes.Internal, "failed to clone tasks")
+	}
+	return &pb.CloneFromCourseResponse{Copied: int32(copied)}, nil
+}
@@ -8,4 +8,5 @@ var (
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrAlreadyExists = errors.New("entity already exists")
 	ErrDatabase      = errors.New("database error")
+	ErrReadOnly      = errors.New("course is read-only")
 )
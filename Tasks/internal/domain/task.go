@@ -8,6 +8,8 @@ type Task struct {
 	Title     string    // Название задания
 	Content   string    // Содержание задания
 	CreatedAt time.Time // Дата создания задания
+	ModuleID  *string   // Идентификатор модуля курса, nil - задание без модуля
+	Position  int32     // Порядок задания в курсе
 }
 
 type StudentTask struct {
@@ -17,6 +19,8 @@ type StudentTask struct {
 	Content   string    // Содержание задания
 	Completed bool      // Флаг, указывающий на выполненность задания
 	CreatedAt time.Time // Дата создания задания
+	ModuleID  *string   // Идентификатор модуля курса, nil - задание без модуля
+	Position  int32     // Порядок задания в курсе
 }
 
 type TaskStatus struct {
@@ -4,10 +4,12 @@ type CreateTaskDTO struct {
 	Title    string `validate:"required"`
 	Content  string `validate:"required"`
 	CourseID string `validate:"required,uuid"`
+	ModuleID *string
 }
 
 type UpdateTaskDTO struct {
-	TaskID  string `validate:"required,uuid"`
-	Title   *string
-	Content *string
+	TaskID   string `validate:"required,uuid"`
+	Title    *string
+	Content  *string
+	ModuleID *string // Пустая строка убирает задание из модуля
 }
@@ -2,45 +2,60 @@ package repo
 
 import (
 	"Classroom/Tasks/internal/domain"
+	"database/sql"
 	"time"
 )
 
 type StudentTask struct {
-	ID        string    `db:"task_id"`
-	CourseID  string    `db:"course_id"`
-	Title     string    `db:"title"`
-	Content   string    `db:"content"`
-	Completed bool      `db:"completed"`
-	CreatedAt time.Time `db:"created_at"`
+	ID        string         `db:"task_id"`
+	CourseID  string         `db:"course_id"`
+	Title     string         `db:"title"`
+	Content   string         `db:"content"`
+	Completed bool           `db:"completed"`
+	CreatedAt time.Time      `db:"created_at"`
+	ModuleID  sql.NullString `db:"module_id"`
+	Position  int32          `db:"position"`
 }
 
 func (t StudentTask) ToEntity() domain.StudentTask {
-	return domain.StudentTask{
+	task := domain.StudentTask{
 		ID:        t.ID,
 		CourseID:  t.CourseID,
 		Title:     t.Title,
 		Content:   t.Content,
 		Completed: t.Completed,
 		CreatedAt: t.CreatedAt,
+		Position:  t.Position,
 	}
+	if t.ModuleID.Valid {
+		task.ModuleID = &t.ModuleID.String
+	}
+	return task
 }
 
 type Task struct {
-	ID        string    `db:"task_id"`
-	CourseID  string    `db:"course_id"`
-	Title     string    `db:"title"`
-	Content   string    `db:"content"`
-	CreatedAt time.Time `db:"created_at"`
+	ID        string         `db:"task_id"`
+	CourseID  string         `db:"course_id"`
+	Title     string         `db:"title"`
+	Content   string         `db:"content"`
+	CreatedAt time.Time      `db:"created_at"`
+	ModuleID  sql.NullString `db:"module_id"`
+	Position  int32          `db:"position"`
 }
 
 func (t Task) ToEntity() domain.Task {
-	return domain.Task{
+	task := domain.Task{
 		ID:        t.ID,
 		CourseID:  t.CourseID,
 		Title:     t.Title,
 		Content:   t.Content,
 		CreatedAt: t.CreatedAt,
+		Position:  t.Position,
+	}
+	if t.ModuleID.Valid {
+		task.ModuleID = &t.ModuleID.String
 	}
+	return task
 }
 
 type TaskStatus struct {
@@ -56,3 +71,20 @@ func (t TaskStatus) ToEntity() domain.TaskStatus {
 		Completed: t.Completed,
 	}
 }
+
+type CourseState struct {
+	CourseID   string       `db:"course_id"`
+	ArchivedAt sql.NullTime `db:"archived_at"`
+	DeletedAt  sql.NullTime `db:"deleted_at"`
+}
+
+func (s CourseState) ToEntity() domain.CourseState {
+	state := domain.CourseState{CourseID: s.CourseID}
+	if s.ArchivedAt.Valid {
+		state.ArchivedAt = &s.ArchivedAt.Time
+	}
+	if s.DeletedAt.Valid {
+		state.DeletedAt = &s.DeletedAt.Time
+	}
+	return state
+}
@@ -41,11 +41,38 @@ func (r *taskRepo) CourseExists(ctx context.Context, courseID string) (bool, err
 	return isExists, nil
 }
 
+// Проверяет, что модуль принадлежит курсу
+func (r *taskRepo) ModuleExists(ctx context.Context, courseID, moduleID string) (bool, error) {
+	query, args := r.qb.
+		Select("TRUE").
+		From("course_modules").
+		Where(sq.Eq{"course_id": courseID, "module_id": moduleID}).
+		MustSql()
+	var isExists bool
+	err := r.storage.GetContext(ctx, &isExists, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return isExists, nil
+}
+
+// Задание добавляется в конец курса
 func (r *taskRepo) Create(ctx context.Context, payload dto.CreateTaskDTO) (domain.Task, error) {
 	query, args := r.qb.
 		Insert("tasks").
-		Columns("course_id", "title", "content").
-		Values(payload.CourseID, payload.Title, payload.Content).
+		Columns("course_id", "title", "content", "module_id", "position").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", payload.CourseID).
+			Column("?", payload.Title).
+			Column("?", payload.Content).
+			Column("?::uuid", payload.ModuleID).
+			Column("COALESCE(MAX(position) + 1, 0)").
+			From("tasks").
+			Where(sq.Eq{"course_id": payload.CourseID})).
 		Suffix("RETURNING *").
 		MustSql()
 
@@ -81,6 +108,7 @@ func (r *taskRepo) ListByCourseID(ctx context.Context, courseID string) ([]domai
 		Select("*").
 		From("tasks").
 		Where(sq.Eq{"course_id": courseID}).
+		OrderBy("position", "created_at").
 		MustSql()
 
 	var tasks []Task
@@ -105,6 +133,7 @@ func (r *taskRepo) Update(ctx context.Context, task domain.Task) error {
 		Update("tasks").
 		Set("title", task.Title).
 		Set("content", task.Content).
+		Set("module_id", task.ModuleID).
 		Where(sq.Eq{"task_id": task.ID}).
 		MustSql()
 
@@ -139,11 +168,14 @@ func (r *taskRepo) ListByStudentID(ctx context.Context, studentID, courseID stri
 			"COALESCE(ts.completed, FALSE) AS completed",
 			"t.created_at AS created_at",
 			"e.course_id AS course_id",
+			"t.module_id AS module_id",
+			"t.position AS position",
 		).
 		From("tasks t").
 		Join("enrollments e ON e.course_id = t.course_id").
 		LeftJoin("task_submissions ts ON ts.task_id = t.task_id AND ts.student_id = e.student_id").
 		Where(sq.Eq{"e.student_id": studentID, "t.course_id": courseID}).
+		OrderBy("t.position", "t.created_at").
 		MustSql()
 
 	var tasks []StudentTask
@@ -162,3 +194,49 @@ func (r *taskRepo) ListByStudentID(ctx context.Context, studentID, courseID stri
 
 	return result, nil
 }
+
+// CloneFromCourse заменяет задания целевого курса копиями заданий исходного курса.
+// Статусы выполнения не копируются, порядок заданий сохраняется. Модули целевого
+// курса Courses копирует заранее, задание попадает в модуль с той же позицией
+func (r *taskRepo) CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string) (int, error) {
+	tx, err := r.storage.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query, args := r.qb.
+		Delete("tasks").
+		Where(sq.Eq{"course_id": targetCourseID}).
+		MustSql()
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, err
+	}
+
+	query, args = r.qb.
+		Insert("tasks").
+		Columns("course_id", "title", "content", "created_at", "module_id", "position").
+		Select(r.qb.
+			Select().
+			Column("?::uuid", targetCourseID).
+			Columns("t.title", "t.content").
+			Column("NOW() + ROW_NUMBER() OVER (ORDER BY t.created_at, t.task_id) * INTERVAL '1 microsecond'").
+			Columns("tm.module_id", "t.position").
+			From("tasks t").
+			LeftJoin("course_modules sm ON sm.module_id = t.module_id").
+			LeftJoin("course_modules tm ON tm.course_id = ? AND tm.position = sm.position", targetCourseID).
+			Where(sq.Eq{"t.course_id": sourceCourseID})).
+		MustSql()
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	copied, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(copied), nil
+}
@@ -8,6 +8,7 @@ import (
 	"Classroom/Tasks/internal/domain"
 	"Classroom/Tasks/internal/dto"
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,6 +40,108 @@ func (_m *MockTaskRepo) EXPECT() *MockTaskRepo_Expecter {
 	return &MockTaskRepo_Expecter{mock: &_m.Mock}
 }
 
+// ClearCourseState provides a mock function for the type MockTaskRepo
+func (_mock *MockTaskRepo) ClearCourseState(ctx context.Context, courseID string) error {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearCourseState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTaskRepo_ClearCourseState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCourseState'
+type MockTaskRepo_ClearCourseState_Call struct {
+	*mock.Call
+}
+
+// ClearCourseState is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockTaskRepo_Expecter) ClearCourseState(ctx interface{}, courseID interface{}) *MockTaskRepo_ClearCourseState_Call {
+	return &MockTaskRepo_ClearCourseState_Call{Call: _e.mock.On("ClearCourseState", ctx, courseID)}
+}
+
+func (_c *MockTaskRepo_ClearCourseState_Call) Run(run func(ctx context.Context, courseID string)) *MockTaskRepo_ClearCourseState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepo_ClearCourseState_Call) Return(err error) *MockTaskRepo_ClearCourseState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskRepo_ClearCourseState_Call) RunAndReturn(run func(ctx context.Context, courseID string) error) *MockTaskRepo_ClearCourseState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneFromCourse provides a mock function for the type MockTaskRepo
+func (_mock *MockTaskRepo) CloneFromCourse(ctx context.Context, sourceCourseID string, targetCourseID string) (int, error) {
+	ret := _mock.Called(ctx, sourceCourseID, targetCourseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneFromCourse")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return returnFunc(ctx, sourceCourseID, targetCourseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = returnFunc(ctx, sourceCourseID, targetCourseID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, sourceCourseID, targetCourseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskRepo_CloneFromCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneFromCourse'
+type MockTaskRepo_CloneFromCourse_Call struct {
+	*mock.Call
+}
+
+// CloneFromCourse is a helper method to define mock.On call
+//   - ctx
+//   - sourceCourseID
+//   - targetCourseID
+func (_e *MockTaskRepo_Expecter) CloneFromCourse(ctx interface{}, sourceCourseID interface{}, targetCourseID interface{}) *MockTaskRepo_CloneFromCourse_Call {
+	return &MockTaskRepo_CloneFromCourse_Call{Call: _e.mock.On("CloneFromCourse", ctx, sourceCourseID, targetCourseID)}
+}
+
+func (_c *MockTaskRepo_CloneFromCourse_Call) Run(run func(ctx context.Context, sourceCourseID string, targetCourseID string)) *MockTaskRepo_CloneFromCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepo_CloneFromCourse_Call) Return(n int, err error) *MockTaskRepo_CloneFromCourse_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTaskRepo_CloneFromCourse_Call) RunAndReturn(run func(ctx context.Context, sourceCourseID string, targetCourseID string) (int, error)) *MockTaskRepo_CloneFromCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CourseExists provides a mock function for the type MockTaskRepo
 func (_mock *MockTaskRepo) CourseExists(ctx context.Context, courseID string) (bool, error) {
 	ret := _mock.Called(ctx, courseID)
@@ -250,6 +353,61 @@ func (_c *MockTaskRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// GetCourseState provides a mock function for the type MockTaskRepo
+func (_mock *MockTaskRepo) GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error) {
+	ret := _mock.Called(ctx, courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCourseState")
+	}
+
+	var r0 domain.CourseState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.CourseState, error)); ok {
+		return returnFunc(ctx, courseID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.CourseState); ok {
+		r0 = returnFunc(ctx, courseID)
+	} else {
+		r0 = ret.Get(0).(domain.CourseState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskRepo_GetCourseState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCourseState'
+type MockTaskRepo_GetCourseState_Call struct {
+	*mock.Call
+}
+
+// GetCourseState is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+func (_e *MockTaskRepo_Expecter) GetCourseState(ctx interface{}, courseID interface{}) *MockTaskRepo_GetCourseState_Call {
+	return &MockTaskRepo_GetCourseState_Call{Call: _e.mock.On("GetCourseState", ctx, courseID)}
+}
+
+func (_c *MockTaskRepo_GetCourseState_Call) Run(run func(ctx context.Context, courseID string)) *MockTaskRepo_GetCourseState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepo_GetCourseState_Call) Return(courseState domain.CourseState, err error) *MockTaskRepo_GetCourseState_Call {
+	_c.Call.Return(courseState, err)
+	return _c
+}
+
+func (_c *MockTaskRepo_GetCourseState_Call) RunAndReturn(run func(ctx context.Context, courseID string) (domain.CourseState, error)) *MockTaskRepo_GetCourseState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByCourseID provides a mock function for the type MockTaskRepo
 func (_mock *MockTaskRepo) ListByCourseID(ctx context.Context, courseID string) ([]domain.Task, error) {
 	ret := _mock.Called(ctx, courseID)
@@ -365,6 +523,156 @@ func (_c *MockTaskRepo_ListByStudentID_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// ModuleExists provides a mock function for the type MockTaskRepo
+func (_mock *MockTaskRepo) ModuleExists(ctx context.Context, courseID string, moduleID string) (bool, error) {
+	ret := _mock.Called(ctx, courseID, moduleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModuleExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, courseID, moduleID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, courseID, moduleID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, courseID, moduleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskRepo_ModuleExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModuleExists'
+type MockTaskRepo_ModuleExists_Call struct {
+	*mock.Call
+}
+
+// ModuleExists is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - moduleID
+func (_e *MockTaskRepo_Expecter) ModuleExists(ctx interface{}, courseID interface{}, moduleID interface{}) *MockTaskRepo_ModuleExists_Call {
+	return &MockTaskRepo_ModuleExists_Call{Call: _e.mock.On("ModuleExists", ctx, courseID, moduleID)}
+}
+
+func (_c *MockTaskRepo_ModuleExists_Call) Run(run func(ctx context.Context, courseID string, moduleID string)) *MockTaskRepo_ModuleExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepo_ModuleExists_Call) Return(b bool, err error) *MockTaskRepo_ModuleExists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockTaskRepo_ModuleExists_Call) RunAndReturn(run func(ctx context.Context, courseID string, moduleID string) (bool, error)) *MockTaskRepo_ModuleExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCourseArchived provides a mock function for the type MockTaskRepo
+func (_mock *MockTaskRepo) SetCourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error {
+	ret := _mock.Called(ctx, courseID, archivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCourseArchived")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, courseID, archivedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTaskRepo_SetCourseArchived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCourseArchived'
+type MockTaskRepo_SetCourseArchived_Call struct {
+	*mock.Call
+}
+
+// SetCourseArchived is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - archivedAt
+func (_e *MockTaskRepo_Expecter) SetCourseArchived(ctx interface{}, courseID interface{}, archivedAt interface{}) *MockTaskRepo_SetCourseArchived_Call {
+	return &MockTaskRepo_SetCourseArchived_Call{Call: _e.mock.On("SetCourseArchived", ctx, courseID, archivedAt)}
+}
+
+func (_c *MockTaskRepo_SetCourseArchived_Call) Run(run func(ctx context.Context, courseID string, archivedAt time.Time)) *MockTaskRepo_SetCourseArchived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepo_SetCourseArchived_Call) Return(err error) *MockTaskRepo_SetCourseArchived_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskRepo_SetCourseArchived_Call) RunAndReturn(run func(ctx context.Context, courseID string, archivedAt time.Time) error) *MockTaskRepo_SetCourseArchived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCourseDeleted provides a mock function for the type MockTaskRepo
+func (_mock *MockTaskRepo) SetCourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error {
+	ret := _mock.Called(ctx, courseID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCourseDeleted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, courseID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTaskRepo_SetCourseDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCourseDeleted'
+type MockTaskRepo_SetCourseDeleted_Call struct {
+	*mock.Call
+}
+
+// SetCourseDeleted is a helper method to define mock.On call
+//   - ctx
+//   - courseID
+//   - deletedAt
+func (_e *MockTaskRepo_Expecter) SetCourseDeleted(ctx interface{}, courseID interface{}, deletedAt interface{}) *MockTaskRepo_SetCourseDeleted_Call {
+	return &MockTaskRepo_SetCourseDeleted_Call{Call: _e.mock.On("SetCourseDeleted", ctx, courseID, deletedAt)}
+}
+
+func (_c *MockTaskRepo_SetCourseDeleted_Call) Run(run func(ctx context.Context, courseID string, deletedAt time.Time)) *MockTaskRepo_SetCourseDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepo_SetCourseDeleted_Call) Return(err error) *MockTaskRepo_SetCourseDeleted_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskRepo_SetCourseDeleted_Call) RunAndReturn(run func(ctx context.Context, courseID string, deletedAt time.Time) error) *MockTaskRepo_SetCourseDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockTaskRepo
 func (_mock *MockTaskRepo) Update(ctx context.Context, task domain.Task) error {
 	ret := _mock.Called(ctx, task)
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 )
 
 type TaskRepo interface {
@@ -18,6 +19,13 @@ type TaskRepo interface {
 	Update(ctx context.Context, task domain.Task) error
 	Delete(ctx context.Context, id string) error
 	CourseExists(ctx context.Context, courseID string) (bool, error)
+	ModuleExists(ctx context.Context, courseID, moduleID string) (bool, error)
+	CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string) (int, error)
+
+	GetCourseState(ctx context.Context, courseID string) (domain.CourseState, error)
+	SetCourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error
+	SetCourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error
+	ClearCourseState(ctx context.Context, courseID string) error
 }
 
 type StatusRepo interface {
@@ -50,6 +58,14 @@ func (s *taskService) Create(ctx context.Context, payload dto.CreateTaskDTO) (st
 	if !courseExists {
 		return "", domain.ErrNotFound
 	}
+	if err := s.checkWritable(ctx, payload.CourseID); err != nil {
+		return "", err
+	}
+	if payload.ModuleID != nil {
+		if err := s.checkModule(ctx, payload.CourseID, *payload.ModuleID); err != nil {
+			return "", err
+		}
+	}
 
 	task, err := s.tasks.Create(ctx, payload)
 	if err != nil {
@@ -83,6 +99,9 @@ func (s *taskService) Update(ctx context.Context, dto dto.UpdateTaskDTO) (domain
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("failed to get task: %w", err)
 	}
+	if err := s.checkWritable(ctx, task.CourseID); err != nil {
+		return domain.Task{}, err
+	}
 
 	if dto.Title != nil {
 		task.Title = *dto.Title
@@ -90,6 +109,16 @@ func (s *taskService) Update(ctx context.Context, dto dto.UpdateTaskDTO) (domain
 	if dto.Content != nil {
 		task.Content = *dto.Content
 	}
+	if dto.ModuleID != nil {
+		if *dto.ModuleID == "" {
+			task.ModuleID = nil
+		} else {
+			if err := s.checkModule(ctx, task.CourseID, *dto.ModuleID); err != nil {
+				return domain.Task{}, err
+			}
+			task.ModuleID = dto.ModuleID
+		}
+	}
 
 	if err = s.tasks.Update(ctx, task); err != nil {
 		return domain.Task{}, fmt.Errorf("failed to update task: %w", err)
@@ -100,6 +129,13 @@ func (s *taskService) Update(ctx context.Context, dto dto.UpdateTaskDTO) (domain
 }
 
 func (s *taskService) Delete(ctx context.Context, id string) error {
+	task, err := s.tasks.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if err := s.checkWritable(ctx, task.CourseID); err != nil {
+		return err
+	}
 	return s.tasks.Delete(ctx, id)
 }
 
@@ -108,6 +144,9 @@ func (s *taskService) ToggleTaskStatus(ctx context.Context, taskID, userID strin
 	if err != nil {
 		return domain.TaskStatus{}, fmt.Errorf("failed to get task: %w", err)
 	}
+	if err := s.checkWritable(ctx, task.CourseID); err != nil {
+		return domain.TaskStatus{}, err
+	}
 
 	currentStatus, err := s.statuses.Get(ctx, task.ID, userID)
 	if err != nil && !errors.Is(err, domain.ErrNotFound) {
@@ -152,3 +191,76 @@ func (s *taskService) ListByStudentID(ctx context.Context, studentID, courseID s
 	// Берутся все задания с курса, и если записи по статусу нет, то в статус ставится false
 	return s.tasks.ListByStudentID(ctx, studentID, courseID)
 }
+
+// Копирует задания в курс, созданный клонированием в Courses. События о создании
+// заданий не публикуются, чтобы студенты не получали уведомления о копиях
+func (s *taskService) CloneFromCourse(ctx context.Context, sourceCourseID, targetCourseID string) (int, error) {
+	for _, courseID := range []string{sourceCourseID, targetCourseID} {
+		courseExists, err := s.tasks.CourseExists(ctx, courseID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check course exists: %w", err)
+		}
+		if !courseExists {
+			return 0, domain.ErrNotFound
+		}
+	}
+	if err := s.checkWritable(ctx, targetCourseID); err != nil {
+		return 0, err
+	}
+
+	copied, err := s.tasks.CloneFromCourse(ctx, sourceCourseID, targetCourseID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clone tasks: %w", err)
+	}
+	s.logger.Info("tasks cloned", "source_course_id", sourceCourseID, "target_course_id", targetCourseID, "copied", copied)
+	return copied, nil
+}
+
+// Задания архивного и удалённого курса доступны только для чтения
+func (s *taskService) checkWritable(ctx context.Context, courseID string) error {
+	state, err := s.tasks.GetCourseState(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course state: %w", err)
+	}
+	if state.ReadOnly() {
+		return domain.ErrReadOnly
+	}
+	return nil
+}
+
+// Задание можно положить только в модуль своего курса
+func (s *taskService) checkModule(ctx context.Context, courseID, moduleID string) error {
+	moduleExists, err := s.tasks.ModuleExists(ctx, courseID, moduleID)
+	if err != nil {
+		return fmt.Errorf("failed to check module exists: %w", err)
+	}
+	if !moduleExists {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+// Состояние курса приходит событиями из Courses
+func (s *taskService) CourseArchived(ctx context.Context, courseID string, archivedAt time.Time) error {
+	if err := s.tasks.SetCourseArchived(ctx, courseID, archivedAt); err != nil {
+		return fmt.Errorf("failed to set course archived: %w", err)
+	}
+	s.logger.Info("course archived", "course_id", courseID)
+	return nil
+}
+
+func (s *taskService) CourseDeleted(ctx context.Context, courseID string, deletedAt time.Time) error {
+	if err := s.tasks.SetCourseDeleted(ctx, courseID, deletedAt); err != nil {
+		return fmt.Errorf("failed to set course deleted: %w", err)
+	}
+	s.logger.Info("course deleted", "course_id", courseID)
+	return nil
+}
+
+func (s *taskService) CourseRestored(ctx context.Context, courseID string) error {
+	if err := s.tasks.ClearCourseState(ctx, courseID); err != nil {
+		return fmt.Errorf("failed to clear course state: %w", err)
+	}
+	s.logger.Info("course restored", "course_id", courseID)
+	return nil
+}
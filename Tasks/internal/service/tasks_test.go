@@ -9,6 +9,7 @@ import (
 	"context"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,6 +30,7 @@ func TestTaskService_Create(t *testing.T) {
 			name: "success",
 			mockBehavior: func(repo *mocks.MockTaskRepo, pr *mocks.MockProducer, payload dto.CreateTaskDTO) {
 				repo.EXPECT().CourseExists(context.Background(), payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(context.Background(), payload.CourseID).Return(domain.CourseState{CourseID: payload.CourseID}, nil)
 				repo.EXPECT().Create(context.Background(), payload).Return(domain.Task{
 					ID:       "task-id",
 					Title:    payload.Title,
@@ -45,6 +47,21 @@ func TestTaskService_Create(t *testing.T) {
 			},
 			want: "task-id",
 		},
+		{
+			name: "course is archived",
+			mockBehavior: func(repo *mocks.MockTaskRepo, pr *mocks.MockProducer, payload dto.CreateTaskDTO) {
+				archivedAt := time.Now()
+				repo.EXPECT().CourseExists(context.Background(), payload.CourseID).Return(true, nil)
+				repo.EXPECT().GetCourseState(context.Background(), payload.CourseID).
+					Return(domain.CourseState{CourseID: payload.CourseID, ArchivedAt: &archivedAt}, nil)
+			},
+			payload: dto.CreateTaskDTO{
+				CourseID: "course-id",
+				Title:    "title",
+				Content:  "content",
+			},
+			wantErr: domain.ErrReadOnly,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -82,6 +99,7 @@ func TestTaskService_Update(t *testing.T) {
 					Content: "old",
 				}
 				repo.EXPECT().GetByID(mock.Anything, payload.TaskID).Return(task, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, task.CourseID).Return(domain.CourseState{CourseID: task.CourseID}, nil)
 				repo.EXPECT().Update(mock.Anything, domain.Task{
 					ID:      payload.TaskID,
 					Title:   *payload.Title,
@@ -111,6 +129,42 @@ func TestTaskService_Update(t *testing.T) {
 			},
 			wantErr: domain.ErrNotFound,
 		},
+		{
+			name: "move to module",
+			mockBehavior: func(repo *mocks.MockTaskRepo, payload dto.UpdateTaskDTO) {
+				task := domain.Task{ID: payload.TaskID, CourseID: "course-id", Title: "old", Content: "old"}
+				repo.EXPECT().GetByID(mock.Anything, payload.TaskID).Return(task, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, task.CourseID).Return(domain.CourseState{CourseID: task.CourseID}, nil)
+				repo.EXPECT().ModuleExists(mock.Anything, task.CourseID, *payload.ModuleID).Return(true, nil)
+				task.ModuleID = payload.ModuleID
+				repo.EXPECT().Update(mock.Anything, task).Return(nil)
+			},
+			payload: dto.UpdateTaskDTO{
+				TaskID:   "task-id",
+				ModuleID: strPtr("module-id"),
+			},
+			want: domain.Task{
+				ID:       "task-id",
+				CourseID: "course-id",
+				Title:    "old",
+				Content:  "old",
+				ModuleID: strPtr("module-id"),
+			},
+		},
+		{
+			name: "module of another course",
+			mockBehavior: func(repo *mocks.MockTaskRepo, payload dto.UpdateTaskDTO) {
+				task := domain.Task{ID: payload.TaskID, CourseID: "course-id"}
+				repo.EXPECT().GetByID(mock.Anything, payload.TaskID).Return(task, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, task.CourseID).Return(domain.CourseState{CourseID: task.CourseID}, nil)
+				repo.EXPECT().ModuleExists(mock.Anything, task.CourseID, *payload.ModuleID).Return(false, nil)
+			},
+			payload: dto.UpdateTaskDTO{
+				TaskID:   "task-id",
+				ModuleID: strPtr("module-id"),
+			},
+			wantErr: domain.ErrInvalidInput,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -146,6 +200,7 @@ func TestTaskService_ToggleTaskStatus(t *testing.T) {
 			name: "need to create status",
 			mockBehavior: func(tasks *mocks.MockTaskRepo, statuses *mocks.MockStatusRepo, args args) {
 				tasks.EXPECT().GetByID(mock.Anything, args.TaskID).Return(domain.Task{ID: args.TaskID}, nil)
+				tasks.EXPECT().GetCourseState(mock.Anything, "").Return(domain.CourseState{}, nil)
 				statuses.EXPECT().Get(mock.Anything, args.TaskID, args.UserID).Return(domain.TaskStatus{}, domain.ErrNotFound)
 				statuses.EXPECT().Create(mock.Anything, domain.TaskStatus{
 					TaskID:    args.TaskID,
@@ -167,6 +222,7 @@ func TestTaskService_ToggleTaskStatus(t *testing.T) {
 			name: "need to update status",
 			mockBehavior: func(tasks *mocks.MockTaskRepo, statuses *mocks.MockStatusRepo, args args) {
 				tasks.EXPECT().GetByID(mock.Anything, args.TaskID).Return(domain.Task{ID: args.TaskID}, nil)
+				tasks.EXPECT().GetCourseState(mock.Anything, "").Return(domain.CourseState{}, nil)
 				statuses.EXPECT().Get(mock.Anything, args.TaskID, args.UserID).Return(domain.TaskStatus{
 					TaskID:    args.TaskID,
 					UserID:    args.UserID,
@@ -210,3 +266,65 @@ func TestTaskService_ToggleTaskStatus(t *testing.T) {
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestTaskService_CloneFromCourse(t *testing.T) {
+	type MockBehavior func(repo *mocks.MockTaskRepo)
+
+	const (
+		sourceID = "source-id"
+		targetID = "target-id"
+	)
+
+	testCases := []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         int
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockTaskRepo) {
+				repo.EXPECT().CourseExists(mock.Anything, sourceID).Return(true, nil)
+				repo.EXPECT().CourseExists(mock.Anything, targetID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, targetID).Return(domain.CourseState{CourseID: targetID}, nil)
+				repo.EXPECT().CloneFromCourse(mock.Anything, sourceID, targetID).Return(3, nil)
+			},
+			want: 3,
+		},
+		{
+			name: "target course not found",
+			mockBehavior: func(repo *mocks.MockTaskRepo) {
+				repo.EXPECT().CourseExists(mock.Anything, sourceID).Return(true, nil)
+				repo.EXPECT().CourseExists(mock.Anything, targetID).Return(false, nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "target course is deleted",
+			mockBehavior: func(repo *mocks.MockTaskRepo) {
+				deletedAt := time.Now()
+				repo.EXPECT().CourseExists(mock.Anything, sourceID).Return(true, nil)
+				repo.EXPECT().CourseExists(mock.Anything, targetID).Return(true, nil)
+				repo.EXPECT().GetCourseState(mock.Anything, targetID).
+					Return(domain.CourseState{CourseID: targetID, DeletedAt: &deletedAt}, nil)
+			},
+			wantErr: domain.ErrReadOnly,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewMockTaskRepo(t)
+			pr := mocks.NewMockProducer(t)
+			tc.mockBehavior(repo)
+			svc := service.NewTaskService(slog.Default(), repo, nil, pr)
+			got, err := svc.CloneFromCourse(context.Background(), sourceID, targetID)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
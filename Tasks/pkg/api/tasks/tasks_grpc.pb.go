@@ -27,6 +27,7 @@ const (
 	TasksService_UpdateTask_FullMethodName         = "/tasks.TasksService/UpdateTask"
 	TasksService_ChangeStatusTask_FullMethodName   = "/tasks.TasksService/ChangeStatusTask"
 	TasksService_DeleteTask_FullMethodName         = "/tasks.TasksService/DeleteTask"
+	TasksService_CloneFromCourse_FullMethodName    = "/tasks.TasksService/CloneFromCourse"
 )
 
 // TasksServiceClient is the client API for TasksService service.
@@ -41,6 +42,7 @@ type TasksServiceClient interface {
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
 	ChangeStatusTask(ctx context.Context, in *ChangeStatusTaskRequest, opts ...grpc.CallOption) (*ChangeStatusTaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	CloneFromCourse(ctx context.Context, in *CloneFromCourseRequest, opts ...grpc.CallOption) (*CloneFromCourseResponse, error)
 }
 
 type tasksServiceClient struct {
@@ -131,6 +133,16 @@ func (c *tasksServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskReque
 	return out, nil
 }
 
+func (c *tasksServiceClient) CloneFromCourse(ctx context.Context, in *CloneFromCourseRequest, opts ...grpc.CallOption) (*CloneFromCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneFromCourseResponse)
+	err := c.cc.Invoke(ctx, TasksService_CloneFromCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TasksServiceServer is the server API for TasksService service.
 // All implementations must embed UnimplementedTasksServiceServer
 // for forward compatibility.
@@ -143,6 +155,7 @@ type TasksServiceServer interface {
 	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
 	ChangeStatusTask(context.Context, *ChangeStatusTaskRequest) (*ChangeStatusTaskResponse, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	CloneFromCourse(context.Context, *CloneFromCourseRequest) (*CloneFromCourseResponse, error)
 	mustEmbedUnimplementedTasksServiceServer()
 }
 
@@ -177,6 +190,9 @@ func (UnimplementedTasksServiceServer) ChangeStatusTask(context.Context, *Change
 func (UnimplementedTasksServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTasksServiceServer) CloneFromCourse(context.Context, *CloneFromCourseRequest) (*CloneFromCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneFromCourse not implemented")
+}
 func (UnimplementedTasksServiceServer) mustEmbedUnimplementedTasksServiceServer() {}
 func (UnimplementedTasksServiceServer) testEmbeddedByValue()                      {}
 
@@ -342,6 +358,24 @@ func _TasksService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TasksService_CloneFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneFromCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).CloneFromCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_CloneFromCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).CloneFromCourse(ctx, req.(*CloneFromCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TasksService_ServiceDesc is the grpc.ServiceDesc for TasksService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +415,10 @@ var TasksService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _TasksService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "CloneFromCourse",
+			Handler:    _TasksService_CloneFromCourse_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "Common/Proto/tasks.proto",